@@ -0,0 +1,354 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	formatJSON    = "json"
+	formatParquet = "parquet"
+
+	// parquetBatchRows is the number of rows buffered before a row group is written.
+	parquetBatchRows = 4096
+)
+
+// segmentDumper reads the rows of a segment from its binlogs and applies the deletes in the deltalogs.
+type segmentDumper struct {
+	cm      storage.ChunkManager
+	schema  *schemapb.CollectionSchema
+	pkField *schemapb.FieldSchema
+
+	pks     map[any]struct{} // nil means no primary key filter
+	startTs uint64
+	endTs   uint64
+}
+
+func newSegmentDumper(cm storage.ChunkManager, schema *schemapb.CollectionSchema, pks string, startTs, endTs uint64) (*segmentDumper, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	if endTs == 0 {
+		endTs = math.MaxUint64
+	}
+	if startTs > endTs {
+		return nil, fmt.Errorf("invalid timestamp range [%d, %d]", startTs, endTs)
+	}
+	d := &segmentDumper{
+		cm:      cm,
+		schema:  schema,
+		pkField: pkField,
+		startTs: startTs,
+		endTs:   endTs,
+	}
+	if len(pks) > 0 {
+		d.pks, err = parsePks(pkField, pks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// parsePks parses the comma separated primary keys according to the type of the primary key field.
+func parsePks(pkField *schemapb.FieldSchema, pks string) (map[any]struct{}, error) {
+	result := make(map[any]struct{})
+	for _, s := range strings.Split(pks, ",") {
+		s = strings.TrimSpace(s)
+		switch pkField.GetDataType() {
+		case schemapb.DataType_Int64:
+			pk, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid int64 primary key: %s", s)
+			}
+			result[pk] = struct{}{}
+		case schemapb.DataType_VarChar:
+			result[s] = struct{}{}
+		default:
+			return nil, fmt.Errorf("unsupported primary key type: %s", pkField.GetDataType().String())
+		}
+	}
+	return result, nil
+}
+
+// LoadDeletes reads the deltalogs of the segments, and returns the latest delete timestamp of each primary key.
+// Deletes after the end timestamp are ignored so that the dump reflects the segment at the end timestamp.
+func (d *segmentDumper) LoadDeletes(ctx context.Context, segments ...*datapb.SegmentInfo) (map[any]uint64, error) {
+	deleteData := storage.NewDeleteData(nil, nil)
+	for _, segment := range segments {
+		paths := lo.FlatMap(segment.GetDeltalogs(), func(fieldBinlog *datapb.FieldBinlog, _ int) []string {
+			return lo.Map(fieldBinlog.GetBinlogs(), func(binlog *datapb.Binlog, _ int) string {
+				return binlog.GetLogPath()
+			})
+		})
+		if len(paths) == 0 {
+			continue
+		}
+		values, err := d.cm.MultiRead(ctx, paths)
+		if err != nil {
+			return nil, err
+		}
+		blobs := lo.Map(values, func(v []byte, i int) *storage.Blob {
+			return &storage.Blob{Key: paths[i], Value: v}
+		})
+		reader, err := storage.NewDeltalogDeserializeReader(blobs)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			if err = reader.Next(); err != nil {
+				break
+			}
+			dl := reader.Value()
+			deleteData.Append(dl.Pk, dl.Ts)
+		}
+		reader.Close()
+		if err != io.EOF {
+			return nil, err
+		}
+	}
+
+	deletes := make(map[any]uint64)
+	for i, pk := range deleteData.Pks {
+		ts := deleteData.Tss[i]
+		if ts > d.endTs {
+			continue
+		}
+		if ts > deletes[pk.GetValue()] {
+			deletes[pk.GetValue()] = ts
+		}
+	}
+	return deletes, nil
+}
+
+// Dump writes the rows of the segment which pass the filters and are not deleted, it returns the number of dumped rows.
+func (d *segmentDumper) Dump(ctx context.Context, segment *datapb.SegmentInfo, deletes map[any]uint64, w rowWriter) (int64, error) {
+	var batchCount int
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		batchCount = len(fieldBinlog.GetBinlogs())
+		break
+	}
+
+	var rows int64
+	for idx := 0; idx < batchCount; idx++ {
+		paths := make([]string, 0, len(segment.GetBinlogs()))
+		for _, fieldBinlog := range segment.GetBinlogs() {
+			paths = append(paths, fieldBinlog.GetBinlogs()[idx].GetLogPath())
+		}
+		values, err := d.cm.MultiRead(ctx, paths)
+		if err != nil {
+			return rows, err
+		}
+		blobs := lo.Map(values, func(v []byte, i int) *storage.Blob {
+			return &storage.Blob{Key: paths[i], Value: v}
+		})
+		reader, err := storage.NewBinlogDeserializeReader(blobs, d.pkField.GetFieldID())
+		if err != nil {
+			return rows, err
+		}
+		for {
+			if err = reader.Next(); err != nil {
+				break
+			}
+			v := reader.Value()
+			if !d.match(v, deletes) {
+				continue
+			}
+			if err = w.Write(v.Value.(map[storage.FieldID]interface{})); err != nil {
+				break
+			}
+			rows++
+		}
+		reader.Close()
+		if err != io.EOF {
+			return rows, err
+		}
+	}
+	return rows, nil
+}
+
+func (d *segmentDumper) match(v *storage.Value, deletes map[any]uint64) bool {
+	ts := uint64(v.Timestamp)
+	if ts < d.startTs || ts > d.endTs {
+		return false
+	}
+	pk := v.PK.GetValue()
+	if d.pks != nil {
+		if _, ok := d.pks[pk]; !ok {
+			return false
+		}
+	}
+	// the insert and delete of an upsert have the same timestamp, the row is kept
+	deleteTs, ok := deletes[pk]
+	return !ok || ts >= deleteTs
+}
+
+// rowWriter writes the dumped rows, a row maps field id to the value deserialized from the binlog.
+type rowWriter interface {
+	Write(row map[storage.FieldID]interface{}) error
+	Close() error
+}
+
+// jsonRowWriter writes a JSON object per line, keyed by field name.
+type jsonRowWriter struct {
+	encoder *json.Encoder
+	fields  []*schemapb.FieldSchema
+}
+
+func newJSONRowWriter(w io.Writer, schema *schemapb.CollectionSchema) *jsonRowWriter {
+	return &jsonRowWriter{
+		encoder: json.NewEncoder(w),
+		fields:  schema.GetFields(),
+	}
+}
+
+func (w *jsonRowWriter) Write(row map[storage.FieldID]interface{}) error {
+	obj := make(map[string]any, len(w.fields))
+	for _, field := range w.fields {
+		value, ok := row[field.GetFieldID()]
+		if !ok {
+			continue
+		}
+		obj[field.GetName()] = toJSONValue(field, value)
+	}
+	return w.encoder.Encode(obj)
+}
+
+func (w *jsonRowWriter) Close() error {
+	return nil
+}
+
+// toJSONValue converts the deserialized value to the representation accepted by the JSON import.
+func toJSONValue(field *schemapb.FieldSchema, value any) any {
+	if value == nil {
+		return nil
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_JSON:
+		return json.RawMessage(value.([]byte))
+	case schemapb.DataType_BinaryVector:
+		return lo.Map(value.([]byte), func(b byte, _ int) int {
+			return int(b)
+		})
+	case schemapb.DataType_Float16Vector:
+		return typeutil.Float16BytesToFloat32Vector(value.([]byte))
+	case schemapb.DataType_BFloat16Vector:
+		return typeutil.BFloat16BytesToFloat32Vector(value.([]byte))
+	case schemapb.DataType_SparseFloatVector:
+		return typeutil.SparseFloatBytesToMap(value.([]byte))
+	case schemapb.DataType_Array:
+		scalar := value.(*schemapb.ScalarField)
+		switch scalar.GetData().(type) {
+		case *schemapb.ScalarField_BoolData:
+			return scalar.GetBoolData().GetData()
+		case *schemapb.ScalarField_IntData:
+			return scalar.GetIntData().GetData()
+		case *schemapb.ScalarField_LongData:
+			return scalar.GetLongData().GetData()
+		case *schemapb.ScalarField_FloatData:
+			return scalar.GetFloatData().GetData()
+		case *schemapb.ScalarField_DoubleData:
+			return scalar.GetDoubleData().GetData()
+		case *schemapb.ScalarField_StringData:
+			return scalar.GetStringData().GetData()
+		default:
+			return nil
+		}
+	default:
+		return value
+	}
+}
+
+// parquetRowWriter buffers the rows and writes them as parquet row groups, system fields are not written.
+type parquetRowWriter struct {
+	schema *schemapb.CollectionSchema
+	buffer *storage.InsertData
+	writer *parquet.Writer
+}
+
+func newParquetRowWriter(w io.Writer, schema *schemapb.CollectionSchema) (*parquetRowWriter, error) {
+	// the primary key is dumped even if it is auto generated
+	userSchema := typeutil.Clone(schema)
+	userSchema.Fields = lo.Filter(userSchema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return field.GetFieldID() >= common.StartOfUserFieldID
+	})
+	for _, field := range userSchema.GetFields() {
+		field.AutoID = false
+	}
+	writer, err := parquet.NewWriter(w, userSchema)
+	if err != nil {
+		return nil, err
+	}
+	buffer, err := storage.NewInsertData(userSchema)
+	if err != nil {
+		return nil, err
+	}
+	return &parquetRowWriter{
+		schema: userSchema,
+		buffer: buffer,
+		writer: writer,
+	}, nil
+}
+
+func (w *parquetRowWriter) Write(row map[storage.FieldID]interface{}) error {
+	userRow := lo.PickBy(row, func(fieldID storage.FieldID, _ interface{}) bool {
+		return fieldID >= common.StartOfUserFieldID
+	})
+	if err := w.buffer.Append(userRow); err != nil {
+		return err
+	}
+	if w.buffer.GetRowNum() >= parquetBatchRows {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *parquetRowWriter) flush() error {
+	if err := w.writer.Write(w.buffer); err != nil {
+		return err
+	}
+	buffer, err := storage.NewInsertData(w.schema)
+	if err != nil {
+		return err
+	}
+	w.buffer = buffer
+	return nil
+}
+
+func (w *parquetRowWriter) Close() error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+	return w.writer.Close()
+}
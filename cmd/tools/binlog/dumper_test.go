@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+const (
+	testCollectionID = 1
+	testPartitionID  = 2
+	testSegmentID    = 3
+)
+
+func newTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+			{
+				FieldID:    101,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+			{FieldID: 102, Name: "json", DataType: schemapb.DataType_JSON},
+		},
+	}
+}
+
+// prepareSegment writes 10 rows with pk i and timestamp 100+i, pk 1 is deleted at 200 and pk 2 is deleted at 50.
+func prepareSegment(t *testing.T, cm storage.ChunkManager, schema *schemapb.CollectionSchema) *datapb.SegmentInfo {
+	ctx := context.Background()
+	insertData, err := storage.NewInsertData(schema)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, insertData.Append(map[storage.FieldID]interface{}{
+			common.RowIDField:     int64(i),
+			common.TimeStampField: int64(100 + i),
+			100:                   int64(i),
+			101:                   []float32{float32(i), float32(i)},
+			102:                   []byte(fmt.Sprintf(`{"a": %d}`, i)),
+		}))
+	}
+	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: testCollectionID, Schema: schema})
+	blobs, err := codec.Serialize(testPartitionID, testSegmentID, insertData)
+	require.NoError(t, err)
+
+	segment := &datapb.SegmentInfo{ID: testSegmentID, CollectionID: testCollectionID, PartitionID: testPartitionID}
+	for i, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.Key, 10, 64)
+		require.NoError(t, err)
+		logPath := metautil.BuildInsertLogPath(cm.RootPath(), testCollectionID, testPartitionID, testSegmentID, fieldID, int64(i))
+		require.NoError(t, cm.Write(ctx, logPath, blob.Value))
+		segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogPath: logPath}},
+		})
+	}
+
+	deleteData := storage.NewDeleteData(
+		[]storage.PrimaryKey{storage.NewInt64PrimaryKey(1), storage.NewInt64PrimaryKey(2)},
+		[]storage.Timestamp{200, 50})
+	blob, err := storage.NewDeleteCodec().Serialize(testCollectionID, testPartitionID, testSegmentID, deleteData)
+	require.NoError(t, err)
+	logPath := metautil.BuildDeltaLogPath(cm.RootPath(), testCollectionID, testPartitionID, testSegmentID, 100)
+	require.NoError(t, cm.Write(ctx, logPath, blob.Value))
+	segment.Deltalogs = []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: logPath}}}}
+	return segment
+}
+
+func dumpJSON(t *testing.T, cm storage.ChunkManager, schema *schemapb.CollectionSchema, segment *datapb.SegmentInfo,
+	pks string, startTs, endTs uint64,
+) []map[string]any {
+	ctx := context.Background()
+	dumper, err := newSegmentDumper(cm, schema, pks, startTs, endTs)
+	require.NoError(t, err)
+	deletes, err := dumper.LoadDeletes(ctx, segment)
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	w := newJSONRowWriter(buf, schema)
+	rows, err := dumper.Dump(ctx, segment, deletes, w)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	result := make([]map[string]any, 0)
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		row := make(map[string]any)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+		result = append(result, row)
+	}
+	assert.Equal(t, int64(len(result)), rows)
+	return result
+}
+
+func TestSegmentDumper_Dump(t *testing.T) {
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	schema := newTestSchema()
+	segment := prepareSegment(t, cm, schema)
+
+	rows := dumpJSON(t, cm, schema, segment, "", 0, 0)
+	assert.Equal(t, 9, len(rows))
+	for _, row := range rows {
+		assert.NotEqual(t, float64(1), row["pk"])
+	}
+	assert.Equal(t, float64(0), rows[0]["pk"])
+	assert.Equal(t, []any{float64(0), float64(0)}, rows[0]["vec"])
+	assert.Equal(t, map[string]any{"a": float64(0)}, rows[0]["json"])
+	assert.Equal(t, float64(100), rows[0][common.TimeStampFieldName])
+
+	// the delete of pk 1 happens after the end timestamp
+	rows = dumpJSON(t, cm, schema, segment, "", 0, 105)
+	assert.Equal(t, 6, len(rows))
+
+	rows = dumpJSON(t, cm, schema, segment, "", 108, 0)
+	assert.Equal(t, 2, len(rows))
+
+	rows = dumpJSON(t, cm, schema, segment, "1, 2,3", 0, 0)
+	assert.Equal(t, 2, len(rows))
+
+	_, err := newSegmentDumper(cm, schema, "a", 0, 0)
+	assert.Error(t, err)
+	_, err = newSegmentDumper(cm, schema, "", 10, 5)
+	assert.Error(t, err)
+}
+
+func TestSegmentDumper_Parquet(t *testing.T) {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	schema := newTestSchema()
+	segment := prepareSegment(t, cm, schema)
+
+	dumper, err := newSegmentDumper(cm, schema, "", 0, 0)
+	require.NoError(t, err)
+	deletes, err := dumper.LoadDeletes(ctx, segment)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	w, err := newParquetRowWriter(buf, schema)
+	require.NoError(t, err)
+	rows, err := dumper.Dump(ctx, segment, deletes, w)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), rows)
+	assert.NoError(t, w.Close())
+	assert.Equal(t, int64(9), w.writer.Rows())
+	assert.Equal(t, 3, len(w.schema.GetFields()))
+	assert.False(t, w.schema.GetFields()[0].GetAutoID())
+}
@@ -23,9 +23,21 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 )
 
+const usage = `usage:
+  binlog file1 file2 ...
+  binlog segment-dump -config milvus.yaml -segment id [-pks pk1,pk2] [-start-ts ts] [-end-ts ts] [-format json|parquet] [-output file]`
+
 func main() {
 	if len(os.Args) == 1 {
-		fmt.Println("usage: binlog file1 file2 ...")
+		fmt.Println(usage)
+		return
+	}
+	if os.Args[1] == "segment-dump" {
+		if err := segmentDump(os.Args[2:]); err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			os.Exit(1)
+		}
+		return
 	}
 	if err := storage.PrintBinlogFiles(os.Args[1:]); err != nil {
 		fmt.Printf("error: %s\n", err.Error())
@@ -0,0 +1,222 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	kv_tikv "github.com/milvus-io/milvus/internal/kv/tikv"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/kv"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tikv"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type segmentDumpArgs struct {
+	config    string
+	segmentID int64
+	pks       string
+	startTs   uint64
+	endTs     uint64
+	format    string
+	output    string
+}
+
+// segmentDump resolves the binlogs of a segment through the datacoord catalog, reads them from the object storage,
+// applies the deletes of the segment and the L0 segments of the same channel, and prints or writes the rows.
+func segmentDump(args []string) error {
+	a := &segmentDumpArgs{}
+	flags := flag.NewFlagSet("segment-dump", flag.ExitOnError)
+	flags.StringVar(&a.config, "config", "", "Path to the milvus configuration file")
+	flags.Int64Var(&a.segmentID, "segment", 0, "Segment ID to dump")
+	flags.StringVar(&a.pks, "pks", "", "Comma separated primary keys to filter with")
+	flags.Uint64Var(&a.startTs, "start-ts", 0, "Dump the rows inserted at or after the timestamp")
+	flags.Uint64Var(&a.endTs, "end-ts", 0, "Dump the rows inserted at or before the timestamp, deletes after it are ignored, 0 means no limit")
+	flags.StringVar(&a.format, "format", formatJSON, "Output format, json or parquet")
+	flags.StringVar(&a.output, "output", "", "Output file path, the rows are printed to stdout if not set")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if a.config == "" || a.segmentID == 0 {
+		flags.Usage()
+		return fmt.Errorf("config and segment are required")
+	}
+	if a.format != formatJSON && a.format != formatParquet {
+		return fmt.Errorf("unsupported format: %s", a.format)
+	}
+	if a.format == formatParquet && a.output == "" {
+		return fmt.Errorf("output is required for parquet format")
+	}
+
+	ctx := context.Background()
+	paramtable.Get().Init(paramtable.NewBaseTableFromYamlOnly(a.config))
+	metaKV, err := metaKVCreator()
+	if err != nil {
+		return err
+	}
+	segments, err := datacoord.NewCatalog(metaKV, "", metaRootPath()).ListSegments(ctx)
+	if err != nil {
+		return err
+	}
+	segment, ok := lo.Find(segments, func(s *datapb.SegmentInfo) bool {
+		return s.GetID() == a.segmentID
+	})
+	if !ok {
+		return fmt.Errorf("segment %d not found", a.segmentID)
+	}
+	// the deletes of the L0 segments are applied to the segments of the same channel
+	l0Segments := lo.Filter(segments, func(s *datapb.SegmentInfo, _ int) bool {
+		return s.GetID() != segment.GetID() &&
+			s.GetLevel() == datapb.SegmentLevel_L0 &&
+			s.GetState() != commonpb.SegmentState_Dropped &&
+			s.GetInsertChannel() == segment.GetInsertChannel() &&
+			(s.GetPartitionID() == common.AllPartitionsID || s.GetPartitionID() == segment.GetPartitionID())
+	})
+	deleteSegments := append(l0Segments, segment)
+	for _, s := range deleteSegments {
+		if err = binlog.DecompressBinLogs(s); err != nil {
+			return err
+		}
+	}
+
+	schema, err := getCollectionSchema(ctx, metaKV, segment.GetCollectionID())
+	if err != nil {
+		return err
+	}
+	cm, err := storage.NewChunkManagerFactoryWithParam(paramtable.Get()).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		return err
+	}
+	dumper, err := newSegmentDumper(cm, schema, a.pks, a.startTs, a.endTs)
+	if err != nil {
+		return err
+	}
+	deletes, err := dumper.LoadDeletes(ctx, deleteSegments...)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if a.output != "" {
+		f, err := os.Create(a.output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	var w rowWriter
+	if a.format == formatParquet {
+		w, err = newParquetRowWriter(out, schema)
+		if err != nil {
+			return err
+		}
+	} else {
+		w = newJSONRowWriter(out, schema)
+	}
+	rows, err := dumper.Dump(ctx, segment, deletes, w)
+	if err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "dump segment %d complete, rows: %d, deleted pks: %d\n", a.segmentID, rows, len(deletes))
+	return nil
+}
+
+func metaRootPath() string {
+	if paramtable.Get().MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeTiKV {
+		return paramtable.Get().TiKVCfg.MetaRootPath.GetValue()
+	}
+	return paramtable.Get().EtcdCfg.MetaRootPath.GetValue()
+}
+
+func metaKVCreator() (kv.MetaKv, error) {
+	if paramtable.Get().MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeTiKV {
+		tikvCli, err := tikv.GetTiKVClient(&paramtable.Get().TiKVCfg)
+		if err != nil {
+			return nil, err
+		}
+		return kv_tikv.NewTiKV(tikvCli, metaRootPath()), nil
+	}
+	etcdConfig := &paramtable.Get().EtcdCfg
+	etcdCli, err := etcd.CreateEtcdClient(
+		etcdConfig.UseEmbedEtcd.GetAsBool(),
+		etcdConfig.EtcdEnableAuth.GetAsBool(),
+		etcdConfig.EtcdAuthUserName.GetValue(),
+		etcdConfig.EtcdAuthPassword.GetValue(),
+		etcdConfig.EtcdUseSSL.GetAsBool(),
+		etcdConfig.Endpoints.GetAsStrings(),
+		etcdConfig.EtcdTLSCert.GetValue(),
+		etcdConfig.EtcdTLSKey.GetValue(),
+		etcdConfig.EtcdTLSCACert.GetValue(),
+		etcdConfig.EtcdTLSMinVersion.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return etcdkv.NewEtcdKV(etcdCli, metaRootPath()), nil
+}
+
+// getCollectionSchema loads the latest schema of the collection from the rootcoord catalog,
+// the segment meta doesn't record the database, so all databases are searched.
+func getCollectionSchema(ctx context.Context, metaKV kv.MetaKv, collectionID int64) (*schemapb.CollectionSchema, error) {
+	ss, err := kvmetestore.NewSuffixSnapshot(metaKV, kvmetestore.SnapshotsSep, metaRootPath(), kvmetestore.SnapshotPrefix)
+	if err != nil {
+		return nil, err
+	}
+	catalog := &kvmetestore.Catalog{Txn: metaKV, Snapshot: ss}
+	dbs, err := catalog.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	dbIDs := append([]int64{util.NonDBID}, lo.Map(dbs, func(db *model.Database, _ int) int64 {
+		return db.ID
+	})...)
+	for _, dbID := range dbIDs {
+		coll, err := catalog.GetCollectionByID(ctx, dbID, typeutil.MaxTimestamp, collectionID)
+		if err != nil {
+			continue
+		}
+		return &schemapb.CollectionSchema{
+			Name:               coll.Name,
+			Description:        coll.Description,
+			AutoID:             coll.AutoID,
+			Fields:             model.MarshalFieldModels(coll.Fields),
+			EnableDynamicField: coll.EnableDynamicField,
+		}, nil
+	}
+	return nil, fmt.Errorf("collection %d not found", collectionID)
+}
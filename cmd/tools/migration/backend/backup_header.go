@@ -10,10 +10,18 @@ type BackupHeaderVersion int32
 
 const (
 	BackupHeaderVersionV1 BackupHeaderVersion = iota
+	// BackupHeaderVersionV2 is the metadata snapshot format, entries are relative to the instance root path
+	// and the extra carries the milvus version and the checksum of the entries.
+	BackupHeaderVersionV2
 )
 
 type BackupHeaderExtra struct {
 	EntryIncludeRootPath bool `json:"entry_include_root_path"`
+	// fields below are only set by snapshot
+	MilvusVersion string   `json:"milvus_version,omitempty"`
+	Checksum      string   `json:"checksum,omitempty"`
+	Prefixes      []string `json:"prefixes,omitempty"`
+	CreateTime    string   `json:"create_time,omitempty"`
 }
 
 type extraOption func(extra *BackupHeaderExtra)
@@ -24,6 +32,30 @@ func setEntryIncludeRootPath(include bool) extraOption {
 	}
 }
 
+func setMilvusVersion(version string) extraOption {
+	return func(extra *BackupHeaderExtra) {
+		extra.MilvusVersion = version
+	}
+}
+
+func setChecksum(checksum string) extraOption {
+	return func(extra *BackupHeaderExtra) {
+		extra.Checksum = checksum
+	}
+}
+
+func setPrefixes(prefixes []string) extraOption {
+	return func(extra *BackupHeaderExtra) {
+		extra.Prefixes = prefixes
+	}
+}
+
+func setCreateTime(createTime string) extraOption {
+	return func(extra *BackupHeaderExtra) {
+		extra.CreateTime = createTime
+	}
+}
+
 func newDefaultBackupHeaderExtra() *BackupHeaderExtra {
 	return &BackupHeaderExtra{EntryIncludeRootPath: false}
 }
@@ -0,0 +1,245 @@
+package backend
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/cmd/tools/migration/configs"
+	"github.com/milvus-io/milvus/cmd/tools/migration/console"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	kv_tikv "github.com/milvus-io/milvus/internal/kv/tikv"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/metastore/kv/streamingcoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/kv"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/tikv"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// snapshotRestoreBatchSize is the number of entries saved in one transaction while restoring,
+// etcd limits the number of operations in a transaction to 128 by default.
+const snapshotRestoreBatchSize = 64
+
+type snapshotPrefix struct {
+	component string
+	// kv is true if the prefix is under the kv sub path, e.g. the id and tso allocators, or else the meta sub path.
+	kv     bool
+	prefix string
+}
+
+// snapshotPrefixes are the catalog prefixes dumped by snapshot.
+var snapshotPrefixes = []snapshotPrefix{
+	{component: typeutil.RootCoordRole, prefix: rootcoord.ComponentPrefix},
+	{component: typeutil.RootCoordRole, prefix: rootcoord.SnapshotPrefix},
+	{component: typeutil.RootCoordRole, kv: true, prefix: "gid"},
+	{component: typeutil.RootCoordRole, kv: true, prefix: "tso"},
+
+	{component: typeutil.DataCoordRole, prefix: datacoord.MetaPrefix},
+	{component: typeutil.DataCoordRole, prefix: util.FieldIndexPrefix},
+	{component: typeutil.DataCoordRole, prefix: util.SegmentIndexPrefix},
+
+	{component: typeutil.QueryCoordRole, prefix: querycoord.CollectionLoadInfoPrefix},
+	{component: typeutil.QueryCoordRole, prefix: querycoord.PartitionLoadInfoPrefix},
+	{component: typeutil.QueryCoordRole, prefix: querycoord.ReplicaPrefix},
+	{component: typeutil.QueryCoordRole, prefix: querycoord.ResourceGroupPrefix},
+	{component: typeutil.QueryCoordRole, prefix: querycoord.CollectionTargetPrefix},
+
+	{component: typeutil.StreamingCoordRole, prefix: streamingcoord.MetaPrefix},
+}
+
+// Snapshotter dumps the catalogs of all coordinators into a versioned and checksummed archive,
+// and restores the archive into an empty meta store.
+type Snapshotter struct {
+	txn         kv.TxnKV // rooted at the instance root path
+	rootPath    string
+	metaSubPath string
+	kvSubPath   string
+}
+
+func NewSnapshotter(cfg *configs.MilvusConfig) (*Snapshotter, error) {
+	switch cfg.MetaStoreCfg.MetaStoreType.GetValue() {
+	case util.MetaStoreTypeEtcd:
+		b, err := newEtcdBasedBackend(cfg)
+		if err != nil {
+			return nil, err
+		}
+		rootPath := cfg.EtcdCfg.RootPath.GetValue()
+		return newSnapshotter(etcdkv.NewEtcdKV(b.etcdCli, rootPath), rootPath,
+			cfg.EtcdCfg.MetaSubPath.GetValue(), cfg.EtcdCfg.KvSubPath.GetValue()), nil
+	case util.MetaStoreTypeTiKV:
+		cli, err := tikv.GetTiKVClient(cfg.TiKVCfg)
+		if err != nil {
+			return nil, err
+		}
+		rootPath := cfg.TiKVCfg.RootPath.GetValue()
+		return newSnapshotter(kv_tikv.NewTiKV(cli, rootPath), rootPath,
+			cfg.TiKVCfg.MetaSubPath.GetValue(), cfg.TiKVCfg.KvSubPath.GetValue()), nil
+	default:
+		return nil, fmt.Errorf("%s is not supported now", cfg.MetaStoreCfg.MetaStoreType.GetValue())
+	}
+}
+
+func newSnapshotter(txn kv.TxnKV, rootPath, metaSubPath, kvSubPath string) *Snapshotter {
+	return &Snapshotter{
+		txn:         txn,
+		rootPath:    rootPath,
+		metaSubPath: metaSubPath,
+		kvSubPath:   kvSubPath,
+	}
+}
+
+func (s *Snapshotter) fullPrefix(p snapshotPrefix) string {
+	if p.kv {
+		return path.Join(s.kvSubPath, p.prefix)
+	}
+	return path.Join(s.metaSubPath, p.prefix)
+}
+
+// relativeKey trims the root path from the key returned by the meta store.
+func (s *Snapshotter) relativeKey(key string) string {
+	if len(s.rootPath) == 0 {
+		return key
+	}
+	return strings.TrimPrefix(key, s.rootPath+"/")
+}
+
+// Snapshot dumps the catalog prefixes into @file, @version is the milvus version of the cluster.
+func (s *Snapshotter) Snapshot(file string, version string) error {
+	if _, err := semver.Parse(version); err != nil {
+		return err
+	}
+	saves := make(map[string]string)
+	prefixes := make([]string, 0, len(snapshotPrefixes))
+	for _, p := range snapshotPrefixes {
+		prefix := s.fullPrefix(p)
+		keys, values, err := s.txn.LoadWithPrefix(prefix)
+		if err != nil {
+			return err
+		}
+		for i, key := range keys {
+			saves[s.relativeKey(key)] = values[i]
+		}
+		prefixes = append(prefixes, prefix)
+		console.Warning(fmt.Sprintf("snapshot prefix: %s, entries: %d", prefix, len(keys)))
+	}
+
+	components := lo.Uniq(lo.Map(snapshotPrefixes, func(p snapshotPrefix, _ int) string {
+		return p.component
+	}))
+	header := &BackupHeader{
+		Version:   int32(BackupHeaderVersionV2),
+		Instance:  s.rootPath,
+		MetaPath:  s.metaSubPath,
+		Entries:   int64(len(saves)),
+		Component: strings.Join(components, ","),
+		Extra: newBackupHeaderExtra(
+			setMilvusVersion(version),
+			setChecksum(snapshotChecksum(saves)),
+			setPrefixes(prefixes),
+			setCreateTime(time.Now().Format(time.RFC3339)),
+		).ToJSONBytes(),
+	}
+	backup, err := NewBackupCodec().Serialize(header, saves)
+	if err != nil {
+		return err
+	}
+	console.Warning(fmt.Sprintf("snapshot %d entries to: %s", len(saves), file))
+	return storage.WriteFile(file, backup, 0o600)
+}
+
+// Restore validates the snapshot in @file against the cluster of @version and replays it into the meta store,
+// the meta store must not contain any of the catalog prefixes.
+func (s *Snapshotter) Restore(file string, version string) error {
+	backup, err := storage.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	header, saves, err := NewBackupCodec().DeSerialize(backup)
+	if err != nil {
+		return err
+	}
+	if BackupHeaderVersion(header.GetVersion()) != BackupHeaderVersionV2 {
+		return fmt.Errorf("not a metadata snapshot, backup version: %d", header.GetVersion())
+	}
+	extra := GetExtra(header.GetExtra())
+	if header.GetEntries() != int64(len(saves)) {
+		return fmt.Errorf("snapshot is corrupted, expected entries: %d, actual entries: %d", header.GetEntries(), len(saves))
+	}
+	if checksum := snapshotChecksum(saves); checksum != extra.Checksum {
+		return fmt.Errorf("snapshot is corrupted, expected checksum: %s, actual checksum: %s", extra.Checksum, checksum)
+	}
+	if err := checkSnapshotVersion(extra.MilvusVersion, version); err != nil {
+		return err
+	}
+	if header.GetInstance() != s.rootPath {
+		console.Warning(fmt.Sprintf("restore snapshot of instance %s to instance %s", header.GetInstance(), s.rootPath))
+	}
+	for _, p := range snapshotPrefixes {
+		has, err := s.txn.HasPrefix(s.fullPrefix(p))
+		if err != nil {
+			return err
+		}
+		if has {
+			return fmt.Errorf("meta store is not empty, found keys with prefix: %s", s.fullPrefix(p))
+		}
+	}
+
+	keys := lo.Keys(saves)
+	sort.Strings(keys)
+	for _, batch := range lo.Chunk(keys, snapshotRestoreBatchSize) {
+		if err := s.txn.MultiSave(lo.SliceToMap(batch, func(key string) (string, string) {
+			return key, saves[key]
+		})); err != nil {
+			return err
+		}
+	}
+	console.Success(fmt.Sprintf("restore %d entries from: %s, snapshot created at: %s", len(saves), file, extra.CreateTime))
+	return nil
+}
+
+// checkSnapshotVersion checks the snapshot is taken from a cluster of the same minor version,
+// clusterVersion is the build version of the milvus to restore, see common.Version.
+func checkSnapshotVersion(snapshotVersion string, clusterVersion string) error {
+	sv, err := semver.Parse(snapshotVersion)
+	if err != nil {
+		return fmt.Errorf("invalid snapshot version: %s", snapshotVersion)
+	}
+	cv, err := semver.Parse(clusterVersion)
+	if err != nil {
+		return err
+	}
+	if sv.Major != cv.Major || sv.Minor != cv.Minor {
+		return fmt.Errorf("snapshot version %s is not compatible with cluster version %s", sv.String(), cv.String())
+	}
+	return nil
+}
+
+// snapshotChecksum computes the sha256 of the entries sorted by key, each key and value is length prefixed.
+func snapshotChecksum(kvs map[string]string) string {
+	keys := lo.Keys(kvs)
+	sort.Strings(keys)
+	h := sha256.New()
+	lengthBytes := make([]byte, 8)
+	write := func(s string) {
+		binary.LittleEndian.PutUint64(lengthBytes, uint64(len(s)))
+		h.Write(lengthBytes)
+		h.Write([]byte(s))
+	}
+	for _, key := range keys {
+		write(key)
+		write(kvs[key])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,110 @@
+package backend
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func newTestSnapshotKV(t *testing.T) *memkv.MemoryKV {
+	txn := memkv.NewMemoryKV()
+	require.NoError(t, txn.MultiSave(map[string]string{
+		"meta/root-coord/database/db-info/1":             "db",
+		"meta/root-coord/collection/1/100":               "collection",
+		"meta/snapshots/root-coord/collection/1/100_ts1": "snapshot",
+		"meta/datacoord-meta/s/100/101/1000":             "segment",
+		"meta/field-index/100/1":                         "index",
+		"meta/querycoord-replica/100/1":                  "replica",
+		"meta/streamingcoord-meta/pchannel/by-dev-dml_0": "pchannel",
+		"kv/gid/idTimestamp":                             "1",
+		"kv/tso/timestamp":                               "2",
+		"meta/session/datanode-1":                        "session",
+		"meta/channelwatch/1/by-dev-dml_0_100v0":         "watch",
+	}))
+	return txn
+}
+
+func TestSnapshotter_SnapshotRestore(t *testing.T) {
+	file := path.Join(t.TempDir(), "snapshot.bak")
+	source := newSnapshotter(newTestSnapshotKV(t), "", "meta", "kv")
+	require.NoError(t, source.Snapshot(file, "2.4.5"))
+
+	backup, err := storage.ReadFile(file)
+	require.NoError(t, err)
+	header, saves, err := NewBackupCodec().DeSerialize(backup)
+	require.NoError(t, err)
+	assert.Equal(t, int32(BackupHeaderVersionV2), header.GetVersion())
+	assert.Equal(t, int64(9), header.GetEntries())
+	assert.Equal(t, "rootcoord,datacoord,querycoord,streamingcoord", header.GetComponent())
+	assert.NotContains(t, saves, "meta/session/datanode-1")
+	assert.NotContains(t, saves, "meta/channelwatch/1/by-dev-dml_0_100v0")
+	extra := GetExtra(header.GetExtra())
+	assert.Equal(t, "2.4.5", extra.MilvusVersion)
+	assert.Equal(t, snapshotChecksum(saves), extra.Checksum)
+	assert.Equal(t, len(snapshotPrefixes), len(extra.Prefixes))
+
+	target := memkv.NewMemoryKV()
+	restorer := newSnapshotter(target, "", "meta", "kv")
+	require.NoError(t, restorer.Restore(file, "2.4.9"))
+	for key, value := range saves {
+		got, err := target.Load(key)
+		assert.NoError(t, err)
+		assert.Equal(t, value, got)
+	}
+
+	// the meta store is not empty any more
+	assert.Error(t, restorer.Restore(file, "2.4.9"))
+}
+
+func TestSnapshotter_RestoreFailed(t *testing.T) {
+	file := path.Join(t.TempDir(), "snapshot.bak")
+	source := newSnapshotter(newTestSnapshotKV(t), "", "meta", "kv")
+	require.NoError(t, source.Snapshot(file, "2.4.5"))
+
+	t.Run("version mismatch", func(t *testing.T) {
+		restorer := newSnapshotter(memkv.NewMemoryKV(), "", "meta", "kv")
+		assert.Error(t, restorer.Restore(file, "2.5.0"))
+		assert.Error(t, restorer.Restore(file, "invalid"))
+	})
+
+	t.Run("not exist", func(t *testing.T) {
+		restorer := newSnapshotter(memkv.NewMemoryKV(), "", "meta", "kv")
+		assert.Error(t, restorer.Restore(path.Join(t.TempDir(), "not_exist.bak"), "2.4.5"))
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		backup, err := storage.ReadFile(file)
+		require.NoError(t, err)
+		header, saves, err := NewBackupCodec().DeSerialize(backup)
+		require.NoError(t, err)
+		saves["meta/root-coord/collection/1/100"] = "modified"
+		corrupted, err := NewBackupCodec().Serialize(header, saves)
+		require.NoError(t, err)
+		corruptedFile := path.Join(t.TempDir(), "corrupted.bak")
+		require.NoError(t, storage.WriteFile(corruptedFile, corrupted, 0o600))
+
+		restorer := newSnapshotter(memkv.NewMemoryKV(), "", "meta", "kv")
+		assert.Error(t, restorer.Restore(corruptedFile, "2.4.5"))
+	})
+
+	t.Run("not a snapshot", func(t *testing.T) {
+		backup, err := NewBackupCodec().Serialize(&BackupHeader{Version: int32(BackupHeaderVersionV1)}, map[string]string{})
+		require.NoError(t, err)
+		backupFile := path.Join(t.TempDir(), "v1.bak")
+		require.NoError(t, storage.WriteFile(backupFile, backup, 0o600))
+
+		restorer := newSnapshotter(memkv.NewMemoryKV(), "", "meta", "kv")
+		assert.Error(t, restorer.Restore(backupFile, "2.4.5"))
+	})
+}
+
+func TestSnapshotter_RelativeKey(t *testing.T) {
+	s := newSnapshotter(memkv.NewMemoryKV(), "by-dev", "meta", "kv")
+	assert.Equal(t, "meta/root-coord/collection/1", s.relativeKey("by-dev/meta/root-coord/collection/1"))
+	assert.Equal(t, "kv/gid/idTimestamp", s.relativeKey("by-dev/kv/gid/idTimestamp"))
+}
@@ -28,6 +28,10 @@ func Execute(args []string) {
 		Backup(cfg)
 	case configs.RollbackCmd:
 		Rollback(cfg)
+	case configs.SnapshotCmd:
+		Snapshot(cfg)
+	case configs.RestoreCmd:
+		Restore(cfg)
 	default:
 		console.AbnormalExit(false, fmt.Sprintf("cmd not set or not supported: %s", cfg.Cmd))
 	}
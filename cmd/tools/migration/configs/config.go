@@ -13,6 +13,8 @@ const (
 	RunCmd      = "run"
 	BackupCmd   = "backup"
 	RollbackCmd = "rollback"
+	SnapshotCmd = "snapshot"
+	RestoreCmd  = "restore"
 )
 
 type RunConfig struct {
@@ -44,6 +46,9 @@ func (c *RunConfig) String() string {
 	case RollbackCmd:
 		return fmt.Sprintf("Cmd: %s, SourceVersion: %s, TargetVersion: %s, BackupFilePath: %s",
 			c.Cmd, c.SourceVersion, c.TargetVersion, c.BackupFilePath)
+	case SnapshotCmd, RestoreCmd:
+		// the snapshot is versioned by the build version, the source version is not used
+		return fmt.Sprintf("Cmd: %s, BackupFilePath: %s", c.Cmd, c.BackupFilePath)
 	default:
 		return fmt.Sprintf("invalid cmd: %s", c.Cmd)
 	}
@@ -66,6 +71,7 @@ func (c *RunConfig) init(base *paramtable.BaseTable) {
 type MilvusConfig struct {
 	MetaStoreCfg *paramtable.MetaStoreConfig
 	EtcdCfg      *paramtable.EtcdConfig
+	TiKVCfg      *paramtable.TiKVConfig
 }
 
 func newMilvusConfig(base *paramtable.BaseTable) *MilvusConfig {
@@ -77,9 +83,11 @@ func newMilvusConfig(base *paramtable.BaseTable) *MilvusConfig {
 func (c *MilvusConfig) init(base *paramtable.BaseTable) {
 	c.MetaStoreCfg = &paramtable.MetaStoreConfig{}
 	c.EtcdCfg = &paramtable.EtcdConfig{}
+	c.TiKVCfg = &paramtable.TiKVConfig{}
 
 	c.MetaStoreCfg.Init(base)
 	c.EtcdCfg.Init(base)
+	c.TiKVCfg.Init(base)
 }
 
 func (c *MilvusConfig) String() string {
@@ -89,6 +97,8 @@ func (c *MilvusConfig) String() string {
 	switch c.MetaStoreCfg.MetaStoreType.GetValue() {
 	case util.MetaStoreTypeEtcd:
 		return fmt.Sprintf("Type: %s, EndPoints: %v, MetaRootPath: %s", c.MetaStoreCfg.MetaStoreType.GetValue(), c.EtcdCfg.Endpoints.GetValue(), c.EtcdCfg.MetaRootPath.GetValue())
+	case util.MetaStoreTypeTiKV:
+		return fmt.Sprintf("Type: %s, EndPoints: %v, MetaRootPath: %s", c.MetaStoreCfg.MetaStoreType.GetValue(), c.TiKVCfg.Endpoints.GetValue(), c.TiKVCfg.MetaRootPath.GetValue())
 	default:
 		return fmt.Sprintf("unsupported meta store: %s", c.MetaStoreCfg.MetaStoreType.GetValue())
 	}
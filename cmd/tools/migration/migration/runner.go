@@ -16,6 +16,7 @@ import (
 	"github.com/milvus-io/milvus/cmd/tools/migration/console"
 	"github.com/milvus-io/milvus/cmd/tools/migration/versions"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 )
 
@@ -197,6 +198,26 @@ func (r *Runner) Rollback() error {
 	return source.Restore(r.cfg.BackupFilePath)
 }
 
+// Snapshot dumps the catalogs of all coordinators into the backup file,
+// the snapshot is stamped with the build version of milvus rather than the configured source version.
+func (r *Runner) Snapshot() error {
+	snapshotter, err := backend.NewSnapshotter(r.cfg.MilvusConfig)
+	if err != nil {
+		return err
+	}
+	return snapshotter.Snapshot(r.cfg.BackupFilePath, common.Version.String())
+}
+
+// Restore replays the snapshot in the backup file into an empty meta store,
+// the snapshot must be taken by the same minor version as the build version of milvus.
+func (r *Runner) Restore() error {
+	snapshotter, err := backend.NewSnapshotter(r.cfg.MilvusConfig)
+	if err != nil {
+		return err
+	}
+	return snapshotter.Restore(r.cfg.BackupFilePath, common.Version.String())
+}
+
 func (r *Runner) Migrate() error {
 	migrator, err := NewMigrator(r.cfg.SourceVersion, r.cfg.TargetVersion)
 	if err != nil {
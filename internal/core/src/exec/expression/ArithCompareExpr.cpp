@@ -0,0 +1,328 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "ArithCompareExpr.h"
+
+#include "common/Json.h"
+
+namespace milvus {
+namespace exec {
+
+template <typename T>
+static bool
+CompareByOp(proto::plan::OpType op_type, const T& left, const T& right) {
+    switch (op_type) {
+        case proto::plan::GreaterThan:
+            return left > right;
+        case proto::plan::GreaterEqual:
+            return left >= right;
+        case proto::plan::LessThan:
+            return left < right;
+        case proto::plan::LessEqual:
+            return left <= right;
+        case proto::plan::Equal:
+            return left == right;
+        case proto::plan::NotEqual:
+            return left != right;
+        default:
+            PanicInfo(OpTypeInvalid,
+                      "unsupported operator type for arith compare expr: {}",
+                      op_type);
+    }
+}
+
+// the int64 arithmetic wraps around on overflow like the go side, it's done
+// in uint64 since the overflow of signed integers is undefined behavior
+static std::optional<int64_t>
+ApplyArith(proto::plan::ArithOpType op, int64_t left, int64_t right) {
+    auto l = static_cast<uint64_t>(left);
+    auto r = static_cast<uint64_t>(right);
+    switch (op) {
+        case proto::plan::ArithOpType::Add:
+            return static_cast<int64_t>(l + r);
+        case proto::plan::ArithOpType::Sub:
+            return static_cast<int64_t>(l - r);
+        case proto::plan::ArithOpType::Mul:
+            return static_cast<int64_t>(l * r);
+        case proto::plan::ArithOpType::Div:
+            if (right == 0) {
+                return std::nullopt;
+            }
+            // INT64_MIN / -1 overflows, which wraps to INT64_MIN
+            if (right == -1) {
+                return static_cast<int64_t>(0 - l);
+            }
+            return left / right;
+        case proto::plan::ArithOpType::Mod:
+            if (right == 0) {
+                return std::nullopt;
+            }
+            if (right == -1) {
+                return 0;
+            }
+            return left % right;
+        default:
+            PanicInfo(OpTypeInvalid,
+                      "unsupported arith type for arith compare expr: {}",
+                      proto::plan::ArithOpType_Name(op));
+    }
+}
+
+static std::optional<double>
+ApplyArith(proto::plan::ArithOpType op, double left, double right) {
+    switch (op) {
+        case proto::plan::ArithOpType::Add:
+            return left + right;
+        case proto::plan::ArithOpType::Sub:
+            return left - right;
+        case proto::plan::ArithOpType::Mul:
+            return left * right;
+        case proto::plan::ArithOpType::Div:
+            if (right == 0) {
+                return std::nullopt;
+            }
+            return left / right;
+        default:
+            PanicInfo(OpTypeInvalid,
+                      "unsupported arith type for arith compare expr: {}",
+                      proto::plan::ArithOpType_Name(op));
+    }
+}
+
+int64_t
+PhyArithCompareExpr::GetNextBatchSize() {
+    auto current_rows =
+        segment_->type() == SegmentType::Growing
+            ? current_chunk_id_ * size_per_chunk_ + current_chunk_pos_
+            : current_chunk_pos_;
+    return current_rows + batch_size_ >= active_count_
+               ? active_count_ - current_rows
+               : batch_size_;
+}
+
+void
+PhyArithCompareExpr::Eval(EvalCtx& context, VectorPtr& result) {
+    switch (expr_->compute_type_) {
+        case DataType::INT64: {
+            result = ExecArithCompare<int64_t>();
+            break;
+        }
+        case DataType::DOUBLE: {
+            result = ExecArithCompare<double>();
+            break;
+        }
+        default:
+            PanicInfo(DataTypeInvalid,
+                      "unsupported compute type for arith compare expr: {}",
+                      expr_->compute_type_);
+    }
+}
+
+template <typename T>
+VectorPtr
+PhyArithCompareExpr::ExecArithCompare() {
+    auto real_batch_size = GetNextBatchSize();
+    if (real_batch_size == 0) {
+        return nullptr;
+    }
+
+    auto res_vec =
+        std::make_shared<ColumnVector>(TargetBitmap(real_batch_size));
+    TargetBitmapView res(res_vec->GetRawData(), real_batch_size);
+
+    int64_t processed_rows = 0;
+    while (processed_rows < real_batch_size && current_chunk_id_ < num_chunk_) {
+        auto chunk_size = GetChunkSize(current_chunk_id_);
+        if (current_chunk_pos_ >= chunk_size) {
+            ++current_chunk_id_;
+            current_chunk_pos_ = 0;
+            continue;
+        }
+        auto size = std::min(chunk_size - current_chunk_pos_,
+                             real_batch_size - processed_rows);
+        auto left = GetOperandAccessor<T>(
+            *expr_->left_, current_chunk_id_, current_chunk_pos_, size);
+        auto right = GetOperandAccessor<T>(
+            *expr_->right_, current_chunk_id_, current_chunk_pos_, size);
+        for (int64_t i = current_chunk_pos_; i < current_chunk_pos_ + size;
+             ++i) {
+            auto l = left(i);
+            auto r = right(i);
+            res[processed_rows++] =
+                l.has_value() && r.has_value() &&
+                CompareByOp<T>(expr_->op_type_, l.value(), r.value());
+        }
+        current_chunk_pos_ += size;
+    }
+    AssertInfo(processed_rows == real_batch_size,
+               "internal error: expr processed rows {} not equal "
+               "expect batch size {}",
+               processed_rows,
+               real_batch_size);
+    return res_vec;
+}
+
+template <typename T>
+PhyArithCompareExpr::RowAccessor<T>
+PhyArithCompareExpr::GetOperandAccessor(const expr::ArithOperand& operand,
+                                        int64_t chunk_id,
+                                        int64_t offset,
+                                        int64_t length) {
+    if (operand.column_.has_value()) {
+        return GetColumnAccessor<T>(
+            operand.column_.value(), chunk_id, offset, length);
+    }
+    if (operand.value_.has_value()) {
+        auto& value = operand.value_.value();
+        std::optional<T> constant;
+        switch (value.val_case()) {
+            case proto::plan::GenericValue::kInt64Val:
+                constant = static_cast<T>(value.int64_val());
+                break;
+            case proto::plan::GenericValue::kFloatVal:
+                constant = static_cast<T>(value.float_val());
+                break;
+            default:
+                PanicInfo(DataTypeInvalid,
+                          "unsupported value for arith compare expr: {}",
+                          value.DebugString());
+        }
+        return [constant](int64_t) { return constant; };
+    }
+
+    auto left = GetOperandAccessor<T>(*operand.left_, chunk_id, offset, length);
+    auto right =
+        GetOperandAccessor<T>(*operand.right_, chunk_id, offset, length);
+    auto op = operand.op_;
+    return [left = std::move(left), right = std::move(right), op](
+               int64_t i) -> std::optional<T> {
+        auto l = left(i);
+        if (!l.has_value()) {
+            return std::nullopt;
+        }
+        auto r = right(i);
+        if (!r.has_value()) {
+            return std::nullopt;
+        }
+        return ApplyArith(op, l.value(), r.value());
+    };
+}
+
+template <typename T>
+PhyArithCompareExpr::RowAccessor<T>
+PhyArithCompareExpr::GetColumnAccessor(const expr::ColumnInfo& column,
+                                       int64_t chunk_id,
+                                       int64_t offset,
+                                       int64_t length) {
+    switch (column.data_type_) {
+        case DataType::INT8:
+            return GetScalarAccessor<T, int8_t>(column.field_id_, chunk_id);
+        case DataType::INT16:
+            return GetScalarAccessor<T, int16_t>(column.field_id_, chunk_id);
+        case DataType::INT32:
+            return GetScalarAccessor<T, int32_t>(column.field_id_, chunk_id);
+        case DataType::INT64:
+            return GetScalarAccessor<T, int64_t>(column.field_id_, chunk_id);
+        case DataType::FLOAT:
+            return GetScalarAccessor<T, float>(column.field_id_, chunk_id);
+        case DataType::DOUBLE:
+            return GetScalarAccessor<T, double>(column.field_id_, chunk_id);
+        case DataType::JSON:
+            return GetJsonAccessor<T>(column, chunk_id, offset, length);
+        default:
+            PanicInfo(DataTypeInvalid,
+                      "unsupported data type for arith compare expr: {}",
+                      column.data_type_);
+    }
+}
+
+template <typename T, typename FieldType>
+PhyArithCompareExpr::RowAccessor<T>
+PhyArithCompareExpr::GetScalarAccessor(FieldId field_id, int64_t chunk_id) {
+    // the raw data may be dropped after the index is loaded, read the rows
+    // from the index then
+    if (chunk_id >= segment_->num_chunk_data(field_id)) {
+        auto& indexing =
+            segment_->chunk_scalar_index<FieldType>(field_id, chunk_id);
+        AssertInfo(indexing.HasRawData(),
+                   "arith compare expr requires the raw data of field {}",
+                   field_id.get());
+        return [&indexing](int64_t i) -> std::optional<T> {
+            return static_cast<T>(indexing.Reverse_Lookup(i));
+        };
+    }
+    auto chunk = segment_->chunk_data<FieldType>(field_id, chunk_id);
+    auto data = chunk.data();
+    const bool* valid_data = segment_->get_schema()[field_id].is_nullable()
+                                 ? chunk.valid_data()
+                                 : nullptr;
+    return [data, valid_data](int64_t i) -> std::optional<T> {
+        if (valid_data != nullptr && !valid_data[i]) {
+            return std::nullopt;
+        }
+        return static_cast<T>(data[i]);
+    };
+}
+
+template <typename T>
+PhyArithCompareExpr::RowAccessor<T>
+PhyArithCompareExpr::GetJsonAccessor(const expr::ColumnInfo& column,
+                                     int64_t chunk_id,
+                                     int64_t offset,
+                                     int64_t length) {
+    auto pointer = milvus::Json::pointer(column.nested_path_);
+    auto get_value = [pointer](const milvus::Json& json) -> std::optional<T> {
+        // integers are read as double as well, other types never match
+        auto x = json.at<double>(pointer);
+        if (x.error()) {
+            return std::nullopt;
+        }
+        return static_cast<T>(x.value());
+    };
+    bool nullable = segment_->get_schema()[column.field_id_].is_nullable();
+
+    if (segment_->type() == SegmentType::Growing) {
+        auto chunk =
+            segment_->chunk_data<milvus::Json>(column.field_id_, chunk_id);
+        auto data = chunk.data();
+        const bool* valid_data = nullable ? chunk.valid_data() : nullptr;
+        return [data, valid_data, get_value](int64_t i) -> std::optional<T> {
+            if (valid_data != nullptr && !valid_data[i]) {
+                return std::nullopt;
+            }
+            return get_value(data[i]);
+        };
+    }
+
+    // For sealed segment, only single chunk
+    auto [data_vec, valid_data] = segment_->get_batch_views<milvus::Json>(
+        column.field_id_, 0, offset, length);
+    auto rows =
+        std::make_shared<std::vector<milvus::Json>>(std::move(data_vec));
+    auto valid = nullable ? std::make_shared<FixedVector<bool>>(
+                                std::move(valid_data))
+                          : nullptr;
+    return [rows, valid, offset, get_value](int64_t i) -> std::optional<T> {
+        auto pos = i - offset;
+        if (valid != nullptr && !(*valid)[pos]) {
+            return std::nullopt;
+        }
+        return get_value((*rows)[pos]);
+    };
+}
+
+}  //namespace exec
+}  // namespace milvus
@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <fmt/core.h>
+#include <functional>
+#include <optional>
+
+#include "common/EasyAssert.h"
+#include "common/Types.h"
+#include "common/Vector.h"
+#include "exec/expression/Expr.h"
+#include "segcore/SegmentInterface.h"
+
+namespace milvus {
+namespace exec {
+
+// PhyArithCompareExpr evaluates the comparison between arithmetic of fields,
+// e.g. `price * qty > budget`. The rows are evaluated one by one in the
+// compute type of the plan: int64 wraps around on overflow and truncates on
+// division, otherwise double. A row doesn't match if a divisor is zero, a
+// json operand is missing or not a number, or an operand is null.
+class PhyArithCompareExpr : public Expr {
+ public:
+    PhyArithCompareExpr(
+        const std::vector<std::shared_ptr<Expr>>& input,
+        const std::shared_ptr<const milvus::expr::ArithCompareExpr>& expr,
+        const std::string& name,
+        const segcore::SegmentInternalInterface* segment,
+        int64_t active_count,
+        int64_t batch_size)
+        : Expr(DataType::BOOL, std::move(input), name),
+          active_count_(active_count),
+          segment_(segment),
+          batch_size_(batch_size),
+          expr_(expr) {
+        size_per_chunk_ = segment_->size_per_chunk();
+        num_chunk_ = upper_div(active_count_, size_per_chunk_);
+        AssertInfo(
+            batch_size_ > 0,
+            fmt::format("expr batch size should greater than zero, but now: {}",
+                        batch_size_));
+    }
+
+    void
+    Eval(EvalCtx& context, VectorPtr& result) override;
+
+    void
+    MoveCursor() override {
+        auto rows = GetNextBatchSize();
+        while (rows > 0 && current_chunk_id_ < num_chunk_) {
+            auto size =
+                std::min(GetChunkSize(current_chunk_id_) - current_chunk_pos_,
+                         rows);
+            current_chunk_pos_ += size;
+            rows -= size;
+            if (rows > 0) {
+                ++current_chunk_id_;
+                current_chunk_pos_ = 0;
+            }
+        }
+    }
+
+ private:
+    // returns the value of the row in the chunk, nullopt if it doesn't match
+    template <typename T>
+    using RowAccessor = std::function<std::optional<T>(int64_t)>;
+
+    int64_t
+    GetNextBatchSize();
+
+    int64_t
+    GetChunkSize(int64_t chunk_id) const {
+        return chunk_id == num_chunk_ - 1
+                   ? active_count_ - chunk_id * size_per_chunk_
+                   : size_per_chunk_;
+    }
+
+    template <typename T>
+    VectorPtr
+    ExecArithCompare();
+
+    // builds the accessor of the operand for the rows [offset, offset + length)
+    // of the chunk
+    template <typename T>
+    RowAccessor<T>
+    GetOperandAccessor(const expr::ArithOperand& operand,
+                       int64_t chunk_id,
+                       int64_t offset,
+                       int64_t length);
+
+    template <typename T>
+    RowAccessor<T>
+    GetColumnAccessor(const expr::ColumnInfo& column,
+                      int64_t chunk_id,
+                      int64_t offset,
+                      int64_t length);
+
+    template <typename T, typename FieldType>
+    RowAccessor<T>
+    GetScalarAccessor(FieldId field_id, int64_t chunk_id);
+
+    template <typename T>
+    RowAccessor<T>
+    GetJsonAccessor(const expr::ColumnInfo& column,
+                    int64_t chunk_id,
+                    int64_t offset,
+                    int64_t length);
+
+ private:
+    int64_t active_count_{0};
+    int64_t num_chunk_{0};
+    int64_t current_chunk_id_{0};
+    int64_t current_chunk_pos_{0};
+    int64_t size_per_chunk_{0};
+
+    const segcore::SegmentInternalInterface* segment_;
+    int64_t batch_size_;
+    std::shared_ptr<const milvus::expr::ArithCompareExpr> expr_;
+};
+}  //namespace exec
+}  // namespace milvus
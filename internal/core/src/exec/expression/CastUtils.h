@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <cctype>
+#include <charconv>
+#include <cmath>
+#include <cstdlib>
+#include <limits>
+#include <optional>
+#include <string>
+#include <string_view>
+#include <type_traits>
+
+#include <fmt/core.h>
+
+#include "common/Json.h"
+
+namespace milvus {
+namespace exec {
+
+// The casts of field values follow castConstant of the go parser, so that
+// `(int64) json["x"] > 30` and `json["x"] > (int64) "30"` agree on the values.
+// A value which can't be cast is nullopt.
+
+// the same as strconv.ParseBool
+inline std::optional<bool>
+ParseBool(std::string_view str) {
+    if (str == "1" || str == "t" || str == "T" || str == "true" ||
+        str == "TRUE" || str == "True") {
+        return true;
+    }
+    if (str == "0" || str == "f" || str == "F" || str == "false" ||
+        str == "FALSE" || str == "False") {
+        return false;
+    }
+    return std::nullopt;
+}
+
+// only decimal integers are parsed
+inline std::optional<int64_t>
+ParseInt64(std::string_view str) {
+    if (!str.empty() && str.front() == '+') {
+        str.remove_prefix(1);
+    }
+    int64_t value = 0;
+    auto end = str.data() + str.size();
+    auto [ptr, ec] = std::from_chars(str.data(), end, value);
+    if (str.empty() || ec != std::errc() || ptr != end) {
+        return std::nullopt;
+    }
+    return value;
+}
+
+inline std::optional<double>
+ParseDouble(std::string_view str) {
+    if (str.empty() || std::isspace(static_cast<unsigned char>(str.front()))) {
+        return std::nullopt;
+    }
+    std::string s(str);
+    char* end = nullptr;
+    auto value = std::strtod(s.c_str(), &end);
+    if (end != s.c_str() + s.size()) {
+        return std::nullopt;
+    }
+    return value;
+}
+
+// truncated towards zero, nullopt if it's out of the range of int64
+inline std::optional<int64_t>
+DoubleToInt64(double value) {
+    // 2^63 is exactly representable by double
+    constexpr double kTwoPow63 = 9223372036854775808.0;
+    if (std::isnan(value) || value >= kTwoPow63 || value < -kTwoPow63) {
+        return std::nullopt;
+    }
+    return static_cast<int64_t>(value);
+}
+
+// CastScalarValue casts the value of a numeric field, only the casts between
+// integers and floating numbers are recorded in the plan.
+template <typename U, typename T>
+std::optional<U>
+CastScalarValue(const T& value) {
+    if constexpr (std::is_same_v<U, int64_t> && std::is_floating_point_v<T>) {
+        return DoubleToInt64(static_cast<double>(value));
+    } else if constexpr (std::is_arithmetic_v<U> && !std::is_same_v<U, bool> &&
+                         std::is_arithmetic_v<T> && !std::is_same_v<T, bool>) {
+        return static_cast<U>(value);
+    } else {
+        return std::nullopt;
+    }
+}
+
+// CastJsonValue casts the json value at the pointer, nullopt if the key
+// doesn't exist.
+template <typename U>
+std::optional<U>
+CastJsonValue(const milvus::Json& json, const std::string& pointer) {
+    simdjson::dom::element element;
+    if (json.dom_doc().at_pointer(pointer).get(element) != simdjson::SUCCESS) {
+        return std::nullopt;
+    }
+    switch (element.type()) {
+        case simdjson::dom::element_type::BOOL: {
+            bool value = element.get_bool().value();
+            if constexpr (std::is_same_v<U, bool>) {
+                return value;
+            } else if constexpr (std::is_same_v<U, std::string>) {
+                return std::string(value ? "true" : "false");
+            }
+            return std::nullopt;
+        }
+        case simdjson::dom::element_type::INT64: {
+            int64_t value = element.get_int64().value();
+            if constexpr (std::is_same_v<U, int64_t> ||
+                          std::is_same_v<U, double>) {
+                return static_cast<U>(value);
+            } else if constexpr (std::is_same_v<U, std::string>) {
+                return std::to_string(value);
+            }
+            return std::nullopt;
+        }
+        case simdjson::dom::element_type::UINT64: {
+            uint64_t value = element.get_uint64().value();
+            if constexpr (std::is_same_v<U, int64_t>) {
+                if (value > static_cast<uint64_t>(
+                                std::numeric_limits<int64_t>::max())) {
+                    return std::nullopt;
+                }
+                return static_cast<int64_t>(value);
+            } else if constexpr (std::is_same_v<U, double>) {
+                return static_cast<double>(value);
+            } else if constexpr (std::is_same_v<U, std::string>) {
+                return std::to_string(value);
+            }
+            return std::nullopt;
+        }
+        case simdjson::dom::element_type::DOUBLE: {
+            double value = element.get_double().value();
+            if constexpr (std::is_same_v<U, int64_t>) {
+                return DoubleToInt64(value);
+            } else if constexpr (std::is_same_v<U, double>) {
+                return value;
+            } else if constexpr (std::is_same_v<U, std::string>) {
+                // the shortest representation, like strconv.FormatFloat
+                return fmt::format("{}", value);
+            }
+            return std::nullopt;
+        }
+        case simdjson::dom::element_type::STRING: {
+            std::string_view value = element.get_string().value();
+            if constexpr (std::is_same_v<U, bool>) {
+                return ParseBool(value);
+            } else if constexpr (std::is_same_v<U, int64_t>) {
+                return ParseInt64(value);
+            } else if constexpr (std::is_same_v<U, double>) {
+                return ParseDouble(value);
+            } else if constexpr (std::is_same_v<U, std::string>) {
+                return std::string(value);
+            }
+            return std::nullopt;
+        }
+        default:
+            // null, array and object can't be cast
+            return std::nullopt;
+    }
+}
+
+}  // namespace exec
+}  // namespace milvus
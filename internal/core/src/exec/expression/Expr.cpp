@@ -17,6 +17,7 @@
 #include "Expr.h"
 
 #include "exec/expression/AlwaysTrueExpr.h"
+#include "exec/expression/ArithCompareExpr.h"
 #include "exec/expression/BinaryArithOpEvalRangeExpr.h"
 #include "exec/expression/BinaryRangeExpr.h"
 #include "exec/expression/CompareExpr.h"
@@ -25,6 +26,8 @@
 #include "exec/expression/JsonContainsExpr.h"
 #include "exec/expression/LogicalBinaryExpr.h"
 #include "exec/expression/LogicalUnaryExpr.h"
+#include "exec/expression/NullExpr.h"
+#include "exec/expression/StringFunctionExpr.h"
 #include "exec/expression/TermExpr.h"
 #include "exec/expression/UnaryExpr.h"
 namespace milvus {
@@ -251,6 +254,34 @@ CompileExpression(const expr::TypedExprPtr& expr,
             context->get_segment(),
             context->get_active_count(),
             context->query_config()->get_expr_batch_size());
+    } else if (auto casted_expr =
+                   std::dynamic_pointer_cast<const milvus::expr::NullExpr>(
+                       expr)) {
+        result = std::make_shared<PhyNullFilterExpr>(
+            compiled_inputs,
+            casted_expr,
+            "PhyNullFilterExpr",
+            context->get_segment(),
+            context->get_active_count(),
+            context->query_config()->get_expr_batch_size());
+    } else if (auto casted_expr = std::dynamic_pointer_cast<
+                   const milvus::expr::StringFunctionExpr>(expr)) {
+        result = std::make_shared<PhyStringFunctionExpr>(
+            compiled_inputs,
+            casted_expr,
+            "PhyStringFunctionExpr",
+            context->get_segment(),
+            context->get_active_count(),
+            context->query_config()->get_expr_batch_size());
+    } else if (auto casted_expr = std::dynamic_pointer_cast<
+                   const milvus::expr::ArithCompareExpr>(expr)) {
+        result = std::make_shared<PhyArithCompareExpr>(
+            compiled_inputs,
+            casted_expr,
+            "PhyArithCompareExpr",
+            context->get_segment(),
+            context->get_active_count(),
+            context->query_config()->get_expr_batch_size());
     }
     return result;
 }
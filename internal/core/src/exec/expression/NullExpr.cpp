@@ -0,0 +1,209 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "NullExpr.h"
+#include "common/Json.h"
+#include "storage/MmapManager.h"
+
+namespace milvus {
+namespace exec {
+
+void
+PhyNullFilterExpr::Eval(EvalCtx& context, VectorPtr& result) {
+    if (!nullable_ && expr_->column_.nested_path_.empty()) {
+        result = EvalForNonNullableField();
+        return;
+    }
+    if (!segment_->HasFieldData(field_id_)) {
+        PanicInfo(ExprInvalid,
+                  "null expr requires the raw data of field {} to be loaded",
+                  field_id_.get());
+    }
+
+    switch (expr_->column_.data_type_) {
+        case DataType::BOOL: {
+            result = ExecNullVisitorImpl<bool>();
+            break;
+        }
+        case DataType::INT8: {
+            result = ExecNullVisitorImpl<int8_t>();
+            break;
+        }
+        case DataType::INT16: {
+            result = ExecNullVisitorImpl<int16_t>();
+            break;
+        }
+        case DataType::INT32: {
+            result = ExecNullVisitorImpl<int32_t>();
+            break;
+        }
+        case DataType::INT64: {
+            result = ExecNullVisitorImpl<int64_t>();
+            break;
+        }
+        case DataType::FLOAT: {
+            result = ExecNullVisitorImpl<float>();
+            break;
+        }
+        case DataType::DOUBLE: {
+            result = ExecNullVisitorImpl<double>();
+            break;
+        }
+        case DataType::VARCHAR:
+        case DataType::STRING: {
+            if (segment_->type() == SegmentType::Growing &&
+                !storage::MmapManager::GetInstance()
+                     .GetMmapConfig()
+                     .growing_enable_mmap) {
+                result = ExecNullVisitorImpl<std::string>();
+            } else {
+                result = ExecNullVisitorImpl<std::string_view>();
+            }
+            break;
+        }
+        case DataType::JSON: {
+            result = ExecNullVisitorImpl<milvus::Json>();
+            break;
+        }
+        case DataType::ARRAY: {
+            result = ExecNullVisitorImpl<milvus::ArrayView>();
+            break;
+        }
+        default:
+            PanicInfo(DataTypeInvalid,
+                      "unsupported data type: {}",
+                      expr_->column_.data_type_);
+    }
+}
+
+VectorPtr
+PhyNullFilterExpr::EvalForNonNullableField() {
+    auto real_batch_size = GetNextBatchSize();
+    if (real_batch_size == 0) {
+        return nullptr;
+    }
+    auto res_vec =
+        std::make_shared<ColumnVector>(TargetBitmap(real_batch_size));
+    TargetBitmapView res(res_vec->GetRawData(), real_batch_size);
+
+    // the field is never null
+    if (expr_->op_ == proto::plan::NullExpr_NullOp_IsNotNull) {
+        res.set();
+    }
+    MoveCursorForData();
+    return res_vec;
+}
+
+template <typename T>
+VectorPtr
+PhyNullFilterExpr::ExecNullVisitorImpl() {
+    auto real_batch_size = GetNextBatchSize();
+    if (real_batch_size == 0) {
+        return nullptr;
+    }
+    auto res_vec =
+        std::make_shared<ColumnVector>(TargetBitmap(real_batch_size));
+    TargetBitmapView res(res_vec->GetRawData(), real_batch_size);
+
+    auto is_null_op = expr_->op_ == proto::plan::NullExpr_NullOp_IsNull;
+    auto pointer = milvus::Json::pointer(expr_->column_.nested_path_);
+    auto execute_sub_batch = [is_null_op, &pointer](const T* data,
+                                                    const bool* valid_data,
+                                                    const int size,
+                                                    TargetBitmapView res) {
+        for (int i = 0; i < size; ++i) {
+            bool is_null = valid_data != nullptr && !valid_data[i];
+            if constexpr (std::is_same_v<T, Json>) {
+                if (!is_null && !pointer.empty()) {
+                    simdjson::dom::element element;
+                    auto error =
+                        data[i].dom_doc().at_pointer(pointer).get(element);
+                    is_null =
+                        error != simdjson::SUCCESS || element.is_null();
+                }
+            }
+            res[i] = is_null == is_null_op;
+        }
+    };
+
+    int64_t processed_size =
+        ProcessDataChunksWithValidData<T>(execute_sub_batch, res);
+    AssertInfo(processed_size == real_batch_size,
+               "internal error: expr processed rows {} not equal "
+               "expect batch size {}",
+               processed_size,
+               real_batch_size);
+    return res_vec;
+}
+
+// ProcessDataChunksWithValidData is the same as ProcessDataChunks except that
+// the valid data of the rows is also passed to func, which is nullptr if the
+// field is not nullable.
+template <typename T, typename FUNC>
+int64_t
+PhyNullFilterExpr::ProcessDataChunksWithValidData(FUNC func,
+                                                  TargetBitmapView res) {
+    if constexpr (std::is_same_v<T, std::string_view> ||
+                  std::is_same_v<T, Json>) {
+        if (segment_->type() == SegmentType::Sealed) {
+            // For sealed segment, only single chunk
+            Assert(num_data_chunk_ == 1);
+            auto need_size =
+                std::min(active_count_ - current_data_chunk_pos_, batch_size_);
+            auto [data_vec, valid_data] = segment_->get_batch_views<T>(
+                field_id_, 0, current_data_chunk_pos_, need_size);
+            func(data_vec.data(),
+                 nullable_ ? valid_data.data() : nullptr,
+                 need_size,
+                 res);
+            current_data_chunk_pos_ += need_size;
+            return need_size;
+        }
+    }
+
+    int64_t processed_size = 0;
+    for (size_t i = current_data_chunk_; i < num_data_chunk_; i++) {
+        auto data_pos =
+            (i == current_data_chunk_) ? current_data_chunk_pos_ : 0;
+        auto size =
+            (i == (num_data_chunk_ - 1))
+                ? (segment_->type() == SegmentType::Growing
+                       ? (active_count_ % size_per_chunk_ == 0
+                              ? size_per_chunk_ - data_pos
+                              : active_count_ % size_per_chunk_ - data_pos)
+                       : active_count_ - data_pos)
+                : size_per_chunk_ - data_pos;
+
+        size = std::min(size, batch_size_ - processed_size);
+
+        auto chunk = segment_->chunk_data<T>(field_id_, i);
+        const bool* valid_data =
+            nullable_ ? chunk.valid_data() + data_pos : nullptr;
+        func(chunk.data() + data_pos, valid_data, size, res + processed_size);
+
+        processed_size += size;
+        if (processed_size >= batch_size_) {
+            current_data_chunk_ = i;
+            current_data_chunk_pos_ = data_pos + size;
+            break;
+        }
+    }
+
+    return processed_size;
+}
+
+}  //namespace exec
+}  // namespace milvus
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <fmt/core.h>
+
+#include "common/EasyAssert.h"
+#include "common/Types.h"
+#include "common/Vector.h"
+#include "exec/expression/Expr.h"
+#include "segcore/SegmentInterface.h"
+
+namespace milvus {
+namespace exec {
+
+// PhyNullFilterExpr evaluates `is null` and `is not null` by the valid data of
+// the field, a json path is also null if the key doesn't exist or the value is
+// json null. The scalar index is never used since it doesn't keep null rows.
+class PhyNullFilterExpr : public SegmentExpr {
+ public:
+    PhyNullFilterExpr(const std::vector<std::shared_ptr<Expr>>& input,
+                      const std::shared_ptr<const milvus::expr::NullExpr>& expr,
+                      const std::string& name,
+                      const segcore::SegmentInternalInterface* segment,
+                      int64_t active_count,
+                      int64_t batch_size)
+        : SegmentExpr(std::move(input),
+                      name,
+                      segment,
+                      expr->column_.field_id_,
+                      active_count,
+                      batch_size),
+          expr_(expr) {
+        nullable_ = segment_->get_schema()[field_id_].is_nullable();
+        SetNotUseIndex();
+    }
+
+    void
+    Eval(EvalCtx& context, VectorPtr& result) override;
+
+    void
+    MoveCursor() override {
+        MoveCursorForData();
+    }
+
+ private:
+    VectorPtr
+    EvalForNonNullableField();
+
+    template <typename T>
+    VectorPtr
+    ExecNullVisitorImpl();
+
+    template <typename T, typename FUNC>
+    int64_t
+    ProcessDataChunksWithValidData(FUNC func, TargetBitmapView res);
+
+ private:
+    std::shared_ptr<const milvus::expr::NullExpr> expr_;
+    bool nullable_{false};
+};
+}  //namespace exec
+}  // namespace milvus
@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "StringFunctionExpr.h"
+
+#include <algorithm>
+#include <cctype>
+
+#include "common/Json.h"
+#include "storage/MmapManager.h"
+
+namespace milvus {
+namespace exec {
+
+template <typename T>
+static bool
+CompareByOp(proto::plan::OpType op_type, const T& left, const T& right) {
+    switch (op_type) {
+        case proto::plan::GreaterThan:
+            return left > right;
+        case proto::plan::GreaterEqual:
+            return left >= right;
+        case proto::plan::LessThan:
+            return left < right;
+        case proto::plan::LessEqual:
+            return left <= right;
+        case proto::plan::Equal:
+            return left == right;
+        case proto::plan::NotEqual:
+            return left != right;
+        default:
+            PanicInfo(OpTypeInvalid,
+                      "unsupported operator type for string function expr: {}",
+                      op_type);
+    }
+}
+
+// the number of characters of an utf-8 string, the same as
+// utf8.RuneCountInString in the go side
+static int64_t
+CountRunes(std::string_view str) {
+    return std::count_if(str.begin(), str.end(), [](char c) {
+        return (static_cast<unsigned char>(c) & 0xC0) != 0x80;
+    });
+}
+
+// only ascii letters are converted
+static std::string
+ConvertCase(std::string_view str, bool to_lower) {
+    std::string result(str);
+    for (auto& c : result) {
+        auto uc = static_cast<unsigned char>(c);
+        c = static_cast<char>(to_lower ? std::tolower(uc) : std::toupper(uc));
+    }
+    return result;
+}
+
+PhyStringFunctionExpr::PhyStringFunctionExpr(
+    const std::vector<std::shared_ptr<Expr>>& input,
+    const std::shared_ptr<const milvus::expr::StringFunctionExpr>& expr,
+    const std::string& name,
+    const segcore::SegmentInternalInterface* segment,
+    int64_t active_count,
+    int64_t batch_size)
+    : SegmentExpr(std::move(input),
+                  name,
+                  segment,
+                  expr->column_.field_id_,
+                  active_count,
+                  batch_size),
+      expr_(expr) {
+    if (expr_->function_ ==
+        proto::plan::StringFunctionExpr_FunctionType_RegexMatch) {
+        AssertInfo(expr_->arguments_.size() == 1,
+                   "regex_match requires a pattern, got {} arguments",
+                   expr_->arguments_.size());
+        // the go side matches by regexp.MatchString, which searches the
+        // pattern in the string rather than matching the whole string
+        regex_ = boost::regex(expr_->arguments_[0].string_val());
+    }
+    SetNotUseIndex();
+}
+
+void
+PhyStringFunctionExpr::Eval(EvalCtx& context, VectorPtr& result) {
+    if (!segment_->HasFieldData(field_id_)) {
+        PanicInfo(
+            ExprInvalid,
+            "string function expr requires the raw data of field {} to be "
+            "loaded",
+            field_id_.get());
+    }
+
+    switch (expr_->column_.data_type_) {
+        case DataType::VARCHAR:
+        case DataType::STRING: {
+            if (segment_->type() == SegmentType::Growing &&
+                !storage::MmapManager::GetInstance()
+                     .GetMmapConfig()
+                     .growing_enable_mmap) {
+                result = ExecStringFunctionImpl<std::string>();
+            } else {
+                result = ExecStringFunctionImpl<std::string_view>();
+            }
+            break;
+        }
+        case DataType::JSON: {
+            result = ExecStringFunctionImpl<milvus::Json>();
+            break;
+        }
+        default:
+            PanicInfo(DataTypeInvalid,
+                      "unsupported data type for string function expr: {}",
+                      expr_->column_.data_type_);
+    }
+}
+
+bool
+PhyStringFunctionExpr::Match(std::string_view str) const {
+    switch (expr_->function_) {
+        case proto::plan::StringFunctionExpr_FunctionType_Lower:
+        case proto::plan::StringFunctionExpr_FunctionType_Upper: {
+            auto converted = ConvertCase(
+                str,
+                expr_->function_ ==
+                    proto::plan::StringFunctionExpr_FunctionType_Lower);
+            return CompareByOp<std::string_view>(
+                expr_->op_type_, converted, expr_->val_.string_val());
+        }
+        case proto::plan::StringFunctionExpr_FunctionType_Length: {
+            return CompareByOp<int64_t>(
+                expr_->op_type_, CountRunes(str), expr_->val_.int64_val());
+        }
+        case proto::plan::StringFunctionExpr_FunctionType_RegexMatch: {
+            return boost::regex_search(str.begin(), str.end(), regex_);
+        }
+        default:
+            PanicInfo(ExprInvalid,
+                      "unsupported string function: {}",
+                      proto::plan::StringFunctionExpr_FunctionType_Name(
+                          expr_->function_));
+    }
+}
+
+template <typename T>
+VectorPtr
+PhyStringFunctionExpr::ExecStringFunctionImpl() {
+    auto real_batch_size = GetNextBatchSize();
+    if (real_batch_size == 0) {
+        return nullptr;
+    }
+    auto res_vec =
+        std::make_shared<ColumnVector>(TargetBitmap(real_batch_size));
+    TargetBitmapView res(res_vec->GetRawData(), real_batch_size);
+
+    auto pointer = milvus::Json::pointer(expr_->column_.nested_path_);
+    auto execute_sub_batch =
+        [this, &pointer](const T* data, const int size, TargetBitmapView res) {
+            for (int i = 0; i < size; ++i) {
+                if constexpr (std::is_same_v<T, milvus::Json>) {
+                    auto x = data[i].template at<std::string_view>(pointer);
+                    if (x.error()) {
+                        res[i] = false;
+                        continue;
+                    }
+                    res[i] = Match(x.value());
+                } else {
+                    res[i] = Match(std::string_view(data[i]));
+                }
+            }
+        };
+
+    int64_t processed_size =
+        ProcessDataChunks<T>(execute_sub_batch, std::nullptr_t{}, res);
+    AssertInfo(processed_size == real_batch_size,
+               "internal error: expr processed rows {} not equal "
+               "expect batch size {}",
+               processed_size,
+               real_batch_size);
+    return res_vec;
+}
+
+}  //namespace exec
+}  // namespace milvus
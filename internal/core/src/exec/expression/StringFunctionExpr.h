@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <fmt/core.h>
+#include <boost/regex.hpp>
+
+#include "common/EasyAssert.h"
+#include "common/Types.h"
+#include "common/Vector.h"
+#include "exec/expression/Expr.h"
+#include "segcore/SegmentInterface.h"
+
+namespace milvus {
+namespace exec {
+
+// PhyStringFunctionExpr evaluates lower/upper/length and regex_match on a
+// string field or a json path. The result of lower/upper/length is compared
+// with the value by op, regex_match is a predicate itself. A json row whose
+// value at the path is missing or not a string never matches. The scalar
+// index is never used since the functions are applied on the raw data.
+class PhyStringFunctionExpr : public SegmentExpr {
+ public:
+    PhyStringFunctionExpr(
+        const std::vector<std::shared_ptr<Expr>>& input,
+        const std::shared_ptr<const milvus::expr::StringFunctionExpr>& expr,
+        const std::string& name,
+        const segcore::SegmentInternalInterface* segment,
+        int64_t active_count,
+        int64_t batch_size);
+
+    void
+    Eval(EvalCtx& context, VectorPtr& result) override;
+
+    void
+    MoveCursor() override {
+        MoveCursorForData();
+    }
+
+ private:
+    template <typename T>
+    VectorPtr
+    ExecStringFunctionImpl();
+
+    bool
+    Match(std::string_view str) const;
+
+ private:
+    std::shared_ptr<const milvus::expr::StringFunctionExpr> expr_;
+    // the compiled pattern of regex_match
+    boost::regex regex_;
+};
+}  //namespace exec
+}  // namespace milvus
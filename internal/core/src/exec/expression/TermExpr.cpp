@@ -15,12 +15,17 @@
 // limitations under the License.
 
 #include "TermExpr.h"
+#include "exec/expression/CastUtils.h"
 #include "query/Utils.h"
 namespace milvus {
 namespace exec {
 
 void
 PhyTermFilterExpr::Eval(EvalCtx& context, VectorPtr& result) {
+    if (expr_->column_.cast_type_ != DataType::NONE) {
+        result = ExecVisitorImplForCast();
+        return;
+    }
     if (is_pk_field_) {
         result = ExecPkTermImpl();
         return;
@@ -575,5 +580,95 @@ PhyTermFilterExpr::ExecVisitorImplForData() {
     return res_vec;
 }
 
+VectorPtr
+PhyTermFilterExpr::ExecVisitorImplForCast() {
+    if (!segment_->HasFieldData(field_id_)) {
+        PanicInfo(ExprInvalid,
+                  "cast requires the raw data of field {} to be loaded",
+                  field_id_.get());
+    }
+    switch (expr_->column_.data_type_) {
+        case DataType::INT8:
+            return DispatchCastType<int8_t>();
+        case DataType::INT16:
+            return DispatchCastType<int16_t>();
+        case DataType::INT32:
+            return DispatchCastType<int32_t>();
+        case DataType::INT64:
+            return DispatchCastType<int64_t>();
+        case DataType::FLOAT:
+            return DispatchCastType<float>();
+        case DataType::DOUBLE:
+            return DispatchCastType<double>();
+        case DataType::JSON:
+            return DispatchCastType<milvus::Json>();
+        default:
+            PanicInfo(DataTypeInvalid,
+                      "unsupported data type to cast: {}",
+                      expr_->column_.data_type_);
+    }
+}
+
+template <typename T>
+VectorPtr
+PhyTermFilterExpr::DispatchCastType() {
+    switch (expr_->column_.cast_type_) {
+        case DataType::BOOL:
+            return ExecVisitorImplForCast<T, bool>();
+        case DataType::INT64:
+            return ExecVisitorImplForCast<T, int64_t>();
+        case DataType::DOUBLE:
+            return ExecVisitorImplForCast<T, double>();
+        case DataType::VARCHAR:
+            return ExecVisitorImplForCast<T, std::string>();
+        default:
+            PanicInfo(DataTypeInvalid,
+                      "unsupported cast type: {}",
+                      expr_->column_.cast_type_);
+    }
+}
+
+// ExecVisitorImplForCast casts the values of type T to U and looks them up in
+// the terms, a value which can't be cast is not in the terms.
+template <typename T, typename U>
+VectorPtr
+PhyTermFilterExpr::ExecVisitorImplForCast() {
+    auto real_batch_size = GetNextBatchSize();
+    if (real_batch_size == 0) {
+        return nullptr;
+    }
+    auto res_vec =
+        std::make_shared<ColumnVector>(TargetBitmap(real_batch_size));
+    TargetBitmapView res(res_vec->GetRawData(), real_batch_size);
+
+    std::unordered_set<U> term_set;
+    for (const auto& element : expr_->vals_) {
+        term_set.insert(GetValueFromProto<U>(element));
+    }
+    auto pointer = milvus::Json::pointer(expr_->column_.nested_path_);
+
+    auto execute_sub_batch = [&pointer, &term_set](const T* data,
+                                                   const int size,
+                                                   TargetBitmapView res) {
+        for (int i = 0; i < size; ++i) {
+            std::optional<U> x;
+            if constexpr (std::is_same_v<T, milvus::Json>) {
+                x = CastJsonValue<U>(data[i], pointer);
+            } else {
+                x = CastScalarValue<U>(data[i]);
+            }
+            res[i] = x.has_value() && term_set.find(x.value()) != term_set.end();
+        }
+    };
+    int64_t processed_size =
+        ProcessDataChunks<T>(execute_sub_batch, std::nullptr_t{}, res);
+    AssertInfo(processed_size == real_batch_size,
+               "internal error: expr processed rows {} not equal "
+               "expect batch size {}",
+               processed_size,
+               real_batch_size);
+    return res_vec;
+}
+
 }  //namespace exec
 }  // namespace milvus
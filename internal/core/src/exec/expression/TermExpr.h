@@ -65,6 +65,10 @@ class PhyTermFilterExpr : public SegmentExpr {
                       batch_size),
           expr_(expr),
           query_timestamp_(timestamp) {
+        if (expr_->column_.cast_type_ != DataType::NONE) {
+            // the index is built on the values before cast
+            SetNotUseIndex();
+        }
     }
 
     void
@@ -90,6 +94,17 @@ class PhyTermFilterExpr : public SegmentExpr {
     VectorPtr
     ExecVisitorImpl();
 
+    VectorPtr
+    ExecVisitorImplForCast();
+
+    template <typename T>
+    VectorPtr
+    DispatchCastType();
+
+    template <typename T, typename U>
+    VectorPtr
+    ExecVisitorImplForCast();
+
     template <typename T>
     VectorPtr
     ExecVisitorImplForIndex();
@@ -16,6 +16,7 @@
 
 #include "UnaryExpr.h"
 #include "common/Json.h"
+#include "exec/expression/CastUtils.h"
 
 namespace milvus {
 namespace exec {
@@ -145,6 +146,10 @@ PhyUnaryRangeFilterExpr::ExecRangeVisitorImplArrayForIndex<
 
 void
 PhyUnaryRangeFilterExpr::Eval(EvalCtx& context, VectorPtr& result) {
+    if (expr_->column_.cast_type_ != DataType::NONE) {
+        result = ExecRangeVisitorImplForCast();
+        return;
+    }
     switch (expr_->column_.data_type_) {
         case DataType::BOOL: {
             result = ExecRangeVisitorImpl<bool>();
@@ -857,5 +862,146 @@ PhyUnaryRangeFilterExpr::CanUseIndex() {
     return res;
 }
 
+VectorPtr
+PhyUnaryRangeFilterExpr::ExecRangeVisitorImplForCast() {
+    if (!segment_->HasFieldData(field_id_)) {
+        PanicInfo(ExprInvalid,
+                  "cast requires the raw data of field {} to be loaded",
+                  field_id_.get());
+    }
+    switch (expr_->column_.data_type_) {
+        case DataType::INT8:
+            return DispatchCastType<int8_t>();
+        case DataType::INT16:
+            return DispatchCastType<int16_t>();
+        case DataType::INT32:
+            return DispatchCastType<int32_t>();
+        case DataType::INT64:
+            return DispatchCastType<int64_t>();
+        case DataType::FLOAT:
+            return DispatchCastType<float>();
+        case DataType::DOUBLE:
+            return DispatchCastType<double>();
+        case DataType::JSON:
+            return DispatchCastType<milvus::Json>();
+        default:
+            PanicInfo(DataTypeInvalid,
+                      "unsupported data type to cast: {}",
+                      expr_->column_.data_type_);
+    }
+}
+
+template <typename T>
+VectorPtr
+PhyUnaryRangeFilterExpr::DispatchCastType() {
+    switch (expr_->column_.cast_type_) {
+        case DataType::BOOL:
+            return ExecRangeVisitorImplForCast<T, bool>();
+        case DataType::INT64:
+            return ExecRangeVisitorImplForCast<T, int64_t>();
+        case DataType::DOUBLE:
+            return ExecRangeVisitorImplForCast<T, double>();
+        case DataType::VARCHAR:
+            return ExecRangeVisitorImplForCast<T, std::string>();
+        default:
+            PanicInfo(DataTypeInvalid,
+                      "unsupported cast type: {}",
+                      expr_->column_.cast_type_);
+    }
+}
+
+// ExecRangeVisitorImplForCast casts the values of type T to U and compares
+// them with the value, a value which can't be cast only matches `!=`, the
+// same as a missing json key.
+template <typename T, typename U>
+VectorPtr
+PhyUnaryRangeFilterExpr::ExecRangeVisitorImplForCast() {
+    auto real_batch_size = GetNextBatchSize();
+    if (real_batch_size == 0) {
+        return nullptr;
+    }
+    auto res_vec =
+        std::make_shared<ColumnVector>(TargetBitmap(real_batch_size));
+    TargetBitmapView res(res_vec->GetRawData(), real_batch_size);
+
+    auto val = GetValueFromProto<U>(expr_->val_);
+    auto op_type = expr_->op_type_;
+    auto pointer = milvus::Json::pointer(expr_->column_.nested_path_);
+    std::optional<RegexMatcher> matcher;
+    if constexpr (std::is_same_v<U, std::string>) {
+        if (op_type == proto::plan::Match) {
+            PatternMatchTranslator translator;
+            matcher.emplace(translator(val));
+        }
+    }
+
+    auto execute_sub_batch = [op_type, &pointer, &val, &matcher](
+                                 const T* data,
+                                 const int size,
+                                 TargetBitmapView res) {
+        for (int i = 0; i < size; ++i) {
+            std::optional<U> x;
+            if constexpr (std::is_same_v<T, milvus::Json>) {
+                x = CastJsonValue<U>(data[i], pointer);
+            } else {
+                x = CastScalarValue<U>(data[i]);
+            }
+            if (!x.has_value()) {
+                res[i] = op_type == proto::plan::NotEqual;
+                continue;
+            }
+            switch (op_type) {
+                case proto::plan::GreaterThan:
+                    res[i] = x.value() > val;
+                    break;
+                case proto::plan::GreaterEqual:
+                    res[i] = x.value() >= val;
+                    break;
+                case proto::plan::LessThan:
+                    res[i] = x.value() < val;
+                    break;
+                case proto::plan::LessEqual:
+                    res[i] = x.value() <= val;
+                    break;
+                case proto::plan::Equal:
+                    res[i] = x.value() == val;
+                    break;
+                case proto::plan::NotEqual:
+                    res[i] = x.value() != val;
+                    break;
+                case proto::plan::PrefixMatch:
+                case proto::plan::PostfixMatch:
+                    if constexpr (std::is_same_v<U, std::string>) {
+                        res[i] = milvus::query::Match(x.value(), val, op_type);
+                        break;
+                    }
+                    PanicInfo(OpTypeInvalid,
+                              "pattern matching is only supported on string");
+                case proto::plan::Match:
+                    if constexpr (std::is_same_v<U, std::string>) {
+                        res[i] = matcher.value()(x.value());
+                        break;
+                    }
+                    PanicInfo(OpTypeInvalid,
+                              "pattern matching is only supported on string");
+                default:
+                    PanicInfo(
+                        OpTypeInvalid,
+                        fmt::format(
+                            "unsupported operator type for unary expr: {}",
+                            op_type));
+            }
+        }
+    };
+    int64_t processed_size =
+        ProcessDataChunks<T>(execute_sub_batch, std::nullptr_t{}, res);
+    AssertInfo(processed_size == real_batch_size,
+               "internal error: expr processed rows {} not equal "
+               "expect batch size {}",
+               processed_size,
+               real_batch_size);
+    return res_vec;
+}
+
 }  // namespace exec
 }  // namespace milvus
@@ -284,6 +284,10 @@ class PhyUnaryRangeFilterExpr : public SegmentExpr {
                       active_count,
                       batch_size),
           expr_(expr) {
+        if (expr_->column_.cast_type_ != DataType::NONE) {
+            // the index is built on the values before cast
+            SetNotUseIndex();
+        }
     }
 
     void
@@ -294,6 +298,17 @@ class PhyUnaryRangeFilterExpr : public SegmentExpr {
     VectorPtr
     ExecRangeVisitorImpl();
 
+    VectorPtr
+    ExecRangeVisitorImplForCast();
+
+    template <typename T>
+    VectorPtr
+    DispatchCastType();
+
+    template <typename T, typename U>
+    VectorPtr
+    ExecRangeVisitorImplForCast();
+
     template <typename T>
     VectorPtr
     ExecRangeVisitorImplForIndex();
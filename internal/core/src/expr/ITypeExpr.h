@@ -18,6 +18,7 @@
 
 #include <fmt/core.h>
 #include <memory>
+#include <optional>
 #include <string>
 #include <vector>
 
@@ -115,13 +116,16 @@ struct ColumnInfo {
     DataType data_type_;
     DataType element_type_;
     std::vector<std::string> nested_path_;
+    // the values are cast to cast_type_ before evaluated, NONE if no cast
+    DataType cast_type_;
 
     ColumnInfo(const proto::plan::ColumnInfo& column_info)
         : field_id_(column_info.field_id()),
           data_type_(static_cast<DataType>(column_info.data_type())),
           element_type_(static_cast<DataType>(column_info.element_type())),
           nested_path_(column_info.nested_path().begin(),
-                       column_info.nested_path().end()) {
+                       column_info.nested_path().end()),
+          cast_type_(static_cast<DataType>(column_info.cast_type())) {
     }
 
     ColumnInfo(FieldId field_id,
@@ -130,7 +134,8 @@ struct ColumnInfo {
         : field_id_(field_id),
           data_type_(data_type),
           element_type_(DataType::NONE),
-          nested_path_(std::move(nested_path)) {
+          nested_path_(std::move(nested_path)),
+          cast_type_(DataType::NONE) {
     }
 
     bool
@@ -147,6 +152,10 @@ struct ColumnInfo {
             return false;
         }
 
+        if (cast_type_ != other.cast_type_) {
+            return false;
+        }
+
         for (int i = 0; i < nested_path_.size(); ++i) {
             if (nested_path_[i] != other.nested_path_[i]) {
                 return false;
@@ -159,11 +168,13 @@ struct ColumnInfo {
     std::string
     ToString() const {
         return fmt::format(
-            "[FieldId:{}, data_type:{}, element_type:{}, nested_path:{}]",
+            "[FieldId:{}, data_type:{}, element_type:{}, nested_path:{}, "
+            "cast_type:{}]",
             std::to_string(field_id_.get()),
             data_type_,
             element_type_,
-            milvus::Join<std::string>(nested_path_, ","));
+            milvus::Join<std::string>(nested_path_, ","),
+            cast_type_);
     }
 };
 
@@ -384,6 +395,64 @@ class ExistsExpr : public ITypeFilterExpr {
     const ColumnInfo column_;
 };
 
+class NullExpr : public ITypeFilterExpr {
+ public:
+    explicit NullExpr(const ColumnInfo& column,
+                      proto::plan::NullExpr_NullOp op)
+        : ITypeFilterExpr(), column_(column), op_(op) {
+    }
+
+    std::string
+    ToString() const override {
+        return fmt::format("NullExpr:[Column: {}, Operator: {}]",
+                           column_.ToString(),
+                           proto::plan::NullExpr_NullOp_Name(op_));
+    }
+
+ public:
+    const ColumnInfo column_;
+    const proto::plan::NullExpr_NullOp op_;
+};
+
+class StringFunctionExpr : public ITypeFilterExpr {
+ public:
+    explicit StringFunctionExpr(
+        const ColumnInfo& column,
+        proto::plan::StringFunctionExpr_FunctionType function,
+        const std::vector<proto::plan::GenericValue>& arguments,
+        proto::plan::OpType op_type,
+        const proto::plan::GenericValue& val)
+        : ITypeFilterExpr(),
+          column_(column),
+          function_(function),
+          arguments_(arguments),
+          op_type_(op_type),
+          val_(val) {
+    }
+
+    std::string
+    ToString() const override {
+        std::stringstream ss;
+        ss << "StringFunctionExpr: {columnInfo:" << column_.ToString()
+           << " function:"
+           << proto::plan::StringFunctionExpr_FunctionType_Name(function_)
+           << " arguments:[";
+        for (const auto& arg : arguments_) {
+            ss << arg.DebugString();
+        }
+        ss << "] op_type:" << milvus::proto::plan::OpType_Name(op_type_)
+           << " val:" << val_.DebugString() << "}";
+        return ss.str();
+    }
+
+ public:
+    const ColumnInfo column_;
+    const proto::plan::StringFunctionExpr_FunctionType function_;
+    const std::vector<proto::plan::GenericValue> arguments_;
+    const proto::plan::OpType op_type_;
+    const proto::plan::GenericValue val_;
+};
+
 class LogicalUnaryExpr : public ITypeFilterExpr {
  public:
     enum class OpType { Invalid = 0, LogicalNot = 1 };
@@ -633,6 +702,81 @@ class CompareExpr : public ITypeFilterExpr {
     const proto::plan::OpType op_type_;
 };
 
+// ArithOperand is a node of the arithmetic tree in ArithCompareExpr, a leaf is
+// either a column or a constant, an inner node applies op_ on left_ and right_.
+struct ArithOperand;
+using ArithOperandPtr = std::shared_ptr<const ArithOperand>;
+
+struct ArithOperand {
+    std::optional<ColumnInfo> column_;
+    std::optional<proto::plan::GenericValue> value_;
+    proto::plan::ArithOpType op_{proto::plan::ArithOpType::Unknown};
+    ArithOperandPtr left_;
+    ArithOperandPtr right_;
+
+    std::string
+    ToString() const {
+        if (column_.has_value()) {
+            return column_->ToString();
+        }
+        if (value_.has_value()) {
+            return value_->DebugString();
+        }
+        return fmt::format("({} {} {})",
+                           left_->ToString(),
+                           proto::plan::ArithOpType_Name(op_),
+                           right_->ToString());
+    }
+
+    void
+    CollectColumns(std::vector<ColumnInfo>& columns) const {
+        if (column_.has_value()) {
+            columns.push_back(column_.value());
+        } else if (!value_.has_value()) {
+            left_->CollectColumns(columns);
+            right_->CollectColumns(columns);
+        }
+    }
+};
+
+class ArithCompareExpr : public ITypeFilterExpr {
+ public:
+    ArithCompareExpr(const ArithOperandPtr& left,
+                     const ArithOperandPtr& right,
+                     proto::plan::OpType op_type,
+                     DataType compute_type)
+        : left_(left),
+          right_(right),
+          op_type_(op_type),
+          compute_type_(compute_type) {
+    }
+
+    std::string
+    ToString() const override {
+        return fmt::format(
+            "ArithCompareExpr:[Left: {}, Operator: {}, Right: {}, Compute "
+            "Type: {}]",
+            left_->ToString(),
+            milvus::proto::plan::OpType_Name(op_type_),
+            right_->ToString(),
+            compute_type_);
+    }
+
+    std::vector<ColumnInfo>
+    GetColumns() const {
+        std::vector<ColumnInfo> columns;
+        left_->CollectColumns(columns);
+        right_->CollectColumns(columns);
+        return columns;
+    }
+
+ public:
+    const ArithOperandPtr left_;
+    const ArithOperandPtr right_;
+    const proto::plan::OpType op_type_;
+    const DataType compute_type_;
+};
+
 class JsonContainsExpr : public ITypeFilterExpr {
  public:
     JsonContainsExpr(ColumnInfo column,
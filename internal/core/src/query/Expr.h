@@ -303,6 +303,80 @@ struct JsonContainsExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+struct NullExpr : Expr {
+    const ColumnInfo column_;
+    const proto::plan::NullExpr_NullOp op_;
+
+ protected:
+    // prevent accidental instantiation
+    NullExpr() = delete;
+
+    NullExpr(ColumnInfo column, proto::plan::NullExpr_NullOp op)
+        : column_(std::move(column)), op_(op) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+struct StringFunctionExpr : Expr {
+    const ColumnInfo column_;
+    const proto::plan::StringFunctionExpr_FunctionType function_;
+    const std::vector<proto::plan::GenericValue> arguments_;
+    const proto::plan::OpType op_type_;
+    const proto::plan::GenericValue value_;
+
+ protected:
+    // prevent accidental instantiation
+    StringFunctionExpr() = delete;
+
+    StringFunctionExpr(ColumnInfo column,
+                       proto::plan::StringFunctionExpr_FunctionType function,
+                       std::vector<proto::plan::GenericValue> arguments,
+                       proto::plan::OpType op_type,
+                       proto::plan::GenericValue value)
+        : column_(std::move(column)),
+          function_(function),
+          arguments_(std::move(arguments)),
+          op_type_(op_type),
+          value_(std::move(value)) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+struct ArithCompareExpr : Expr {
+    // the columns involved in the arithmetic of both sides
+    const std::vector<ColumnInfo> columns_;
+    const proto::plan::Expr left_;
+    const proto::plan::Expr right_;
+    const proto::plan::OpType op_type_;
+    const DataType compute_type_;
+
+ protected:
+    // prevent accidental instantiation
+    ArithCompareExpr() = delete;
+
+    ArithCompareExpr(std::vector<ColumnInfo> columns,
+                     proto::plan::Expr left,
+                     proto::plan::Expr right,
+                     proto::plan::OpType op_type,
+                     DataType compute_type)
+        : columns_(std::move(columns)),
+          left_(std::move(left)),
+          right_(std::move(right)),
+          op_type_(op_type),
+          compute_type_(compute_type) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 inline bool
 IsTermExpr(Expr* expr) {
     TermExpr* term_expr = dynamic_cast<TermExpr*>(expr);
@@ -97,6 +97,41 @@ struct ExistsExprImpl : ExistsExpr {
     }
 };
 
+struct NullExprImpl : NullExpr {
+    NullExprImpl(ColumnInfo column, proto::plan::NullExpr_NullOp op)
+        : NullExpr(std::forward<ColumnInfo>(column), op) {
+    }
+};
+
+struct StringFunctionExprImpl : StringFunctionExpr {
+    StringFunctionExprImpl(
+        ColumnInfo column,
+        proto::plan::StringFunctionExpr_FunctionType function,
+        std::vector<proto::plan::GenericValue> arguments,
+        proto::plan::OpType op_type,
+        proto::plan::GenericValue value)
+        : StringFunctionExpr(std::forward<ColumnInfo>(column),
+                             function,
+                             std::move(arguments),
+                             op_type,
+                             std::move(value)) {
+    }
+};
+
+struct ArithCompareExprImpl : ArithCompareExpr {
+    ArithCompareExprImpl(std::vector<ColumnInfo> columns,
+                         proto::plan::Expr left,
+                         proto::plan::Expr right,
+                         proto::plan::OpType op_type,
+                         DataType compute_type)
+        : ArithCompareExpr(std::move(columns),
+                           std::move(left),
+                           std::move(right),
+                           op_type,
+                           compute_type) {
+    }
+};
+
 template <typename T>
 struct JsonContainsExprImpl : JsonContainsExpr {
     const std::vector<T> elements_;
@@ -823,6 +823,117 @@ ProtoParser::ParseExistExpr(const proto::plan::ExistsExpr& expr_pb) {
     return result;
 }
 
+expr::TypedExprPtr
+ProtoParser::ParseNullExprs(const proto::plan::NullExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    return std::make_shared<expr::NullExpr>(column_info, expr_pb.op());
+}
+
+ExprPtr
+ProtoParser::ParseNullExpr(const proto::plan::NullExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    return std::make_unique<NullExprImpl>(column_info, expr_pb.op());
+}
+
+expr::TypedExprPtr
+ProtoParser::ParseStringFunctionExprs(
+    const proto::plan::StringFunctionExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    std::vector<proto::plan::GenericValue> arguments(
+        expr_pb.arguments().begin(), expr_pb.arguments().end());
+    return std::make_shared<expr::StringFunctionExpr>(column_info,
+                                                      expr_pb.function(),
+                                                      arguments,
+                                                      expr_pb.op(),
+                                                      expr_pb.value());
+}
+
+ExprPtr
+ProtoParser::ParseStringFunctionExpr(
+    const proto::plan::StringFunctionExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    std::vector<proto::plan::GenericValue> arguments(
+        expr_pb.arguments().begin(), expr_pb.arguments().end());
+    return std::make_unique<StringFunctionExprImpl>(column_info,
+                                                    expr_pb.function(),
+                                                    std::move(arguments),
+                                                    expr_pb.op(),
+                                                    expr_pb.value());
+}
+
+expr::ArithOperandPtr
+ProtoParser::ParseArithOperand(const proto::plan::Expr& expr_pb) {
+    using ppe = proto::plan::Expr;
+    auto operand = std::make_shared<expr::ArithOperand>();
+    switch (expr_pb.expr_case()) {
+        case ppe::kColumnExpr: {
+            auto& column_info = expr_pb.column_expr().info();
+            auto field_id = FieldId(column_info.field_id());
+            auto data_type = schema[field_id].get_data_type();
+            Assert(data_type == static_cast<DataType>(column_info.data_type()));
+            operand->column_ = expr::ColumnInfo(column_info);
+            break;
+        }
+        case ppe::kValueExpr: {
+            operand->value_ = expr_pb.value_expr().value();
+            break;
+        }
+        case ppe::kBinaryArithExpr: {
+            auto& arith_pb = expr_pb.binary_arith_expr();
+            operand->op_ = arith_pb.op();
+            operand->left_ = ParseArithOperand(arith_pb.left());
+            operand->right_ = ParseArithOperand(arith_pb.right());
+            break;
+        }
+        default: {
+            std::string s;
+            google::protobuf::TextFormat::PrintToString(expr_pb, &s);
+            PanicInfo(ExprInvalid, "unsupported arith operand: {}", s);
+        }
+    }
+    return operand;
+}
+
+expr::TypedExprPtr
+ProtoParser::ParseArithCompareExprs(
+    const proto::plan::ArithCompareExpr& expr_pb) {
+    return std::make_shared<expr::ArithCompareExpr>(
+        ParseArithOperand(expr_pb.left()),
+        ParseArithOperand(expr_pb.right()),
+        expr_pb.op(),
+        static_cast<DataType>(expr_pb.compute_type()));
+}
+
+ExprPtr
+ProtoParser::ParseArithCompareExpr(
+    const proto::plan::ArithCompareExpr& expr_pb) {
+    auto typed_expr = std::dynamic_pointer_cast<const expr::ArithCompareExpr>(
+        ParseArithCompareExprs(expr_pb));
+    std::vector<ColumnInfo> columns;
+    for (const auto& column : typed_expr->GetColumns()) {
+        columns.emplace_back(
+            column.field_id_, column.data_type_, column.nested_path_);
+    }
+    return std::make_unique<ArithCompareExprImpl>(
+        std::move(columns),
+        expr_pb.left(),
+        expr_pb.right(),
+        expr_pb.op(),
+        static_cast<DataType>(expr_pb.compute_type()));
+}
+
 template <typename T>
 std::unique_ptr<JsonContainsExprImpl<T>>
 ExtractJsonContainsExprImpl(const proto::plan::JSONContainsExpr& expr_proto) {
@@ -968,6 +1079,15 @@ ProtoParser::ParseExprs(const proto::plan::Expr& expr_pb) {
         case ppe::kJsonContainsExpr: {
             return ParseJsonContainsExprs(expr_pb.json_contains_expr());
         }
+        case ppe::kNullExpr: {
+            return ParseNullExprs(expr_pb.null_expr());
+        }
+        case ppe::kStringFunctionExpr: {
+            return ParseStringFunctionExprs(expr_pb.string_function_expr());
+        }
+        case ppe::kArithCompareExpr: {
+            return ParseArithCompareExprs(expr_pb.arith_compare_expr());
+        }
         default: {
             std::string s;
             google::protobuf::TextFormat::PrintToString(expr_pb, &s);
@@ -1012,6 +1132,15 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kJsonContainsExpr: {
             return ParseJsonContainsExpr(expr_pb.json_contains_expr());
         }
+        case ppe::kNullExpr: {
+            return ParseNullExpr(expr_pb.null_expr());
+        }
+        case ppe::kStringFunctionExpr: {
+            return ParseStringFunctionExpr(expr_pb.string_function_expr());
+        }
+        case ppe::kArithCompareExpr: {
+            return ParseArithCompareExpr(expr_pb.arith_compare_expr());
+        }
         default: {
             std::string s;
             google::protobuf::TextFormat::PrintToString(expr_pb, &s);
@@ -55,6 +55,15 @@ class ProtoParser {
     ExprPtr
     ParseExistExpr(const proto::plan::ExistsExpr& expr_pb);
 
+    ExprPtr
+    ParseNullExpr(const proto::plan::NullExpr& expr_pb);
+
+    ExprPtr
+    ParseStringFunctionExpr(const proto::plan::StringFunctionExpr& expr_pb);
+
+    ExprPtr
+    ParseArithCompareExpr(const proto::plan::ArithCompareExpr& expr_pb);
+
     ExprPtr
     ParseJsonContainsExpr(const proto::plan::JSONContainsExpr& expr_pb);
 
@@ -101,6 +110,18 @@ class ProtoParser {
     expr::TypedExprPtr
     ParseExistExprs(const proto::plan::ExistsExpr& expr_pb);
 
+    expr::TypedExprPtr
+    ParseNullExprs(const proto::plan::NullExpr& expr_pb);
+
+    expr::TypedExprPtr
+    ParseStringFunctionExprs(const proto::plan::StringFunctionExpr& expr_pb);
+
+    expr::TypedExprPtr
+    ParseArithCompareExprs(const proto::plan::ArithCompareExpr& expr_pb);
+
+    expr::ArithOperandPtr
+    ParseArithOperand(const proto::plan::Expr& expr_pb);
+
     expr::TypedExprPtr
     ParseJsonContainsExprs(const proto::plan::JSONContainsExpr& expr_pb);
 
@@ -59,6 +59,15 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(JsonContainsExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(StringFunctionExpr& expr) override;
+
+    void
+    visit(ArithCompareExpr& expr) override;
+
  public:
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment,
                     int64_t row_count,
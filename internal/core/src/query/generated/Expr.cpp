@@ -63,4 +63,19 @@ void
 JsonContainsExpr::accept(ExprVisitor& visitor) {
     visitor.visit(*this);
 }
+
+void
+NullExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
+void
+StringFunctionExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
+void
+ArithCompareExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
 }  // namespace milvus::query
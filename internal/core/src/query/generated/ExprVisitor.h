@@ -48,5 +48,14 @@ class ExprVisitor {
 
     virtual void
     visit(JsonContainsExpr&) = 0;
+
+    virtual void
+    visit(NullExpr&) = 0;
+
+    virtual void
+    visit(StringFunctionExpr&) = 0;
+
+    virtual void
+    visit(ArithCompareExpr&) = 0;
 };
 }  // namespace milvus::query
@@ -48,6 +48,15 @@ class ExtractInfoExprVisitor : public ExprVisitor {
     void
     visit(JsonContainsExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(StringFunctionExpr& expr) override;
+
+    void
+    visit(ArithCompareExpr& expr) override;
+
  public:
     explicit ExtractInfoExprVisitor(ExtractedPlanInfo& plan_info)
         : plan_info_(plan_info) {
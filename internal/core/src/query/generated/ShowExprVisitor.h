@@ -49,6 +49,15 @@ class ShowExprVisitor : public ExprVisitor {
     void
     visit(JsonContainsExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(StringFunctionExpr& expr) override;
+
+    void
+    visit(ArithCompareExpr& expr) override;
+
  public:
     Json
 
@@ -53,6 +53,15 @@ class VerifyExprVisitor : public ExprVisitor {
     void
     visit(JsonContainsExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(StringFunctionExpr& expr) override;
+
+    void
+    visit(ArithCompareExpr& expr) override;
+
  public:
 };
 }  // namespace milvus::query
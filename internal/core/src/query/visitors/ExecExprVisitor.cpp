@@ -3522,4 +3522,33 @@ ExecExprVisitor::visit(JsonContainsExpr& expr) {
     bitset_opt_ = std::move(res);
 }
 
+void
+ExecExprVisitor::visit(NullExpr& expr) {
+    // null tests read the valid data of the field, which is only supported
+    // by the exec framework, see PhyNullFilterExpr
+    PanicInfo(Unsupported,
+              "null expr is not supported by ExecExprVisitor, field id: {}",
+              expr.column_.field_id.get());
+}
+
+void
+ExecExprVisitor::visit(StringFunctionExpr& expr) {
+    // string functions are only supported by the exec framework, see
+    // PhyStringFunctionExpr
+    PanicInfo(Unsupported,
+              "string function expr is not supported by ExecExprVisitor, "
+              "field id: {}",
+              expr.column_.field_id.get());
+}
+
+void
+ExecExprVisitor::visit(ArithCompareExpr& expr) {
+    // the arithmetic between fields is only supported by the exec framework,
+    // see PhyArithCompareExpr
+    PanicInfo(Unsupported,
+              "arith compare expr is not supported by ExecExprVisitor, "
+              "compute type: {}",
+              expr.compute_type_);
+}
+
 }  // namespace milvus::query
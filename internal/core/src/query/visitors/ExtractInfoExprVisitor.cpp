@@ -80,4 +80,21 @@ ExtractInfoExprVisitor::visit(JsonContainsExpr& expr) {
     plan_info_.add_involved_field(expr.column_.field_id);
 }
 
+void
+ExtractInfoExprVisitor::visit(NullExpr& expr) {
+    plan_info_.add_involved_field(expr.column_.field_id);
+}
+
+void
+ExtractInfoExprVisitor::visit(StringFunctionExpr& expr) {
+    plan_info_.add_involved_field(expr.column_.field_id);
+}
+
+void
+ExtractInfoExprVisitor::visit(ArithCompareExpr& expr) {
+    for (const auto& column : expr.columns_) {
+        plan_info_.add_involved_field(column.field_id);
+    }
+}
+
 }  // namespace milvus::query
@@ -370,4 +370,59 @@ ShowExprVisitor::visit(JsonContainsExpr& expr) {
     json_opt_ = res;
 }
 
+void
+ShowExprVisitor::visit(NullExpr& expr) {
+    using proto::plan::NullExpr_NullOp_Name;
+    AssertInfo(!json_opt_.has_value(),
+               "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "Null"},
+             {"field_id", expr.column_.field_id.get()},
+             {"data_type", expr.column_.data_type},
+             {"nested_path", expr.column_.nested_path},
+             {"op", NullExpr_NullOp_Name(expr.op_)}};
+    json_opt_ = res;
+}
+
+void
+ShowExprVisitor::visit(StringFunctionExpr& expr) {
+    using proto::plan::OpType_Name;
+    using proto::plan::StringFunctionExpr_FunctionType_Name;
+    AssertInfo(!json_opt_.has_value(),
+               "[ShowExprVisitor]Ret json already has value before visit");
+
+    std::vector<std::string> arguments;
+    for (const auto& arg : expr.arguments_) {
+        arguments.push_back(arg.ShortDebugString());
+    }
+    Json res{{"expr_type", "StringFunction"},
+             {"field_id", expr.column_.field_id.get()},
+             {"data_type", expr.column_.data_type},
+             {"nested_path", expr.column_.nested_path},
+             {"function", StringFunctionExpr_FunctionType_Name(expr.function_)},
+             {"arguments", arguments},
+             {"op", OpType_Name(expr.op_type_)},
+             {"value", expr.value_.ShortDebugString()}};
+    json_opt_ = res;
+}
+
+void
+ShowExprVisitor::visit(ArithCompareExpr& expr) {
+    using proto::plan::OpType_Name;
+    AssertInfo(!json_opt_.has_value(),
+               "[ShowExprVisitor]Ret json already has value before visit");
+
+    std::vector<int64_t> field_ids;
+    for (const auto& column : expr.columns_) {
+        field_ids.push_back(column.field_id.get());
+    }
+    Json res{{"expr_type", "ArithCompare"},
+             {"field_ids", field_ids},
+             {"left", expr.left_.ShortDebugString()},
+             {"op", OpType_Name(expr.op_type_)},
+             {"right", expr.right_.ShortDebugString()},
+             {"compute_type", expr.compute_type_}};
+    json_opt_ = res;
+}
+
 }  // namespace milvus::query
@@ -62,4 +62,19 @@ VerifyExprVisitor::visit(JsonContainsExpr& expr) {
     // TODO
 }
 
+void
+VerifyExprVisitor::visit(NullExpr& expr) {
+    // TODO
+}
+
+void
+VerifyExprVisitor::visit(StringFunctionExpr& expr) {
+    // TODO
+}
+
+void
+VerifyExprVisitor::visit(ArithCompareExpr& expr) {
+    // TODO
+}
+
 }  // namespace milvus::query
@@ -816,6 +816,104 @@ LoadFieldDatasFromRemote(const std::vector<std::string>& remote_files,
     }
 }
 
+FieldDataPtr
+CreateScalarFieldDataFrom(const DataArray& data,
+                          int64_t count,
+                          const FieldMeta& field_meta) {
+    auto data_type = field_meta.get_data_type();
+    auto nullable = field_meta.is_nullable();
+    AssertInfo(!IsVectorDataType(data_type),
+               "vector field {} can't be created from scalar data",
+               field_meta.get_id().get());
+    auto field_data = storage::CreateFieldData(data_type, nullable);
+
+    std::vector<uint8_t> valid_data;
+    if (nullable) {
+        AssertInfo(data.valid_data_size() == count,
+                   "valid data size {} mismatches row count {}",
+                   data.valid_data_size(),
+                   count);
+        valid_data.resize((count + 7) / 8, 0);
+        for (int64_t i = 0; i < count; ++i) {
+            if (data.valid_data(i)) {
+                valid_data[i / 8] |= (1 << (i % 8));
+            }
+        }
+    }
+    auto fill = [&](const void* source) {
+        if (nullable) {
+            field_data->FillFieldData(source, valid_data.data(), count);
+        } else {
+            field_data->FillFieldData(source, count);
+        }
+    };
+
+    switch (data_type) {
+        case DataType::BOOL: {
+            fill(data.scalars().bool_data().data().data());
+            break;
+        }
+        case DataType::INT8: {
+            auto& src = data.scalars().int_data().data();
+            std::vector<int8_t> values(src.begin(), src.end());
+            fill(values.data());
+            break;
+        }
+        case DataType::INT16: {
+            auto& src = data.scalars().int_data().data();
+            std::vector<int16_t> values(src.begin(), src.end());
+            fill(values.data());
+            break;
+        }
+        case DataType::INT32: {
+            fill(data.scalars().int_data().data().data());
+            break;
+        }
+        case DataType::INT64: {
+            fill(data.scalars().long_data().data().data());
+            break;
+        }
+        case DataType::FLOAT: {
+            fill(data.scalars().float_data().data().data());
+            break;
+        }
+        case DataType::DOUBLE: {
+            fill(data.scalars().double_data().data().data());
+            break;
+        }
+        case DataType::STRING:
+        case DataType::VARCHAR: {
+            auto& src = data.scalars().string_data().data();
+            std::vector<std::string> values(src.begin(), src.end());
+            fill(values.data());
+            break;
+        }
+        case DataType::JSON: {
+            auto& src = data.scalars().json_data().data();
+            std::vector<Json> values(src.size());
+            for (int i = 0; i < src.size(); ++i) {
+                values[i] = Json(simdjson::padded_string(src.Get(i)));
+            }
+            fill(values.data());
+            break;
+        }
+        case DataType::ARRAY: {
+            auto& src = data.scalars().array_data().data();
+            std::vector<Array> values(src.size());
+            for (int i = 0; i < src.size(); ++i) {
+                values[i] = Array(src.Get(i));
+            }
+            fill(values.data());
+            break;
+        }
+        default: {
+            PanicInfo(DataTypeInvalid,
+                      fmt::format("unsupported data type {}", data_type));
+        }
+    }
+    return field_data;
+}
+
 int64_t
 upper_bound(const ConcurrentVector<Timestamp>& timestamps,
             int64_t first,
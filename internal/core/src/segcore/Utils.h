@@ -188,6 +188,13 @@ void
 LoadFieldDatasFromRemote(const std::vector<std::string>& remote_files,
                          FieldDataChannelPtr channel);
 
+// create the field data of a scalar field from the data array, it fills the
+// fields added to the collection after the segment was written
+FieldDataPtr
+CreateScalarFieldDataFrom(const DataArray& data,
+                          int64_t count,
+                          const FieldMeta& field_meta);
+
 /**
  * Returns an index pointing to the first element in the range [first, last) such that `value < element` is true
  * (i.e. that is strictly greater than value), or last if no such element is found.
@@ -367,6 +367,39 @@ LoadFieldRawData(CSegmentInterface c_segment,
     }
 }
 
+// load the field added to the collection after the segment was written,
+// the data is a serialized schema.FieldData of the null or default values
+CStatus
+LoadFieldDataArray(CSegmentInterface c_segment,
+                   int64_t field_id,
+                   const uint8_t* data,
+                   const uint64_t size,
+                   int64_t row_count) {
+    try {
+        auto segment_interface =
+            reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment =
+            dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        auto data_array = std::make_unique<milvus::proto::schema::FieldData>();
+        auto suc = data_array->ParseFromArray(data, size);
+        AssertInfo(suc, "unmarshal field data failed");
+        auto& field_meta = segment->get_schema()[milvus::FieldId(field_id)];
+        auto field_data = milvus::segcore::CreateScalarFieldDataFrom(
+            *data_array, row_count, field_meta);
+        milvus::FieldDataChannelPtr channel =
+            std::make_shared<milvus::FieldDataChannel>();
+        channel->push(field_data);
+        channel->close();
+        auto field_data_info = milvus::FieldDataInfo(
+            field_id, static_cast<size_t>(row_count), channel);
+        segment->LoadFieldData(milvus::FieldId(field_id), field_data_info);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment,
                   CLoadDeletedRecordInfo deleted_record_info) {
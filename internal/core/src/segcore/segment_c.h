@@ -108,6 +108,13 @@ LoadFieldRawData(CSegmentInterface c_segment,
                  const void* data,
                  int64_t row_count);
 
+CStatus
+LoadFieldDataArray(CSegmentInterface c_segment,
+                   int64_t field_id,
+                   const uint8_t* data,
+                   const uint64_t size,
+                   int64_t row_count);
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment,
                   CLoadDeletedRecordInfo deleted_record_info);
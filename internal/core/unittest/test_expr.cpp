@@ -5309,6 +5309,708 @@ TEST_P(ExprTest, TestExistsWithJSON) {
     }
 }
 
+TEST_P(ExprTest, TestNullExpr) {
+    std::string serialized_expr_plan = R"(vector_anns: <
+                                            field_id: %1%
+                                            predicates: <
+                                                null_expr: <
+                                                    column_info: <
+                                                        field_id: %2%
+                                                        data_type: %3%
+                                                        %4%
+                                                    >
+                                                    op: %5%
+                                                >
+                                            >
+                                            query_info: <
+                                                topk: 10
+                                                round_decimal: 3
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                            >
+                                            placeholder_tag: "$0"
+     >)";
+
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", data_type, 16, metric_type);
+    auto i64_fid = schema->AddDebugField("age64", DataType::INT64);
+    auto nullable_i64_fid =
+        schema->AddDebugField("nullable_age64", DataType::INT64, true);
+    auto nullable_str_fid =
+        schema->AddDebugField("nullable_str", DataType::VARCHAR, true);
+    auto nullable_json_fid =
+        schema->AddDebugField("nullable_json", DataType::JSON, true);
+    schema->set_primary_field_id(i64_fid);
+
+    int N = 1000;
+    auto raw_data = DataGen(schema, N);
+    auto growing = CreateGrowingSegment(schema, empty_index_meta);
+    growing->PreInsert(N);
+    growing->Insert(0,
+                    N,
+                    raw_data.row_ids_.data(),
+                    raw_data.timestamps_.data(),
+                    raw_data.raw_);
+    auto sealed = SealedCreator(schema, raw_data);
+
+    struct NullTestcase {
+        FieldId field_id;
+        DataType data_type;
+        std::string nested_path;
+        // whether the row is null when the field is valid
+        bool null_on_valid;
+    };
+    std::vector<NullTestcase> testcases{
+        {nullable_i64_fid, DataType::INT64, "", false},
+        {nullable_str_fid, DataType::VARCHAR, "", false},
+        {nullable_json_fid, DataType::JSON, "", false},
+        {nullable_json_fid, DataType::JSON, "int", false},
+        {nullable_json_fid, DataType::JSON, "not_exist", true},
+        {i64_fid, DataType::INT64, "", false},
+    };
+    std::vector<proto::plan::NullExpr_NullOp> ops{
+        proto::plan::NullExpr_NullOp_IsNull,
+        proto::plan::NullExpr_NullOp_IsNotNull,
+    };
+
+    std::vector<SegmentInternalInterface*> segments{growing.get(),
+                                                    sealed.get()};
+    for (auto segment : segments) {
+        query::ExecPlanNodeVisitor visitor(*segment, MAX_TIMESTAMP);
+        for (const auto& testcase : testcases) {
+            FixedVector<bool> valid_data(N, true);
+            if (schema->operator[](testcase.field_id).is_nullable()) {
+                valid_data = raw_data.get_col_valid(testcase.field_id);
+            }
+            auto nested_path =
+                testcase.nested_path.empty()
+                    ? std::string()
+                    : "nested_path: \"" + testcase.nested_path + "\"";
+            for (auto op : ops) {
+                auto expr =
+                    boost::format(serialized_expr_plan) % vec_fid.get() %
+                    testcase.field_id.get() %
+                    proto::schema::DataType_Name(int(testcase.data_type)) %
+                    nested_path % proto::plan::NullExpr_NullOp_Name(op);
+                auto binary_plan =
+                    translate_text_plan_with_metric_type(expr.str());
+                auto plan = CreateSearchPlanByExpr(
+                    *schema, binary_plan.data(), binary_plan.size());
+
+                BitsetType final;
+                visitor.ExecuteExprNode(
+                    plan->plan_node_->filter_plannode_.value(),
+                    segment,
+                    N,
+                    final);
+                EXPECT_EQ(final.size(), N);
+
+                for (int i = 0; i < N; ++i) {
+                    auto is_null = !valid_data[i] || testcase.null_on_valid;
+                    auto ref = op == proto::plan::NullExpr_NullOp_IsNull
+                                   ? is_null
+                                   : !is_null;
+                    ASSERT_EQ(final[i], ref)
+                        << expr.str() << "@" << i << "!!" << valid_data[i];
+                }
+            }
+        }
+    }
+}
+
+TEST_P(ExprTest, TestStringFunctionExpr) {
+    std::string serialized_expr_plan = R"(vector_anns: <
+                                            field_id: %1%
+                                            predicates: <
+                                                string_function_expr: <
+                                                    column_info: <
+                                                        field_id: %2%
+                                                        data_type: %3%
+                                                        %4%
+                                                    >
+                                                    %5%
+                                                >
+                                            >
+                                            query_info: <
+                                                topk: 10
+                                                round_decimal: 3
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                            >
+                                            placeholder_tag: "$0"
+     >)";
+
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", data_type, 16, metric_type);
+    auto i64_fid = schema->AddDebugField("age64", DataType::INT64);
+    auto str_fid = schema->AddDebugField("str", DataType::VARCHAR);
+    auto json_fid = schema->AddDebugField("json", DataType::JSON);
+    schema->set_primary_field_id(i64_fid);
+
+    int N = 1000;
+    auto raw_data = DataGen(schema, N);
+    auto growing = CreateGrowingSegment(schema, empty_index_meta);
+    growing->PreInsert(N);
+    growing->Insert(0,
+                    N,
+                    raw_data.row_ids_.data(),
+                    raw_data.timestamps_.data(),
+                    raw_data.raw_);
+    auto sealed = SealedCreator(schema, raw_data);
+
+    auto str_col = raw_data.get_col<std::string>(str_fid);
+    auto json_col = raw_data.get_col<std::string>(json_fid);
+    auto target = str_col[N / 2];
+
+    auto to_lower = [](std::string str) {
+        std::transform(str.begin(), str.end(), str.begin(), ::tolower);
+        return str;
+    };
+    auto to_upper = [](std::string str) {
+        std::transform(str.begin(), str.end(), str.begin(), ::toupper);
+        return str;
+    };
+
+    struct StringFunctionTestcase {
+        std::string function;
+        // nullopt if the value is missing or not a string
+        std::function<bool(const std::optional<std::string>&)> ref;
+    };
+    std::vector<StringFunctionTestcase> testcases{
+        {R"(function: Lower op: Equal value: <string_val: ")" + target +
+             R"(">)",
+         [&](const std::optional<std::string>& s) {
+             return s.has_value() && to_lower(s.value()) == target;
+         }},
+        {R"(function: Upper op: NotEqual value: <string_val: ")" + target +
+             R"(">)",
+         [&](const std::optional<std::string>& s) {
+             return s.has_value() && to_upper(s.value()) != target;
+         }},
+        {R"(function: Upper op: GreaterThan value: <string_val: "5">)",
+         [&](const std::optional<std::string>& s) {
+             return s.has_value() && to_upper(s.value()) > "5";
+         }},
+        {R"(function: Length op: GreaterEqual value: <int64_val: 10>)",
+         [](const std::optional<std::string>& s) {
+             return s.has_value() && s.value().size() >= 10;
+         }},
+        {R"(function: Length op: LessThan value: <int64_val: 9>)",
+         [](const std::optional<std::string>& s) {
+             return s.has_value() && s.value().size() < 9;
+         }},
+        {R"(function: RegexMatch arguments: <string_val: "^1[0-9]*7$">)",
+         [](const std::optional<std::string>& s) {
+             return s.has_value() && s.value().front() == '1' &&
+                    s.value().back() == '7';
+         }},
+        {R"(function: RegexMatch arguments: <string_val: "23">)",
+         [](const std::optional<std::string>& s) {
+             return s.has_value() &&
+                    s.value().find("23") != std::string::npos;
+         }},
+    };
+
+    struct ColumnTestcase {
+        FieldId field_id;
+        DataType data_type;
+        std::string nested_path;
+    };
+    std::vector<ColumnTestcase> columns{
+        {str_fid, DataType::VARCHAR, ""},
+        {json_fid, DataType::JSON, "string"},
+        // not a string
+        {json_fid, DataType::JSON, "int"},
+        {json_fid, DataType::JSON, "not_exist"},
+    };
+
+    std::vector<SegmentInternalInterface*> segments{growing.get(),
+                                                    sealed.get()};
+    for (auto segment : segments) {
+        query::ExecPlanNodeVisitor visitor(*segment, MAX_TIMESTAMP);
+        for (const auto& column : columns) {
+            auto nested_path =
+                column.nested_path.empty()
+                    ? std::string()
+                    : "nested_path: \"" + column.nested_path + "\"";
+            auto pointer = milvus::Json::pointer({column.nested_path});
+            for (const auto& testcase : testcases) {
+                auto expr =
+                    boost::format(serialized_expr_plan) % vec_fid.get() %
+                    column.field_id.get() %
+                    proto::schema::DataType_Name(int(column.data_type)) %
+                    nested_path % testcase.function;
+                auto binary_plan =
+                    translate_text_plan_with_metric_type(expr.str());
+                auto plan = CreateSearchPlanByExpr(
+                    *schema, binary_plan.data(), binary_plan.size());
+
+                BitsetType final;
+                visitor.ExecuteExprNode(
+                    plan->plan_node_->filter_plannode_.value(),
+                    segment,
+                    N,
+                    final);
+                EXPECT_EQ(final.size(), N);
+
+                for (int i = 0; i < N; ++i) {
+                    std::optional<std::string> val;
+                    if (column.data_type == DataType::VARCHAR) {
+                        val = str_col[i];
+                    } else {
+                        auto x =
+                            milvus::Json(simdjson::padded_string(json_col[i]))
+                                .at<std::string_view>(pointer);
+                        if (!x.error()) {
+                            val = std::string(x.value());
+                        }
+                    }
+                    ASSERT_EQ(final[i], testcase.ref(val))
+                        << expr.str() << "@" << i;
+                }
+            }
+        }
+    }
+}
+
+TEST_P(ExprTest, TestCastExpr) {
+    std::string serialized_expr_plan = R"(vector_anns: <
+                                            field_id: %1%
+                                            predicates: <
+                                                %2%
+                                            >
+                                            query_info: <
+                                                topk: 10
+                                                round_decimal: 3
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                            >
+                                            placeholder_tag: "$0"
+     >)";
+    std::string unary_range_expr = R"(unary_range_expr: <
+                                        column_info: <
+                                            field_id: %1%
+                                            data_type: %2%
+                                            %3%
+                                            cast_type: %4%
+                                        >
+                                        op: %5%
+                                        value: < %6% >
+                                    >)";
+
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", data_type, 16, metric_type);
+    auto i64_fid = schema->AddDebugField("age64", DataType::INT64);
+    auto i32_fid = schema->AddDebugField("age32", DataType::INT32);
+    auto float_fid = schema->AddDebugField("age_float", DataType::FLOAT);
+    auto json_fid = schema->AddDebugField("json", DataType::JSON);
+    schema->set_primary_field_id(i64_fid);
+
+    int N = 1000;
+    auto raw_data = DataGen(schema, N);
+    auto growing = CreateGrowingSegment(schema, empty_index_meta);
+    growing->PreInsert(N);
+    growing->Insert(0,
+                    N,
+                    raw_data.row_ids_.data(),
+                    raw_data.timestamps_.data(),
+                    raw_data.raw_);
+    auto sealed = SealedCreator(schema, raw_data);
+
+    auto i32_col = raw_data.get_col<int32_t>(i32_fid);
+    auto float_col = raw_data.get_col<float>(float_fid);
+    auto json_col = raw_data.get_col<std::string>(json_fid);
+
+    auto json_at = [&](int i, const std::string& key) {
+        auto json = milvus::Json(simdjson::padded_string(json_col[i]));
+        simdjson::dom::element element;
+        auto error = json.dom_doc().at_pointer("/" + key).get(element);
+        AssertInfo(error == simdjson::SUCCESS, "key {} not found", key);
+        return element;
+    };
+    auto unary = [&](FieldId field_id,
+                     DataType data_type,
+                     const std::string& key,
+                     DataType cast_type,
+                     const std::string& op,
+                     const std::string& value) {
+        auto nested_path =
+            key.empty() ? std::string() : "nested_path: \"" + key + "\"";
+        return (boost::format(unary_range_expr) % field_id.get() %
+                proto::schema::DataType_Name(int(data_type)) % nested_path %
+                proto::schema::DataType_Name(int(cast_type)) % op % value)
+            .str();
+    };
+
+    auto threshold = int64_t(1) << 30;
+    auto term0 = std::string(json_at(0, "string").get_string().value());
+    auto term1 = std::string(json_at(1, "string").get_string().value());
+
+    struct CastTestcase {
+        std::string predicate;
+        std::function<bool(int)> ref;
+    };
+    std::vector<CastTestcase> testcases{
+        {unary(json_fid,
+               DataType::JSON,
+               "int",
+               DataType::INT64,
+               "GreaterThan",
+               "int64_val: " + std::to_string(threshold)),
+         [&](int i) {
+             return json_at(i, "int").get_int64().value() > threshold;
+         }},
+        // the string is parsed
+        {unary(json_fid,
+               DataType::JSON,
+               "string",
+               DataType::INT64,
+               "GreaterThan",
+               "int64_val: " + std::to_string(threshold)),
+         [&](int i) {
+             auto str = json_at(i, "string").get_string().value();
+             return std::stoll(std::string(str)) > threshold;
+         }},
+        {unary(json_fid,
+               DataType::JSON,
+               "string",
+               DataType::DOUBLE,
+               "LessEqual",
+               "float_val: 1e9"),
+         [&](int i) {
+             auto str = json_at(i, "string").get_string().value();
+             return std::stod(std::string(str)) <= 1e9;
+         }},
+        // the double is truncated
+        {unary(json_fid,
+               DataType::JSON,
+               "double",
+               DataType::INT64,
+               "LessThan",
+               "int64_val: " + std::to_string(threshold)),
+         [&](int i) {
+             auto value = json_at(i, "double").get_double().value();
+             return static_cast<int64_t>(value) < threshold;
+         }},
+        {unary(json_fid,
+               DataType::JSON,
+               "int",
+               DataType::VARCHAR,
+               "PrefixMatch",
+               R"(string_val: "1")"),
+         [&](int i) {
+             auto value = json_at(i, "int").get_int64().value();
+             return std::to_string(value).front() == '1';
+         }},
+        {unary(json_fid,
+               DataType::JSON,
+               "bool",
+               DataType::BOOL,
+               "Equal",
+               "bool_val: true"),
+         [](int i) { return true; }},
+        // the array can't be cast
+        {unary(json_fid,
+               DataType::JSON,
+               "array",
+               DataType::INT64,
+               "Equal",
+               "int64_val: 1"),
+         [](int i) { return false; }},
+        {unary(json_fid,
+               DataType::JSON,
+               "array",
+               DataType::INT64,
+               "NotEqual",
+               "int64_val: 1"),
+         [](int i) { return true; }},
+        {unary(json_fid,
+               DataType::JSON,
+               "not_exist",
+               DataType::INT64,
+               "Equal",
+               "int64_val: 1"),
+         [](int i) { return false; }},
+        {unary(i32_fid,
+               DataType::INT32,
+               "",
+               DataType::DOUBLE,
+               "GreaterThan",
+               "float_val: 500.5"),
+         [&](int i) { return static_cast<double>(i32_col[i]) > 500.5; }},
+        {unary(float_fid,
+               DataType::FLOAT,
+               "",
+               DataType::INT64,
+               "Equal",
+               "int64_val: 0"),
+         [&](int i) { return static_cast<int64_t>(float_col[i]) == 0; }},
+        {R"(term_expr: <
+                column_info: <
+                    field_id: )" +
+             std::to_string(json_fid.get()) + R"(
+                    data_type: JSON
+                    nested_path: "string"
+                    cast_type: Int64
+                >
+                values: < int64_val: )" +
+             term0 + R"( >
+                values: < int64_val: )" +
+             term1 + R"( >
+            >)",
+         [&](int i) {
+             auto str = json_at(i, "string").get_string().value();
+             return str == term0 || str == term1;
+         }},
+    };
+
+    std::vector<SegmentInternalInterface*> segments{growing.get(),
+                                                    sealed.get()};
+    for (auto segment : segments) {
+        query::ExecPlanNodeVisitor visitor(*segment, MAX_TIMESTAMP);
+        for (const auto& testcase : testcases) {
+            auto expr = boost::format(serialized_expr_plan) % vec_fid.get() %
+                        testcase.predicate;
+            auto binary_plan = translate_text_plan_with_metric_type(expr.str());
+            auto plan = CreateSearchPlanByExpr(
+                *schema, binary_plan.data(), binary_plan.size());
+
+            BitsetType final;
+            visitor.ExecuteExprNode(
+                plan->plan_node_->filter_plannode_.value(), segment, N, final);
+            EXPECT_EQ(final.size(), N);
+
+            for (int i = 0; i < N; ++i) {
+                ASSERT_EQ(final[i], testcase.ref(i)) << expr.str() << "@" << i;
+            }
+        }
+    }
+}
+
+TEST_P(ExprTest, TestArithCompareExpr) {
+    std::string serialized_expr_plan = R"(vector_anns: <
+                                            field_id: %1%
+                                            predicates: <
+                                                %2%
+                                            >
+                                            query_info: <
+                                                topk: 10
+                                                round_decimal: 3
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                            >
+                                            placeholder_tag: "$0"
+     >)";
+
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", data_type, 16, metric_type);
+    auto i64_fid = schema->AddDebugField("age64", DataType::INT64);
+    auto i32_fid = schema->AddDebugField("age32", DataType::INT32);
+    auto i8_fid = schema->AddDebugField("age8", DataType::INT8);
+    auto i8_1_fid = schema->AddDebugField("age81", DataType::INT8);
+    auto float_fid = schema->AddDebugField("age_float", DataType::FLOAT);
+    auto json_fid = schema->AddDebugField("json", DataType::JSON);
+    schema->set_primary_field_id(i64_fid);
+
+    int N = 1000;
+    auto raw_data = DataGen(schema, N);
+    auto growing = CreateGrowingSegment(schema, empty_index_meta);
+    growing->PreInsert(N);
+    growing->Insert(0,
+                    N,
+                    raw_data.row_ids_.data(),
+                    raw_data.timestamps_.data(),
+                    raw_data.raw_);
+    auto sealed = SealedCreator(schema, raw_data);
+
+    auto i64_col = raw_data.get_col<int64_t>(i64_fid);
+    auto i32_col = raw_data.get_col<int32_t>(i32_fid);
+    auto i8_col = raw_data.get_col<int8_t>(i8_fid);
+    auto i8_1_col = raw_data.get_col<int8_t>(i8_1_fid);
+    auto float_col = raw_data.get_col<float>(float_fid);
+    auto json_col = raw_data.get_col<std::string>(json_fid);
+
+    auto json_int = [&](int i) {
+        auto json = milvus::Json(simdjson::padded_string(json_col[i]));
+        return json.dom_doc().at_pointer("/int").get_int64().value();
+    };
+    // the int64 arithmetic wraps around on overflow
+    auto wrap = [](uint64_t value) { return static_cast<int64_t>(value); };
+
+    auto column = [](FieldId field_id,
+                     DataType data_type,
+                     const std::string& key = "") {
+        auto nested_path =
+            key.empty() ? std::string() : "nested_path: \"" + key + "\"";
+        return (boost::format(
+                    "column_expr: < info: < field_id: %1% data_type: %2% %3% "
+                    "> >") %
+                field_id.get() %
+                proto::schema::DataType_Name(int(data_type)) % nested_path)
+            .str();
+    };
+    auto value = [](const std::string& val) {
+        return "value_expr: < value: < " + val + " > >";
+    };
+    auto arith = [](const std::string& left,
+                    const std::string& op,
+                    const std::string& right) {
+        return "binary_arith_expr: < left: < " + left + " > right: < " +
+               right + " > op: " + op + " >";
+    };
+    auto compare = [](const std::string& left,
+                      const std::string& op,
+                      const std::string& right,
+                      const std::string& compute_type) {
+        return "arith_compare_expr: < left: < " + left + " > right: < " +
+               right + " > op: " + op + " compute_type: " + compute_type +
+               " >";
+    };
+
+    auto age64 = column(i64_fid, DataType::INT64);
+    auto age32 = column(i32_fid, DataType::INT32);
+
+    struct ArithCompareTestcase {
+        std::string predicate;
+        std::function<bool(int)> ref;
+    };
+    std::vector<ArithCompareTestcase> testcases{
+        // int8 is promoted to int64, the sum doesn't overflow
+        {compare(arith(column(i8_fid, DataType::INT8),
+                       "Add",
+                       column(i8_1_fid, DataType::INT8)),
+                 "GreaterThan",
+                 value("int64_val: 200"),
+                 "Int64"),
+         [&](int i) {
+             return int64_t(i8_col[i]) + int64_t(i8_1_col[i]) > 200;
+         }},
+        // the division truncates in int64
+        {compare(arith(arith(age64, "Mul", value("int64_val: 7")),
+                       "Div",
+                       arith(age32, "Add", value("int64_val: 3"))),
+                 "GreaterThan",
+                 value("int64_val: 6"),
+                 "Int64"),
+         [&](int i) { return i64_col[i] * 7 / (i32_col[i] + 3) > 6; }},
+        // but not in double
+        {compare(arith(age64,
+                       "Div",
+                       arith(age32, "Add", value("int64_val: 3"))),
+                 "GreaterThan",
+                 value("float_val: 0.9"),
+                 "Double"),
+         [&](int i) {
+             return double(i64_col[i]) / (double(i32_col[i]) + 3) > 0.9;
+         }},
+        // the row doesn't match if the divisor is zero
+        {compare(arith(age64,
+                       "Mod",
+                       arith(age32, "Sub", value("int64_val: 500"))),
+                 "Equal",
+                 value("int64_val: 0"),
+                 "Int64"),
+         [&](int i) {
+             auto divisor = int64_t(i32_col[i]) - 500;
+             return divisor != 0 && i64_col[i] % divisor == 0;
+         }},
+        {compare(arith(age64, "Div", age32),
+                 "NotEqual",
+                 value("float_val: 1"),
+                 "Double"),
+         [&](int i) {
+             return i32_col[i] != 0 &&
+                    double(i64_col[i]) / double(i32_col[i]) != 1;
+         }},
+        {compare(arith(column(float_fid, DataType::FLOAT), "Mul", age32),
+                 "LessEqual",
+                 arith(age64, "Sub", value("float_val: 0.5")),
+                 "Double"),
+         [&](int i) {
+             return double(float_col[i]) * double(i32_col[i]) <=
+                    double(i64_col[i]) - 0.5;
+         }},
+        // overflow wraps around
+        {compare(arith(arith(age64, "Add", age32),
+                       "Add",
+                       value("int64_val: 9223372036854775807")),
+                 "LessThan",
+                 value("int64_val: 0"),
+                 "Int64"),
+         [&](int i) {
+             return wrap(uint64_t(i64_col[i]) + uint64_t(i32_col[i]) +
+                         uint64_t(INT64_MAX)) < 0;
+         }},
+        {compare(arith(arith(age64, "Mul", age64),
+                       "Mul",
+                       value("int64_val: 4611686018427387904")),
+                 "Equal",
+                 value("int64_val: 0"),
+                 "Int64"),
+         [&](int i) {
+             return wrap(uint64_t(i64_col[i]) * uint64_t(i64_col[i]) *
+                         (uint64_t(1) << 62)) == 0;
+         }},
+        // INT64_MIN / -1 wraps to INT64_MIN
+        {compare(arith(arith(arith(age64, "Sub", age64),
+                             "Sub",
+                             value("int64_val: -9223372036854775808")),
+                       "Div",
+                       arith(age32,
+                             "Sub",
+                             arith(age32, "Add", value("int64_val: 1")))),
+                 "Equal",
+                 value("int64_val: -9223372036854775808"),
+                 "Int64"),
+         [](int i) { return true; }},
+        // json values are evaluated in double
+        {compare(arith(column(json_fid, DataType::JSON, "int"), "Sub", age64),
+                 "GreaterThan",
+                 value("float_val: 1e9"),
+                 "Double"),
+         [&](int i) {
+             return double(json_int(i)) - double(i64_col[i]) > 1e9;
+         }},
+        // a json value which is not a number never matches
+        {compare(arith(column(json_fid, DataType::JSON, "string"),
+                       "Add",
+                       age64),
+                 "NotEqual",
+                 value("float_val: 0"),
+                 "Double"),
+         [](int i) { return false; }},
+        {compare(arith(column(json_fid, DataType::JSON, "not_exist"),
+                       "Add",
+                       age64),
+                 "NotEqual",
+                 value("float_val: 0"),
+                 "Double"),
+         [](int i) { return false; }},
+    };
+
+    std::vector<SegmentInternalInterface*> segments{growing.get(),
+                                                    sealed.get()};
+    for (auto segment : segments) {
+        query::ExecPlanNodeVisitor visitor(*segment, MAX_TIMESTAMP);
+        for (const auto& testcase : testcases) {
+            auto expr = boost::format(serialized_expr_plan) % vec_fid.get() %
+                        testcase.predicate;
+            auto binary_plan = translate_text_plan_with_metric_type(expr.str());
+            auto plan = CreateSearchPlanByExpr(
+                *schema, binary_plan.data(), binary_plan.size());
+
+            BitsetType final;
+            visitor.ExecuteExprNode(
+                plan->plan_node_->filter_plannode_.value(), segment, N, final);
+            EXPECT_EQ(final.size(), N);
+
+            for (int i = 0; i < N; ++i) {
+                ASSERT_EQ(final[i], testcase.ref(i)) << expr.str() << "@" << i;
+            }
+        }
+    }
+}
+
 template <typename T>
 struct Testcase {
     std::vector<T> term;
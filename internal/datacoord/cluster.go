@@ -25,6 +25,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
@@ -41,6 +42,7 @@ type Cluster interface {
 	Watch(ctx context.Context, ch RWChannel) error
 	Flush(ctx context.Context, nodeID int64, channel string, segments []*datapb.SegmentInfo) error
 	FlushChannels(ctx context.Context, nodeID int64, flushTs Timestamp, channels []string) error
+	UpdateChannelSchema(ctx context.Context, nodeID int64, flushTs Timestamp, channels []string, schema *schemapb.CollectionSchema) error
 	PreImport(nodeID int64, in *datapb.PreImportRequest) error
 	ImportV2(nodeID int64, in *datapb.ImportRequest) error
 	QueryPreImport(nodeID int64, in *datapb.QueryPreImportRequest) (*datapb.QueryPreImportResponse, error)
@@ -159,6 +161,31 @@ func (c *ClusterImpl) FlushChannels(ctx context.Context, nodeID int64, flushTs T
 	return c.sessionManager.FlushChannels(ctx, nodeID, req)
 }
 
+// UpdateChannelSchema flushes the channels and makes the datanode write the new segments with the schema.
+func (c *ClusterImpl) UpdateChannelSchema(ctx context.Context, nodeID int64, flushTs Timestamp, channels []string, schema *schemapb.CollectionSchema) error {
+	if len(channels) == 0 {
+		return nil
+	}
+
+	for _, channel := range channels {
+		if !c.channelManager.Match(nodeID, channel) {
+			return fmt.Errorf("channel %s is not watched on node %d", channel, nodeID)
+		}
+	}
+
+	req := &datapb.FlushChannelsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			commonpbutil.WithTargetID(nodeID),
+		),
+		FlushTs:  flushTs,
+		Channels: channels,
+		Schema:   schema,
+	}
+
+	return c.sessionManager.FlushChannels(ctx, nodeID, req)
+}
+
 func (c *ClusterImpl) PreImport(nodeID int64, in *datapb.PreImportRequest) error {
 	return c.sessionManager.PreImport(nodeID, in)
 }
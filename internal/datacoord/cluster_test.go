@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -178,6 +179,32 @@ func (suite *ClusterSuite) TestFlushChannels() {
 	})
 }
 
+func (suite *ClusterSuite) TestUpdateChannelSchema() {
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}, {FieldID: 101}}}
+	suite.Run("channel not match with node", func() {
+		suite.SetupTest()
+
+		suite.mockChManager.EXPECT().Match(mock.Anything, mock.Anything).Return(false).Once()
+		cluster := NewClusterImpl(suite.mockSession, suite.mockChManager)
+		err := cluster.UpdateChannelSchema(context.Background(), 1, 0, []string{"ch-1"}, schema)
+		suite.Error(err)
+	})
+
+	suite.Run("schema is sent", func() {
+		suite.SetupTest()
+
+		suite.mockChManager.EXPECT().Match(mock.Anything, mock.Anything).Return(true).Once()
+		suite.mockSession.EXPECT().FlushChannels(mock.Anything, int64(1), mock.Anything).
+			RunAndReturn(func(ctx context.Context, nodeID int64, req *datapb.FlushChannelsRequest) error {
+				suite.Equal(schema, req.GetSchema())
+				return nil
+			}).Once()
+		cluster := NewClusterImpl(suite.mockSession, suite.mockChManager)
+		err := cluster.UpdateChannelSchema(context.Background(), 1, 0, []string{"ch-1"}, schema)
+		suite.NoError(err)
+	})
+}
+
 func (suite *ClusterSuite) TestQuerySlot() {
 	suite.Run("query slot failed", func() {
 		suite.SetupTest()
@@ -162,6 +162,7 @@ func (t *clusteringCompactionTask) BuildCompactionRequest() (*datapb.CompactionP
 		Type:               t.GetType(),
 		Channel:            t.GetChannel(),
 		CollectionTtl:      t.GetCollectionTtl(),
+		TtlFieldID:         t.GetTtlFieldID(),
 		TotalRows:          t.GetTotalRows(),
 		Schema:             t.GetSchema(),
 		ClusteringKeyField: t.GetClusteringKeyField().GetFieldID(),
@@ -492,6 +493,7 @@ func (t *clusteringCompactionTask) ShadowClone(opts ...compactionTaskOpt) *datap
 		TimeoutInSeconds:   t.GetTimeoutInSeconds(),
 		Type:               t.GetType(),
 		CollectionTtl:      t.CollectionTtl,
+		TtlFieldID:         t.TtlFieldID,
 		CollectionID:       t.GetCollectionID(),
 		PartitionID:        t.GetPartitionID(),
 		Channel:            t.GetChannel(),
@@ -223,6 +223,7 @@ func (t *mixCompactionTask) ShadowClone(opts ...compactionTaskOpt) *datapb.Compa
 		TimeoutInSeconds: t.GetTimeoutInSeconds(),
 		Type:             t.GetType(),
 		CollectionTtl:    t.CollectionTtl,
+		TtlFieldID:       t.TtlFieldID,
 		CollectionID:     t.GetCollectionID(),
 		PartitionID:      t.GetPartitionID(),
 		Channel:          t.GetChannel(),
@@ -341,6 +342,7 @@ func (t *mixCompactionTask) BuildCompactionRequest() (*datapb.CompactionPlan, er
 		Type:             t.GetType(),
 		Channel:          t.GetChannel(),
 		CollectionTtl:    t.GetCollectionTtl(),
+		TtlFieldID:       t.GetTtlFieldID(),
 		TotalRows:        t.GetTotalRows(),
 		Schema:           t.GetSchema(),
 		BeginLogID:       beginLogID,
@@ -42,6 +42,8 @@ type compactTime struct {
 	startTime     Timestamp
 	expireTime    Timestamp
 	collectionTTL time.Duration
+	// the entities expire once the unix time in the ttl field is not after the physical time of startTime
+	ttlFieldID int64
 }
 
 // todo: migrate to compaction_trigger_v2
@@ -210,15 +212,16 @@ func getCompactTime(ts Timestamp, coll *collectionInfo) (*compactTime, error) {
 	}
 
 	pts, _ := tsoutil.ParseTS(ts)
+	ttlFieldID := getCollectionTTLFieldID(coll)
 
 	if collectionTTL > 0 {
 		ttexpired := pts.Add(-collectionTTL)
 		ttexpiredLogic := tsoutil.ComposeTS(ttexpired.UnixNano()/int64(time.Millisecond), 0)
-		return &compactTime{ts, ttexpiredLogic, collectionTTL}, nil
+		return &compactTime{ts, ttexpiredLogic, collectionTTL, ttlFieldID}, nil
 	}
 
 	// no expiration time
-	return &compactTime{ts, 0, 0}, nil
+	return &compactTime{ts, 0, 0, ttlFieldID}, nil
 }
 
 // triggerCompaction trigger a compaction if any compaction condition satisfy.
@@ -407,6 +410,7 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) error {
 				TimeoutInSeconds: Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt32(),
 				Type:             datapb.CompactionType_MixCompaction,
 				CollectionTtl:    ct.collectionTTL.Nanoseconds(),
+				TtlFieldID:       getCollectionTTLFieldID(coll),
 				CollectionID:     group.collectionID,
 				PartitionID:      group.partitionID,
 				Channel:          group.channelName,
@@ -515,6 +519,7 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 			TimeoutInSeconds: Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt32(),
 			Type:             datapb.CompactionType_MixCompaction,
 			CollectionTtl:    ct.collectionTTL.Nanoseconds(),
+			TtlFieldID:       getCollectionTTLFieldID(coll),
 			CollectionID:     collectionID,
 			PartitionID:      partitionID,
 			Channel:          channel,
@@ -829,6 +834,29 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 		return true
 	}
 
+	// the rows of a binlog of the ttl field are all expired once its max expiration time is passed. The null
+	// expirations, which never expire, are counted as well, they don't trigger compaction again since the compacted
+	// binlogs of nulls have no range.
+	if compactTime.ttlFieldID > 0 {
+		now := tsoutil.PhysicalTime(compactTime.startTime).Unix()
+		ttlFieldExpiredRows := 0
+		for _, binlogs := range segment.GetBinlogs() {
+			if binlogs.GetFieldID() != compactTime.ttlFieldID {
+				continue
+			}
+			for _, l := range binlogs.GetBinlogs() {
+				if l.GetValueRange() != nil && l.GetValueRange().GetMax() <= now {
+					ttlFieldExpiredRows += int(l.GetEntriesNum())
+				}
+			}
+		}
+		if float64(ttlFieldExpiredRows)/float64(segment.GetNumOfRows()) >= Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat() {
+			log.Info("total entities expired by ttl field is too much, trigger compaction", zap.Int64("segmentID", segment.ID),
+				zap.Int64("ttlFieldID", compactTime.ttlFieldID), zap.Int("expiredRows", ttlFieldExpiredRows))
+			return true
+		}
+	}
+
 	// currently delta log size and delete ratio policy is applied
 	if isDeleteRowsTooManySegment(segment) {
 		return true
@@ -1942,6 +1942,85 @@ func Test_compactionTrigger_shouldDoSingleCompaction(t *testing.T) {
 	assert.False(t, couldDo)
 }
 
+func Test_compactionTrigger_shouldDoSingleCompactionByTTLField(t *testing.T) {
+	trigger := newCompactionTrigger(&meta{
+		indexMeta:  newSegmentIndexMeta(nil),
+		channelCPs: newChannelCps(),
+	}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler(), newIndexEngineVersionManager())
+
+	now := time.Now()
+	expired := &datapb.Int64ValueRange{Min: now.Add(-2 * time.Hour).Unix(), Max: now.Add(-time.Hour).Unix()}
+	unexpired := &datapb.Int64ValueRange{Min: now.Add(-time.Hour).Unix(), Max: now.Add(time.Hour).Unix()}
+	// the binlogs of the pk field 100 and the ttl field 101
+	newSegment := func(ttlRanges ...*datapb.Int64ValueRange) *SegmentInfo {
+		var pkBinlogs, ttlBinlogs []*datapb.Binlog
+		for _, r := range ttlRanges {
+			pkBinlogs = append(pkBinlogs, &datapb.Binlog{EntriesNum: 100, LogSize: 100, MemorySize: 100, ValueRange: expired})
+			ttlBinlogs = append(ttlBinlogs, &datapb.Binlog{EntriesNum: 100, LogSize: 100, MemorySize: 100, ValueRange: r})
+		}
+		return &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            1,
+				CollectionID:  2,
+				PartitionID:   1,
+				NumOfRows:     int64(100 * len(ttlRanges)),
+				MaxRowNum:     1000,
+				InsertChannel: "ch1",
+				State:         commonpb.SegmentState_Flushed,
+				Binlogs: []*datapb.FieldBinlog{
+					{FieldID: 100, Binlogs: pkBinlogs},
+					{FieldID: 101, Binlogs: ttlBinlogs},
+				},
+			},
+		}
+	}
+	ct := &compactTime{startTime: tsoutil.ComposeTSByTime(now, 0), ttlFieldID: 101}
+
+	// 3 of 10 binlogs are expired, which exceeds the ratio threshold 0.2
+	segment := newSegment(expired, expired, expired, unexpired, unexpired, unexpired, unexpired, unexpired, unexpired, unexpired)
+	assert.True(t, trigger.ShouldDoSingleCompaction(segment, ct))
+	// the collection has no ttl field
+	assert.False(t, trigger.ShouldDoSingleCompaction(segment, &compactTime{startTime: ct.startTime}))
+	// the entities are not expired yet
+	assert.False(t, trigger.ShouldDoSingleCompaction(segment, &compactTime{
+		startTime:  tsoutil.ComposeTSByTime(now.Add(-3*time.Hour), 0),
+		ttlFieldID: 101,
+	}))
+
+	// 1 of 10 binlogs is expired
+	segment = newSegment(expired, unexpired, unexpired, unexpired, unexpired, unexpired, unexpired, unexpired, unexpired, unexpired)
+	assert.False(t, trigger.ShouldDoSingleCompaction(segment, ct))
+
+	// the binlogs without range, e.g. all the expirations are null, are never expired
+	segment = newSegment(nil, nil, nil, unexpired)
+	assert.False(t, trigger.ShouldDoSingleCompaction(segment, ct))
+
+	// the expiration time equal to now is expired
+	segment = newSegment(&datapb.Int64ValueRange{Min: now.Unix(), Max: now.Unix()})
+	assert.True(t, trigger.ShouldDoSingleCompaction(segment, ct))
+}
+
+func Test_getCompactTime_TTLField(t *testing.T) {
+	coll := &collectionInfo{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "expire_at", DataType: schemapb.DataType_Int64, Nullable: true},
+			},
+		},
+		Properties: map[string]string{common.CollectionTTLFieldKey: "expire_at"},
+	}
+	ct, err := getCompactTime(tsoutil.GetCurrentTime(), coll)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), ct.ttlFieldID)
+
+	coll.Properties = map[string]string{}
+	ct, err = getCompactTime(tsoutil.GetCurrentTime(), coll)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), ct.ttlFieldID)
+}
+
 func Test_compactionTrigger_new(t *testing.T) {
 	type args struct {
 		meta              *meta
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+type ExportJobFilter func(job ExportJob) bool
+
+func WithExportCollectionID(collectionID int64) ExportJobFilter {
+	return func(job ExportJob) bool {
+		return job.GetCollectionID() == collectionID
+	}
+}
+
+type UpdateExportJobAction func(job ExportJob)
+
+func UpdateExportJobState(state internalpb.ExportJobState) UpdateExportJobAction {
+	return func(job ExportJob) {
+		job.(*exportJob).ExportJob.State = state
+		if state == internalpb.ExportJobState_ExportCompleted || state == internalpb.ExportJobState_ExportFailed {
+			// set cleanup ts, only the meta is cleaned up, the exported files are kept
+			dur := Params.DataCoordCfg.ExportTaskRetention.GetAsDuration(time.Second)
+			cleanupTime := time.Now().Add(dur)
+			cleanupTs := tsoutil.ComposeTSByTime(cleanupTime, 0)
+			job.(*exportJob).ExportJob.CleanupTs = cleanupTs
+			log.Info("set export job cleanup ts", zap.Int64("jobID", job.GetJobID()),
+				zap.Time("cleanupTime", cleanupTime), zap.Uint64("cleanupTs", cleanupTs))
+		}
+	}
+}
+
+func UpdateExportJobReason(reason string) UpdateExportJobAction {
+	return func(job ExportJob) {
+		job.(*exportJob).ExportJob.Reason = reason
+	}
+}
+
+func UpdateExportJobCompleteTime(completeTime string) UpdateExportJobAction {
+	return func(job ExportJob) {
+		job.(*exportJob).ExportJob.CompleteTime = completeTime
+	}
+}
+
+type ExportJob interface {
+	GetJobID() int64
+	GetDbID() int64
+	GetCollectionID() int64
+	GetCollectionName() string
+	GetPartitionIDs() []int64
+	GetSchema() *schemapb.CollectionSchema
+	GetStartTs() uint64
+	GetEndTs() uint64
+	GetCleanupTs() uint64
+	GetState() internalpb.ExportJobState
+	GetReason() string
+	GetStartTime() string
+	GetCompleteTime() string
+	GetOptions() []*commonpb.KeyValuePair
+	GetFlushTs() uint64
+	Clone() ExportJob
+}
+
+type exportJob struct {
+	*datapb.ExportJob
+}
+
+func (j *exportJob) Clone() ExportJob {
+	return &exportJob{
+		ExportJob: proto.Clone(j.ExportJob).(*datapb.ExportJob),
+	}
+}
@@ -0,0 +1,201 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/pkg/util/lock"
+)
+
+type ExportMeta interface {
+	AddJob(job ExportJob) error
+	UpdateJob(jobID int64, actions ...UpdateExportJobAction) error
+	GetJob(jobID int64) ExportJob
+	GetJobBy(filters ...ExportJobFilter) []ExportJob
+	RemoveJob(jobID int64) error
+
+	AddTask(task ExportTask) error
+	UpdateTask(taskID int64, actions ...UpdateExportAction) error
+	GetTask(taskID int64) ExportTask
+	GetTaskBy(filters ...ExportTaskFilter) []ExportTask
+	RemoveTask(taskID int64) error
+}
+
+type exportMeta struct {
+	mu    lock.RWMutex // guards jobs and tasks
+	jobs  map[int64]ExportJob
+	tasks map[int64]ExportTask
+
+	catalog metastore.DataCoordCatalog
+}
+
+func NewExportMeta(catalog metastore.DataCoordCatalog) (ExportMeta, error) {
+	restoredTasks, err := catalog.ListExportTasks()
+	if err != nil {
+		return nil, err
+	}
+	restoredJobs, err := catalog.ListExportJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make(map[int64]ExportTask)
+	for _, task := range restoredTasks {
+		tasks[task.GetTaskID()] = &exportTask{
+			ExportTask: task,
+		}
+	}
+
+	jobs := make(map[int64]ExportJob)
+	for _, job := range restoredJobs {
+		jobs[job.GetJobID()] = &exportJob{
+			ExportJob: job,
+		}
+	}
+
+	return &exportMeta{
+		jobs:    jobs,
+		tasks:   tasks,
+		catalog: catalog,
+	}, nil
+}
+
+func (m *exportMeta) AddJob(job ExportJob) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.catalog.SaveExportJob(job.(*exportJob).ExportJob)
+	if err != nil {
+		return err
+	}
+	m.jobs[job.GetJobID()] = job
+	return nil
+}
+
+func (m *exportMeta) UpdateJob(jobID int64, actions ...UpdateExportJobAction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if job, ok := m.jobs[jobID]; ok {
+		updatedJob := job.Clone()
+		for _, action := range actions {
+			action(updatedJob)
+		}
+		err := m.catalog.SaveExportJob(updatedJob.(*exportJob).ExportJob)
+		if err != nil {
+			return err
+		}
+		m.jobs[updatedJob.GetJobID()] = updatedJob
+	}
+	return nil
+}
+
+func (m *exportMeta) GetJob(jobID int64) ExportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.jobs[jobID]
+}
+
+func (m *exportMeta) GetJobBy(filters ...ExportJobFilter) []ExportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]ExportJob, 0)
+OUTER:
+	for _, job := range m.jobs {
+		for _, f := range filters {
+			if !f(job) {
+				continue OUTER
+			}
+		}
+		ret = append(ret, job)
+	}
+	return ret
+}
+
+func (m *exportMeta) RemoveJob(jobID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.jobs[jobID]; ok {
+		err := m.catalog.DropExportJob(jobID)
+		if err != nil {
+			return err
+		}
+		delete(m.jobs, jobID)
+	}
+	return nil
+}
+
+func (m *exportMeta) AddTask(task ExportTask) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.catalog.SaveExportTask(task.(*exportTask).ExportTask)
+	if err != nil {
+		return err
+	}
+	m.tasks[task.GetTaskID()] = task
+	return nil
+}
+
+func (m *exportMeta) UpdateTask(taskID int64, actions ...UpdateExportAction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if task, ok := m.tasks[taskID]; ok {
+		updatedTask := task.Clone()
+		for _, action := range actions {
+			action(updatedTask)
+		}
+		err := m.catalog.SaveExportTask(updatedTask.(*exportTask).ExportTask)
+		if err != nil {
+			return err
+		}
+		m.tasks[updatedTask.GetTaskID()] = updatedTask
+	}
+	return nil
+}
+
+func (m *exportMeta) GetTask(taskID int64) ExportTask {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.tasks[taskID]
+}
+
+func (m *exportMeta) GetTaskBy(filters ...ExportTaskFilter) []ExportTask {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]ExportTask, 0)
+OUTER:
+	for _, task := range m.tasks {
+		for _, f := range filters {
+			if !f(task) {
+				continue OUTER
+			}
+		}
+		ret = append(ret, task)
+	}
+	return ret
+}
+
+func (m *exportMeta) RemoveTask(taskID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.tasks[taskID]; ok {
+		err := m.catalog.DropExportTask(taskID)
+		if err != nil {
+			return err
+		}
+		delete(m.tasks, taskID)
+	}
+	return nil
+}
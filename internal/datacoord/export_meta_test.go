@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestExportMeta_Restore(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs().Return([]*datapb.ExportJob{{JobID: 0}}, nil)
+	catalog.EXPECT().ListExportTasks().Return([]*datapb.ExportTask{{TaskID: 1, JobID: 0}}, nil)
+
+	em, err := NewExportMeta(catalog)
+	assert.NoError(t, err)
+
+	jobs := em.GetJobBy()
+	assert.Equal(t, 1, len(jobs))
+	assert.Equal(t, int64(0), jobs[0].GetJobID())
+	tasks := em.GetTaskBy(WithExportJob(0))
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, int64(1), tasks[0].GetTaskID())
+
+	// new meta failed
+	mockErr := errors.New("mock error")
+	catalog = mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportTasks().Return(nil, mockErr)
+	_, err = NewExportMeta(catalog)
+	assert.Error(t, err)
+
+	catalog = mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportTasks().Return(nil, nil)
+	catalog.EXPECT().ListExportJobs().Return(nil, mockErr)
+	_, err = NewExportMeta(catalog)
+	assert.Error(t, err)
+}
+
+func TestExportMeta_ExportJob(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs().Return(nil, nil)
+	catalog.EXPECT().ListExportTasks().Return(nil, nil)
+	catalog.EXPECT().SaveExportJob(mock.Anything).Return(nil)
+	catalog.EXPECT().DropExportJob(mock.Anything).Return(nil)
+
+	em, err := NewExportMeta(catalog)
+	assert.NoError(t, err)
+
+	var job ExportJob = &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID:        0,
+			CollectionID: 1,
+			PartitionIDs: []int64{2},
+			State:        internalpb.ExportJobState_ExportPending,
+		},
+	}
+
+	err = em.AddJob(job)
+	assert.NoError(t, err)
+	jobs := em.GetJobBy(WithExportCollectionID(1))
+	assert.Equal(t, 1, len(jobs))
+	jobs = em.GetJobBy(WithExportCollectionID(2))
+	assert.Equal(t, 0, len(jobs))
+
+	err = em.UpdateJob(job.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_ExportCompleted))
+	assert.NoError(t, err)
+	job2 := em.GetJob(job.GetJobID())
+	assert.Equal(t, internalpb.ExportJobState_ExportCompleted, job2.GetState())
+	assert.NotEqual(t, uint64(0), job2.GetCleanupTs())
+	assert.Equal(t, job.GetCollectionID(), job2.GetCollectionID())
+	assert.Equal(t, job.GetPartitionIDs(), job2.GetPartitionIDs())
+	// the origin job is not modified
+	assert.Equal(t, internalpb.ExportJobState_ExportPending, job.GetState())
+
+	err = em.RemoveJob(job.GetJobID())
+	assert.NoError(t, err)
+	jobs = em.GetJobBy()
+	assert.Equal(t, 0, len(jobs))
+
+	// test failed
+	mockErr := errors.New("mock err")
+	catalog = mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().SaveExportJob(mock.Anything).Return(mockErr)
+	catalog.EXPECT().DropExportJob(mock.Anything).Return(mockErr)
+	em.(*exportMeta).catalog = catalog
+
+	err = em.AddJob(job)
+	assert.Error(t, err)
+	em.(*exportMeta).jobs[job.GetJobID()] = job
+	err = em.UpdateJob(job.GetJobID())
+	assert.Error(t, err)
+	err = em.RemoveJob(job.GetJobID())
+	assert.Error(t, err)
+}
+
+func TestExportMeta_ExportTask(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs().Return(nil, nil)
+	catalog.EXPECT().ListExportTasks().Return(nil, nil)
+	catalog.EXPECT().SaveExportTask(mock.Anything).Return(nil)
+	catalog.EXPECT().DropExportTask(mock.Anything).Return(nil)
+
+	em, err := NewExportMeta(catalog)
+	assert.NoError(t, err)
+
+	task1 := &exportTask{
+		ExportTask: &datapb.ExportTask{
+			JobID:        1,
+			TaskID:       2,
+			CollectionID: 3,
+			PartitionID:  4,
+			SegmentID:    5,
+			State:        datapb.ImportTaskStateV2_Pending,
+		},
+	}
+	err = em.AddTask(task1)
+	assert.NoError(t, err)
+	res := em.GetTask(task1.GetTaskID())
+	assert.Equal(t, task1, res)
+
+	err = em.UpdateTask(task1.GetTaskID(),
+		UpdateExportState(datapb.ImportTaskStateV2_Completed),
+		UpdateExportResult([]string{"export/1/4_5_0.parquet", "export/1/4_5_1.parquet"}, 100))
+	assert.NoError(t, err)
+	res = em.GetTask(task1.GetTaskID())
+	assert.Equal(t, datapb.ImportTaskStateV2_Completed, res.GetState())
+	assert.Equal(t, []string{"export/1/4_5_0.parquet", "export/1/4_5_1.parquet"}, res.GetFilePaths())
+	assert.Equal(t, int64(100), res.GetExportedRows())
+	tasks := em.GetTaskBy(WithExportJob(1), WithExportStates(datapb.ImportTaskStateV2_Completed))
+	assert.Equal(t, 1, len(tasks))
+	tasks = em.GetTaskBy(WithExportJob(1), WithExportStates(datapb.ImportTaskStateV2_Pending))
+	assert.Equal(t, 0, len(tasks))
+
+	err = em.RemoveTask(task1.GetTaskID())
+	assert.NoError(t, err)
+	tasks = em.GetTaskBy()
+	assert.Equal(t, 0, len(tasks))
+
+	// test failed
+	mockErr := errors.New("mock err")
+	catalog = mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().SaveExportTask(mock.Anything).Return(mockErr)
+	catalog.EXPECT().DropExportTask(mock.Anything).Return(mockErr)
+	em.(*exportMeta).catalog = catalog
+
+	err = em.AddTask(task1)
+	assert.Error(t, err)
+	em.(*exportMeta).tasks[task1.GetTaskID()] = task1
+	err = em.UpdateTask(task1.GetTaskID(), UpdateExportState(datapb.ImportTaskStateV2_Failed))
+	assert.Error(t, err)
+	err = em.RemoveTask(task1.GetTaskID())
+	assert.Error(t, err)
+}
@@ -0,0 +1,251 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type ExportScheduler interface {
+	Start()
+	Close()
+}
+
+// exportScheduler drives the export jobs, the export tasks are executed inside datacoord,
+// each task reads the binlogs of one segment and writes a parquet file through the chunk manager.
+type exportScheduler struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	meta  *meta
+	alloc allocator
+	emeta ExportMeta
+	cm    storage.ChunkManager
+
+	pool         *conc.Pool[any]
+	runningTasks *typeutil.ConcurrentSet[int64]
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func NewExportScheduler(meta *meta,
+	alloc allocator,
+	emeta ExportMeta,
+	cm storage.ChunkManager,
+) ExportScheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &exportScheduler{
+		ctx:          ctx,
+		cancel:       cancel,
+		meta:         meta,
+		alloc:        alloc,
+		emeta:        emeta,
+		cm:           cm,
+		pool:         conc.NewPool[any](Params.DataCoordCfg.MaxConcurrentExportTasks.GetAsInt()),
+		runningTasks: typeutil.NewConcurrentSet[int64](),
+		closeChan:    make(chan struct{}),
+	}
+}
+
+func (s *exportScheduler) Start() {
+	log.Info("start export scheduler")
+	ticker := time.NewTicker(Params.DataCoordCfg.ExportScheduleInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closeChan:
+			log.Info("export scheduler exited")
+			return
+		case <-ticker.C:
+			s.process()
+		}
+	}
+}
+
+func (s *exportScheduler) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closeChan)
+		s.cancel()
+		s.pool.Release()
+	})
+}
+
+func (s *exportScheduler) process() {
+	jobs := s.emeta.GetJobBy()
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].GetJobID() < jobs[j].GetJobID()
+	})
+	for _, job := range jobs {
+		switch job.GetState() {
+		case internalpb.ExportJobState_ExportPending:
+			s.processPendingJob(job)
+		case internalpb.ExportJobState_Exporting:
+			s.processExportingJob(job)
+		case internalpb.ExportJobState_ExportCompleted, internalpb.ExportJobState_ExportFailed:
+			s.checkGC(job)
+		}
+	}
+}
+
+func (s *exportScheduler) processPendingJob(job ExportJob) {
+	logger := log.With(zap.Int64("jobID", job.GetJobID()), zap.Int64("collectionID", job.GetCollectionID()))
+	if len(s.emeta.GetTaskBy(WithExportJob(job.GetJobID()))) == 0 {
+		// the rows written before end ts may still be in growing segments,
+		// wait for the flush issued by ExportV2 before selecting the segments
+		if !isExportJobFlushed(job, s.meta) {
+			logger.RatedInfo(10, "waiting for the collection to be flushed", zap.Uint64("flushTs", job.GetFlushTs()))
+			return
+		}
+		segments := SelectExportSegments(job, s.meta)
+		tasks, err := NewExportTasks(job, segments, s.alloc)
+		if err != nil {
+			logger.Warn("new export tasks failed", zap.Error(err))
+			return
+		}
+		for _, task := range tasks {
+			err = s.emeta.AddTask(task)
+			if err != nil {
+				logger.Warn("add export task failed", WrapExportTaskLog(task, zap.Error(err))...)
+				return
+			}
+		}
+		logger.Info("add export tasks done", zap.Int("taskNum", len(tasks)))
+	}
+	err := s.emeta.UpdateJob(job.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_Exporting))
+	if err != nil {
+		logger.Warn("failed to update export job state to Exporting", zap.Error(err))
+		return
+	}
+	logger.Info("export job start to execute")
+}
+
+func (s *exportScheduler) processExportingJob(job ExportJob) {
+	tasks := s.emeta.GetTaskBy(WithExportJob(job.GetJobID()))
+	failedTask, failed := lo.Find(tasks, func(task ExportTask) bool {
+		return task.GetState() == datapb.ImportTaskStateV2_Failed
+	})
+	if failed {
+		err := s.emeta.UpdateJob(job.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_ExportFailed),
+			UpdateExportJobReason(failedTask.GetReason()))
+		if err != nil {
+			log.Warn("failed to update export job state to Failed", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
+		}
+		return
+	}
+	if lo.EveryBy(tasks, func(task ExportTask) bool {
+		return task.GetState() == datapb.ImportTaskStateV2_Completed
+	}) {
+		completeTime := time.Now().Format("2006-01-02T15:04:05Z07:00")
+		err := s.emeta.UpdateJob(job.GetJobID(), UpdateExportJobState(internalpb.ExportJobState_ExportCompleted),
+			UpdateExportJobCompleteTime(completeTime))
+		if err != nil {
+			log.Warn("failed to update export job state to Completed", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
+			return
+		}
+		log.Info("export job completed", zap.Int64("jobID", job.GetJobID()),
+			zap.String("startTime", job.GetStartTime()), zap.String("completeTime", completeTime))
+		return
+	}
+	for _, task := range tasks {
+		// tasks left InProgress by a previous datacoord are executed again
+		if task.GetState() == datapb.ImportTaskStateV2_Completed || s.runningTasks.Contain(task.GetTaskID()) {
+			continue
+		}
+		if s.pool.Free() <= 0 {
+			return
+		}
+		s.submit(job, task)
+	}
+}
+
+func (s *exportScheduler) submit(job ExportJob, task ExportTask) {
+	err := s.emeta.UpdateTask(task.GetTaskID(), UpdateExportState(datapb.ImportTaskStateV2_InProgress))
+	if err != nil {
+		log.Warn("update export task failed", WrapExportTaskLog(task, zap.Error(err))...)
+		return
+	}
+	s.runningTasks.Insert(task.GetTaskID())
+	log.Info("processing export task...", WrapExportTaskLog(task)...)
+	s.pool.Submit(func() (any, error) {
+		defer s.runningTasks.Remove(task.GetTaskID())
+		filePaths, rows, err := ExportSegment(s.ctx, s.cm, job, task, s.meta)
+		if err != nil {
+			log.Warn("export segment failed", WrapExportTaskLog(task, zap.Error(err))...)
+			if s.ctx.Err() != nil {
+				// datacoord is stopping, the task will be executed again after restart
+				return nil, err
+			}
+			updateErr := s.emeta.UpdateTask(task.GetTaskID(), UpdateExportState(datapb.ImportTaskStateV2_Failed),
+				UpdateExportReason(err.Error()))
+			if updateErr != nil {
+				log.Warn("update export task failed", WrapExportTaskLog(task, zap.Error(updateErr))...)
+			}
+			return nil, err
+		}
+		completeTime := time.Now().Format("2006-01-02T15:04:05Z07:00")
+		err = s.emeta.UpdateTask(task.GetTaskID(), UpdateExportState(datapb.ImportTaskStateV2_Completed),
+			UpdateExportResult(filePaths, rows), UpdateExportCompleteTime(completeTime))
+		if err != nil {
+			log.Warn("update export task failed", WrapExportTaskLog(task, zap.Error(err))...)
+			return nil, err
+		}
+		log.Info("export task done", WrapExportTaskLog(task, zap.Strings("filePaths", filePaths), zap.Int64("rows", rows))...)
+		return nil, nil
+	})
+}
+
+func (s *exportScheduler) checkGC(job ExportJob) {
+	cleanupTime := tsoutil.PhysicalTime(job.GetCleanupTs())
+	if time.Now().Before(cleanupTime) {
+		return
+	}
+	GCRetention := Params.DataCoordCfg.ExportTaskRetention.GetAsDuration(time.Second)
+	log.Info("export job has reached the GC retention", zap.Int64("jobID", job.GetJobID()),
+		zap.Time("cleanupTime", cleanupTime), zap.Duration("GCRetention", GCRetention))
+	tasks := s.emeta.GetTaskBy(WithExportJob(job.GetJobID()))
+	for _, task := range tasks {
+		if s.runningTasks.Contain(task.GetTaskID()) {
+			return
+		}
+		err := s.emeta.RemoveTask(task.GetTaskID())
+		if err != nil {
+			log.Warn("remove export task failed during GC", WrapExportTaskLog(task, zap.Error(err))...)
+			return
+		}
+	}
+	err := s.emeta.RemoveJob(job.GetJobID())
+	if err != nil {
+		log.Warn("remove export job failed", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
+		return
+	}
+	log.Info("export job removed", zap.Int64("jobID", job.GetJobID()))
+}
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestExportScheduler_WaitForFlush(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs().Return(nil, nil)
+	catalog.EXPECT().ListExportTasks().Return(nil, nil)
+	catalog.EXPECT().SaveExportJob(mock.Anything).Return(nil)
+	catalog.EXPECT().SaveExportTask(mock.Anything).Return(nil)
+	em, err := NewExportMeta(catalog)
+	assert.NoError(t, err)
+
+	alloc := NewNMockAllocator(t)
+	alloc.EXPECT().allocN(mock.Anything).RunAndReturn(func(n int64) (int64, int64, error) {
+		return 1000, 1000 + n, nil
+	})
+
+	meta := &meta{
+		collections: map[UniqueID]*collectionInfo{
+			1: {ID: 1, VChannelNames: []string{"ch0", "ch1"}},
+		},
+		segments:   NewSegmentsInfo(),
+		channelCPs: newChannelCps(),
+	}
+	meta.channelCPs.checkpoints["ch0"] = &msgpb.MsgPosition{Timestamp: 300}
+	meta.channelCPs.checkpoints["ch1"] = &msgpb.MsgPosition{Timestamp: 100}
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, State: commonpb.SegmentState_Flushed, InsertChannel: "ch0"},
+		// the rows written before end ts, which are being flushed when the job is created
+		{ID: 2, State: commonpb.SegmentState_Sealed, InsertChannel: "ch1"},
+		// created after the flush
+		{ID: 3, State: commonpb.SegmentState_Growing, InsertChannel: "ch1", StartPosition: &msgpb.MsgPosition{Timestamp: 400}},
+	}
+	for _, segment := range segments {
+		segment.CollectionID = 1
+		segment.PartitionID = 10
+		segment.NumOfRows = 10
+		meta.segments.SetSegment(segment.GetID(), NewSegmentInfo(segment))
+	}
+
+	job := &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID:        1,
+			CollectionID: 1,
+			EndTs:        200,
+			FlushTs:      300,
+			State:        internalpb.ExportJobState_ExportPending,
+		},
+	}
+	err = em.AddJob(job)
+	assert.NoError(t, err)
+
+	scheduler := &exportScheduler{
+		meta:  meta,
+		alloc: alloc,
+		emeta: em,
+	}
+	getSegmentIDs := func() []int64 {
+		return lo.Map(em.GetTaskBy(WithExportJob(1)), func(task ExportTask, _ int) int64 {
+			return task.GetSegmentID()
+		})
+	}
+
+	// the checkpoint of ch1 is behind the flush ts
+	scheduler.processPendingJob(em.GetJob(1))
+	assert.Equal(t, 0, len(getSegmentIDs()))
+	assert.Equal(t, internalpb.ExportJobState_ExportPending, em.GetJob(1).GetState())
+
+	// the sealed segment is not flushed yet
+	meta.channelCPs.checkpoints["ch1"] = &msgpb.MsgPosition{Timestamp: 300}
+	scheduler.processPendingJob(em.GetJob(1))
+	assert.Equal(t, 0, len(getSegmentIDs()))
+
+	// the unflushed rows are exported after the flush is done
+	meta.segments.SetState(2, commonpb.SegmentState_Flushed)
+	scheduler.processPendingJob(em.GetJob(1))
+	assert.ElementsMatch(t, []int64{1, 2}, getSegmentIDs())
+	assert.Equal(t, internalpb.ExportJobState_Exporting, em.GetJob(1).GetState())
+}
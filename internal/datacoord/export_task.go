@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+type ExportTaskFilter func(task ExportTask) bool
+
+func WithExportJob(jobID int64) ExportTaskFilter {
+	return func(task ExportTask) bool {
+		return task.GetJobID() == jobID
+	}
+}
+
+func WithExportStates(states ...datapb.ImportTaskStateV2) ExportTaskFilter {
+	return func(task ExportTask) bool {
+		for _, state := range states {
+			if task.GetState() == state {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+type UpdateExportAction func(task ExportTask)
+
+func UpdateExportState(state datapb.ImportTaskStateV2) UpdateExportAction {
+	return func(t ExportTask) {
+		t.(*exportTask).ExportTask.State = state
+	}
+}
+
+func UpdateExportReason(reason string) UpdateExportAction {
+	return func(t ExportTask) {
+		t.(*exportTask).ExportTask.Reason = reason
+	}
+}
+
+func UpdateExportResult(filePaths []string, exportedRows int64) UpdateExportAction {
+	return func(t ExportTask) {
+		t.(*exportTask).ExportTask.FilePaths = filePaths
+		t.(*exportTask).ExportTask.ExportedRows = exportedRows
+	}
+}
+
+func UpdateExportCompleteTime(completeTime string) UpdateExportAction {
+	return func(t ExportTask) {
+		t.(*exportTask).ExportTask.CompleteTime = completeTime
+	}
+}
+
+// ExportTask exports the rows of one segment to parquet files.
+type ExportTask interface {
+	GetJobID() int64
+	GetTaskID() int64
+	GetCollectionID() int64
+	GetPartitionID() int64
+	GetSegmentID() int64
+	GetState() datapb.ImportTaskStateV2
+	GetReason() string
+	GetFilePaths() []string
+	GetExportedRows() int64
+	GetCompleteTime() string
+	Clone() ExportTask
+}
+
+type exportTask struct {
+	*datapb.ExportTask
+}
+
+func (t *exportTask) Clone() ExportTask {
+	return &exportTask{
+		ExportTask: proto.Clone(t.ExportTask).(*datapb.ExportTask),
+	}
+}
@@ -0,0 +1,324 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"path"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/binlog"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// ExportPathPrefix is the directory under the chunk manager root path where exported files are written.
+	ExportPathPrefix = "export"
+
+	exportL0ReadBufferSize = 16 * 1024 * 1024
+)
+
+func WrapExportTaskLog(task ExportTask, fields ...zap.Field) []zap.Field {
+	res := []zap.Field{
+		zap.Int64("taskID", task.GetTaskID()),
+		zap.Int64("jobID", task.GetJobID()),
+		zap.Int64("collectionID", task.GetCollectionID()),
+		zap.Int64("partitionID", task.GetPartitionID()),
+		zap.Int64("segmentID", task.GetSegmentID()),
+	}
+	res = append(res, fields...)
+	return res
+}
+
+// getExportEndTs returns the upper bound of the export time range, 0 means unbounded.
+func getExportEndTs(job ExportJob) uint64 {
+	if job.GetEndTs() == 0 {
+		return math.MaxUint64
+	}
+	return job.GetEndTs()
+}
+
+// SelectExportSegments selects the flushed segments which may contain rows written in the time range of the job.
+func SelectExportSegments(job ExportJob, meta *meta) []*SegmentInfo {
+	partitions := typeutil.NewSet(job.GetPartitionIDs()...)
+	endTs := getExportEndTs(job)
+	return meta.SelectSegments(WithCollection(job.GetCollectionID()), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return (partitions.Len() == 0 || partitions.Contain(segment.GetPartitionID())) &&
+			segment.GetState() == commonpb.SegmentState_Flushed &&
+			!segment.GetIsImporting() &&
+			segment.GetLevel() != datapb.SegmentLevel_L0 &&
+			segment.GetNumOfRows() > 0 &&
+			segment.GetStartPosition().GetTimestamp() <= endTs &&
+			(segment.GetDmlPosition() == nil || segment.GetDmlPosition().GetTimestamp() >= job.GetStartTs())
+	}))
+}
+
+// isExportJobFlushed returns whether the rows written before the flush ts of the job are flushed,
+// the same as GetFlushState, the checkpoints of all the vchannels must reach the flush ts
+// and the segments sealed by the flush must be flushed.
+func isExportJobFlushed(job ExportJob, meta *meta) bool {
+	collection := meta.GetCollection(job.GetCollectionID())
+	if collection == nil {
+		return false
+	}
+	for _, vchannel := range collection.VChannelNames {
+		cp := meta.GetChannelCheckpoint(vchannel)
+		if cp == nil || cp.GetTimestamp() < job.GetFlushTs() {
+			return false
+		}
+	}
+	unflushed := meta.SelectSegments(WithCollection(job.GetCollectionID()), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetLevel() != datapb.SegmentLevel_L0 &&
+			(segment.GetState() == commonpb.SegmentState_Sealed || segment.GetState() == commonpb.SegmentState_Flushing)
+	}))
+	return len(unflushed) == 0
+}
+
+func NewExportTasks(job ExportJob, segments []*SegmentInfo, alloc allocator) ([]ExportTask, error) {
+	if len(segments) == 0 {
+		return nil, nil
+	}
+	idStart, _, err := alloc.allocN(int64(len(segments)))
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]ExportTask, 0, len(segments))
+	for i, segment := range segments {
+		task := &exportTask{
+			ExportTask: &datapb.ExportTask{
+				JobID:        job.GetJobID(),
+				TaskID:       idStart + int64(i),
+				CollectionID: job.GetCollectionID(),
+				PartitionID:  segment.GetPartitionID(),
+				SegmentID:    segment.GetID(),
+				State:        datapb.ImportTaskStateV2_Pending,
+			},
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func buildExportFilePath(cm storage.ChunkManager, task ExportTask, part int) string {
+	return path.Join(cm.RootPath(), ExportPathPrefix, fmt.Sprint(task.GetJobID()),
+		fmt.Sprintf("%d_%d_%d.parquet", task.GetPartitionID(), task.GetSegmentID(), part))
+}
+
+func buildSegmentLogPrefix(cm storage.ChunkManager, logPath string, collectionID, partitionID, segmentID int64) string {
+	return path.Join(cm.RootPath(), logPath, fmt.Sprint(collectionID), fmt.Sprint(partitionID), fmt.Sprint(segmentID))
+}
+
+// readL0Deletes reads the deletes of the L0 segments which are applied to the segment exported by the task.
+func readL0Deletes(ctx context.Context, cm storage.ChunkManager, job ExportJob, task ExportTask, meta *meta) (map[any]uint64, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(job.GetSchema())
+	if err != nil {
+		return nil, err
+	}
+	segment := meta.GetSegment(task.GetSegmentID())
+	l0Segments := meta.SelectSegments(WithCollection(task.GetCollectionID()), SegmentFilterFunc(func(info *SegmentInfo) bool {
+		return info.GetLevel() == datapb.SegmentLevel_L0 &&
+			isSegmentHealthy(info) &&
+			info.GetInsertChannel() == segment.GetInsertChannel() &&
+			(info.GetPartitionID() == common.AllPartitionsID || info.GetPartitionID() == task.GetPartitionID())
+	}))
+	endTs := getExportEndTs(job)
+	deletes := make(map[any]uint64)
+	for _, l0Segment := range l0Segments {
+		prefix := buildSegmentLogPrefix(cm, common.SegmentDeltaLogPath,
+			l0Segment.GetCollectionID(), l0Segment.GetPartitionID(), l0Segment.GetID())
+		reader, err := binlog.NewL0Reader(ctx, cm, pkField, &internalpb.ImportFile{Paths: []string{prefix}}, exportL0ReadBufferSize)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			deleteData, err := reader.Read()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			for i, pk := range deleteData.Pks {
+				ts := deleteData.Tss[i]
+				if ts < job.GetStartTs() || ts > endTs {
+					continue
+				}
+				if ts > deletes[pk.GetValue()] {
+					deletes[pk.GetValue()] = ts
+				}
+			}
+		}
+	}
+	return deletes, nil
+}
+
+// applyDeletes removes the rows which are deleted after they were written.
+func applyDeletes(data *storage.InsertData, schema *schemapb.CollectionSchema, pkFieldID int64, deletes map[any]uint64) (*storage.InsertData, error) {
+	if len(deletes) == 0 {
+		return data, nil
+	}
+	result, err := storage.NewInsertData(schema)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < data.GetRowNum(); i++ {
+		row := data.GetRow(i)
+		deleteTs, ok := deletes[row[pkFieldID]]
+		if ok && deleteTs > uint64(row[common.TimeStampField].(int64)) {
+			continue
+		}
+		if err = result.Append(row); err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, err=%s", err.Error()))
+		}
+	}
+	return result, nil
+}
+
+// ExportSegment writes the rows of the segment in the time range of the job to parquet files,
+// the deletes in the segment's own delta logs and in the L0 segments are applied.
+// The output is written out as a new file whenever it exceeds dataCoord.export.maxFileSize,
+// so only one file is buffered in memory at a time.
+// It returns the paths of the exported files and the number of exported rows, no file is written if no row is exported.
+func ExportSegment(ctx context.Context, cm storage.ChunkManager, job ExportJob, task ExportTask, meta *meta) ([]string, int64, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(job.GetSchema())
+	if err != nil {
+		return nil, 0, err
+	}
+	deletes, err := readL0Deletes(ctx, cm, job, task, meta)
+	if err != nil {
+		return nil, 0, err
+	}
+	paths := []string{
+		buildSegmentLogPrefix(cm, common.SegmentInsertLogPath, task.GetCollectionID(), task.GetPartitionID(), task.GetSegmentID()),
+		buildSegmentLogPrefix(cm, common.SegmentDeltaLogPath, task.GetCollectionID(), task.GetPartitionID(), task.GetSegmentID()),
+	}
+	reader, err := binlog.NewReader(ctx, cm, job.GetSchema(), paths, job.GetStartTs(), getExportEndTs(job))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		filePaths    []string
+		exportedRows int64
+		writer       *parquet.Writer
+	)
+	maxFileSize := Params.DataCoordCfg.MaxExportFileSize.GetAsInt() * 1024 * 1024
+	buf := &bytes.Buffer{}
+	flush := func() error {
+		if writer == nil {
+			return nil
+		}
+		if err := writer.Close(); err != nil {
+			return err
+		}
+		filePath := buildExportFilePath(cm, task, len(filePaths))
+		if err := cm.Write(ctx, filePath, buf.Bytes()); err != nil {
+			return err
+		}
+		filePaths = append(filePaths, filePath)
+		exportedRows += writer.Rows()
+		writer = nil
+		buf.Reset()
+		return nil
+	}
+	schema := typeutil.AppendSystemFields(job.GetSchema())
+	for {
+		data, err := reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, 0, err
+		}
+		data, err = applyDeletes(data, schema, pkField.GetFieldID(), deletes)
+		if err != nil {
+			return nil, 0, err
+		}
+		if data.GetRowNum() == 0 {
+			// all rows are out of the time range or deleted
+			continue
+		}
+		if writer == nil {
+			writer, err = parquet.NewWriter(buf, job.GetSchema())
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		if err = writer.Write(data); err != nil {
+			return nil, 0, err
+		}
+		if buf.Len() >= maxFileSize {
+			if err = flush(); err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+	if err = flush(); err != nil {
+		return nil, 0, err
+	}
+	return filePaths, exportedRows, nil
+}
+
+func GetExportJobProgress(jobID int64, emeta ExportMeta) (int64, internalpb.ExportJobState, int64, []string, string) {
+	job := emeta.GetJob(jobID)
+	if job == nil {
+		return 0, internalpb.ExportJobState_ExportFailed, 0, nil, fmt.Sprintf("export job does not exist, jobID=%d", jobID)
+	}
+	tasks := emeta.GetTaskBy(WithExportJob(jobID))
+	completedTasks := lo.Filter(tasks, func(task ExportTask, _ int) bool {
+		return task.GetState() == datapb.ImportTaskStateV2_Completed
+	})
+	exportedRows := lo.SumBy(completedTasks, func(task ExportTask) int64 {
+		return task.GetExportedRows()
+	})
+	files := lo.FlatMap(completedTasks, func(task ExportTask, _ int) []string {
+		return task.GetFilePaths()
+	})
+	switch job.GetState() {
+	case internalpb.ExportJobState_ExportPending:
+		return 0, internalpb.ExportJobState_ExportPending, 0, nil, ""
+
+	case internalpb.ExportJobState_Exporting:
+		var progress float32 = 1
+		if len(tasks) != 0 {
+			progress = float32(len(completedTasks)) / float32(len(tasks))
+		}
+		return int64(progress * 100), internalpb.ExportJobState_Exporting, exportedRows, files, ""
+
+	case internalpb.ExportJobState_ExportCompleted:
+		return 100, internalpb.ExportJobState_ExportCompleted, exportedRows, files, ""
+
+	case internalpb.ExportJobState_ExportFailed:
+		return 0, internalpb.ExportJobState_ExportFailed, 0, nil, job.GetReason()
+	}
+	return 0, internalpb.ExportJobState_ExportNone, 0, nil, "unknown export job state"
+}
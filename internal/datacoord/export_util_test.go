@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestExportUtil_SelectExportSegments(t *testing.T) {
+	meta := &meta{segments: NewSegmentsInfo()}
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 10},
+		{ID: 2, PartitionID: 20, State: commonpb.SegmentState_Flushed, NumOfRows: 10},
+		{ID: 3, PartitionID: 10, State: commonpb.SegmentState_Growing, NumOfRows: 10},
+		{ID: 4, PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 10, IsImporting: true},
+		{ID: 5, PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 10, Level: datapb.SegmentLevel_L0},
+		{ID: 6, PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 0},
+		{
+			ID: 7, PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 10,
+			StartPosition: &msgpb.MsgPosition{Timestamp: 100}, DmlPosition: &msgpb.MsgPosition{Timestamp: 200},
+		},
+	}
+	for _, segment := range segments {
+		segment.CollectionID = 1
+		meta.segments.SetSegment(segment.GetID(), NewSegmentInfo(segment))
+	}
+	getIDs := func(job ExportJob) []int64 {
+		return lo.Map(SelectExportSegments(job, meta), func(segment *SegmentInfo, _ int) int64 {
+			return segment.GetID()
+		})
+	}
+
+	job := &exportJob{ExportJob: &datapb.ExportJob{CollectionID: 1}}
+	assert.ElementsMatch(t, []int64{1, 2, 7}, getIDs(job))
+	job = &exportJob{ExportJob: &datapb.ExportJob{CollectionID: 1, PartitionIDs: []int64{10}}}
+	assert.ElementsMatch(t, []int64{1, 7}, getIDs(job))
+	job = &exportJob{ExportJob: &datapb.ExportJob{CollectionID: 1, PartitionIDs: []int64{10}, EndTs: 50}}
+	assert.ElementsMatch(t, []int64{1}, getIDs(job))
+	job = &exportJob{ExportJob: &datapb.ExportJob{CollectionID: 1, PartitionIDs: []int64{10}, StartTs: 300}}
+	assert.ElementsMatch(t, []int64{1}, getIDs(job))
+	job = &exportJob{ExportJob: &datapb.ExportJob{CollectionID: 2}}
+	assert.Equal(t, 0, len(getIDs(job)))
+}
+
+func TestExportUtil_NewExportTasks(t *testing.T) {
+	job := &exportJob{
+		ExportJob: &datapb.ExportJob{JobID: 1, CollectionID: 2},
+	}
+	segments := []*SegmentInfo{
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 10, PartitionID: 3}),
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 11, PartitionID: 4}),
+	}
+	alloc := NewNMockAllocator(t)
+	alloc.EXPECT().allocN(mock.Anything).RunAndReturn(func(n int64) (int64, int64, error) {
+		id := rand.Int63()
+		return id, id + n, nil
+	})
+	tasks, err := NewExportTasks(job, segments, alloc)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tasks))
+	for i, task := range tasks {
+		assert.Equal(t, int64(1), task.GetJobID())
+		assert.Equal(t, int64(2), task.GetCollectionID())
+		assert.Equal(t, segments[i].GetPartitionID(), task.GetPartitionID())
+		assert.Equal(t, segments[i].GetID(), task.GetSegmentID())
+		assert.Equal(t, datapb.ImportTaskStateV2_Pending, task.GetState())
+	}
+
+	tasks, err = NewExportTasks(job, nil, alloc)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(tasks))
+}
+
+func TestExportUtil_ApplyDeletes(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "int32", DataType: schemapb.DataType_Int32},
+		},
+	}
+	schema = typeutil.AppendSystemFields(schema)
+	data, err := storage.NewInsertData(schema)
+	assert.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		err = data.Append(map[storage.FieldID]any{
+			common.RowIDField:     int64(i),
+			common.TimeStampField: int64(100),
+			100:                   int64(i),
+			101:                   int32(i),
+		})
+		assert.NoError(t, err)
+	}
+
+	// delete before the insertion doesn't take effect
+	deletes := map[any]uint64{int64(1): 200, int64(2): 50}
+	res, err := applyDeletes(data, schema, 100, deletes)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, res.GetRowNum())
+	assert.Equal(t, []int64{0, 2, 3}, res.Data[100].(*storage.Int64FieldData).Data)
+
+	res, err = applyDeletes(data, schema, 100, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, res.GetRowNum())
+}
+
+func TestExportUtil_GetExportJobProgress(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs().Return(nil, nil)
+	catalog.EXPECT().ListExportTasks().Return(nil, nil)
+	catalog.EXPECT().SaveExportJob(mock.Anything).Return(nil)
+	catalog.EXPECT().SaveExportTask(mock.Anything).Return(nil)
+
+	em, err := NewExportMeta(catalog)
+	assert.NoError(t, err)
+
+	// job does not exist
+	_, state, _, _, reason := GetExportJobProgress(1, em)
+	assert.Equal(t, internalpb.ExportJobState_ExportFailed, state)
+	assert.NotEqual(t, "", reason)
+
+	job := &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID: 1,
+			State: internalpb.ExportJobState_ExportPending,
+		},
+	}
+	err = em.AddJob(job)
+	assert.NoError(t, err)
+	progress, state, _, _, _ := GetExportJobProgress(1, em)
+	assert.Equal(t, int64(0), progress)
+	assert.Equal(t, internalpb.ExportJobState_ExportPending, state)
+
+	for i := 0; i < 4; i++ {
+		err = em.AddTask(&exportTask{
+			ExportTask: &datapb.ExportTask{
+				JobID:  1,
+				TaskID: int64(i),
+				State:  datapb.ImportTaskStateV2_InProgress,
+			},
+		})
+		assert.NoError(t, err)
+	}
+	err = em.UpdateTask(0, UpdateExportState(datapb.ImportTaskStateV2_Completed), UpdateExportResult("a.parquet", 10))
+	assert.NoError(t, err)
+	// no file is written for the task without exported rows
+	err = em.UpdateTask(1, UpdateExportState(datapb.ImportTaskStateV2_Completed), UpdateExportResult("", 0))
+	assert.NoError(t, err)
+	err = em.UpdateJob(1, UpdateExportJobState(internalpb.ExportJobState_Exporting))
+	assert.NoError(t, err)
+	progress, state, rows, files, _ := GetExportJobProgress(1, em)
+	assert.Equal(t, int64(50), progress)
+	assert.Equal(t, internalpb.ExportJobState_Exporting, state)
+	assert.Equal(t, int64(10), rows)
+	assert.Equal(t, []string{"a.parquet"}, files)
+
+	err = em.UpdateJob(1, UpdateExportJobState(internalpb.ExportJobState_ExportCompleted))
+	assert.NoError(t, err)
+	progress, state, _, _, _ = GetExportJobProgress(1, em)
+	assert.Equal(t, int64(100), progress)
+	assert.Equal(t, internalpb.ExportJobState_ExportCompleted, state)
+
+	err = em.UpdateJob(1, UpdateExportJobState(internalpb.ExportJobState_ExportFailed), UpdateExportJobReason("mock reason"))
+	assert.NoError(t, err)
+	progress, state, _, _, reason = GetExportJobProgress(1, em)
+	assert.Equal(t, int64(0), progress)
+	assert.Equal(t, internalpb.ExportJobState_ExportFailed, state)
+	assert.Equal(t, "mock reason", reason)
+}
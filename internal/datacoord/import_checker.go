@@ -19,6 +19,8 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"path"
+	"strconv"
 	"sync"
 	"time"
 
@@ -29,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
@@ -208,6 +211,11 @@ func (c *importChecker) checkPreImportingJob(job ImportJob) {
 		return
 	}
 
+	if importutilv2.IsDryRun(job.GetOptions()) {
+		c.checkDryRunJob(job, lacks)
+		return
+	}
+
 	requestSize, err := CheckDiskQuota(job, c.meta, c.imeta)
 	if err != nil {
 		log.Warn("import failed, disk quota exceeded", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
@@ -239,6 +247,32 @@ func (c *importChecker) checkPreImportingJob(job ImportJob) {
 	}
 }
 
+// checkDryRunJob finishes the dry run job once all the files are validated by preimport,
+// the job fails if the invalid rows of any file exceed the limits. Neither the disk quota
+// is requested nor the import tasks are created.
+func (c *importChecker) checkDryRunJob(job ImportJob, fileStats []*datapb.ImportFileStats) {
+	log := log.With(zap.Int64("jobID", job.GetJobID()))
+	for _, stat := range fileStats {
+		err := importutilv2.CheckErrorLimits(job.GetOptions(), stat.GetRejectedRows(), stat.GetTotalRows())
+		if err != nil {
+			reason := fmt.Sprintf("dry run failed, file=%v, err=%s", stat.GetImportFile().GetPaths(), err.Error())
+			log.Warn("import dry run failed", zap.String("reason", reason))
+			err = c.imeta.UpdateJob(job.GetJobID(), UpdateJobState(internalpb.ImportJobState_Failed), UpdateJobReason(reason))
+			if err != nil {
+				log.Warn("failed to update job state to Failed", zap.Error(err))
+			}
+			return
+		}
+	}
+	completeTime := time.Now().Format("2006-01-02T15:04:05Z07:00")
+	err := c.imeta.UpdateJob(job.GetJobID(), UpdateJobState(internalpb.ImportJobState_Completed), UpdateJobCompleteTime(completeTime))
+	if err != nil {
+		log.Warn("failed to update job state to Completed", zap.Error(err))
+		return
+	}
+	log.Info("import dry run completed")
+}
+
 func (c *importChecker) checkImportingJob(job ImportJob) {
 	log := log.With(zap.Int64("jobID", job.GetJobID()),
 		zap.Int64("collectionID", job.GetCollectionID()))
@@ -253,8 +287,12 @@ func (c *importChecker) checkImportingJob(job ImportJob) {
 		return t.(*importTask).GetSegmentIDs()
 	})
 
-	// Verify completion of index building for imported segments.
-	unindexed := c.meta.indexMeta.GetUnindexedSegments(job.GetCollectionID(), segmentIDs)
+	// Verify completion of index building for imported segments,
+	// the L0 segments holding the deletes of the upsert import are not indexed.
+	unindexed := c.meta.indexMeta.GetUnindexedSegments(job.GetCollectionID(), lo.Filter(segmentIDs, func(segmentID int64, _ int) bool {
+		segment := c.meta.GetSegment(segmentID)
+		return segment == nil || segment.GetLevel() != datapb.SegmentLevel_L0
+	}))
 	if Params.DataCoordCfg.WaitForIndex.GetAsBool() && len(unindexed) > 0 && !importutilv2.IsL0Import(job.GetOptions()) {
 		log.Debug("waiting for import segments building index...", zap.Int64s("unindexed", unindexed))
 		return
@@ -390,7 +428,15 @@ func (c *importChecker) checkGC(job ImportJob) {
 		if !shouldRemoveJob {
 			return
 		}
-		err := c.imeta.RemoveJob(job.GetJobID())
+		rejectsPrefix := path.Join(c.meta.chunkManager.RootPath(), common.ImportRejectsPath,
+			strconv.FormatInt(job.GetJobID(), 10)) + "/"
+		err := c.meta.chunkManager.RemoveWithPrefix(context.TODO(), rejectsPrefix)
+		if err != nil {
+			log.Warn("remove import rejects failed", zap.Int64("jobID", job.GetJobID()),
+				zap.String("prefix", rejectsPrefix), zap.Error(err))
+			return
+		}
+		err = c.imeta.RemoveJob(job.GetJobID())
 		if err != nil {
 			log.Warn("remove import job failed", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
 			return
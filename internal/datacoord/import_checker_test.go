@@ -30,8 +30,10 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	broker2 "github.com/milvus-io/milvus/internal/datacoord/broker"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	mocks2 "github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/importutilv2"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
@@ -273,6 +275,61 @@ func (s *ImportCheckerSuite) TestCheckJob_Failed() {
 	s.Equal(internalpb.ImportJobState_Importing, s.imeta.GetJob(job.GetJobID()).GetState())
 }
 
+func (s *ImportCheckerSuite) TestCheckJob_DryRun() {
+	catalog := s.imeta.(*importMeta).catalog.(*mocks.DataCoordCatalog)
+	catalog.EXPECT().SavePreImportTask(mock.Anything).Return(nil)
+
+	addJob := func(jobID int64, rejectedRows int64) ImportJob {
+		job := &importJob{
+			ImportJob: &datapb.ImportJob{
+				JobID:        jobID,
+				CollectionID: 1,
+				State:        internalpb.ImportJobState_PreImporting,
+				Options: []*commonpb.KeyValuePair{
+					{Key: importutilv2.DryRun, Value: "true"},
+					{Key: importutilv2.MaxErrorRows, Value: "1"},
+				},
+			},
+		}
+		err := s.imeta.AddJob(job)
+		s.NoError(err)
+		err = s.imeta.AddTask(&preImportTask{
+			PreImportTask: &datapb.PreImportTask{
+				JobID:  jobID,
+				TaskID: jobID,
+				State:  datapb.ImportTaskStateV2_Completed,
+				FileStats: []*datapb.ImportFileStats{{
+					ImportFile:   &internalpb.ImportFile{Id: 1, Paths: []string{"a.json"}},
+					TotalRows:    10,
+					RejectedRows: rejectedRows,
+				}},
+			},
+		})
+		s.NoError(err)
+		return job
+	}
+
+	// the job completes without import tasks
+	job := addJob(100, 1)
+	s.checker.checkPreImportingJob(job)
+	s.Equal(0, len(s.imeta.GetTaskBy(WithJob(job.GetJobID()), WithType(ImportTaskType))))
+	s.Equal(internalpb.ImportJobState_Completed, s.imeta.GetJob(job.GetJobID()).GetState())
+	progress, state, importedRows, totalRows, _ := GetJobProgress(job.GetJobID(), s.imeta, s.checker.meta)
+	s.Equal(int64(100), progress)
+	s.Equal(internalpb.ImportJobState_Completed, state)
+	s.Equal(int64(0), importedRows)
+	s.Equal(int64(10), totalRows)
+	progresses := GetTaskProgresses(job.GetJobID(), s.imeta, s.checker.meta)
+	s.Equal(1, len(progresses))
+	s.Equal(int64(1), progresses[0].GetRejectedRows())
+
+	// the invalid rows exceed max_error_rows
+	job = addJob(101, 2)
+	s.checker.checkPreImportingJob(job)
+	s.Equal(0, len(s.imeta.GetTaskBy(WithJob(job.GetJobID()), WithType(ImportTaskType))))
+	s.Equal(internalpb.ImportJobState_Failed, s.imeta.GetJob(job.GetJobID()).GetState())
+}
+
 func (s *ImportCheckerSuite) TestCheckTimeout() {
 	catalog := s.imeta.(*importMeta).catalog.(*mocks.DataCoordCatalog)
 	catalog.EXPECT().SavePreImportTask(mock.Anything).Return(nil)
@@ -384,9 +441,19 @@ func (s *ImportCheckerSuite) TestCheckGC() {
 	s.Equal(1, len(s.imeta.GetTaskBy(WithJob(s.jobID))))
 	s.Equal(1, len(s.imeta.GetJobBy()))
 
-	// remove job failed
+	// remove rejects failed
+	cm := mocks2.NewChunkManager(s.T())
+	cm.EXPECT().RootPath().Return("root")
+	cm.EXPECT().RemoveWithPrefix(mock.Anything, "root/import_rejects/0/").Return(mockErr).Once()
+	s.checker.meta.chunkManager = cm
 	catalog.ExpectedCalls = nil
 	catalog.EXPECT().DropImportTask(mock.Anything).Return(nil)
+	s.checker.checkGC(s.imeta.GetJob(s.jobID))
+	s.Equal(0, len(s.imeta.GetTaskBy(WithJob(s.jobID))))
+	s.Equal(1, len(s.imeta.GetJobBy()))
+
+	// remove job failed
+	cm.EXPECT().RemoveWithPrefix(mock.Anything, "root/import_rejects/0/").Return(nil)
 	catalog.EXPECT().DropImportJob(mock.Anything).Return(mockErr)
 	s.checker.checkGC(s.imeta.GetJob(s.jobID))
 	s.Equal(0, len(s.imeta.GetTaskBy(WithJob(s.jobID))))
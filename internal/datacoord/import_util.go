@@ -31,9 +31,11 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func WrapTaskLog(task ImportTask, fields ...zap.Field) []zap.Field {
@@ -156,6 +158,24 @@ func AssignSegments(job ImportJob, task ImportTask, manager Manager) ([]int64, e
 			}
 		}
 	}
+
+	// For the upsert import, the deletes of the imported primary keys are written into the L0 segments.
+	// Same as the upsert, the deletes of the partition-key collection take effect on all the partitions.
+	if importutilv2.IsUpsert(job.GetOptions()) {
+		partitionID := job.GetPartitionIDs()[0]
+		if typeutil.HasPartitionKey(job.GetSchema()) {
+			partitionID = common.AllPartitionsID
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		for vchannel := range hashedDataSize {
+			segmentInfo, err := manager.AllocImportSegment(ctx, task.GetTaskID(), task.GetCollectionID(), partitionID, vchannel, datapb.SegmentLevel_L0)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, segmentInfo.GetID())
+		}
+	}
 	return segments, nil
 }
 
@@ -187,6 +207,7 @@ func AssembleImportRequest(task ImportTask, job ImportJob, meta *meta, alloc all
 			SegmentID:   segment.GetID(),
 			PartitionID: segment.GetPartitionID(),
 			Vchannel:    segment.GetInsertChannel(),
+			Level:       segment.GetLevel(),
 		})
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -417,6 +438,15 @@ func GetJobProgress(jobID int64, imeta ImportMeta, meta *meta) (int64, internalp
 		return 10 + 30 + int64(progress*60), internalpb.ImportJobState_Importing, importedRows, totalRows, ""
 
 	case internalpb.ImportJobState_Completed:
+		if importutilv2.IsDryRun(job.GetOptions()) {
+			// Nothing is imported by the dry run, the total rows are the valid rows counted by preimport.
+			totalRows := lo.SumBy(imeta.GetTaskBy(WithJob(jobID), WithType(PreImportTaskType)), func(task ImportTask) int64 {
+				return lo.SumBy(task.GetFileStats(), func(file *datapb.ImportFileStats) int64 {
+					return file.GetTotalRows()
+				})
+			})
+			return 100, internalpb.ImportJobState_Completed, 0, totalRows, ""
+		}
 		totalRows := int64(0)
 		tasks := imeta.GetTaskBy(WithJob(jobID), WithType(ImportTaskType))
 		for _, task := range tasks {
@@ -432,7 +462,20 @@ func GetJobProgress(jobID int64, imeta ImportMeta, meta *meta) (int64, internalp
 	return 0, internalpb.ImportJobState_None, 0, 0, "unknown import job state"
 }
 
+// GetRejectedRows returns the number of the invalid rows skipped by the import job, which are counted by preimport.
+func GetRejectedRows(jobID int64, imeta ImportMeta) int64 {
+	tasks := imeta.GetTaskBy(WithJob(jobID), WithType(PreImportTaskType))
+	return lo.SumBy(tasks, func(task ImportTask) int64 {
+		return lo.SumBy(task.GetFileStats(), func(file *datapb.ImportFileStats) int64 {
+			return file.GetRejectedRows()
+		})
+	})
+}
+
 func GetTaskProgresses(jobID int64, imeta ImportMeta, meta *meta) []*internalpb.ImportTaskProgress {
+	if job := imeta.GetJob(jobID); job != nil && importutilv2.IsDryRun(job.GetOptions()) {
+		return getDryRunProgresses(jobID, imeta)
+	}
 	progresses := make([]*internalpb.ImportTaskProgress, 0)
 	tasks := imeta.GetTaskBy(WithJob(jobID), WithType(ImportTaskType))
 	for _, task := range tasks {
@@ -454,6 +497,33 @@ func GetTaskProgresses(jobID int64, imeta ImportMeta, meta *meta) []*internalpb.
 				State:        task.GetState().String(),
 				ImportedRows: progress * fileStat.GetTotalRows() / 100,
 				TotalRows:    fileStat.GetTotalRows(),
+				RejectedRows: fileStat.GetRejectedRows(),
+				RejectsPath:  fileStat.GetRejectsPath(),
+			})
+		}
+	}
+	return progresses
+}
+
+// getDryRunProgresses reports the validation result of every file by the preimport tasks of the dry run job.
+func getDryRunProgresses(jobID int64, imeta ImportMeta) []*internalpb.ImportTaskProgress {
+	progresses := make([]*internalpb.ImportTaskProgress, 0)
+	tasks := imeta.GetTaskBy(WithJob(jobID), WithType(PreImportTaskType))
+	for _, task := range tasks {
+		progress := int64(0)
+		if task.GetState() == datapb.ImportTaskStateV2_Completed {
+			progress = 100
+		}
+		for _, fileStat := range task.GetFileStats() {
+			progresses = append(progresses, &internalpb.ImportTaskProgress{
+				FileName:     fmt.Sprintf("%v", fileStat.GetImportFile().GetPaths()),
+				FileSize:     fileStat.GetFileSize(),
+				Reason:       task.GetReason(),
+				Progress:     progress,
+				State:        task.GetState().String(),
+				TotalRows:    fileStat.GetTotalRows(),
+				RejectedRows: fileStat.GetRejectedRows(),
+				RejectsPath:  fileStat.GetRejectsPath(),
 			})
 		}
 	}
@@ -30,12 +30,14 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	mocks2 "github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -119,6 +121,70 @@ func TestImportUtil_NewImportTasks(t *testing.T) {
 	}
 }
 
+func TestImportUtil_NewImportTasks_Upsert(t *testing.T) {
+	fileGroups := [][]*datapb.ImportFileStats{
+		{
+			{
+				ImportFile: &internalpb.ImportFile{Id: 0, Paths: []string{"a.json"}},
+				HashedStats: map[string]*datapb.PartitionImportStats{
+					"c0": {PartitionDataSize: map[int64]int64{100: 1024, 101: 1024}},
+					"c1": {PartitionDataSize: map[int64]int64{100: 1024}},
+				},
+			},
+		},
+	}
+	job := &importJob{
+		ImportJob: &datapb.ImportJob{
+			JobID:        1,
+			CollectionID: 2,
+			PartitionIDs: []int64{100, 101},
+			Vchannels:    []string{"c0", "c1"},
+			Schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+					{FieldID: 101, Name: "key", IsPartitionKey: true, DataType: schemapb.DataType_Int64},
+				},
+			},
+			Options: []*commonpb.KeyValuePair{{Key: importutilv2.Upsert, Value: "true"}},
+		},
+	}
+	alloc := NewNMockAllocator(t)
+	alloc.EXPECT().allocN(mock.Anything).RunAndReturn(func(n int64) (int64, int64, error) {
+		id := rand.Int63()
+		return id, id + n, nil
+	})
+	segments := make([]*SegmentInfo, 0)
+	manager := NewMockManager(t)
+	manager.EXPECT().AllocImportSegment(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, taskID int64, collectionID int64, partitionID int64, vchannel string, level datapb.SegmentLevel) (*SegmentInfo, error) {
+			segment := &SegmentInfo{
+				SegmentInfo: &datapb.SegmentInfo{
+					ID:            rand.Int63(),
+					CollectionID:  collectionID,
+					PartitionID:   partitionID,
+					InsertChannel: vchannel,
+					IsImporting:   true,
+					Level:         level,
+				},
+			}
+			segments = append(segments, segment)
+			return segment, nil
+		})
+	tasks, err := NewImportTasks(fileGroups, job, manager, alloc)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, 5, len(tasks[0].(*importTask).GetSegmentIDs()))
+
+	// one L0 segment per vchannel, which takes effect on all the partitions
+	l0Segments := lo.Filter(segments, func(segment *SegmentInfo, _ int) bool {
+		return segment.GetLevel() == datapb.SegmentLevel_L0
+	})
+	assert.Equal(t, 2, len(l0Segments))
+	for _, segment := range l0Segments {
+		assert.Equal(t, common.AllPartitionsID, segment.GetPartitionID())
+	}
+}
+
 func TestImportUtil_AssembleRequest(t *testing.T) {
 	var job ImportJob = &importJob{
 		ImportJob: &datapb.ImportJob{JobID: 0, CollectionID: 1, PartitionIDs: []int64{2}, Vchannels: []string{"v0"}},
@@ -479,13 +545,15 @@ func TestImportUtil_GetImportProgress(t *testing.T) {
 			State:  datapb.ImportTaskStateV2_Completed,
 			FileStats: []*datapb.ImportFileStats{
 				{
-					ImportFile: file3,
+					ImportFile:   file3,
+					RejectedRows: 5,
 				},
 			},
 		},
 	}
 	err = imeta.AddTask(pit2)
 	assert.NoError(t, err)
+	assert.Equal(t, int64(5), GetRejectedRows(job.GetJobID(), imeta))
 
 	it1 := &importTask{
 		ImportTaskV2: &datapb.ImportTaskV2{
@@ -5,6 +5,8 @@ package datacoord
 import (
 	context "context"
 
+	schemapb "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+
 	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -596,6 +598,52 @@ func (_c *MockCluster_UnRegister_Call) RunAndReturn(run func(*NodeInfo) error) *
 	return _c
 }
 
+// UpdateChannelSchema provides a mock function with given fields: ctx, nodeID, flushTs, channels, schema
+func (_m *MockCluster) UpdateChannelSchema(ctx context.Context, nodeID int64, flushTs uint64, channels []string, schema *schemapb.CollectionSchema) error {
+	ret := _m.Called(ctx, nodeID, flushTs, channels, schema)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64, []string, *schemapb.CollectionSchema) error); ok {
+		r0 = rf(ctx, nodeID, flushTs, channels, schema)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCluster_UpdateChannelSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChannelSchema'
+type MockCluster_UpdateChannelSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateChannelSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+//   - flushTs uint64
+//   - channels []string
+//   - schema *schemapb.CollectionSchema
+func (_e *MockCluster_Expecter) UpdateChannelSchema(ctx interface{}, nodeID interface{}, flushTs interface{}, channels interface{}, schema interface{}) *MockCluster_UpdateChannelSchema_Call {
+	return &MockCluster_UpdateChannelSchema_Call{Call: _e.mock.On("UpdateChannelSchema", ctx, nodeID, flushTs, channels, schema)}
+}
+
+func (_c *MockCluster_UpdateChannelSchema_Call) Run(run func(ctx context.Context, nodeID int64, flushTs uint64, channels []string, schema *schemapb.CollectionSchema)) *MockCluster_UpdateChannelSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64), args[3].([]string), args[4].(*schemapb.CollectionSchema))
+	})
+	return _c
+}
+
+func (_c *MockCluster_UpdateChannelSchema_Call) Return(_a0 error) *MockCluster_UpdateChannelSchema_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCluster_UpdateChannelSchema_Call) RunAndReturn(run func(context.Context, int64, uint64, []string, *schemapb.CollectionSchema) error) *MockCluster_UpdateChannelSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Watch provides a mock function with given fields: ctx, ch
 func (_m *MockCluster) Watch(ctx context.Context, ch RWChannel) error {
 	ret := _m.Called(ctx, ch)
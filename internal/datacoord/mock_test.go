@@ -489,6 +489,14 @@ func (m *mockRootCoordClient) AlterCollection(ctx context.Context, request *milv
 	panic("not implemented") // TODO: Implement
 }
 
+func (m *mockRootCoordClient) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordClient) DropCollectionField(ctx context.Context, request *proxypb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *mockRootCoordClient) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -720,6 +728,18 @@ func (m *mockRootCoordClient) ListPolicy(ctx context.Context, in *internalpb.Lis
 	return &internalpb.ListPolicyResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
 }
 
+func (m *mockRootCoordClient) CreateRowPolicy(ctx context.Context, in *internalpb.CreateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) DropRowPolicy(ctx context.Context, in *internalpb.DropRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ListRowPolicies(ctx context.Context, in *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error) {
+	panic("implement me")
+}
+
 type mockHandler struct {
 	meta *meta
 }
@@ -122,6 +122,8 @@ type Server struct {
 	importMeta       ImportMeta
 	importScheduler  ImportScheduler
 	importChecker    ImportChecker
+	exportMeta       ExportMeta
+	exportScheduler  ExportScheduler
 
 	compactionTrigger        trigger
 	compactionHandler        compactionPlanContext
@@ -398,6 +400,12 @@ func (s *Server) initDataCoord() error {
 	s.importScheduler = NewImportScheduler(s.meta, s.cluster, s.allocator, s.importMeta, s.buildIndexCh)
 	s.importChecker = NewImportChecker(s.meta, s.broker, s.cluster, s.allocator, s.segmentManager, s.importMeta)
 
+	s.exportMeta, err = NewExportMeta(s.meta.catalog)
+	if err != nil {
+		return err
+	}
+	s.exportScheduler = NewExportScheduler(s.meta, s.allocator, s.exportMeta, storageCli)
+
 	s.syncSegmentsScheduler = newSyncSegmentsScheduler(s.meta, s.channelManager, s.sessionManager)
 
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
@@ -724,6 +732,7 @@ func (s *Server) startServerLoop() {
 	s.startIndexService(s.serverLoopCtx)
 	go s.importScheduler.Start()
 	go s.importChecker.Start()
+	go s.exportScheduler.Start()
 	s.garbageCollector.start()
 	s.syncSegmentsScheduler.Start()
 }
@@ -1050,6 +1059,7 @@ func (s *Server) Stop() error {
 
 	s.importScheduler.Close()
 	s.importChecker.Close()
+	s.exportScheduler.Close()
 	s.syncSegmentsScheduler.Stop()
 
 	s.stopCompaction()
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -1553,10 +1555,54 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 	}
 
 	clonedColl.Properties = properties
+	getFieldIDs := func(fields []*schemapb.FieldSchema) []int64 {
+		return lo.Map(fields, func(field *schemapb.FieldSchema, _ int) int64 { return field.GetFieldID() })
+	}
+	schemaChanged := len(req.GetSchema().GetFields()) > 0 &&
+		!slices.Equal(getFieldIDs(clonedColl.Schema.GetFields()), getFieldIDs(req.GetSchema().GetFields()))
+	if schemaChanged {
+		if clonedColl.Schema == nil {
+			clonedColl.Schema = &schemapb.CollectionSchema{}
+		}
+		clonedColl.Schema.Fields = req.GetSchema().GetFields()
+	}
 	s.meta.AddCollection(clonedColl)
+
+	// seal the growing segments once fields are added or dropped, so that the data written with the new schema
+	// goes into the new segments
+	if schemaChanged {
+		log.Ctx(ctx).Info("collection schema changed, seal all the segments",
+			zap.Int64("collectionID", req.GetCollectionID()), zap.Int64s("fieldIDs", getFieldIDs(req.GetSchema().GetFields())))
+		if _, err := s.segmentManager.SealAllSegments(ctx, req.GetCollectionID(), nil); err != nil {
+			return merr.Status(err), nil
+		}
+		// the datanodes must write the new segments with the new schema before the proxies accept data of it
+		if err := s.updateChannelSchema(ctx, req.GetCollectionID(), clonedColl.Schema); err != nil {
+			log.Ctx(ctx).Warn("failed to update the schema of channels",
+				zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+			return merr.Status(err), nil
+		}
+	}
 	return merr.Success(), nil
 }
 
+// updateChannelSchema pushes the schema to the datanodes watching the channels of the collection.
+func (s *Server) updateChannelSchema(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema) error {
+	ts, err := s.allocator.allocTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+	return retry.Do(ctx, func() error {
+		nodeChannels := s.channelManager.GetNodeChannelsByCollectionID(collectionID)
+		for nodeID, channelNames := range nodeChannels {
+			if err := s.cluster.UpdateChannelSchema(ctx, nodeID, ts, channelNames, schema); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, retry.Attempts(60)) // about 3min
+}
+
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
 		return &milvuspb.CheckHealthResponse{
@@ -1755,6 +1801,8 @@ func (s *Server) GetImportProgress(ctx context.Context, in *internalpb.GetImport
 	resp.CompleteTime = job.GetCompleteTime()
 	resp.ImportedRows = importedRows
 	resp.TotalRows = totalRows
+	resp.RejectedRows = GetRejectedRows(jobID, s.importMeta)
+	resp.DryRun = importutilv2.IsDryRun(job.GetOptions())
 	resp.TaskProgresses = GetTaskProgresses(jobID, s.importMeta, s.meta)
 	log.Info("GetImportProgress done", zap.Any("resp", resp))
 	return resp, nil
@@ -1792,3 +1840,157 @@ func (s *Server) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 	}
 	return resp, nil
 }
+
+func (s *Server) ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &internalpb.ExportResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp := &internalpb.ExportResponse{
+		Status: merr.Success(),
+	}
+
+	log := log.With(zap.Int64("collection", in.GetCollectionID()),
+		zap.Int64s("partitions", in.GetPartitionIDs()),
+		zap.Uint64("startTs", in.GetStartTs()),
+		zap.Uint64("endTs", in.GetEndTs()))
+	log.Info("receive export request")
+
+	ts, err := s.allocator.allocTimestamp(ctx)
+	if err != nil {
+		resp.Status = merr.Status(merr.WrapErrServiceInternal(fmt.Sprintf("alloc timestamp failed, err=%s", err)))
+		return resp, nil
+	}
+	endTs := in.GetEndTs()
+	if endTs == 0 {
+		// export a consistent snapshot, the rows written after the job is created are excluded
+		endTs = ts
+	}
+	if endTs > ts {
+		// the rows written after the flush below are not exported
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("end_ts %d is later than the current ts %d", endTs, ts))
+		return resp, nil
+	}
+	if in.GetStartTs() > endTs {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("start_ts %d is larger than end_ts %d", in.GetStartTs(), endTs))
+		return resp, nil
+	}
+
+	// the rows written before end ts may be still in growing segments, flush the collection
+	// and the segments are selected after the flush is done, see exportScheduler.processPendingJob
+	flushResp, err := s.Flush(ctx, &datapb.FlushRequest{
+		DbID:         in.GetDbID(),
+		CollectionID: in.GetCollectionID(),
+	})
+	if err = merr.CheckRPCCall(flushResp, err); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	flushTs := flushResp.GetFlushTs()
+	if flushTs < endTs {
+		// flush ts is 0 if the datanodes fall back to the legacy flush,
+		// wait for the checkpoints to reach end ts then
+		flushTs = endTs
+	}
+
+	jobID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		resp.Status = merr.Status(merr.WrapErrServiceInternal(fmt.Sprintf("alloc id failed, err=%s", err)))
+		return resp, nil
+	}
+	job := &exportJob{
+		ExportJob: &datapb.ExportJob{
+			JobID:          jobID,
+			DbID:           in.GetDbID(),
+			CollectionID:   in.GetCollectionID(),
+			CollectionName: in.GetCollectionName(),
+			PartitionIDs:   in.GetPartitionIDs(),
+			Schema:         in.GetSchema(),
+			StartTs:        in.GetStartTs(),
+			EndTs:          endTs,
+			CleanupTs:      math.MaxUint64,
+			State:          internalpb.ExportJobState_ExportPending,
+			Options:        in.GetOptions(),
+			StartTime:      time.Now().Format("2006-01-02T15:04:05Z07:00"),
+			FlushTs:        flushTs,
+		},
+	}
+	err = s.exportMeta.AddJob(job)
+	if err != nil {
+		resp.Status = merr.Status(merr.WrapErrServiceInternal(fmt.Sprintf("add export job failed, err=%s", err)))
+		return resp, nil
+	}
+
+	resp.JobID = fmt.Sprint(job.GetJobID())
+	log.Info("add export job done", zap.Int64("jobID", job.GetJobID()), zap.Uint64("endTs", endTs), zap.Uint64("flushTs", flushTs))
+	return resp, nil
+}
+
+func (s *Server) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	log := log.With(zap.String("jobID", in.GetJobID()))
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &internalpb.GetExportProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp := &internalpb.GetExportProgressResponse{
+		Status: merr.Success(),
+	}
+	jobID, err := strconv.ParseInt(in.GetJobID(), 10, 64)
+	if err != nil {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("parse job id failed, err=%s", err))
+		return resp, nil
+	}
+	job := s.exportMeta.GetJob(jobID)
+	if job == nil {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("export job does not exist, jobID=%d", jobID))
+		return resp, nil
+	}
+	progress, state, exportedRows, files, reason := GetExportJobProgress(jobID, s.exportMeta)
+	resp.State = state
+	resp.Reason = reason
+	resp.Progress = progress
+	resp.CollectionName = job.GetCollectionName()
+	resp.StartTime = job.GetStartTime()
+	resp.CompleteTime = job.GetCompleteTime()
+	resp.ExportedRows = exportedRows
+	resp.Files = files
+	log.Info("GetExportProgress done", zap.String("state", state.String()), zap.Int64("progress", progress))
+	return resp, nil
+}
+
+func (s *Server) ListExports(ctx context.Context, req *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &internalpb.ListExportsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp := &internalpb.ListExportsResponse{
+		Status:     merr.Success(),
+		JobIDs:     make([]string, 0),
+		States:     make([]internalpb.ExportJobState, 0),
+		Reasons:    make([]string, 0),
+		Progresses: make([]int64, 0),
+	}
+
+	var jobs []ExportJob
+	if req.GetCollectionID() != 0 {
+		jobs = s.exportMeta.GetJobBy(WithExportCollectionID(req.GetCollectionID()))
+	} else {
+		jobs = s.exportMeta.GetJobBy()
+	}
+
+	for _, job := range jobs {
+		progress, state, _, _, reason := GetExportJobProgress(job.GetJobID(), s.exportMeta)
+		resp.JobIDs = append(resp.JobIDs, fmt.Sprintf("%d", job.GetJobID()))
+		resp.States = append(resp.States, state)
+		resp.Reasons = append(resp.Reasons, reason)
+		resp.Progresses = append(resp.Progresses, progress)
+		resp.CollectionNames = append(resp.CollectionNames, job.GetCollectionName())
+	}
+	return resp, nil
+}
@@ -725,6 +725,37 @@ func TestBroadcastAlteredCollection(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, s.meta.collections[1].Properties)
 	})
+
+	t.Run("test schema changed", func(t *testing.T) {
+		segmentManager := NewMockManager(t)
+		segmentManager.EXPECT().SealAllSegments(mock.Anything, int64(1), mock.Anything).Return(nil, nil).Once()
+		alloc := NewNMockAllocator(t)
+		alloc.EXPECT().allocTimestamp(mock.Anything).Return(1000, nil).Once()
+		channelManager := NewMockChannelManager(t)
+		channelManager.EXPECT().GetNodeChannelsByCollectionID(int64(1)).Return(map[int64][]string{1: {"ch1"}}).Once()
+		cluster := NewMockCluster(t)
+		cluster.EXPECT().UpdateChannelSchema(mock.Anything, int64(1), uint64(1000), []string{"ch1"}, mock.Anything).
+			RunAndReturn(func(ctx context.Context, nodeID int64, ts uint64, channels []string, schema *schemapb.CollectionSchema) error {
+				assert.Equal(t, 2, len(schema.GetFields()))
+				return nil
+			}).Once()
+		s := &Server{meta: &meta{collections: map[UniqueID]*collectionInfo{
+			1: {ID: 1, Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}}}},
+		}}, segmentManager: segmentManager, allocator: alloc, channelManager: channelManager, cluster: cluster}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		ctx := context.Background()
+		req := &datapb.AlterCollectionRequest{
+			CollectionID: 1,
+			Schema:       &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}, {FieldID: 101}}},
+		}
+		resp, err := s.BroadcastAlteredCollection(ctx, req)
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Equal(t, 2, len(s.meta.collections[1].Schema.GetFields()))
+
+		// the segments are not sealed if the fields are not changed
+		resp, err = s.BroadcastAlteredCollection(ctx, req)
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+	})
 }
 
 func TestServer_GcConfirm(t *testing.T) {
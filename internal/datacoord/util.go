@@ -139,6 +139,12 @@ func getCollectionTTL(properties map[string]string) (time.Duration, error) {
 	return Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second), nil
 }
 
+// getCollectionTTLFieldID returns the id of the ttl field of the collection, or 0 if the entities don't expire by a field
+func getCollectionTTLFieldID(coll *collectionInfo) int64 {
+	fieldID, _ := common.GetCollectionTTLFieldID(coll.Schema, funcutil.Map2KeyValuePair(coll.Properties)...)
+	return fieldID
+}
+
 func UpdateCompactionSegmentSizeMetrics(segments []*datapb.CompactionSegment) {
 	var totalSize int64
 	for _, seg := range segments {
@@ -568,7 +568,7 @@ func (t *clusteringCompactionTask) mappingSegment(
 			}
 			// Filtering expired entity
 			ts := typeutil.Timestamp(v.Timestamp)
-			if isExpiredEntity(t.plan.GetCollectionTtl(), t.currentTs, ts) {
+			if isExpiredEntity(t.plan.GetCollectionTtl(), t.currentTs, ts) || isExpiredByField(t.plan.GetTtlFieldID(), t.currentTs, v) {
 				expired++
 				continue
 			}
@@ -654,6 +654,7 @@ func (t *clusteringCompactionTask) mappingSegment(
 		}
 	}
 
+	metrics.DataNodeCompactionExpiredEntityCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), t.plan.GetType().String()).Add(float64(expired))
 	log.Info("mapping segment end",
 		zap.Int64("remained_entities", remained),
 		zap.Int64("deleted_entities", deleted),
@@ -47,6 +47,24 @@ func isExpiredEntity(ttl int64, now, ts typeutil.Timestamp) bool {
 	return expireTime.Before(pnow)
 }
 
+// isExpiredByField returns whether the unix time in the ttl field of the entity is not after now,
+// the entities with null expiration never expire.
+func isExpiredByField(ttlFieldID int64, now typeutil.Timestamp, v *storage.Value) bool {
+	if ttlFieldID <= 0 {
+		return false
+	}
+	row, ok := v.Value.(map[typeutil.UniqueID]interface{})
+	if !ok {
+		return false
+	}
+	expiration, ok := row[ttlFieldID].(int64)
+	if !ok {
+		return false
+	}
+	pnow, _ := tsoutil.ParseTS(now)
+	return expiration <= pnow.Unix()
+}
+
 func mergeDeltalogs(ctx context.Context, io io.BinlogIO, dpaths map[typeutil.UniqueID][]string) (map[interface{}]typeutil.Timestamp, error) {
 	pk2ts := make(map[interface{}]typeutil.Timestamp)
 
@@ -134,6 +152,8 @@ func serializeWrite(ctx context.Context, allocator allocator.Interface, writer *
 	_, span := otel.Tracer(typeutil.DataNodeRole).Start(ctx, "serializeWrite")
 	defer span.End()
 
+	// the ranges are reset by SerializeYield
+	valueRanges := writer.GetValueRanges()
 	blobs, tr, err := writer.SerializeYield()
 	startID, _, err := allocator.Alloc(uint32(len(blobs)))
 	if err != nil {
@@ -158,6 +178,7 @@ func serializeWrite(ctx context.Context, allocator allocator.Interface, writer *
 					EntriesNum:    blobs[i].RowNum,
 					TimestampFrom: tr.GetMinTimestamp(),
 					TimestampTo:   tr.GetMaxTimestamp(),
+					ValueRange:    valueRanges[fID],
 				},
 			},
 		}
@@ -188,7 +188,8 @@ func (t *mixCompactionTask) merge(
 			}
 
 			// Filtering expired entity
-			if isExpiredEntity(t.plan.GetCollectionTtl(), t.currentTs, typeutil.Timestamp(v.Timestamp)) {
+			if isExpiredEntity(t.plan.GetCollectionTtl(), t.currentTs, typeutil.Timestamp(v.Timestamp)) ||
+				isExpiredByField(t.plan.GetTtlFieldID(), t.currentTs, v) {
 				expiredRowCount++
 				continue
 			}
@@ -260,6 +261,7 @@ func (t *mixCompactionTask) merge(
 		Channel:             t.plan.GetChannel(),
 	}
 
+	metrics.DataNodeCompactionExpiredEntityCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), t.plan.GetType().String()).Add(float64(expiredRowCount))
 	totalElapse := t.tr.RecordSpan()
 
 	log.Info("compact merge end",
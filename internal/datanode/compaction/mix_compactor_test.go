@@ -520,6 +520,90 @@ func (s *MixCompactionTaskSuite) TestIsExpiredEntity() {
 	}
 }
 
+func (s *MixCompactionTaskSuite) TestIsExpiredByField() {
+	nowTs := tsoutil.ComposeTSByTime(getMilvusBirthday(), 0)
+	now := getMilvusBirthday().Unix()
+	newValue := func(expiration interface{}) *storage.Value {
+		return &storage.Value{Value: map[int64]interface{}{Int64Field: expiration}}
+	}
+
+	s.True(isExpiredByField(Int64Field, nowTs, newValue(now-1)))
+	s.True(isExpiredByField(Int64Field, nowTs, newValue(now)))
+	s.False(isExpiredByField(Int64Field, nowTs, newValue(now+1)))
+	// null expiration never expires
+	s.False(isExpiredByField(Int64Field, nowTs, newValue(nil)))
+	// the collection has no ttl field
+	s.False(isExpiredByField(0, nowTs, newValue(now-1)))
+}
+
+func (s *MixCompactionTaskSuite) TestSegmentWriterAlignSchema() {
+	schema := typeutil.Clone(s.meta.GetSchema())
+	// the varchar field is dropped, and two fields are added
+	schema.Fields = lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return field.GetFieldID() != VarCharField
+	})
+	schema.Fields = append(schema.Fields,
+		&schemapb.FieldSchema{FieldID: 200, Name: "nullable", DataType: schemapb.DataType_Int64, Nullable: true},
+		&schemapb.FieldSchema{
+			FieldID:      201,
+			Name:         "defaulted",
+			DataType:     schemapb.DataType_Int32,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+		},
+	)
+	segWriter, err := NewSegmentWriter(schema, 100, 1, PartitionID, CollectionID)
+	s.Require().NoError(err)
+
+	v := &storage.Value{
+		PK:        storage.NewInt64PrimaryKey(1),
+		Timestamp: int64(tsoutil.ComposeTSByTime(getMilvusBirthday(), 0)),
+		Value:     getRow(1),
+	}
+	s.NoError(segWriter.Write(v))
+	row := v.Value.(map[int64]interface{})
+	s.NotContains(row, int64(VarCharField))
+	s.Contains(row, int64(200))
+	s.Nil(row[200])
+	s.Equal(int32(7), row[201])
+	s.Equal(len(schema.GetFields()), len(row))
+
+	// the fields without null or default values can't be filled
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 202, Name: "required", DataType: schemapb.DataType_Int64})
+	segWriter, err = NewSegmentWriter(schema, 100, 1, PartitionID, CollectionID)
+	s.Require().NoError(err)
+	v.Value = getRow(1)
+	s.Error(segWriter.Write(v))
+}
+
+func (s *MixCompactionTaskSuite) TestSerializeWriteValueRanges() {
+	schema := typeutil.Clone(s.meta.GetSchema())
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 200, Name: "nullable", DataType: schemapb.DataType_Int64, Nullable: true})
+	segWriter, err := NewSegmentWriter(schema, 100, 1, PartitionID, CollectionID)
+	s.Require().NoError(err)
+
+	for _, magic := range []int64{3, 1, 7} {
+		err := segWriter.Write(&storage.Value{
+			PK:        storage.NewInt64PrimaryKey(magic),
+			Timestamp: int64(tsoutil.ComposeTSByTime(getMilvusBirthday(), 0)),
+			Value:     getRow(magic),
+		})
+		s.Require().NoError(err)
+	}
+
+	alloc := allocator.NewLocalAllocator(7777777, math.MaxInt64)
+	_, fBinlogs, err := serializeWrite(context.TODO(), alloc, segWriter)
+	s.Require().NoError(err)
+	valueRange := fBinlogs[Int64Field].GetBinlogs()[0].GetValueRange()
+	s.EqualValues(1, valueRange.GetMin())
+	s.EqualValues(7, valueRange.GetMax())
+	// the fields of other types, the system fields and the fields of nulls have no range
+	s.Nil(fBinlogs[Int32Field].GetBinlogs()[0].GetValueRange())
+	s.Nil(fBinlogs[common.RowIDField].GetBinlogs()[0].GetValueRange())
+	s.Nil(fBinlogs[200].GetBinlogs()[0].GetValueRange())
+	// the ranges are reset for the next binlogs
+	s.Empty(segWriter.GetValueRanges())
+}
+
 func getRow(magic int64) map[int64]interface{} {
 	ts := tsoutil.ComposeTSByTime(getMilvusBirthday(), 0)
 	return map[int64]interface{}{
@@ -12,9 +12,12 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/flushcommon/writebuffer"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -103,6 +106,12 @@ type SegmentWriter struct {
 	collectionID int64
 	sch          *schemapb.CollectionSchema
 	rowCount     *atomic.Int64
+
+	// the values of the fields added after the segments written were created
+	defaultValues map[int64]any
+	// the ranges of the int64 user fields in the binlogs to serialize, see datapb.Binlog.value_range
+	int64Fields []int64
+	valueRanges map[int64]*datapb.Int64ValueRange
 }
 
 func (w *SegmentWriter) GetRowNum() int64 {
@@ -138,11 +147,71 @@ func (w *SegmentWriter) Write(v *storage.Value) error {
 		w.tsTo = ts
 	}
 
+	if err := w.alignSchema(v); err != nil {
+		return err
+	}
+
+	w.updateValueRanges(v)
 	w.pkstats.Update(v.PK)
 	w.rowCount.Inc()
 	return w.writer.Write(v)
 }
 
+// updateValueRanges extends the ranges of the int64 fields by the row, the null values are skipped.
+func (w *SegmentWriter) updateValueRanges(v *storage.Value) {
+	m, ok := v.Value.(map[storage.FieldID]any)
+	if !ok {
+		return
+	}
+	for _, fieldID := range w.int64Fields {
+		value, ok := m[fieldID].(int64)
+		if !ok {
+			continue
+		}
+		r, ok := w.valueRanges[fieldID]
+		if !ok {
+			w.valueRanges[fieldID] = &datapb.Int64ValueRange{Min: value, Max: value}
+			continue
+		}
+		if value < r.Min {
+			r.Min = value
+		}
+		if value > r.Max {
+			r.Max = value
+		}
+	}
+}
+
+// GetValueRanges returns the ranges of the int64 fields in the binlogs to serialize, fieldID => range.
+func (w *SegmentWriter) GetValueRanges() map[int64]*datapb.Int64ValueRange {
+	return w.valueRanges
+}
+
+// alignSchema removes the values of the dropped fields from the row, and fills the fields added to the collection
+// after the row was written with their default values, so that the data of the dropped fields is cleaned up and the
+// added fields are written physically by compaction.
+func (w *SegmentWriter) alignSchema(v *storage.Value) error {
+	m, ok := v.Value.(map[storage.FieldID]any)
+	if !ok {
+		return nil
+	}
+	for fieldID := range m {
+		if _, ok := w.defaultValues[fieldID]; !ok {
+			delete(m, fieldID)
+		}
+	}
+	for _, field := range w.sch.GetFields() {
+		if _, ok := m[field.GetFieldID()]; ok {
+			continue
+		}
+		if !field.GetNullable() && field.GetDefaultValue() == nil {
+			return merr.WrapErrServiceInternal(fmt.Sprintf("field %d of row is missing", field.GetFieldID()))
+		}
+		m[field.GetFieldID()] = w.defaultValues[field.GetFieldID()]
+	}
+	return nil
+}
+
 func (w *SegmentWriter) Finish(actualRowCount int64) (*storage.Blob, error) {
 	w.writer.Flush()
 	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: w.collectionID, Schema: w.sch})
@@ -196,6 +265,7 @@ func (w *SegmentWriter) clear() {
 	w.closers = closers
 	w.tsFrom = math.MaxUint64
 	w.tsTo = 0
+	w.valueRanges = make(map[int64]*datapb.Int64ValueRange)
 }
 
 func NewSegmentWriter(sch *schemapb.CollectionSchema, maxCount int64, segID, partID, collID int64) (*SegmentWriter, error) {
@@ -232,11 +302,51 @@ func NewSegmentWriter(sch *schemapb.CollectionSchema, maxCount int64, segID, par
 		partitionID:  partID,
 		collectionID: collID,
 		rowCount:     atomic.NewInt64(0),
+
+		defaultValues: make(map[int64]any, len(sch.GetFields())),
+		valueRanges:   make(map[int64]*datapb.Int64ValueRange),
+	}
+	for _, field := range sch.GetFields() {
+		segWriter.defaultValues[field.GetFieldID()] = getDefaultValue(field)
+		if field.GetDataType() == schemapb.DataType_Int64 && field.GetFieldID() >= common.StartOfUserFieldID {
+			segWriter.int64Fields = append(segWriter.int64Fields, field.GetFieldID())
+		}
 	}
 
 	return &segWriter, nil
 }
 
+// getDefaultValue returns the default value of the field in the type of storage values, it's nil if the field
+// doesn't have a default value.
+func getDefaultValue(field *schemapb.FieldSchema) any {
+	defaultValue := field.GetDefaultValue()
+	if defaultValue == nil {
+		return nil
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return defaultValue.GetBoolData()
+	case schemapb.DataType_Int8:
+		return int8(defaultValue.GetIntData())
+	case schemapb.DataType_Int16:
+		return int16(defaultValue.GetIntData())
+	case schemapb.DataType_Int32:
+		return defaultValue.GetIntData()
+	case schemapb.DataType_Int64:
+		return defaultValue.GetLongData()
+	case schemapb.DataType_Float:
+		return defaultValue.GetFloatData()
+	case schemapb.DataType_Double:
+		return defaultValue.GetDoubleData()
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return defaultValue.GetStringData()
+	case schemapb.DataType_JSON:
+		return defaultValue.GetBytesData()
+	default:
+		return nil
+	}
+}
+
 func newBinlogWriter(collID, partID, segID int64, schema *schemapb.CollectionSchema,
 ) (writer *storage.SerializeWriter[*storage.Value], closers []func() (*storage.Blob, error), err error) {
 	fieldWriters := storage.NewBinlogStreamWriters(collID, partID, segID, schema.Fields)
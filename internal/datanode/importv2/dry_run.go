@@ -0,0 +1,244 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importv2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bloomfilter"
+	importcommon "github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/parameterutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// dryRunBloomFilterCapacity is the capacity of the bloom filter of the primary keys of a file.
+	dryRunBloomFilterCapacity = 1 << 23
+	// dryRunMaxExactPKs is the max number of the primary keys kept to confirm the duplicates.
+	dryRunMaxExactPKs = 1 << 20
+)
+
+// RowValidator validates the rows of an import file against the schema during the dry run.
+// The readers have checked the types and the dimensions, RowValidator checks the rules which
+// are not checked until the data is inserted, and reports the invalid rows by the primary keys.
+//
+// The duplicated primary keys are only checked within the file, the duplicates across the files
+// of the job or against the data in the collection are not reported. A primary key hit by the
+// bloom filter is confirmed by the exact set of the first dryRunMaxExactPKs primary keys, the hits
+// which can't be confirmed after the set is full aren't rejected but counted as unconfirmed.
+type RowValidator struct {
+	file     string
+	rejector *importcommon.Rejector
+
+	pkField      *schemapb.FieldSchema
+	dynamicField *schemapb.FieldSchema
+	maxLength    map[int64]int64
+	maxCapacity  map[int64]int64
+
+	pkFilter    bloomfilter.BloomFilterInterface
+	pks         typeutil.Set[any]
+	maxExactPKs int
+	unconfirmed int64
+}
+
+func NewRowValidator(schema *schemapb.CollectionSchema, file string, rejector *importcommon.Rejector) (*RowValidator, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	v := &RowValidator{
+		file:         file,
+		rejector:     rejector,
+		pkField:      pkField,
+		dynamicField: typeutil.GetDynamicField(schema),
+		maxLength:    make(map[int64]int64),
+		maxCapacity:  make(map[int64]int64),
+		pkFilter: bloomfilter.NewBloomFilterWithType(dryRunBloomFilterCapacity,
+			paramtable.Get().CommonCfg.MaxBloomFalsePositive.GetAsFloat(),
+			paramtable.Get().CommonCfg.BloomFilterType.GetValue()),
+		pks:         typeutil.NewSet[any](),
+		maxExactPKs: dryRunMaxExactPKs,
+	}
+	for _, field := range schema.GetFields() {
+		if typeutil.IsArrayType(field.GetDataType()) {
+			maxCapacity, err := parameterutil.GetMaxCapacity(field)
+			if err != nil {
+				return nil, err
+			}
+			v.maxCapacity[field.GetFieldID()] = maxCapacity
+		}
+		if typeutil.IsStringType(field.GetDataType()) || typeutil.IsStringType(field.GetElementType()) {
+			maxLength, err := parameterutil.GetMaxLength(field)
+			if err != nil {
+				return nil, err
+			}
+			v.maxLength[field.GetFieldID()] = maxLength
+		}
+	}
+	return v, nil
+}
+
+// Validate validates the rows, the invalid rows are recorded by the rejector,
+// it returns the number of the invalid rows of the data.
+func (v *RowValidator) Validate(data *storage.InsertData) (int64, error) {
+	var rejected int64
+	for i := 0; i < data.GetRowNum(); i++ {
+		var pk any
+		if pkData, ok := data.Data[v.pkField.GetFieldID()]; ok {
+			pk = pkData.GetRow(i)
+		}
+		err := v.validateRow(data, i, pk)
+		if err != nil {
+			if err = v.rejector.RejectPK(v.file, pk, err); err != nil {
+				return rejected, err
+			}
+			rejected++
+			continue
+		}
+		if pk != nil {
+			v.addPK(pk)
+		}
+	}
+	return rejected, nil
+}
+
+// UnconfirmedDuplicates returns the number of the primary keys hit by the bloom filter
+// which can't be confirmed as duplicates.
+func (v *RowValidator) UnconfirmedDuplicates() int64 {
+	return v.unconfirmed
+}
+
+// isDuplicated checks the primary key by the bloom filter first, the hits are confirmed by the exact set.
+func (v *RowValidator) isDuplicated(pk any) bool {
+	if !v.testPK(pk) {
+		return false
+	}
+	if v.pks.Contain(pk) {
+		return true
+	}
+	if v.pks.Len() >= v.maxExactPKs {
+		v.unconfirmed++
+	}
+	return false
+}
+
+func (v *RowValidator) addPK(pk any) {
+	switch pk := pk.(type) {
+	case int64:
+		b := make([]byte, 8)
+		common.Endian.PutUint64(b, uint64(pk))
+		v.pkFilter.Add(b)
+	case string:
+		v.pkFilter.AddString(pk)
+	}
+	if v.pks.Len() < v.maxExactPKs {
+		v.pks.Insert(pk)
+	}
+}
+
+func (v *RowValidator) testPK(pk any) bool {
+	switch pk := pk.(type) {
+	case int64:
+		b := make([]byte, 8)
+		common.Endian.PutUint64(b, uint64(pk))
+		return v.pkFilter.Test(b)
+	case string:
+		return v.pkFilter.TestString(pk)
+	}
+	return false
+}
+
+func (v *RowValidator) validateRow(data *storage.InsertData, i int, pk any) error {
+	if pk != nil && v.isDuplicated(pk) {
+		return merr.WrapErrImportFailed(fmt.Sprintf("duplicated primary key '%v'", pk))
+	}
+	for fieldID, fieldData := range data.Data {
+		if maxLength, ok := v.maxLength[fieldID]; ok {
+			if err := v.checkLength(fieldData, i, maxLength); err != nil {
+				return err
+			}
+		}
+		if maxCapacity, ok := v.maxCapacity[fieldID]; ok {
+			array := fieldData.GetRow(i).(*schemapb.ScalarField)
+			if length := getArrayLength(array); int64(length) > maxCapacity {
+				return merr.WrapErrImportFailed(fmt.Sprintf("the length (%d) of array field exceeds max capacity (%d), fieldID=%d",
+					length, maxCapacity, fieldID))
+			}
+		}
+		if v.dynamicField != nil && fieldID == v.dynamicField.GetFieldID() {
+			if err := v.checkDynamic(fieldData.GetRow(i).([]byte)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (v *RowValidator) checkLength(fieldData storage.FieldData, i int, maxLength int64) error {
+	var values []string
+	switch row := fieldData.GetRow(i).(type) {
+	case string:
+		values = []string{row}
+	case *schemapb.ScalarField:
+		values = row.GetStringData().GetData()
+	}
+	for _, value := range values {
+		if int64(len(value)) > maxLength {
+			return merr.WrapErrImportFailed(fmt.Sprintf("the length (%d) of string exceeds max length (%d), value='%s'",
+				len(value), maxLength, value))
+		}
+	}
+	return nil
+}
+
+func (v *RowValidator) checkDynamic(value []byte) error {
+	if len(value) == 0 {
+		return nil
+	}
+	dynamic := make(map[string]any)
+	if err := json.Unmarshal(value, &dynamic); err != nil {
+		return merr.WrapErrImportFailed(fmt.Sprintf("the value of the dynamic field is not a JSON object, error: %v", err))
+	}
+	if _, ok := dynamic[common.MetaFieldName]; ok {
+		return merr.WrapErrImportFailed(fmt.Sprintf("cannot set json key to: %s", common.MetaFieldName))
+	}
+	return nil
+}
+
+func getArrayLength(array *schemapb.ScalarField) int {
+	switch array.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return len(array.GetBoolData().GetData())
+	case *schemapb.ScalarField_IntData:
+		return len(array.GetIntData().GetData())
+	case *schemapb.ScalarField_LongData:
+		return len(array.GetLongData().GetData())
+	case *schemapb.ScalarField_FloatData:
+		return len(array.GetFloatData().GetData())
+	case *schemapb.ScalarField_DoubleData:
+		return len(array.GetDoubleData().GetData())
+	case *schemapb.ScalarField_StringData:
+		return len(array.GetStringData().GetData())
+	}
+	return 0
+}
@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	importcommon "github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func Test_RowValidator(t *testing.T) {
+	paramtable.Init()
+	schema := &schemapb.CollectionSchema{
+		EnableDynamicField: true,
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_VarChar,
+				TypeParams:   []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "4"}},
+			},
+			{
+				FieldID:     101,
+				Name:        "array",
+				DataType:    schemapb.DataType_Array,
+				ElementType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.MaxLengthKey, Value: "2"},
+					{Key: common.MaxCapacityKey, Value: "2"},
+				},
+			},
+			{
+				FieldID:   102,
+				Name:      "$meta",
+				DataType:  schemapb.DataType_JSON,
+				IsDynamic: true,
+			},
+		},
+	}
+	data, err := storage.NewInsertData(schema)
+	assert.NoError(t, err)
+	appendRow := func(pk string, array []string, dynamic string) {
+		err := data.Append(map[storage.FieldID]any{
+			100: pk,
+			101: &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: array}}},
+			102: []byte(dynamic),
+		})
+		assert.NoError(t, err)
+	}
+	appendRow("a", []string{"a"}, "{}")
+	appendRow("b", []string{"a", "b"}, `{"x": 1}`)
+	appendRow("a", []string{"a"}, "{}")           // duplicated pk
+	appendRow("ccccc", []string{"a"}, "{}")       // pk exceeds max length
+	appendRow("d", []string{"a", "b", "c"}, "{}") // array exceeds max capacity
+	appendRow("e", []string{"abc"}, "{}")         // array element exceeds max length
+	appendRow("f", []string{"a"}, "[1]")          // dynamic field isn't an object
+	appendRow("g", []string{"a"}, `{"$meta": 1}`) // dynamic field contains $meta
+	appendRow("h", []string{"a"}, `{"y": "ok", "z": 2}`)
+
+	rejector := importcommon.NewRejector(-1, 1)
+	validator, err := NewRowValidator(schema, "a.json", rejector)
+	assert.NoError(t, err)
+	rejected, err := validator.Validate(data)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), rejected)
+	assert.Equal(t, int64(6), rejector.RejectedRows())
+
+	// the duplicated primary keys are checked across the batches
+	rejected, err = validator.Validate(data)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), rejected)
+
+	// the hits of the bloom filter which can't be confirmed are not rejected
+	validator, err = NewRowValidator(schema, "a.json", importcommon.NewRejector(-1, 1))
+	assert.NoError(t, err)
+	validator.maxExactPKs = 1
+	rejected, err = validator.Validate(data)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), rejected)
+	rejected, err = validator.Validate(data)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), rejected)
+	assert.Equal(t, int64(2), validator.UnconfirmedDuplicates())
+
+	// the limits are exceeded
+	validator, err = NewRowValidator(schema, "a.json", importcommon.NewRejector(5, 1))
+	assert.NoError(t, err)
+	_, err = validator.Validate(data)
+	assert.Error(t, err)
+}
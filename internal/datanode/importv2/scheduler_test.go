@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/testutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -379,7 +380,7 @@ func (s *SchedulerSuite) TestScheduler_ReadFileStat() {
 	}
 	preimportTask := NewPreImportTask(preimportReq, s.manager, s.cm)
 	s.manager.Add(preimportTask)
-	err = preimportTask.(*PreImportTask).readFileStat(s.reader, 0)
+	err = preimportTask.(*PreImportTask).readFileStat(s.reader, nil, nil, 0)
 	s.NoError(err)
 }
 
@@ -435,6 +436,80 @@ func (s *SchedulerSuite) TestScheduler_ImportFile() {
 	s.NoError(err)
 }
 
+func (s *SchedulerSuite) TestScheduler_ImportFile_Upsert() {
+	var mu sync.Mutex
+	syncedSegments := make([]int64, 0)
+	s.syncMgr.EXPECT().SyncData(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, task syncmgr.Task, callbacks ...func(error) error) *conc.Future[struct{}] {
+		mu.Lock()
+		syncedSegments = append(syncedSegments, task.SegmentID())
+		mu.Unlock()
+		future := conc.Go(func() (struct{}, error) {
+			return struct{}{}, nil
+		})
+		return future
+	})
+	var once sync.Once
+	data, err := testutil.CreateInsertData(s.schema, s.numRows)
+	s.NoError(err)
+	s.reader = importutilv2.NewMockReader(s.T())
+	s.reader.EXPECT().Read().RunAndReturn(func() (*storage.InsertData, error) {
+		var res *storage.InsertData
+		once.Do(func() {
+			res = data
+		})
+		if res != nil {
+			return res, nil
+		}
+		return nil, io.EOF
+	})
+	importReq := &datapb.ImportRequest{
+		JobID:        10,
+		TaskID:       11,
+		CollectionID: 12,
+		PartitionIDs: []int64{13},
+		Vchannels:    []string{"v0"},
+		Schema:       s.schema,
+		Files: []*internalpb.ImportFile{
+			{
+				Paths: []string{"dummy.json"},
+			},
+		},
+		Options: []*commonpb.KeyValuePair{{Key: importutilv2.Upsert, Value: "true"}},
+		Ts:      1000,
+		IDRange: &datapb.IDRange{
+			Begin: 0,
+			End:   int64(s.numRows),
+		},
+		RequestSegments: []*datapb.ImportRequestSegment{
+			{
+				SegmentID:   14,
+				PartitionID: 13,
+				Vchannel:    "v0",
+				Level:       datapb.SegmentLevel_L1,
+			},
+			{
+				SegmentID:   15,
+				PartitionID: 13,
+				Vchannel:    "v0",
+				Level:       datapb.SegmentLevel_L0,
+			},
+		},
+	}
+	importTask := NewImportTask(importReq, s.manager, s.syncMgr, s.cm)
+	s.manager.Add(importTask)
+	err = importTask.(*ImportTask).importFile(s.reader)
+	s.NoError(err)
+	// the rows are imported into the L1 segment, and the deletes are written into the L0 segment
+	s.ElementsMatch([]int64{14, 15}, syncedSegments)
+
+	// the duplicated primary keys across the batches of the file are rejected
+	s.reader = importutilv2.NewMockReader(s.T())
+	s.reader.EXPECT().Read().Return(data, nil)
+	err = importTask.(*ImportTask).importFile(s.reader)
+	s.Error(err)
+	s.True(errors.Is(err, merr.ErrImportFailed))
+}
+
 func TestScheduler(t *testing.T) {
 	suite.Run(t, new(SchedulerSuite))
 }
@@ -110,6 +110,8 @@ func UpdateFileStat(idx int, fileStat *datapb.ImportFileStats) UpdateAction {
 			t.FileStats[idx].TotalRows = fileStat.GetTotalRows()
 			t.FileStats[idx].TotalMemorySize = fileStat.GetTotalMemorySize()
 			t.FileStats[idx].HashedStats = fileStat.GetHashedStats()
+			t.FileStats[idx].RejectedRows = fileStat.GetRejectedRows()
+			t.FileStats[idx].RejectsPath = fileStat.GetRejectsPath()
 		}
 	}
 }
@@ -18,6 +18,7 @@ package importv2
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"math"
 	"time"
@@ -34,8 +35,10 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -137,7 +140,13 @@ func (t *ImportTask) Execute() []*conc.Future[any] {
 	req := t.req
 
 	fn := func(file *internalpb.ImportFile) error {
-		reader, err := importutilv2.NewReader(t.ctx, t.cm, t.GetSchema(), file, req.GetOptions(), bufferSize)
+		// the invalid rows are rejected by the preimport, they are skipped in the same way
+		rejector, err := importutilv2.NewRejector(req.GetOptions())
+		if err != nil {
+			t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Failed), UpdateReason(err.Error()))
+			return err
+		}
+		reader, err := importutilv2.NewReader(t.ctx, t.cm, t.GetSchema(), file, req.GetOptions(), bufferSize, rejector)
 		if err != nil {
 			log.Warn("new reader failed", WrapLogFields(t, zap.String("file", file.String()), zap.Error(err))...)
 			t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Failed), UpdateReason(err.Error()))
@@ -171,6 +180,8 @@ func (t *ImportTask) Execute() []*conc.Future[any] {
 func (t *ImportTask) importFile(reader importutilv2.Reader) error {
 	syncFutures := make([]*conc.Future[struct{}], 0)
 	syncTasks := make([]syncmgr.Task, 0)
+	// the primary keys imported by the upsert, which must be unique in the file
+	upsertPKs := typeutil.NewSet[any]()
 	for {
 		data, err := reader.Read()
 		if err != nil {
@@ -183,6 +194,14 @@ func (t *ImportTask) importFile(reader importutilv2.Reader) error {
 		if err != nil {
 			return err
 		}
+		if importutilv2.IsUpsert(t.req.GetOptions()) {
+			fs, sts, err := t.syncUpsertDelete(data, upsertPKs)
+			if err != nil {
+				return err
+			}
+			syncFutures = append(syncFutures, fs...)
+			syncTasks = append(syncTasks, sts...)
+		}
 		hashedData, err := HashData(t, data)
 		if err != nil {
 			return err
@@ -220,7 +239,7 @@ func (t *ImportTask) sync(hashedData HashedData) ([]*conc.Future[struct{}], []sy
 				continue
 			}
 			partitionID := t.GetPartitionIDs()[partitionIdx]
-			segmentID := PickSegment(t.req.GetRequestSegments(), channel, partitionID)
+			segmentID := PickSegment(t.getSegments(false), channel, partitionID)
 			syncTask, err := NewSyncTask(t.ctx, t.allocator, t.metaCaches, t.req.GetTs(),
 				segmentID, partitionID, t.GetCollectionID(), channel, data, nil)
 			if err != nil {
@@ -233,3 +252,72 @@ func (t *ImportTask) sync(hashedData HashedData) ([]*conc.Future[struct{}], []sy
 	}
 	return futures, syncTasks, nil
 }
+
+// syncUpsertDelete deletes the imported primary keys at the import timestamp by the L0 segments,
+// so the existing rows with the same primary keys are superseded, while the imported rows,
+// whose timestamps are the same as the deletes, are kept. The primary keys must be unique in the file,
+// upsertPKs holds the primary keys of the file which have been imported.
+func (t *ImportTask) syncUpsertDelete(data *storage.InsertData, upsertPKs typeutil.Set[any]) ([]*conc.Future[struct{}], []syncmgr.Task, error) {
+	pkField, err := typeutil.GetPrimaryFieldSchema(t.GetSchema())
+	if err != nil {
+		return nil, nil, err
+	}
+	if pkField.GetAutoID() {
+		return nil, nil, merr.WrapErrImportFailed("upsert import is not supported for the collection with autoID")
+	}
+	pkData, ok := data.Data[pkField.GetFieldID()]
+	if !ok {
+		return nil, nil, merr.WrapErrImportFailed("no primary key data for upsert import")
+	}
+	delData := storage.NewDeleteData(nil, nil)
+	for i := 0; i < pkData.RowNum(); i++ {
+		// The rows of an upsert share the import timestamp, so the rows with the same
+		// primary key would all be kept, which breaks the uniqueness of the primary key.
+		if upsertPKs.Contain(pkData.GetRow(i)) {
+			return nil, nil, merr.WrapErrImportFailed(fmt.Sprintf("duplicated primary key '%v' in the upsert import", pkData.GetRow(i)))
+		}
+		upsertPKs.Insert(pkData.GetRow(i))
+		pk, err := storage.GenPrimaryKeyByRawData(pkData.GetRow(i), pkField.GetDataType())
+		if err != nil {
+			return nil, nil, err
+		}
+		delData.Append(pk, t.req.GetTs())
+	}
+	hashedDelData, err := HashDeleteData(t, delData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Same as the upsert, the deletes of the partition-key collection take effect
+	// on all the partitions, since the partition keys of the rows may be changed.
+	partitionID := t.GetPartitionIDs()[0]
+	if typeutil.HasPartitionKey(t.GetSchema()) {
+		partitionID = common.AllPartitionsID
+	}
+	futures := make([]*conc.Future[struct{}], 0)
+	syncTasks := make([]syncmgr.Task, 0)
+	for channelIdx, delData := range hashedDelData {
+		if delData.RowCount == 0 {
+			continue
+		}
+		channel := t.GetVchannels()[channelIdx]
+		segmentID := PickSegment(t.getSegments(true), channel, partitionID)
+		syncTask, err := NewSyncTask(t.ctx, t.allocator, t.metaCaches, t.req.GetTs(),
+			segmentID, partitionID, t.GetCollectionID(), channel, nil, delData)
+		if err != nil {
+			return nil, nil, err
+		}
+		future := t.syncMgr.SyncData(t.ctx, syncTask)
+		futures = append(futures, future)
+		syncTasks = append(syncTasks, syncTask)
+	}
+	return futures, syncTasks, nil
+}
+
+// getSegments returns the L0 segments for the deletes of the upsert import if l0 is true,
+// otherwise returns the segments for the imported rows.
+func (t *ImportTask) getSegments(l0 bool) []*datapb.ImportRequestSegment {
+	return lo.Filter(t.req.GetRequestSegments(), func(segment *datapb.ImportRequestSegment, _ int) bool {
+		return (segment.GetLevel() == datapb.SegmentLevel_L0) == l0
+	})
+}
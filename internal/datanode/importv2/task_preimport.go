@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"path"
+	"strconv"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -32,8 +34,11 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	importcommon "github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -130,7 +135,25 @@ func (t *PreImportTask) Execute() []*conc.Future[any] {
 		})
 
 	fn := func(i int, file *internalpb.ImportFile) error {
-		reader, err := importutilv2.NewReader(t.ctx, t.cm, t.GetSchema(), file, t.options, bufferSize)
+		var (
+			rejector  *importcommon.Rejector
+			validator *RowValidator
+			err       error
+		)
+		if importutilv2.IsDryRun(t.options) {
+			// The dry run reports all the invalid rows, whether the limits
+			// of the invalid rows are exceeded is decided by the datacoord.
+			rejector = importcommon.NewRejector(-1, 1)
+			validator, err = NewRowValidator(t.GetSchema(), file.GetPaths()[0], rejector)
+		} else {
+			rejector, err = importutilv2.NewRejector(t.options)
+		}
+		if err != nil {
+			t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Failed), UpdateReason(err.Error()))
+			return err
+		}
+		rejector = rejector.WithWriter(t.newRejectsWriter(i))
+		reader, err := importutilv2.NewReader(t.ctx, t.cm, t.GetSchema(), file, t.options, bufferSize, rejector)
 		if err != nil {
 			log.Warn("new reader failed", WrapLogFields(t, zap.String("file", file.String()), zap.Error(err))...)
 			t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Failed), UpdateReason(err.Error()))
@@ -138,7 +161,7 @@ func (t *PreImportTask) Execute() []*conc.Future[any] {
 		}
 		defer reader.Close()
 		start := time.Now()
-		err = t.readFileStat(reader, i)
+		err = t.readFileStat(reader, rejector, validator, i)
 		if err != nil {
 			log.Warn("preimport failed", WrapLogFields(t, zap.String("file", file.String()), zap.Error(err))...)
 			t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Failed), UpdateReason(err.Error()))
@@ -162,7 +185,9 @@ func (t *PreImportTask) Execute() []*conc.Future[any] {
 	return futures
 }
 
-func (t *PreImportTask) readFileStat(reader importutilv2.Reader, fileIdx int) error {
+// readFileStat reads the file and collects the stats of the file, the rows are validated
+// by the validator as well if it isn't nil.
+func (t *PreImportTask) readFileStat(reader importutilv2.Reader, rejector *importcommon.Rejector, validator *RowValidator, fileIdx int) error {
 	fileSize, err := reader.Size()
 	if err != nil {
 		return err
@@ -196,17 +221,62 @@ func (t *PreImportTask) readFileStat(reader importutilv2.Reader, fileIdx int) er
 		MergeHashedStats(rowsCount, hashedStats)
 		rows := data.GetRowNum()
 		size := data.GetMemorySize()
+		if validator != nil {
+			invalidRows, err := validator.Validate(data)
+			if err != nil {
+				return err
+			}
+			rows -= int(invalidRows)
+		}
 		totalRows += rows
 		totalSize += size
 		log.Info("reading file stat...", WrapLogFields(t, zap.Int("readRows", rows), zap.Int("readSize", size))...)
 	}
 
+	if validator == nil {
+		err = rejector.Check(int64(totalRows))
+		if err != nil {
+			return err
+		}
+	} else if unconfirmed := validator.UnconfirmedDuplicates(); unconfirmed > 0 {
+		log.Warn("primary keys may be duplicated but can't be confirmed",
+			WrapLogFields(t, zap.Int64("unconfirmed", unconfirmed))...)
+	}
+
 	stat := &datapb.ImportFileStats{
 		FileSize:        fileSize,
 		TotalRows:       int64(totalRows),
 		TotalMemorySize: int64(totalSize),
 		HashedStats:     hashedStats,
 	}
+	if rejector.RejectedRows() > 0 {
+		err = rejector.Flush()
+		if err != nil {
+			return err
+		}
+		stat.RejectedRows = rejector.RejectedRows()
+		stat.RejectsPath = t.rejectsPath(fileIdx)
+		log.Info("invalid rows are rejected", WrapLogFields(t, zap.Int64("rejectedRows", stat.RejectedRows),
+			zap.String("rejectsPath", stat.RejectsPath))...)
+	}
 	t.manager.Update(t.GetTaskID(), UpdateFileStat(fileIdx, stat))
 	return nil
 }
+
+// rejectsPath returns the directory of the rejects files of the file, the rejected rows are
+// written to the numbered parts under it, one JSON object per line.
+func (t *PreImportTask) rejectsPath(fileIdx int) string {
+	return path.Join(t.cm.RootPath(), common.ImportRejectsPath, strconv.FormatInt(t.GetJobID(), 10),
+		strconv.FormatInt(t.GetTaskID(), 10), strconv.Itoa(fileIdx))
+}
+
+func (t *PreImportTask) newRejectsWriter(fileIdx int) importcommon.RejectsWriter {
+	return func(part int, content []byte) error {
+		partPath := path.Join(t.rejectsPath(fileIdx), fmt.Sprintf("%d.jsonl", part))
+		err := t.cm.Write(t.ctx, partPath, content)
+		if err != nil {
+			return merr.WrapErrImportFailed(fmt.Sprintf("failed to write rejects file, path=%s, err=%v", partPath, err))
+		}
+		return nil
+	}
+}
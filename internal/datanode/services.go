@@ -429,6 +429,19 @@ func (node *DataNode) FlushChannels(ctx context.Context, req *datapb.FlushChanne
 	}
 
 	for _, channel := range req.GetChannels() {
+		// the schema is switched before the flush, the growing segments keep the schema they are written with
+		// and get flushed, the new segments are written with the new schema
+		if req.GetSchema() != nil {
+			ds, ok := node.flowgraphManager.GetFlowgraphService(channel)
+			if !ok {
+				err := merr.WrapErrChannelNotFound(channel)
+				log.Warn("failed to get flow graph service", zap.String("channel", channel), zap.Error(err))
+				return merr.Status(err), nil
+			}
+			ds.GetMetaCache().UpdateSchema(req.GetSchema())
+			log.Info("update the schema of channel", zap.String("channel", channel),
+				zap.Int("fieldNum", len(req.GetSchema().GetFields())))
+		}
 		err := node.writeBufferManager.FlushChannel(ctx, channel, req.GetFlushTs())
 		if err != nil {
 			log.Warn("WriteBufferManager failed to flush channel", zap.String("channel", channel), zap.Error(err))
@@ -763,6 +763,24 @@ func (c *Client) ListImports(ctx context.Context, in *internalpb.ListImportsRequ
 	})
 }
 
+func (c *Client) ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*internalpb.ExportResponse, error) {
+		return client.ExportV2(ctx, in)
+	})
+}
+
+func (c *Client) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*internalpb.GetExportProgressResponse, error) {
+		return client.GetExportProgress(ctx, in)
+	})
+}
+
+func (c *Client) ListExports(ctx context.Context, in *internalpb.ListExportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*internalpb.ListExportsResponse, error) {
+		return client.ListExports(ctx, in)
+	})
+}
+
 func (c *Client) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest, opts ...grpc.CallOption) (*indexpb.ListIndexesResponse, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.ListIndexesResponse, error) {
 		return client.ListIndexes(ctx, in)
@@ -504,6 +504,18 @@ func (s *Server) ListImports(ctx context.Context, in *internalpb.ListImportsRequ
 	return s.dataCoord.ListImports(ctx, in)
 }
 
+func (s *Server) ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error) {
+	return s.dataCoord.ExportV2(ctx, in)
+}
+
+func (s *Server) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	return s.dataCoord.GetExportProgress(ctx, in)
+}
+
+func (s *Server) ListExports(ctx context.Context, in *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error) {
+	return s.dataCoord.ListExports(ctx, in)
+}
+
 func (s *Server) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	return s.dataCoord.ListIndexes(ctx, in)
 }
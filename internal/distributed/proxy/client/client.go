@@ -217,6 +217,54 @@ func (c *Client) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 	})
 }
 
+func (c *Client) ExportV2(ctx context.Context, req *internalpb.ExportRequest, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.ExportResponse, error) {
+		return client.ExportV2(ctx, req)
+	})
+}
+
+func (c *Client) GetExportProgress(ctx context.Context, req *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.GetExportProgressResponse, error) {
+		return client.GetExportProgress(ctx, req)
+	})
+}
+
+func (c *Client) ListExports(ctx context.Context, req *internalpb.ListExportsRequest, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.ListExportsResponse, error) {
+		return client.ListExports(ctx, req)
+	})
+}
+
+func (c *Client) AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.AddCollectionField(ctx, req)
+	})
+}
+
+func (c *Client) DropCollectionField(ctx context.Context, req *proxypb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.DropCollectionField(ctx, req)
+	})
+}
+
+func (c *Client) CreateRowPolicy(ctx context.Context, req *internalpb.CreateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.CreateRowPolicy(ctx, req)
+	})
+}
+
+func (c *Client) DropRowPolicy(ctx context.Context, req *internalpb.DropRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.DropRowPolicy(ctx, req)
+	})
+}
+
+func (c *Client) ListRowPolicies(ctx context.Context, req *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*internalpb.ListRowPoliciesResponse, error) {
+		return client.ListRowPolicies(ctx, req)
+	})
+}
+
 func (c *Client) InvalidateShardLeaderCache(ctx context.Context, req *proxypb.InvalidateShardLeaderCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
 		return client.InvalidateShardLeaderCache(ctx, req)
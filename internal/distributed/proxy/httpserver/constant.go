@@ -17,6 +17,7 @@ const (
 	IndexCategory      = "/indexes/"
 	AliasCategory      = "/aliases/"
 	ImportJobCategory  = "/jobs/import/"
+	ExportJobCategory  = "/jobs/export/"
 
 	ListAction           = "list"
 	HasAction            = "has"
@@ -37,6 +38,11 @@ const (
 	AdvancedSearchAction = "advanced_search"
 	HybridSearchAction   = "hybrid_search"
 
+	SearchCollectionsAction = "search_collections"
+
+	AddFieldAction  = "add_field"
+	DropFieldAction = "drop_field"
+
 	UpdatePasswordAction  = "update_password"
 	GrantRoleAction       = "grant_role"
 	RevokeRoleAction      = "revoke_role"
@@ -44,6 +50,10 @@ const (
 	RevokePrivilegeAction = "revoke_privilege"
 	AlterAction           = "alter"
 	GetProgressAction     = "get_progress"
+
+	CreateRowPolicyAction = "create_row_policy"
+	DropRowPolicyAction   = "drop_row_policy"
+	ListRowPoliciesAction = "list_row_policies"
 )
 
 const (
@@ -121,6 +131,10 @@ const (
 	HTTPReturnGrantor    = "grantor"
 	HTTPReturnDbName     = "dbName"
 
+	HTTPReturnRowPolicyName = "policyName"
+	HTTPReturnActions       = "actions"
+	HTTPReturnExpr          = "expr"
+
 	DefaultMetricType       = metric.COSINE
 	DefaultPrimaryFieldName = "id"
 	DefaultVectorFieldName  = "vector"
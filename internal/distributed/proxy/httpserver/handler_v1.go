@@ -29,19 +29,21 @@ import (
 
 var RestRequestInterceptorErr = errors.New("interceptor error placeholder")
 
-func checkAuthorization(ctx context.Context, c *gin.Context, req interface{}) error {
+// checkAuthorization checks the privileges of the request, the returned context carries the row policies
+// applied to the request, which must be passed to the handler.
+func checkAuthorization(ctx context.Context, c *gin.Context, req interface{}) (context.Context, error) {
 	username, ok := c.Get(ContextUsername)
 	if !ok || username.(string) == "" {
 		HTTPReturn(c, http.StatusUnauthorized, gin.H{HTTPReturnCode: merr.Code(merr.ErrNeedAuthenticate), HTTPReturnMessage: merr.ErrNeedAuthenticate.Error()})
-		return RestRequestInterceptorErr
+		return ctx, RestRequestInterceptorErr
 	}
-	_, authErr := proxy.PrivilegeInterceptor(ctx, req)
+	ctx, authErr := proxy.PrivilegeInterceptor(ctx, req)
 	if authErr != nil {
 		HTTPReturn(c, http.StatusForbidden, gin.H{HTTPReturnCode: merr.Code(authErr), HTTPReturnMessage: authErr.Error()})
-		return RestRequestInterceptorErr
+		return ctx, RestRequestInterceptorErr
 	}
 
-	return nil
+	return ctx, nil
 }
 
 type RestRequestInterceptor func(ctx context.Context, ginCtx *gin.Context, req any, handler func(reqCtx context.Context, req any) (any, error)) (any, error)
@@ -62,7 +64,7 @@ func NewHandlersV1(proxyComponent types.ProxyComponent) *HandlersV1 {
 		h.interceptors = append(h.interceptors,
 			// authorization
 			func(ctx context.Context, ginCtx *gin.Context, req any, handler func(reqCtx context.Context, req any) (any, error)) (any, error) {
-				err := checkAuthorization(ctx, ginCtx, req)
+				ctx, err := checkAuthorization(ctx, ginCtx, req)
 				if err != nil {
 					return nil, err
 				}
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -58,6 +59,8 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(CollectionCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionReq{AutoID: DisableAutoID} }, wrapperTraceLog(h.wrapperCheckDatabase(h.createCollection)))))
 	router.POST(CollectionCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.dropCollection)))))
 	router.POST(CollectionCategory+RenameAction, timeoutMiddleware(wrapperPost(func() any { return &RenameCollectionReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.renameCollection)))))
+	router.POST(CollectionCategory+AddFieldAction, timeoutMiddleware(wrapperPost(func() any { return &AddCollectionFieldReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.addCollectionField)))))
+	router.POST(CollectionCategory+DropFieldAction, timeoutMiddleware(wrapperPost(func() any { return &DropCollectionFieldReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.dropCollectionField)))))
 	router.POST(CollectionCategory+LoadAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.loadCollection)))))
 	router.POST(CollectionCategory+ReleaseAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.releaseCollection)))))
 
@@ -96,6 +99,11 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 			Limit: 100,
 		}
 	}, wrapperTraceLog(h.wrapperCheckDatabase(h.advancedSearch)))))
+	router.POST(EntityCategory+SearchCollectionsAction, timeoutMiddleware(wrapperPost(func() any {
+		return &SearchCollectionsReqV2{
+			Limit: 100,
+		}
+	}, wrapperTraceLog(h.wrapperCheckDatabase(h.searchCollections)))))
 
 	router.POST(PartitionCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.listPartitions)))))
 	router.POST(PartitionCategory+HasAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.hasPartitions)))))
@@ -122,6 +130,9 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(RoleCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &RoleReq{} }, wrapperTraceLog(h.dropRole))))
 	router.POST(RoleCategory+GrantPrivilegeAction, timeoutMiddleware(wrapperPost(func() any { return &GrantReq{} }, wrapperTraceLog(h.addPrivilegeToRole))))
 	router.POST(RoleCategory+RevokePrivilegeAction, timeoutMiddleware(wrapperPost(func() any { return &GrantReq{} }, wrapperTraceLog(h.removePrivilegeFromRole))))
+	router.POST(RoleCategory+CreateRowPolicyAction, timeoutMiddleware(wrapperPost(func() any { return &RowPolicyReq{} }, wrapperTraceLog(h.createRowPolicy))))
+	router.POST(RoleCategory+DropRowPolicyAction, timeoutMiddleware(wrapperPost(func() any { return &RowPolicyReq{} }, wrapperTraceLog(h.dropRowPolicy))))
+	router.POST(RoleCategory+ListRowPoliciesAction, timeoutMiddleware(wrapperPost(func() any { return &ListRowPoliciesReq{} }, wrapperTraceLog(h.listRowPolicies))))
 
 	router.POST(IndexCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.listIndexes)))))
 	router.POST(IndexCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &IndexReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.describeIndex)))))
@@ -140,6 +151,10 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(ImportJobCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &OptionalCollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.listImportJob)))))
 	router.POST(ImportJobCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &ImportReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.createImportJob)))))
 	router.POST(ImportJobCategory+GetProgressAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getImportJobProcess)))))
+	// export
+	router.POST(ExportJobCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &OptionalCollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.listExportJob)))))
+	router.POST(ExportJobCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &ExportReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.createExportJob)))))
+	router.POST(ExportJobCategory+GetProgressAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getExportJobProcess)))))
 }
 
 type (
@@ -227,23 +242,25 @@ func wrapperTraceLog(v2 handlerFuncV2) handlerFuncV2 {
 	}
 }
 
-func checkAuthorizationV2(ctx context.Context, c *gin.Context, ignoreErr bool, req interface{}) error {
+// checkAuthorizationV2 checks the privileges of the request, the returned context carries the row policies
+// applied to the request, which must be passed to the handler.
+func checkAuthorizationV2(ctx context.Context, c *gin.Context, ignoreErr bool, req interface{}) (context.Context, error) {
 	username, ok := c.Get(ContextUsername)
 	if !ok || username.(string) == "" {
 		if !ignoreErr {
 			HTTPReturn(c, http.StatusUnauthorized, gin.H{HTTPReturnCode: merr.Code(merr.ErrNeedAuthenticate), HTTPReturnMessage: merr.ErrNeedAuthenticate.Error()})
 		}
-		return merr.ErrNeedAuthenticate
+		return ctx, merr.ErrNeedAuthenticate
 	}
-	_, authErr := proxy.PrivilegeInterceptor(ctx, req)
+	ctx, authErr := proxy.PrivilegeInterceptor(ctx, req)
 	if authErr != nil {
 		if !ignoreErr {
 			HTTPReturn(c, http.StatusForbidden, gin.H{HTTPReturnCode: merr.Code(authErr), HTTPReturnMessage: authErr.Error()})
 		}
-		return authErr
+		return ctx, authErr
 	}
 
-	return nil
+	return ctx, nil
 }
 
 func wrapperProxy(ctx context.Context, c *gin.Context, req any, checkAuth bool, ignoreErr bool, fullMethod string, handler func(reqCtx context.Context, req any) (any, error)) (interface{}, error) {
@@ -252,7 +269,8 @@ func wrapperProxy(ctx context.Context, c *gin.Context, req any, checkAuth bool,
 		span.AddEvent(baseGetter.GetBase().GetMsgType().String())
 	}
 	if checkAuth {
-		err := checkAuthorizationV2(ctx, c, ignoreErr, req)
+		var err error
+		ctx, err = checkAuthorizationV2(ctx, c, ignoreErr, req)
 		if err != nil {
 			return nil, err
 		}
@@ -536,6 +554,79 @@ func (h *HandlersV2) renameCollection(ctx context.Context, c *gin.Context, anyRe
 	return resp, err
 }
 
+func (h *HandlersV2) addCollectionField(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*AddCollectionFieldReq)
+	fieldDataType, ok := schemapb.DataType_value[httpReq.DataType]
+	if !ok {
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrParameterInvalid),
+			HTTPReturnMessage: merr.ErrParameterInvalid.Error() + ", data type " + httpReq.DataType + " is invalid(case sensitive).",
+		})
+		return nil, merr.ErrParameterInvalid
+	}
+	field := &schemapb.FieldSchema{
+		Name:       httpReq.FieldName,
+		DataType:   schemapb.DataType(fieldDataType),
+		TypeParams: []*commonpb.KeyValuePair{},
+		Nullable:   httpReq.Nullable,
+	}
+	if field.DataType == schemapb.DataType_Array {
+		elementDataType, ok := schemapb.DataType_value[httpReq.ElementDataType]
+		if !ok {
+			HTTPAbortReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrParameterInvalid),
+				HTTPReturnMessage: merr.ErrParameterInvalid.Error() + ", element data type " + httpReq.ElementDataType + " is invalid(case sensitive).",
+			})
+			return nil, merr.ErrParameterInvalid
+		}
+		field.ElementType = schemapb.DataType(elementDataType)
+	}
+	for key, fieldParam := range httpReq.ElementTypeParams {
+		field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: key, Value: fmt.Sprintf("%v", fieldParam)})
+	}
+	if httpReq.DefaultValue != nil {
+		defaultValue, err := convertDefaultValue(httpReq.DefaultValue, field.DataType)
+		if err != nil {
+			HTTPAbortReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(err),
+				HTTPReturnMessage: err.Error(),
+			})
+			return nil, err
+		}
+		field.DefaultValue = defaultValue
+	}
+	req := &proxypb.AddCollectionFieldRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		Field:          field,
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.proxy.Proxy/AddCollectionField", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.AddCollectionField(reqCtx, req.(*proxypb.AddCollectionFieldRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) dropCollectionField(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*DropCollectionFieldReq)
+	req := &proxypb.DropCollectionFieldRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		FieldName:      httpReq.FieldName,
+	}
+	c.Set(ContextRequest, req)
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.proxy.Proxy/DropCollectionField", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DropCollectionField(reqCtx, req.(*proxypb.DropCollectionFieldRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) loadCollection(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	getter, _ := anyReq.(requestutil.CollectionNameGetter)
 	req := &milvuspb.LoadCollectionRequest{
@@ -588,9 +679,22 @@ func (h *HandlersV2) query(ctx context.Context, c *gin.Context, anyReq any, dbNa
 	if httpReq.Offset > 0 {
 		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamOffset, Value: strconv.FormatInt(int64(httpReq.Offset), 10)})
 	}
-	if httpReq.Limit > 0 && !matchCountRule(httpReq.OutputFields) {
+	if httpReq.Limit > 0 && (!matchCountRule(httpReq.OutputFields) || len(httpReq.GroupBy) > 0) {
 		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamLimit, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
 	}
+	if len(httpReq.ExprParams) > 0 {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: proxy.ExprParamsKey, Value: string(httpReq.ExprParams)})
+	}
+	if len(httpReq.OrderBy) > 0 {
+		orderBy := make([]string, 0, len(httpReq.OrderBy))
+		for _, item := range httpReq.OrderBy {
+			orderBy = append(orderBy, item.Field+":"+item.Order)
+		}
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: proxy.OrderByKey, Value: strings.Join(orderBy, ",")})
+	}
+	if len(httpReq.GroupBy) > 0 {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: proxy.GroupByKey, Value: strings.Join(httpReq.GroupBy, ",")})
+	}
 	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.milvus.MilvusService/Query", func(reqCtx context.Context, req any) (interface{}, error) {
 		return h.proxy.Query(reqCtx, req.(*milvuspb.QueryRequest))
 	})
@@ -943,8 +1047,17 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: common.TopKKey, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamOffset, Value: strconv.FormatInt(int64(httpReq.Offset), 10)})
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamGroupByField, Value: httpReq.GroupByField})
+	if httpReq.GroupSize > 0 {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.GroupSizeKey, Value: strconv.FormatInt(int64(httpReq.GroupSize), 10)})
+	}
+	if httpReq.StrictGroupSize {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.StrictGroupSizeKey, Value: "true"})
+	}
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.AnnsFieldKey, Value: httpReq.AnnsField})
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamRoundDecimal, Value: "-1"})
+	if len(httpReq.ExprParams) > 0 {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.ExprParamsKey, Value: string(httpReq.ExprParams)})
+	}
 	body, _ := c.Get(gin.BodyBytesKey)
 	placeholderGroup, err := generatePlaceholderGroup(ctx, string(body.([]byte)), collSchema, httpReq.AnnsField)
 	if err != nil {
@@ -982,6 +1095,84 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 	return resp, err
 }
 
+func (h *HandlersV2) searchCollections(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*SearchCollectionsReqV2)
+	searchReq := &milvuspb.SearchRequest{
+		Dsl:                   httpReq.Filter,
+		DslType:               commonpb.DslType_BoolExprV1,
+		OutputFields:          httpReq.OutputFields,
+		UseDefaultConsistency: true,
+	}
+	req := &proxypb.SearchCollectionsRequest{
+		DbName:          dbName,
+		CollectionNames: httpReq.CollectionNames,
+		Request:         searchReq,
+	}
+	c.Set(ContextRequest, req)
+
+	if len(httpReq.CollectionNames) == 0 {
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrMissingRequiredParameters),
+			HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error() + ", error: collectionNames is empty",
+		})
+		return nil, merr.ErrMissingRequiredParameters
+	}
+	// the vectors are converted by the schema of the first collection, the collections share the searched vector field
+	collSchema, err := h.GetCollectionSchema(ctx, c, dbName, httpReq.CollectionNames[0])
+	if err != nil {
+		return nil, err
+	}
+	searchParams, err := generateSearchParams(ctx, c, httpReq.Params)
+	if err != nil {
+		return nil, err
+	}
+	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: common.TopKKey, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
+	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamOffset, Value: strconv.FormatInt(int64(httpReq.Offset), 10)})
+	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.AnnsFieldKey, Value: httpReq.AnnsField})
+	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamRoundDecimal, Value: "-1"})
+	if len(httpReq.ExprParams) > 0 {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.ExprParamsKey, Value: string(httpReq.ExprParams)})
+	}
+	body, _ := c.Get(gin.BodyBytesKey)
+	placeholderGroup, err := generatePlaceholderGroup(ctx, string(body.([]byte)), collSchema, httpReq.AnnsField)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, search collections with vector invalid", zap.Error(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return nil, err
+	}
+	searchReq.SearchParams = searchParams
+	searchReq.PlaceholderGroup = placeholderGroup
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, "/milvus.proto.proxy.FederatedSearch/SearchCollections", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.SearchCollections(reqCtx, req.(*proxypb.SearchCollectionsRequest))
+	})
+	if err == nil {
+		searchResp := resp.(*proxypb.SearchCollectionsResults)
+		cost := proxy.GetCostValue(searchResp.GetStatus())
+		if searchResp.Results.GetTopK() == int64(0) {
+			HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: []interface{}{}, HTTPReturnCost: cost})
+		} else {
+			allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+			outputData, err := buildQueryResp(0, searchResp.Results.OutputFields, searchResp.Results.FieldsData, searchResp.Results.Ids, searchResp.Results.Scores, allowJS)
+			if err != nil {
+				log.Ctx(ctx).Warn("high level restful api, fail to deal with search collections result", zap.Any("result", searchResp.Results), zap.Error(err))
+				HTTPReturn(c, http.StatusOK, gin.H{
+					HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+					HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+				})
+			} else {
+				for i, row := range outputData {
+					row[HTTPCollectionName] = searchResp.GetCollectionNames()[i]
+				}
+				HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: outputData, HTTPReturnCost: cost})
+			}
+		}
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) advancedSearch(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*HybridSearchReq)
 	req := &milvuspb.HybridSearchRequest{
@@ -1650,6 +1841,99 @@ func (h *HandlersV2) removePrivilegeFromRole(ctx context.Context, c *gin.Context
 	return h.operatePrivilegeToRole(ctx, c, anyReq.(*GrantReq), milvuspb.OperatePrivilegeType_Revoke, dbName)
 }
 
+func (h *HandlersV2) createRowPolicy(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*RowPolicyReq)
+	req := &internalpb.CreateRowPolicyRequest{
+		Policy: &internalpb.RowPolicy{
+			PolicyName:     httpReq.PolicyName,
+			RoleName:       httpReq.RoleName,
+			DbName:         dbName,
+			CollectionName: httpReq.CollectionName,
+			Actions:        httpReq.Actions,
+			Expr:           httpReq.Expr,
+		},
+	}
+	c.Set(ContextRequest, req)
+
+	// the row policies are managed with the privilege of granting privileges
+	if h.checkAuth {
+		_, err := checkAuthorizationV2(ctx, c, false, &milvuspb.OperatePrivilegeRequest{})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.proxy.Proxy/CreateRowPolicy", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.CreateRowPolicy(reqCtx, req.(*internalpb.CreateRowPolicyRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) dropRowPolicy(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*RowPolicyReq)
+	req := &internalpb.DropRowPolicyRequest{
+		PolicyName:     httpReq.PolicyName,
+		RoleName:       httpReq.RoleName,
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+	}
+	c.Set(ContextRequest, req)
+
+	if h.checkAuth {
+		_, err := checkAuthorizationV2(ctx, c, false, &milvuspb.OperatePrivilegeRequest{})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.proxy.Proxy/DropRowPolicy", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DropRowPolicy(reqCtx, req.(*internalpb.DropRowPolicyRequest))
+	})
+	if err == nil {
+		HTTPReturn(c, http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) listRowPolicies(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ListRowPoliciesReq)
+	req := &internalpb.ListRowPoliciesRequest{
+		RoleName:       httpReq.RoleName,
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+	}
+	c.Set(ContextRequest, req)
+
+	// the same as describing the role, the users can list the row policies of their own roles
+	if h.checkAuth {
+		_, err := checkAuthorizationV2(ctx, c, false, &milvuspb.SelectGrantRequest{
+			Entity: &milvuspb.GrantEntity{Role: &milvuspb.RoleEntity{Name: httpReq.RoleName}},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.proxy.Proxy/ListRowPolicies", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ListRowPolicies(reqCtx, req.(*internalpb.ListRowPoliciesRequest))
+	})
+	if err == nil {
+		policies := []map[string]interface{}{}
+		for _, policy := range resp.(*internalpb.ListRowPoliciesResponse).GetPolicies() {
+			policies = append(policies, map[string]interface{}{
+				HTTPReturnRowPolicyName: policy.GetPolicyName(),
+				HTTPRoleName:            policy.GetRoleName(),
+				HTTPReturnDbName:        policy.GetDbName(),
+				HTTPCollectionName:      policy.GetCollectionName(),
+				HTTPReturnActions:       policy.GetActions(),
+				HTTPReturnExpr:          policy.GetExpr(),
+			})
+		}
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: policies})
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) listIndexes(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	collectionGetter, _ := anyReq.(requestutil.CollectionNameGetter)
 	indexNames := []string{}
@@ -1879,7 +2163,7 @@ func (h *HandlersV2) listImportJob(ctx context.Context, c *gin.Context, anyReq a
 	c.Set(ContextRequest, req)
 
 	if h.checkAuth {
-		err := checkAuthorizationV2(ctx, c, false, &milvuspb.ListImportsAuthPlaceholder{
+		_, err := checkAuthorizationV2(ctx, c, false, &milvuspb.ListImportsAuthPlaceholder{
 			DbName:         dbName,
 			CollectionName: collectionName,
 		})
@@ -1931,7 +2215,7 @@ func (h *HandlersV2) createImportJob(ctx context.Context, c *gin.Context, anyReq
 	c.Set(ContextRequest, req)
 
 	if h.checkAuth {
-		err := checkAuthorizationV2(ctx, c, false, &milvuspb.ImportAuthPlaceholder{
+		_, err := checkAuthorizationV2(ctx, c, false, &milvuspb.ImportAuthPlaceholder{
 			DbName:         dbName,
 			CollectionName: collectionGetter.GetCollectionName(),
 			PartitionName:  partitionGetter.GetPartitionName(),
@@ -1960,7 +2244,7 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 	c.Set(ContextRequest, req)
 
 	if h.checkAuth {
-		err := checkAuthorizationV2(ctx, c, false, &milvuspb.GetImportProgressAuthPlaceholder{
+		_, err := checkAuthorizationV2(ctx, c, false, &milvuspb.GetImportProgressAuthPlaceholder{
 			DbName: dbName,
 		})
 		if err != nil {
@@ -1980,6 +2264,8 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 		returnData["progress"] = response.GetProgress()
 		returnData["importedRows"] = response.GetImportedRows()
 		returnData["totalRows"] = response.GetTotalRows()
+		returnData["rejectedRows"] = response.GetRejectedRows()
+		returnData["dryRun"] = response.GetDryRun()
 		reason := response.GetReason()
 		if reason != "" {
 			returnData["reason"] = reason
@@ -1995,6 +2281,10 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 			detail["state"] = taskProgress.GetState()
 			detail["importedRows"] = taskProgress.GetImportedRows()
 			detail["totalRows"] = taskProgress.GetTotalRows()
+			detail["rejectedRows"] = taskProgress.GetRejectedRows()
+			if rejectsPath := taskProgress.GetRejectsPath(); rejectsPath != "" {
+				detail["rejectsPath"] = rejectsPath
+			}
 			reason = taskProgress.GetReason()
 			if reason != "" {
 				detail["reason"] = reason
@@ -2009,6 +2299,122 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 	return resp, err
 }
 
+func (h *HandlersV2) listExportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	var collectionName string
+	if collectionGetter, ok := anyReq.(requestutil.CollectionNameGetter); ok {
+		collectionName = collectionGetter.GetCollectionName()
+	}
+	req := &internalpb.ListExportsRequest{
+		DbName:         dbName,
+		CollectionName: collectionName,
+	}
+	c.Set(ContextRequest, req)
+
+	if h.checkAuth {
+		_, err := checkAuthorizationV2(ctx, c, false, &milvuspb.ShowCollectionsRequest{
+			DbName: dbName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/ListExports", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ListExports(reqCtx, req.(*internalpb.ListExportsRequest))
+	})
+	if err == nil {
+		returnData := make(map[string]interface{})
+		records := make([]map[string]interface{}, 0)
+		response := resp.(*internalpb.ListExportsResponse)
+		for i, jobID := range response.GetJobIDs() {
+			jobDetail := make(map[string]interface{})
+			jobDetail["jobId"] = jobID
+			jobDetail["collectionName"] = response.GetCollectionNames()[i]
+			jobDetail["state"] = response.GetStates()[i].String()
+			jobDetail["progress"] = response.GetProgresses()[i]
+			reason := response.GetReasons()[i]
+			if reason != "" {
+				jobDetail["reason"] = reason
+			}
+			records = append(records, jobDetail)
+		}
+		returnData["records"] = records
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: returnData})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) createExportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ExportReq)
+	req := &internalpb.ExportRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.GetCollectionName(),
+		PartitionName:  httpReq.GetPartitionName(),
+		StartTs:        httpReq.StartTs,
+		EndTs:          httpReq.EndTs,
+		Options:        funcutil.Map2KeyValuePair(httpReq.GetOptions()),
+	}
+	c.Set(ContextRequest, req)
+
+	if h.checkAuth {
+		// exporting reads all the data, so it requires the query privilege of the collection
+		_, err := checkAuthorizationV2(ctx, c, false, &milvuspb.QueryRequest{
+			DbName:         dbName,
+			CollectionName: httpReq.GetCollectionName(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/ExportV2", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ExportV2(reqCtx, req.(*internalpb.ExportRequest))
+	})
+	if err == nil {
+		returnData := make(map[string]interface{})
+		returnData["jobId"] = resp.(*internalpb.ExportResponse).GetJobID()
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: returnData})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) getExportJobProcess(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	jobIDGetter := anyReq.(JobIDGetter)
+	req := &internalpb.GetExportProgressRequest{
+		DbName: dbName,
+		JobID:  jobIDGetter.GetJobID(),
+	}
+	c.Set(ContextRequest, req)
+
+	if h.checkAuth {
+		_, err := checkAuthorizationV2(ctx, c, false, &milvuspb.ShowCollectionsRequest{
+			DbName: dbName,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, "/milvus.proto.milvus.MilvusService/GetExportProgress", func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.GetExportProgress(reqCtx, req.(*internalpb.GetExportProgressRequest))
+	})
+	if err == nil {
+		response := resp.(*internalpb.GetExportProgressResponse)
+		returnData := make(map[string]interface{})
+		returnData["jobId"] = jobIDGetter.GetJobID()
+		returnData["collectionName"] = response.GetCollectionName()
+		returnData["startTime"] = response.GetStartTime()
+		returnData["completeTime"] = response.GetCompleteTime()
+		returnData["state"] = response.GetState().String()
+		returnData["progress"] = response.GetProgress()
+		returnData["exportedRows"] = response.GetExportedRows()
+		returnData["files"] = response.GetFiles()
+		reason := response.GetReason()
+		if reason != "" {
+			returnData["reason"] = reason
+		}
+		HTTPReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(nil), HTTPReturnData: returnData})
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) GetCollectionSchema(ctx context.Context, c *gin.Context, dbName, collectionName string) (*schemapb.CollectionSchema, error) {
 	collSchema, err := proxy.GetCachedCollectionSchema(ctx, dbName, collectionName)
 	if err == nil {
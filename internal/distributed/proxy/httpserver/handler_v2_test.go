@@ -1036,6 +1036,14 @@ func TestMethodPost(t *testing.T) {
 	mp.EXPECT().OperateUserRole(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Twice()
 	mp.EXPECT().CreateRole(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().OperatePrivilege(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Twice()
+	mp.EXPECT().CreateRowPolicy(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().DropRowPolicy(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().ListRowPolicies(mock.Anything, mock.Anything).Return(&internalpb.ListRowPoliciesResponse{
+		Status: commonSuccessStatus,
+		Policies: []*internalpb.RowPolicy{
+			{PolicyName: "p1", RoleName: "role1", DbName: "default", CollectionName: DefaultCollectionName, Expr: "book_id > 0"},
+		},
+	}, nil).Once()
 	mp.EXPECT().CreateIndex(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Twice()
 	mp.EXPECT().CreateIndex(mock.Anything, mock.Anything).Return(commonErrorStatus, nil).Once()
 	mp.EXPECT().CreateAlias(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
@@ -1062,6 +1070,27 @@ func TestMethodPost(t *testing.T) {
 		Reason:   "",
 		Progress: 100,
 	}, nil).Once()
+	mp.EXPECT().ExportV2(mock.Anything, mock.Anything).Return(&internalpb.ExportResponse{
+		Status: commonSuccessStatus, JobID: "1234567890",
+	}, nil).Once()
+	mp.EXPECT().ListExports(mock.Anything, mock.Anything).Return(&internalpb.ListExportsResponse{
+		Status: &StatusSuccess,
+		JobIDs: []string{"1", "2"},
+		States: []internalpb.ExportJobState{
+			internalpb.ExportJobState_Exporting,
+			internalpb.ExportJobState_ExportFailed,
+		},
+		Reasons:         []string{"", "mock reason"},
+		Progresses:      []int64{50, 0},
+		CollectionNames: []string{"AAA", "BBB"},
+	}, nil).Once()
+	mp.EXPECT().GetExportProgress(mock.Anything, mock.Anything).Return(&internalpb.GetExportProgressResponse{
+		Status:       &StatusSuccess,
+		State:        internalpb.ExportJobState_ExportCompleted,
+		Progress:     100,
+		ExportedRows: 10,
+		Files:        []string{"export/1234567890/1_2.parquet"},
+	}, nil).Once()
 	testEngine := initHTTPServerV2(mp, false)
 	queryTestCases := []rawTestCase{}
 	queryTestCases = append(queryTestCases, rawTestCase{
@@ -1106,6 +1135,15 @@ func TestMethodPost(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(RoleCategory, RevokePrivilegeAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(RoleCategory, CreateRowPolicyAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(RoleCategory, DropRowPolicyAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(RoleCategory, ListRowPoliciesAction),
+	})
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(IndexCategory, CreateAction),
 	})
@@ -1129,6 +1167,15 @@ func TestMethodPost(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(ImportJobCategory, GetProgressAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ExportJobCategory, CreateAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ExportJobCategory, ListAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ExportJobCategory, GetProgressAction),
+	})
 
 	for _, testcase := range queryTestCases {
 		t.Run(testcase.path, func(t *testing.T) {
@@ -1140,6 +1187,7 @@ func TestMethodPost(t *testing.T) {
 				`"userName": "` + util.UserRoot + `", "password": "Milvus", "newPassword": "milvus", "roleName": "` + util.RoleAdmin + `",` +
 				`"roleName": "` + util.RoleAdmin + `", "objectType": "Global", "objectName": "*", "privilege": "*",` +
 				`"aliasName": "` + DefaultAliasName + `",` +
+				`"policyName": "p1", "expr": "book_id > 0",` +
 				`"jobId": "1234567890",` +
 				`"files": [["book.json"]]` +
 				`}`))
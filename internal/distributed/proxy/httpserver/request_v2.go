@@ -1,6 +1,7 @@
 package httpserver
 
 import (
+	"encoding/json"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -55,6 +56,27 @@ type RenameCollectionReq struct {
 
 func (req *RenameCollectionReq) GetDbName() string { return req.DbName }
 
+type AddCollectionFieldReq struct {
+	DbName            string                 `json:"dbName"`
+	CollectionName    string                 `json:"collectionName" binding:"required"`
+	FieldName         string                 `json:"fieldName" binding:"required"`
+	DataType          string                 `json:"dataType" binding:"required"`
+	ElementDataType   string                 `json:"elementDataType"`
+	ElementTypeParams map[string]interface{} `json:"elementTypeParams"`
+	Nullable          bool                   `json:"nullable"`
+	DefaultValue      interface{}            `json:"defaultValue"`
+}
+
+func (req *AddCollectionFieldReq) GetDbName() string { return req.DbName }
+
+type DropCollectionFieldReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" binding:"required"`
+	FieldName      string `json:"fieldName" binding:"required"`
+}
+
+func (req *DropCollectionFieldReq) GetDbName() string { return req.DbName }
+
 type PartitionReq struct {
 	// CollectionNameReq
 	DbName         string `json:"dbName"`
@@ -94,6 +116,31 @@ func (req *ImportReq) GetOptions() map[string]string {
 	return req.Options
 }
 
+type ExportReq struct {
+	DbName         string            `json:"dbName"`
+	CollectionName string            `json:"collectionName" binding:"required"`
+	PartitionName  string            `json:"partitionName"`
+	StartTs        uint64            `json:"startTs"`
+	EndTs          uint64            `json:"endTs"`
+	Options        map[string]string `json:"options"`
+}
+
+func (req *ExportReq) GetDbName() string {
+	return req.DbName
+}
+
+func (req *ExportReq) GetCollectionName() string {
+	return req.CollectionName
+}
+
+func (req *ExportReq) GetPartitionName() string {
+	return req.PartitionName
+}
+
+func (req *ExportReq) GetOptions() map[string]string {
+	return req.Options
+}
+
 type JobIDReq struct {
 	JobID string `json:"jobId" binding:"required"`
 }
@@ -101,17 +148,25 @@ type JobIDReq struct {
 func (req *JobIDReq) GetJobID() string { return req.JobID }
 
 type QueryReqV2 struct {
-	DbName         string   `json:"dbName"`
-	CollectionName string   `json:"collectionName" binding:"required"`
-	PartitionNames []string `json:"partitionNames"`
-	OutputFields   []string `json:"outputFields"`
-	Filter         string   `json:"filter"`
-	Limit          int32    `json:"limit"`
-	Offset         int32    `json:"offset"`
+	DbName         string          `json:"dbName"`
+	CollectionName string          `json:"collectionName" binding:"required"`
+	PartitionNames []string        `json:"partitionNames"`
+	OutputFields   []string        `json:"outputFields"`
+	Filter         string          `json:"filter"`
+	ExprParams     json.RawMessage `json:"exprParams"`
+	OrderBy        []OrderByReq    `json:"orderBy"`
+	GroupBy        []string        `json:"groupBy"`
+	Limit          int32           `json:"limit"`
+	Offset         int32           `json:"offset"`
 }
 
 func (req *QueryReqV2) GetDbName() string { return req.DbName }
 
+type OrderByReq struct {
+	Field string `json:"field" binding:"required"`
+	Order string `json:"order"`
+}
+
 type CollectionIDReq struct {
 	DbName         string      `json:"dbName"`
 	CollectionName string      `json:"collectionName" binding:"required"`
@@ -142,21 +197,39 @@ type CollectionDataReq struct {
 func (req *CollectionDataReq) GetDbName() string { return req.DbName }
 
 type SearchReqV2 struct {
-	DbName         string             `json:"dbName"`
-	CollectionName string             `json:"collectionName" binding:"required"`
-	Data           []interface{}      `json:"data" binding:"required"`
-	AnnsField      string             `json:"annsField"`
-	PartitionNames []string           `json:"partitionNames"`
-	Filter         string             `json:"filter"`
-	GroupByField   string             `json:"groupingField"`
-	Limit          int32              `json:"limit"`
-	Offset         int32              `json:"offset"`
-	OutputFields   []string           `json:"outputFields"`
-	Params         map[string]float64 `json:"params"`
+	DbName          string             `json:"dbName"`
+	CollectionName  string             `json:"collectionName" binding:"required"`
+	Data            []interface{}      `json:"data" binding:"required"`
+	AnnsField       string             `json:"annsField"`
+	PartitionNames  []string           `json:"partitionNames"`
+	Filter          string             `json:"filter"`
+	ExprParams      json.RawMessage    `json:"exprParams"`
+	GroupByField    string             `json:"groupingField"`
+	GroupSize       int32              `json:"groupSize"`
+	StrictGroupSize bool               `json:"strictGroupSize"`
+	Limit           int32              `json:"limit"`
+	Offset          int32              `json:"offset"`
+	OutputFields    []string           `json:"outputFields"`
+	Params          map[string]float64 `json:"params"`
 }
 
 func (req *SearchReqV2) GetDbName() string { return req.DbName }
 
+type SearchCollectionsReqV2 struct {
+	DbName          string             `json:"dbName"`
+	CollectionNames []string           `json:"collectionNames" binding:"required"`
+	Data            []interface{}      `json:"data" binding:"required"`
+	AnnsField       string             `json:"annsField"`
+	Filter          string             `json:"filter"`
+	ExprParams      json.RawMessage    `json:"exprParams"`
+	Limit           int32              `json:"limit"`
+	Offset          int32              `json:"offset"`
+	OutputFields    []string           `json:"outputFields"`
+	Params          map[string]float64 `json:"params"`
+}
+
+func (req *SearchCollectionsReqV2) GetDbName() string { return req.DbName }
+
 type Rand struct {
 	Strategy string                 `json:"strategy"`
 	Params   map[string]interface{} `json:"params"`
@@ -268,6 +341,25 @@ type GrantReq struct {
 
 func (req *GrantReq) GetDbName() string { return req.DbName }
 
+type RowPolicyReq struct {
+	DbName         string   `json:"dbName"`
+	RoleName       string   `json:"roleName" binding:"required"`
+	CollectionName string   `json:"collectionName" binding:"required"`
+	PolicyName     string   `json:"policyName" binding:"required"`
+	Actions        []string `json:"actions"`
+	Expr           string   `json:"expr"`
+}
+
+func (req *RowPolicyReq) GetDbName() string { return req.DbName }
+
+type ListRowPoliciesReq struct {
+	DbName         string `json:"dbName"`
+	RoleName       string `json:"roleName"`
+	CollectionName string `json:"collectionName"`
+}
+
+func (req *ListRowPoliciesReq) GetDbName() string { return req.DbName }
+
 type IndexParam struct {
 	FieldName  string                 `json:"fieldName" binding:"required"`
 	IndexName  string                 `json:"indexName" binding:"required"`
@@ -515,6 +515,46 @@ func containsString(arr []string, s string) bool {
 	return false
 }
 
+// convertDefaultValue converts the default value of a field decoded from json to the value field of the data type.
+func convertDefaultValue(value interface{}, dataType schemapb.DataType) (*schemapb.ValueField, error) {
+	var err error
+	defaultValue := &schemapb.ValueField{}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		var v bool
+		v, err = cast.ToBoolE(value)
+		defaultValue.Data = &schemapb.ValueField_BoolData{BoolData: v}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		var v int32
+		v, err = cast.ToInt32E(value)
+		defaultValue.Data = &schemapb.ValueField_IntData{IntData: v}
+	case schemapb.DataType_Int64:
+		var v int64
+		v, err = cast.ToInt64E(value)
+		defaultValue.Data = &schemapb.ValueField_LongData{LongData: v}
+	case schemapb.DataType_Float:
+		var v float32
+		v, err = cast.ToFloat32E(value)
+		defaultValue.Data = &schemapb.ValueField_FloatData{FloatData: v}
+	case schemapb.DataType_Double:
+		var v float64
+		v, err = cast.ToFloat64E(value)
+		defaultValue.Data = &schemapb.ValueField_DoubleData{DoubleData: v}
+	case schemapb.DataType_VarChar:
+		v, ok := value.(string)
+		if !ok {
+			err = fmt.Errorf("%v is not a string", value)
+		}
+		defaultValue.Data = &schemapb.ValueField_StringData{StringData: v}
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("default value of data type %s is not supported", dataType.String())
+	}
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid default value %v of data type %s: %s", value, dataType.String(), err.Error())
+	}
+	return defaultValue, nil
+}
+
 func getDim(field *schemapb.FieldSchema) (int64, error) {
 	dimensionInSchema, err := funcutil.GetAttrByKeyFromRepeatedKV(common.DimKey, field.TypeParams)
 	if err != nil {
@@ -1372,3 +1372,28 @@ func TestBuildQueryResps(t *testing.T) {
 	_, err = buildQueryResp(int64(0), outputFields, newFieldData(generateFieldData(), schemapb.DataType_None), generateIDs(schemapb.DataType_Int64, 3), []float32{0.01, 0.04}, true)
 	assert.Equal(t, nil, err)
 }
+
+func TestConvertDefaultValue(t *testing.T) {
+	value, err := convertDefaultValue(float64(10), schemapb.DataType_Int64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), value.GetLongData())
+
+	value, err = convertDefaultValue(float64(10), schemapb.DataType_Int16)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(10), value.GetIntData())
+
+	value, err = convertDefaultValue("abc", schemapb.DataType_VarChar)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", value.GetStringData())
+
+	value, err = convertDefaultValue(true, schemapb.DataType_Bool)
+	assert.NoError(t, err)
+	assert.True(t, value.GetBoolData())
+
+	_, err = convertDefaultValue(10, schemapb.DataType_VarChar)
+	assert.Error(t, err)
+	_, err = convertDefaultValue("abc", schemapb.DataType_Double)
+	assert.Error(t, err)
+	_, err = convertDefaultValue("{}", schemapb.DataType_JSON)
+	assert.Error(t, err)
+}
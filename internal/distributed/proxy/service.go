@@ -367,6 +367,7 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	}
 
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
+	proxypb.RegisterFederatedSearchServer(s.grpcExternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcExternalServer, s)
 	errChan <- nil
 
@@ -1255,10 +1256,46 @@ func (s *Server) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 	return s.proxy.ListImports(ctx, req)
 }
 
+func (s *Server) ExportV2(ctx context.Context, req *internalpb.ExportRequest) (*internalpb.ExportResponse, error) {
+	return s.proxy.ExportV2(ctx, req)
+}
+
+func (s *Server) GetExportProgress(ctx context.Context, req *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	return s.proxy.GetExportProgress(ctx, req)
+}
+
+func (s *Server) ListExports(ctx context.Context, req *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error) {
+	return s.proxy.ListExports(ctx, req)
+}
+
 func (s *Server) AlterDatabase(ctx context.Context, req *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
 	return s.proxy.AlterDatabase(ctx, req)
 }
 
+func (s *Server) SearchCollections(ctx context.Context, req *proxypb.SearchCollectionsRequest) (*proxypb.SearchCollectionsResults, error) {
+	return s.proxy.SearchCollections(ctx, req)
+}
+
+func (s *Server) AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddCollectionField(ctx, req)
+}
+
+func (s *Server) DropCollectionField(ctx context.Context, req *proxypb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.DropCollectionField(ctx, req)
+}
+
+func (s *Server) CreateRowPolicy(ctx context.Context, req *internalpb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateRowPolicy(ctx, req)
+}
+
+func (s *Server) DropRowPolicy(ctx context.Context, req *internalpb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRowPolicy(ctx, req)
+}
+
+func (s *Server) ListRowPolicies(ctx context.Context, req *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	return s.proxy.ListRowPolicies(ctx, req)
+}
+
 func (s *Server) InvalidateShardLeaderCache(ctx context.Context, req *proxypb.InvalidateShardLeaderCacheRequest) (*commonpb.Status, error) {
 	return s.proxy.InvalidateShardLeaderCache(ctx, req)
 }
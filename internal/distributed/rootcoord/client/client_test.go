@@ -220,6 +220,18 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ListPolicy(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.CreateRowPolicy(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DropRowPolicy(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListRowPolicies(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.ShowConfigurations(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -438,6 +450,18 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ListPolicy(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.CreateRowPolicy(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DropRowPolicy(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListRowPolicies(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.CheckHealth(shortCtx, nil)
 		retCheck(rTimeout, err)
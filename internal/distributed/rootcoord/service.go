@@ -526,10 +526,30 @@ func (s *Server) ListPolicy(ctx context.Context, request *internalpb.ListPolicyR
 	return s.rootCoord.ListPolicy(ctx, request)
 }
 
+func (s *Server) CreateRowPolicy(ctx context.Context, request *internalpb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateRowPolicy(ctx, request)
+}
+
+func (s *Server) DropRowPolicy(ctx context.Context, request *internalpb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropRowPolicy(ctx, request)
+}
+
+func (s *Server) ListRowPolicies(ctx context.Context, request *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	return s.rootCoord.ListRowPolicies(ctx, request)
+}
+
 func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.AlterCollection(ctx, request)
 }
 
+func (s *Server) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddCollectionField(ctx, request)
+}
+
+func (s *Server) DropCollectionField(ctx context.Context, request *proxypb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropCollectionField(ctx, request)
+}
+
 func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.RenameCollection(ctx, request)
 }
@@ -21,12 +21,14 @@ import (
 
 	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 //go:generate mockery --name=MetaCache --structname=MockMetaCache --output=./  --filename=mock_meta_cache.go --with-expecter --inpackage
@@ -35,6 +37,9 @@ type MetaCache interface {
 	Collection() int64
 	// Schema returns collection schema.
 	Schema() *schemapb.CollectionSchema
+	// UpdateSchema updates the collection schema, which applies to the segments added afterwards,
+	// the existing segments keep the schema they are written with.
+	UpdateSchema(schema *schemapb.CollectionSchema)
 	// AddSegment adds a segment from segment info.
 	AddSegment(segInfo *datapb.SegmentInfo, factory PkStatsFactory, actions ...SegmentAction)
 	// UpdateSegments applies action to segment(s) satisfy the provided filters.
@@ -102,10 +107,33 @@ func (c *metaCacheImpl) init(vchannel *datapb.VchannelInfo, factory PkStatsFacto
 	for _, seg := range vchannel.UnflushedSegments {
 		// segment state could be sealed for growing segment if flush request processed before datanode watch
 		seg.State = commonpb.SegmentState_Growing
-		c.addSegment(NewSegmentInfo(seg, factory(seg)))
+		segment := NewSegmentInfo(seg, factory(seg))
+		segment.schema = recoveredSegmentSchema(c.schema, seg)
+		c.addSegment(segment)
 	}
 }
 
+// recoveredSegmentSchema returns the schema of the unflushed segment recovered from datacoord, the fields
+// added to the collection after the segment has synced binlogs are excluded, so that the segment keeps
+// the fields it is written with.
+func recoveredSegmentSchema(schema *schemapb.CollectionSchema, segment *datapb.SegmentInfo) *schemapb.CollectionSchema {
+	if len(segment.GetBinlogs()) == 0 {
+		return schema
+	}
+	fieldIDs := typeutil.NewSet(lo.Map(segment.GetBinlogs(), func(binlog *datapb.FieldBinlog, _ int) int64 {
+		return binlog.GetFieldID()
+	})...)
+	fields := lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return fieldIDs.Contain(field.GetFieldID())
+	})
+	if len(fields) == len(schema.GetFields()) {
+		return schema
+	}
+	segmentSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+	segmentSchema.Fields = fields
+	return segmentSchema
+}
+
 // Collection returns collection id of metacache.
 func (c *metaCacheImpl) Collection() int64 {
 	return c.collectionID
@@ -113,9 +141,20 @@ func (c *metaCacheImpl) Collection() int64 {
 
 // Schema returns collection schema.
 func (c *metaCacheImpl) Schema() *schemapb.CollectionSchema {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	return c.schema
 }
 
+// UpdateSchema updates the collection schema, the existing segments keep the schema they are written with.
+func (c *metaCacheImpl) UpdateSchema(schema *schemapb.CollectionSchema) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.schema = schema
+}
+
 // AddSegment adds a segment from segment info.
 func (c *metaCacheImpl) AddSegment(segInfo *datapb.SegmentInfo, factory PkStatsFactory, actions ...SegmentAction) {
 	segment := NewSegmentInfo(segInfo, factory(segInfo))
@@ -130,6 +169,9 @@ func (c *metaCacheImpl) AddSegment(segInfo *datapb.SegmentInfo, factory PkStatsF
 }
 
 func (c *metaCacheImpl) addSegment(segment *SegmentInfo) {
+	if segment.schema == nil {
+		segment.schema = c.schema
+	}
 	segID := segment.SegmentID()
 	c.segmentInfos[segID] = segment
 	c.stateSegments[segment.State()][segID] = segment
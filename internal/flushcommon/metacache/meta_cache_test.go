@@ -103,6 +103,42 @@ func (s *MetaCacheSuite) TestMetaInfo() {
 	s.Equal(s.collSchema, s.cache.Schema())
 }
 
+func (s *MetaCacheSuite) TestUpdateSchema() {
+	newSchema := &schemapb.CollectionSchema{
+		Name:   s.collSchema.GetName(),
+		Fields: append(s.collSchema.GetFields(), &schemapb.FieldSchema{FieldID: 102, DataType: schemapb.DataType_Int64, Nullable: true}),
+	}
+	s.cache.UpdateSchema(newSchema)
+	s.Equal(newSchema, s.cache.Schema())
+
+	// the existing segments keep the schema they are written with
+	segment, ok := s.cache.GetSegmentByID(s.growingSegments[0])
+	s.Require().True(ok)
+	s.Equal(s.collSchema, segment.Schema())
+
+	s.cache.AddSegment(&datapb.SegmentInfo{ID: 100, PartitionID: 10}, s.bfsFactory)
+	segment, ok = s.cache.GetSegmentByID(100)
+	s.Require().True(ok)
+	s.Equal(newSchema, segment.Schema())
+}
+
+func (s *MetaCacheSuite) TestRecoveredSegmentSchema() {
+	schema := &schemapb.CollectionSchema{
+		Name:   s.collSchema.GetName(),
+		Fields: append(s.collSchema.GetFields(), &schemapb.FieldSchema{FieldID: 102, DataType: schemapb.DataType_Int64, Nullable: true}),
+	}
+
+	// no binlogs synced yet
+	s.Equal(schema, recoveredSegmentSchema(schema, &datapb.SegmentInfo{ID: 1}))
+
+	// the field is added after the segment synced the binlogs
+	segmentSchema := recoveredSegmentSchema(schema, &datapb.SegmentInfo{ID: 1, Binlogs: []*datapb.FieldBinlog{
+		{FieldID: 100}, {FieldID: 101},
+	}})
+	s.Equal([]int64{100, 101}, lo.Map(segmentSchema.GetFields(), func(field *schemapb.FieldSchema, _ int) int64 { return field.GetFieldID() }))
+	s.Len(schema.GetFields(), 3)
+}
+
 func (s *MetaCacheSuite) TestAddSegment() {
 	testSegs := []int64{100, 101, 102}
 	for _, segID := range testSegs {
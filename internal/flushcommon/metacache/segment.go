@@ -19,6 +19,7 @@ package metacache
 import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 )
@@ -36,6 +37,9 @@ type SegmentInfo struct {
 	bfs              *BloomFilterSet
 	level            datapb.SegmentLevel
 	syncingTasks     int32
+	// schema is the schema the segment is written with, all the binlogs of a segment have the same fields
+	// even if the schema of the collection changes after the segment is created
+	schema *schemapb.CollectionSchema
 }
 
 func (s *SegmentInfo) SegmentID() int64 {
@@ -81,6 +85,11 @@ func (s *SegmentInfo) Level() datapb.SegmentLevel {
 	return s.level
 }
 
+// Schema returns the schema the segment is written with.
+func (s *SegmentInfo) Schema() *schemapb.CollectionSchema {
+	return s.schema
+}
+
 func (s *SegmentInfo) Clone() *SegmentInfo {
 	return &SegmentInfo{
 		segmentID:        s.segmentID,
@@ -95,6 +104,7 @@ func (s *SegmentInfo) Clone() *SegmentInfo {
 		bfs:              s.bfs,
 		level:            s.level,
 		syncingTasks:     s.syncingTasks,
+		schema:           s.schema,
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -55,6 +56,8 @@ type SyncPack struct {
 	segmentID    int64
 	channelName  string
 	level        datapb.SegmentLevel
+	// schema is the schema the segment is written with, the schema of the collection is used if it's nil
+	schema *schemapb.CollectionSchema
 }
 
 func (p *SyncPack) WithInsertData(insertData []*storage.InsertData) *SyncPack {
@@ -64,6 +67,11 @@ func (p *SyncPack) WithInsertData(insertData []*storage.InsertData) *SyncPack {
 	return p
 }
 
+func (p *SyncPack) WithSchema(schema *schemapb.CollectionSchema) *SyncPack {
+	p.schema = schema
+	return p
+}
+
 func (p *SyncPack) WithDeleteData(deltaData *storage.DeleteData) *SyncPack {
 	p.deltaData = deltaData
 	return p
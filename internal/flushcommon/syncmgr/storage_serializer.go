@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -100,6 +101,7 @@ func (s *storageV1Serializer) EncodeBuffer(ctx context.Context, pack *SyncPack)
 			return nil, err
 		}
 		task.binlogBlobs = binlogBlobs
+		task.binlogValueRanges = getInt64ValueRanges(pack.insertData)
 
 		singlePKStats, batchStatsBlob, err := s.serializeStatslog(pack)
 		if err != nil {
@@ -150,7 +152,7 @@ func (s *storageV1Serializer) setTaskMeta(task *SyncTask, pack *SyncPack) {
 		WithChannelName(pack.channelName).
 		WithSegmentID(pack.segmentID).
 		WithBatchSize(pack.batchSize).
-		WithSchema(s.metacache.Schema()).
+		WithSchema(s.getSchema(pack)).
 		WithStartPosition(pack.startPosition).
 		WithCheckpoint(pack.checkpoint).
 		WithLevel(pack.level).
@@ -163,9 +165,25 @@ func (s *storageV1Serializer) setTaskMeta(task *SyncTask, pack *SyncPack) {
 		})
 }
 
+// getSchema returns the schema the segment is written with, which differs from the schema
+// of the collection if fields are added or dropped after the segment is created.
+func (s *storageV1Serializer) getSchema(pack *SyncPack) *schemapb.CollectionSchema {
+	if pack.schema != nil {
+		return pack.schema
+	}
+	return s.metacache.Schema()
+}
+
 func (s *storageV1Serializer) serializeBinlog(ctx context.Context, pack *SyncPack) (map[int64]*storage.Blob, error) {
 	log := log.Ctx(ctx)
-	blobs, err := s.inCodec.Serialize(pack.partitionID, pack.segmentID, pack.insertData...)
+	inCodec := s.inCodec
+	if schema := s.getSchema(pack); schema != s.schema {
+		inCodec = storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{
+			Schema: schema,
+			ID:     s.collectionID,
+		})
+	}
+	blobs, err := inCodec.Serialize(pack.partitionID, pack.segmentID, pack.insertData...)
 	if err != nil {
 		return nil, err
 	}
@@ -183,6 +201,37 @@ func (s *storageV1Serializer) serializeBinlog(ctx context.Context, pack *SyncPac
 	return result, nil
 }
 
+// getInt64ValueRanges returns the ranges of the non-null values of the int64 user fields, the fields without
+// non-null values are absent.
+func getInt64ValueRanges(insertData []*storage.InsertData) map[int64]*datapb.Int64ValueRange {
+	ranges := make(map[int64]*datapb.Int64ValueRange)
+	for _, chunk := range insertData {
+		for fieldID, fieldData := range chunk.Data {
+			data, ok := fieldData.(*storage.Int64FieldData)
+			if !ok || fieldID < common.StartOfUserFieldID {
+				continue
+			}
+			for i, v := range data.Data {
+				if len(data.ValidData) > 0 && !data.ValidData[i] {
+					continue
+				}
+				r, ok := ranges[fieldID]
+				if !ok {
+					ranges[fieldID] = &datapb.Int64ValueRange{Min: v, Max: v}
+					continue
+				}
+				if v < r.Min {
+					r.Min = v
+				}
+				if v > r.Max {
+					r.Max = v
+				}
+			}
+		}
+	}
+	return ranges
+}
+
 func (s *storageV1Serializer) serializeStatslog(pack *SyncPack) (*storage.PrimaryKeyStats, *storage.Blob, error) {
 	var rowNum int64
 	var pkFieldData []storage.FieldData
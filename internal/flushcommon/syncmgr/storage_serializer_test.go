@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
@@ -235,6 +236,10 @@ func (s *StorageV1SerializerSuite) TestSerializeInsert() {
 		s.EqualValues(100, taskV1.tsTo)
 		s.Len(taskV1.binlogBlobs, 4)
 		s.NotNil(taskV1.batchStatsBlob)
+		// only the int64 user fields have ranges
+		s.Len(taskV1.binlogValueRanges, 1)
+		s.EqualValues(1, taskV1.binlogValueRanges[100].GetMin())
+		s.EqualValues(10, taskV1.binlogValueRanges[100].GetMax())
 	})
 
 	s.Run("with_flush_segment_not_found", func() {
@@ -324,6 +329,27 @@ func (s *StorageV1SerializerSuite) TestBadSchema() {
 	s.Error(err)
 }
 
+func TestGetInt64ValueRanges(t *testing.T) {
+	ranges := getInt64ValueRanges([]*storage.InsertData{
+		{Data: map[storage.FieldID]storage.FieldData{
+			100: &storage.Int64FieldData{Data: []int64{5, 3, 8}},
+			101: &storage.Int64FieldData{Data: []int64{0, -1, 0}, ValidData: []bool{false, true, false}},
+			102: &storage.Int64FieldData{Data: []int64{0, 0}, ValidData: []bool{false, false}},
+			103: &storage.Int32FieldData{Data: []int32{1, 2}},
+		}},
+		{Data: map[storage.FieldID]storage.FieldData{
+			100: &storage.Int64FieldData{Data: []int64{10, 1}},
+			101: &storage.Int64FieldData{Data: []int64{7, 0}, ValidData: []bool{true, false}},
+		}},
+	})
+	assert.Len(t, ranges, 2)
+	assert.EqualValues(t, 1, ranges[100].GetMin())
+	assert.EqualValues(t, 10, ranges[100].GetMax())
+	// the nulls are skipped
+	assert.EqualValues(t, -1, ranges[101].GetMin())
+	assert.EqualValues(t, 7, ranges[101].GetMax())
+}
+
 func TestStorageV1Serializer(t *testing.T) {
 	suite.Run(t, new(StorageV1SerializerSuite))
 }
@@ -73,12 +73,13 @@ type SyncTask struct {
 	statsBinlogs  map[int64]*datapb.FieldBinlog // map[int64]*datapb.Binlog
 	deltaBinlog   *datapb.FieldBinlog
 
-	binlogBlobs     map[int64]*storage.Blob // fieldID => blob
-	binlogMemsize   map[int64]int64         // memory size
-	batchStatsBlob  *storage.Blob
-	mergedStatsBlob *storage.Blob
-	deltaBlob       *storage.Blob
-	deltaRowCount   int64
+	binlogBlobs       map[int64]*storage.Blob           // fieldID => blob
+	binlogMemsize     map[int64]int64                   // memory size
+	binlogValueRanges map[int64]*datapb.Int64ValueRange // fieldID => range of the int64 field
+	batchStatsBlob    *storage.Blob
+	mergedStatsBlob   *storage.Blob
+	deltaBlob         *storage.Blob
+	deltaRowCount     int64
 
 	// prefetched log ids
 	ids []int64
@@ -236,6 +237,7 @@ func (t *SyncTask) processInsertBlobs() {
 			LogPath:       key,
 			LogSize:       int64(len(blob.GetValue())),
 			MemorySize:    t.binlogMemsize[fieldID],
+			ValueRange:    t.binlogValueRanges[fieldID],
 		})
 	}
 }
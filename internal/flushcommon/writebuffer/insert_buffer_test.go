@@ -6,11 +6,13 @@ import (
 	"time"
 
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -126,9 +128,17 @@ func (s *InsertBufferSuite) TestBasic() {
 	})
 }
 
+func (s *InsertBufferSuite) newMetaCache() metacache.MetaCache {
+	metaCache := metacache.NewMockMetaCache(s.T())
+	metaCache.EXPECT().GetSegmentByID(mock.Anything).Return(nil, false).Maybe()
+	metaCache.EXPECT().Schema().Return(s.collSchema).Maybe()
+	return metaCache
+}
+
 func (s *InsertBufferSuite) TestBuffer() {
 	wb := &writeBufferBase{
 		collSchema: s.collSchema,
+		metaCache:  s.newMetaCache(),
 	}
 	_, insertMsg := s.composeInsertMsg(10, 128)
 
@@ -148,6 +158,7 @@ func (s *InsertBufferSuite) TestBuffer() {
 func (s *InsertBufferSuite) TestYield() {
 	wb := &writeBufferBase{
 		collSchema: s.collSchema,
+		metaCache:  s.newMetaCache(),
 	}
 	insertBuffer, err := NewInsertBuffer(s.collSchema)
 	s.Require().NoError(err)
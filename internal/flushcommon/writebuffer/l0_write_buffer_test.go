@@ -178,7 +178,7 @@ func (s *L0WriteBufferSuite) TestBufferData() {
 
 		seg := metacache.NewSegmentInfo(&datapb.SegmentInfo{ID: 1000}, metacache.NewBloomFilterSet())
 		s.metacache.EXPECT().GetSegmentsBy(mock.Anything, mock.Anything).Return([]*metacache.SegmentInfo{seg})
-		s.metacache.EXPECT().GetSegmentByID(int64(1000)).Return(nil, false).Once()
+		s.metacache.EXPECT().GetSegmentByID(int64(1000)).Return(nil, false).Times(2)
 		s.metacache.EXPECT().AddSegment(mock.Anything, mock.Anything, mock.Anything).Return()
 		s.metacache.EXPECT().UpdateSegments(mock.Anything, mock.Anything).Return()
 
@@ -207,6 +207,7 @@ func (s *L0WriteBufferSuite) TestBufferData() {
 
 		seg := metacache.NewSegmentInfo(&datapb.SegmentInfo{ID: 1000}, metacache.NewBloomFilterSet())
 		s.metacache.EXPECT().GetSegmentsBy(mock.Anything, mock.Anything).Return([]*metacache.SegmentInfo{seg})
+		s.metacache.EXPECT().GetSegmentByID(int64(1000)).Return(nil, false).Maybe()
 		s.metacache.EXPECT().AddSegment(mock.Anything, mock.Anything, mock.Anything).Return()
 		s.metacache.EXPECT().UpdateSegments(mock.Anything, mock.Anything).Return()
 
@@ -366,6 +366,15 @@ func (wb *writeBufferBase) getSegmentsToSync(ts typeutil.Timestamp, policies ...
 	return segments.Collect()
 }
 
+// getSegmentSchema returns the schema the segment is written with, a segment not in the meta cache yet is
+// written with the current schema of the collection.
+func (wb *writeBufferBase) getSegmentSchema(segmentID int64) *schemapb.CollectionSchema {
+	if segment, ok := wb.metaCache.GetSegmentByID(segmentID); ok && segment.Schema() != nil {
+		return segment.Schema()
+	}
+	return wb.metaCache.Schema()
+}
+
 func (wb *writeBufferBase) getOrCreateBuffer(segmentID int64) *segmentBuffer {
 	buffer, ok := wb.buffers[segmentID]
 	if !ok {
@@ -399,6 +408,7 @@ func (wb *writeBufferBase) yieldBuffer(segmentID int64) ([]*storage.InsertData,
 type inData struct {
 	segmentID   int64
 	partitionID int64
+	schema      *schemapb.CollectionSchema
 	data        []*storage.InsertData
 	pkField     []storage.FieldData
 	tsField     []*storage.Int64FieldData
@@ -458,6 +468,7 @@ func (wb *writeBufferBase) prepareInsert(insertMsgs []*msgstream.InsertMsg) ([]*
 		inData := &inData{
 			segmentID:   segment,
 			partitionID: segmentPartition[segment],
+			schema:      wb.getSegmentSchema(segment),
 			data:        make([]*storage.InsertData, 0, len(msgs)),
 			pkField:     make([]storage.FieldData, 0, len(msgs)),
 		}
@@ -469,13 +480,13 @@ func (wb *writeBufferBase) prepareInsert(insertMsgs []*msgstream.InsertMsg) ([]*
 		}
 
 		for _, msg := range msgs {
-			data, err := storage.InsertMsgToInsertData(msg, wb.collSchema)
+			data, err := storage.InsertMsgToInsertData(msg, inData.schema)
 			if err != nil {
 				log.Warn("failed to transfer insert msg to insert data", zap.Error(err))
 				return nil, err
 			}
 
-			pkFieldData, err := storage.GetPkFromInsertData(wb.collSchema, data)
+			pkFieldData, err := storage.GetPkFromInsertData(inData.schema, data)
 			if err != nil {
 				return nil, err
 			}
@@ -537,7 +548,7 @@ func (wb *writeBufferBase) bufferInsert(inData *inData, startPos, endPos *msgpb.
 			State:         commonpb.SegmentState_Growing,
 		}, func(_ *datapb.SegmentInfo) *metacache.BloomFilterSet {
 			return metacache.NewBloomFilterSetWithBatchSize(wb.getEstBatchSize())
-		}, metacache.SetStartPosRecorded(false))
+		}, metacache.MergeSegmentAction(metacache.SetStartPosRecorded(false), metacache.SetSchema(inData.schema)))
 		log.Info("add growing segment", zap.Int64("segmentID", inData.segmentID), zap.String("channel", wb.channelName))
 	}
 
@@ -606,7 +617,8 @@ func (wb *writeBufferBase) getSyncTask(ctx context.Context, segmentID int64) (sy
 		WithTimeRange(tsFrom, tsTo).
 		WithLevel(segmentInfo.Level()).
 		WithCheckpoint(wb.checkpoint).
-		WithBatchSize(batchSize)
+		WithBatchSize(batchSize).
+		WithSchema(segmentInfo.Schema())
 
 	if segmentInfo.State() == commonpb.SegmentState_Flushing ||
 		segmentInfo.Level() == datapb.SegmentLevel_L0 { // Level zero segment will always be sync as flushed
@@ -8,6 +8,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/streaming/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -81,6 +82,12 @@ type RootCoordCatalog interface {
 	// List all user role pair in string for the tenant
 	// For example []string{"user1/role1"}
 	ListUserRole(ctx context.Context, tenant string) ([]string, error)
+	// SaveRowPolicy saves the row policy for the tenant, the policy with the same name of the same role and collection is overwritten.
+	SaveRowPolicy(ctx context.Context, tenant string, policy *internalpb.RowPolicy) error
+	// DropRowPolicy removes the row policy identified by the role name, db name, collection name and policy name.
+	DropRowPolicy(ctx context.Context, tenant string, policy *internalpb.RowPolicy) error
+	// ListRowPolicies lists all the row policies for the tenant.
+	ListRowPolicies(ctx context.Context, tenant string) ([]*internalpb.RowPolicy, error)
 
 	Close()
 }
@@ -149,6 +156,13 @@ type DataCoordCatalog interface {
 	ListImportTasks() ([]*datapb.ImportTaskV2, error)
 	DropImportTask(taskID int64) error
 
+	SaveExportJob(job *datapb.ExportJob) error
+	ListExportJobs() ([]*datapb.ExportJob, error)
+	DropExportJob(jobID int64) error
+	SaveExportTask(task *datapb.ExportTask) error
+	ListExportTasks() ([]*datapb.ExportTask, error)
+	DropExportTask(taskID int64) error
+
 	GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool
 
 	ListCompactionTask(ctx context.Context) ([]*datapb.CompactionTask, error)
@@ -27,6 +27,8 @@ const (
 	ImportJobPrefix                    = MetaPrefix + "/import-job"
 	ImportTaskPrefix                   = MetaPrefix + "/import-task"
 	PreImportTaskPrefix                = MetaPrefix + "/preimport-task"
+	ExportJobPrefix                    = MetaPrefix + "/export-job"
+	ExportTaskPrefix                   = MetaPrefix + "/export-task"
 	CompactionTaskPrefix               = MetaPrefix + "/compaction-task"
 	AnalyzeTaskPrefix                  = MetaPrefix + "/analyze-task"
 	PartitionStatsInfoPrefix           = MetaPrefix + "/partition-stats"
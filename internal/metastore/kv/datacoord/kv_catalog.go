@@ -776,6 +776,69 @@ func (kc *Catalog) DropImportTask(taskID int64) error {
 	return kc.MetaKv.Remove(key)
 }
 
+func (kc *Catalog) SaveExportJob(job *datapb.ExportJob) error {
+	key := buildExportJobKey(job.GetJobID())
+	value, err := proto.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(key, string(value))
+}
+
+func (kc *Catalog) ListExportJobs() ([]*datapb.ExportJob, error) {
+	jobs := make([]*datapb.ExportJob, 0)
+	_, values, err := kc.MetaKv.LoadWithPrefix(ExportJobPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		job := &datapb.ExportJob{}
+		err = proto.Unmarshal([]byte(value), job)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (kc *Catalog) DropExportJob(jobID int64) error {
+	key := buildExportJobKey(jobID)
+	return kc.MetaKv.Remove(key)
+}
+
+func (kc *Catalog) SaveExportTask(task *datapb.ExportTask) error {
+	key := buildExportTaskKey(task.GetTaskID())
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(key, string(value))
+}
+
+func (kc *Catalog) ListExportTasks() ([]*datapb.ExportTask, error) {
+	tasks := make([]*datapb.ExportTask, 0)
+
+	_, values, err := kc.MetaKv.LoadWithPrefix(ExportTaskPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		task := &datapb.ExportTask{}
+		err = proto.Unmarshal([]byte(value), task)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func (kc *Catalog) DropExportTask(taskID int64) error {
+	key := buildExportTaskKey(taskID)
+	return kc.MetaKv.Remove(key)
+}
+
 // GcConfirm returns true if related collection/partition is not found.
 // DataCoord will remove all the meta eventually after GC is finished.
 func (kc *Catalog) GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool {
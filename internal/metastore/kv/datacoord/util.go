@@ -347,6 +347,14 @@ func buildPreImportTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", PreImportTaskPrefix, taskID)
 }
 
+func buildExportJobKey(jobID int64) string {
+	return fmt.Sprintf("%s/%d", ExportJobPrefix, jobID)
+}
+
+func buildExportTaskKey(taskID int64) string {
+	return fmt.Sprintf("%s/%d", ExportTaskPrefix, taskID)
+}
+
 func buildAnalyzeTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", AnalyzeTaskPrefix, taskID)
 }
@@ -481,7 +481,25 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 		return err
 	}
 	saves := map[string]string{newKey: string(value)}
+	// save the fields added to or dropped from the collection
+	oldFields := make(map[int64]*model.Field, len(oldColl.Fields))
+	for _, field := range oldColl.Fields {
+		oldFields[field.FieldID] = field
+	}
+	for _, field := range newColl.Fields {
+		if oldField, ok := oldFields[field.FieldID]; ok && oldField.State == field.State && oldField.Equal(*field) {
+			continue
+		}
+		fieldValue, err := proto.Marshal(model.MarshalFieldModel(field))
+		if err != nil {
+			return err
+		}
+		saves[BuildFieldKey(oldColl.CollectionID, field.FieldID)] = string(fieldValue)
+	}
 	if oldKey == newKey {
+		if len(saves) > 1 {
+			return kc.Snapshot.MultiSave(saves, ts)
+		}
 		return kc.Snapshot.Save(newKey, string(value), ts)
 	}
 	return kc.Snapshot.MultiSaveAndRemove(saves, []string{oldKey}, ts)
@@ -1210,6 +1228,52 @@ func (kc *Catalog) ListUserRole(ctx context.Context, tenant string) ([]string, e
 	return userRoles, nil
 }
 
+func buildRowPolicyKey(tenant string, policy *internalpb.RowPolicy) string {
+	return funcutil.HandleTenantForEtcdKey(RowPolicyPrefix, tenant,
+		fmt.Sprintf("%s/%s/%s/%s", policy.GetRoleName(), policy.GetDbName(), policy.GetCollectionName(), policy.GetPolicyName()))
+}
+
+func (kc *Catalog) SaveRowPolicy(ctx context.Context, tenant string, policy *internalpb.RowPolicy) error {
+	k := buildRowPolicyKey(tenant, policy)
+	v, err := proto.Marshal(policy)
+	if err != nil {
+		log.Warn("fail to marshal the row policy", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	if err = kc.Txn.Save(k, string(v)); err != nil {
+		log.Warn("fail to save the row policy", zap.String("key", k), zap.Error(err))
+	}
+	return err
+}
+
+func (kc *Catalog) DropRowPolicy(ctx context.Context, tenant string, policy *internalpb.RowPolicy) error {
+	k := buildRowPolicyKey(tenant, policy)
+	if err := kc.Txn.Remove(k); err != nil {
+		log.Warn("fail to remove the row policy", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListRowPolicies(ctx context.Context, tenant string) ([]*internalpb.RowPolicy, error) {
+	k := funcutil.HandleTenantForEtcdKey(RowPolicyPrefix, tenant, "")
+	keys, values, err := kc.Txn.LoadWithPrefix(k)
+	if err != nil {
+		log.Error("fail to load the row policies", zap.String("key", k), zap.Error(err))
+		return nil, err
+	}
+	policies := make([]*internalpb.RowPolicy, 0, len(values))
+	for i, value := range values {
+		policy := &internalpb.RowPolicy{}
+		if err := proto.Unmarshal([]byte(value), policy); err != nil {
+			log.Warn("invalid row policy", zap.String("key", keys[i]), zap.Error(err))
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
 func (kc *Catalog) Close() {
 	// do nothing
 }
@@ -1035,6 +1035,40 @@ func TestCatalog_AlterCollection(t *testing.T) {
 		assert.Equal(t, pb.CollectionState_CollectionCreated, got.State)
 	})
 
+	t.Run("modify fields", func(t *testing.T) {
+		var collectionID int64 = 1
+		snapshot := kv.NewMockSnapshotKV()
+		kvs := map[string]string{}
+		snapshot.MultiSaveFunc = func(saves map[string]string, ts typeutil.Timestamp) error {
+			for key, value := range saves {
+				kvs[key] = value
+			}
+			return nil
+		}
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		oldC := &model.Collection{CollectionID: collectionID, State: pb.CollectionState_CollectionCreated, Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "name", DataType: schemapb.DataType_VarChar},
+		}}
+		newC := oldC.Clone()
+		newC.Fields[1].State = schemapb.FieldState_FieldDropped
+		newC.Fields = append(newC.Fields, &model.Field{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64, Nullable: true})
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(kvs))
+		assert.Contains(t, kvs, BuildCollectionKey(0, collectionID))
+		assert.NotContains(t, kvs, BuildFieldKey(collectionID, 100))
+
+		field := &schemapb.FieldSchema{}
+		err = proto.Unmarshal([]byte(kvs[BuildFieldKey(collectionID, 101)]), field)
+		assert.NoError(t, err)
+		assert.Equal(t, schemapb.FieldState_FieldDropped, field.GetState())
+		err = proto.Unmarshal([]byte(kvs[BuildFieldKey(collectionID, 102)]), field)
+		assert.NoError(t, err)
+		assert.Equal(t, "age", field.GetName())
+	})
+
 	t.Run("modify, tenant id changed", func(t *testing.T) {
 		kc := &Catalog{}
 		ctx := context.Background()
@@ -2544,6 +2578,62 @@ func TestRBAC_Grant(t *testing.T) {
 	})
 }
 
+func TestRBAC_RowPolicy(t *testing.T) {
+	var (
+		tenant = "default"
+		ctx    = context.TODO()
+		policy = &internalpb.RowPolicy{
+			PolicyName:     "p1",
+			RoleName:       "role1",
+			DbName:         util.DefaultDBName,
+			CollectionName: "coll",
+			Actions:        []string{"Query"},
+			Expr:           "tenant == 'a'",
+		}
+		key = funcutil.HandleTenantForEtcdKey(RowPolicyPrefix, tenant, "role1/default/coll/p1")
+	)
+
+	t.Run("test SaveRowPolicy", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+		kvmock.EXPECT().Save(key, mock.Anything).Return(nil).Once()
+		assert.NoError(t, c.SaveRowPolicy(ctx, tenant, policy))
+		kvmock.EXPECT().Save(key, mock.Anything).Return(errors.New("mock save error")).Once()
+		assert.Error(t, c.SaveRowPolicy(ctx, tenant, policy))
+	})
+
+	t.Run("test DropRowPolicy", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+		kvmock.EXPECT().Remove(key).Return(nil).Once()
+		assert.NoError(t, c.DropRowPolicy(ctx, tenant, policy))
+		kvmock.EXPECT().Remove(key).Return(errors.New("mock remove error")).Once()
+		assert.Error(t, c.DropRowPolicy(ctx, tenant, policy))
+	})
+
+	t.Run("test ListRowPolicies", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+		value, err := proto.Marshal(policy)
+		require.NoError(t, err)
+		prefix := funcutil.HandleTenantForEtcdKey(RowPolicyPrefix, tenant, "")
+
+		kvmock.EXPECT().LoadWithPrefix(prefix).Return([]string{key}, []string{string(value)}, nil).Once()
+		policies, err := c.ListRowPolicies(ctx, tenant)
+		assert.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.True(t, proto.Equal(policy, policies[0]))
+
+		kvmock.EXPECT().LoadWithPrefix(prefix).Return([]string{key}, []string{"invalid"}, nil).Once()
+		_, err = c.ListRowPolicies(ctx, tenant)
+		assert.Error(t, err)
+
+		kvmock.EXPECT().LoadWithPrefix(prefix).Return(nil, nil, errors.New("mock load error")).Once()
+		_, err = c.ListRowPolicies(ctx, tenant)
+		assert.Error(t, err)
+	})
+}
+
 func TestCatalog_AlterDatabase(t *testing.T) {
 	kvmock := mocks.NewSnapShotKV(t)
 	c := &Catalog{Snapshot: kvmock}
@@ -49,6 +49,9 @@ const (
 
 	// GranteeIDPrefix prefix for mapping among privilege and grantor
 	GranteeIDPrefix = ComponentPrefix + CommonCredentialPrefix + "/grantee-id"
+
+	// RowPolicyPrefix prefix for the row policies of roles
+	RowPolicyPrefix = ComponentPrefix + CommonCredentialPrefix + "/row-policy"
 )
 
 func BuildDatabasePrefixWithDBID(dbID int64) string {
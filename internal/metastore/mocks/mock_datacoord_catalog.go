@@ -563,6 +563,90 @@ func (_c *DataCoordCatalog_DropCurrentPartitionStatsVersion_Call) RunAndReturn(r
 	return _c
 }
 
+// DropExportJob provides a mock function with given fields: jobID
+func (_m *DataCoordCatalog) DropExportJob(jobID int64) error {
+	ret := _m.Called(jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropExportJob'
+type DataCoordCatalog_DropExportJob_Call struct {
+	*mock.Call
+}
+
+// DropExportJob is a helper method to define mock.On call
+//   - jobID int64
+func (_e *DataCoordCatalog_Expecter) DropExportJob(jobID interface{}) *DataCoordCatalog_DropExportJob_Call {
+	return &DataCoordCatalog_DropExportJob_Call{Call: _e.mock.On("DropExportJob", jobID)}
+}
+
+func (_c *DataCoordCatalog_DropExportJob_Call) Run(run func(jobID int64)) *DataCoordCatalog_DropExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropExportJob_Call) Return(_a0 error) *DataCoordCatalog_DropExportJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropExportJob_Call) RunAndReturn(run func(int64) error) *DataCoordCatalog_DropExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropExportTask provides a mock function with given fields: taskID
+func (_m *DataCoordCatalog) DropExportTask(taskID int64) error {
+	ret := _m.Called(taskID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropExportTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropExportTask'
+type DataCoordCatalog_DropExportTask_Call struct {
+	*mock.Call
+}
+
+// DropExportTask is a helper method to define mock.On call
+//   - taskID int64
+func (_e *DataCoordCatalog_Expecter) DropExportTask(taskID interface{}) *DataCoordCatalog_DropExportTask_Call {
+	return &DataCoordCatalog_DropExportTask_Call{Call: _e.mock.On("DropExportTask", taskID)}
+}
+
+func (_c *DataCoordCatalog_DropExportTask_Call) Run(run func(taskID int64)) *DataCoordCatalog_DropExportTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropExportTask_Call) Return(_a0 error) *DataCoordCatalog_DropExportTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropExportTask_Call) RunAndReturn(run func(int64) error) *DataCoordCatalog_DropExportTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropImportJob provides a mock function with given fields: jobID
 func (_m *DataCoordCatalog) DropImportJob(jobID int64) error {
 	ret := _m.Called(jobID)
@@ -1126,6 +1210,112 @@ func (_c *DataCoordCatalog_ListCompactionTask_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ListExportJobs provides a mock function with given fields:
+func (_m *DataCoordCatalog) ListExportJobs() ([]*datapb.ExportJob, error) {
+	ret := _m.Called()
+
+	var r0 []*datapb.ExportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*datapb.ExportJob, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*datapb.ExportJob); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.ExportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListExportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportJobs'
+type DataCoordCatalog_ListExportJobs_Call struct {
+	*mock.Call
+}
+
+// ListExportJobs is a helper method to define mock.On call
+func (_e *DataCoordCatalog_Expecter) ListExportJobs() *DataCoordCatalog_ListExportJobs_Call {
+	return &DataCoordCatalog_ListExportJobs_Call{Call: _e.mock.On("ListExportJobs")}
+}
+
+func (_c *DataCoordCatalog_ListExportJobs_Call) Run(run func()) *DataCoordCatalog_ListExportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListExportJobs_Call) Return(_a0 []*datapb.ExportJob, _a1 error) *DataCoordCatalog_ListExportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListExportJobs_Call) RunAndReturn(run func() ([]*datapb.ExportJob, error)) *DataCoordCatalog_ListExportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExportTasks provides a mock function with given fields:
+func (_m *DataCoordCatalog) ListExportTasks() ([]*datapb.ExportTask, error) {
+	ret := _m.Called()
+
+	var r0 []*datapb.ExportTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*datapb.ExportTask, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*datapb.ExportTask); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.ExportTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListExportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportTasks'
+type DataCoordCatalog_ListExportTasks_Call struct {
+	*mock.Call
+}
+
+// ListExportTasks is a helper method to define mock.On call
+func (_e *DataCoordCatalog_Expecter) ListExportTasks() *DataCoordCatalog_ListExportTasks_Call {
+	return &DataCoordCatalog_ListExportTasks_Call{Call: _e.mock.On("ListExportTasks")}
+}
+
+func (_c *DataCoordCatalog_ListExportTasks_Call) Run(run func()) *DataCoordCatalog_ListExportTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListExportTasks_Call) Return(_a0 []*datapb.ExportTask, _a1 error) *DataCoordCatalog_ListExportTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListExportTasks_Call) RunAndReturn(run func() ([]*datapb.ExportTask, error)) *DataCoordCatalog_ListExportTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImportJobs provides a mock function with given fields:
 func (_m *DataCoordCatalog) ListImportJobs() ([]*datapb.ImportJob, error) {
 	ret := _m.Called()
@@ -1849,6 +2039,90 @@ func (_c *DataCoordCatalog_SaveDroppedSegmentsInBatch_Call) RunAndReturn(run fun
 	return _c
 }
 
+// SaveExportJob provides a mock function with given fields: job
+func (_m *DataCoordCatalog) SaveExportJob(job *datapb.ExportJob) error {
+	ret := _m.Called(job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datapb.ExportJob) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveExportJob'
+type DataCoordCatalog_SaveExportJob_Call struct {
+	*mock.Call
+}
+
+// SaveExportJob is a helper method to define mock.On call
+//   - job *datapb.ExportJob
+func (_e *DataCoordCatalog_Expecter) SaveExportJob(job interface{}) *DataCoordCatalog_SaveExportJob_Call {
+	return &DataCoordCatalog_SaveExportJob_Call{Call: _e.mock.On("SaveExportJob", job)}
+}
+
+func (_c *DataCoordCatalog_SaveExportJob_Call) Run(run func(job *datapb.ExportJob)) *DataCoordCatalog_SaveExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*datapb.ExportJob))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveExportJob_Call) Return(_a0 error) *DataCoordCatalog_SaveExportJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveExportJob_Call) RunAndReturn(run func(*datapb.ExportJob) error) *DataCoordCatalog_SaveExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveExportTask provides a mock function with given fields: task
+func (_m *DataCoordCatalog) SaveExportTask(task *datapb.ExportTask) error {
+	ret := _m.Called(task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datapb.ExportTask) error); ok {
+		r0 = rf(task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveExportTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveExportTask'
+type DataCoordCatalog_SaveExportTask_Call struct {
+	*mock.Call
+}
+
+// SaveExportTask is a helper method to define mock.On call
+//   - task *datapb.ExportTask
+func (_e *DataCoordCatalog_Expecter) SaveExportTask(task interface{}) *DataCoordCatalog_SaveExportTask_Call {
+	return &DataCoordCatalog_SaveExportTask_Call{Call: _e.mock.On("SaveExportTask", task)}
+}
+
+func (_c *DataCoordCatalog_SaveExportTask_Call) Run(run func(task *datapb.ExportTask)) *DataCoordCatalog_SaveExportTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*datapb.ExportTask))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveExportTask_Call) Return(_a0 error) *DataCoordCatalog_SaveExportTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveExportTask_Call) RunAndReturn(run func(*datapb.ExportTask) error) *DataCoordCatalog_SaveExportTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveImportJob provides a mock function with given fields: job
 func (_m *DataCoordCatalog) SaveImportJob(job *datapb.ImportJob) error {
 	ret := _m.Called(job)
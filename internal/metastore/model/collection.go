@@ -64,6 +64,17 @@ func (c *Collection) GetPartitionNum(filterUnavailable bool) int {
 	return lo.CountBy(c.Partitions, func(p *Partition) bool { return p.Available() })
 }
 
+// GetAvailableFields returns the fields of the collection except the dropped ones.
+func (c *Collection) GetAvailableFields() []*Field {
+	var fields []*Field
+	for _, field := range c.Fields {
+		if field.Available() {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
 func (c *Collection) Equal(other Collection) bool {
 	return c.TenantID == other.TenantID &&
 		c.DBID == other.DBID &&
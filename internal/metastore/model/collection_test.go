@@ -119,6 +119,20 @@ func TestCollection_GetPartitionNum(t *testing.T) {
 	assert.Equal(t, 6, coll.GetPartitionNum(false))
 }
 
+func TestCollection_GetAvailableFields(t *testing.T) {
+	coll := &Collection{
+		Fields: []*Field{
+			{FieldID: 100, State: schemapb.FieldState_FieldCreated},
+			{FieldID: 101, State: schemapb.FieldState_FieldDropped},
+			{FieldID: 102, State: schemapb.FieldState_FieldCreated},
+		},
+	}
+	fields := coll.GetAvailableFields()
+	assert.Equal(t, 2, len(fields))
+	assert.Equal(t, int64(100), fields[0].FieldID)
+	assert.Equal(t, int64(102), fields[1].FieldID)
+}
+
 func TestCollection_Equal(t *testing.T) {
 	equal := func(a, b Collection) bool {
 		return a.Equal(b)
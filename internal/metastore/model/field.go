@@ -105,6 +105,7 @@ func MarshalFieldModel(field *Field) *schemapb.FieldSchema {
 		TypeParams:      field.TypeParams,
 		IndexParams:     field.IndexParams,
 		AutoID:          field.AutoID,
+		State:           field.State,
 		IsDynamic:       field.IsDynamic,
 		IsPartitionKey:  field.IsPartitionKey,
 		IsClusteringKey: field.IsClusteringKey,
@@ -140,6 +141,7 @@ func UnmarshalFieldModel(fieldSchema *schemapb.FieldSchema) *Field {
 		TypeParams:      fieldSchema.TypeParams,
 		IndexParams:     fieldSchema.IndexParams,
 		AutoID:          fieldSchema.AutoID,
+		State:           fieldSchema.State,
 		IsDynamic:       fieldSchema.IsDynamic,
 		IsPartitionKey:  fieldSchema.IsPartitionKey,
 		IsClusteringKey: fieldSchema.IsClusteringKey,
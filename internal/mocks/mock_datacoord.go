@@ -533,6 +533,61 @@ func (_c *MockDataCoord_DropVirtualChannel_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ExportV2 provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ExportV2(_a0 context.Context, _a1 *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequestInternal) *internalpb.ExportResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ExportRequestInternal) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockDataCoord_ExportV2_Call struct {
+	*mock.Call
+}
+
+// ExportV2 is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *internalpb.ExportRequestInternal
+func (_e *MockDataCoord_Expecter) ExportV2(_a0 interface{}, _a1 interface{}) *MockDataCoord_ExportV2_Call {
+	return &MockDataCoord_ExportV2_Call{Call: _e.mock.On("ExportV2", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ExportV2_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ExportRequestInternal)) *MockDataCoord_ExportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ExportRequestInternal))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ExportV2_Call) Return(_a0 *internalpb.ExportResponse, _a1 error) *MockDataCoord_ExportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ExportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ExportRequestInternal) (*internalpb.ExportResponse, error)) *MockDataCoord_ExportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) Flush(_a0 context.Context, _a1 *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -973,6 +1028,61 @@ func (_c *MockDataCoord_GetComponentStates_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetExportProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetExportProgress(_a0 context.Context, _a1 *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.GetExportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest) *internalpb.GetExportProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetExportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetExportProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetExportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportProgress'
+type MockDataCoord_GetExportProgress_Call struct {
+	*mock.Call
+}
+
+// GetExportProgress is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *internalpb.GetExportProgressRequest
+func (_e *MockDataCoord_Expecter) GetExportProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetExportProgress_Call {
+	return &MockDataCoord_GetExportProgress_Call{Call: _e.mock.On("GetExportProgress", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetExportProgress_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetExportProgressRequest)) *MockDataCoord_GetExportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetExportProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetExportProgress_Call) Return(_a0 *internalpb.GetExportProgressResponse, _a1 error) *MockDataCoord_GetExportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetExportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)) *MockDataCoord_GetExportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlushAllState provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) GetFlushAllState(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2169,6 +2279,61 @@ func (_c *MockDataCoord_Init_Call) RunAndReturn(run func() error) *MockDataCoord
 	return _c
 }
 
+// ListExports provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListExports(_a0 context.Context, _a1 *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListExportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequestInternal) *internalpb.ListExportsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListExportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListExportsRequestInternal) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ListExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExports'
+type MockDataCoord_ListExports_Call struct {
+	*mock.Call
+}
+
+// ListExports is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 *internalpb.ListExportsRequestInternal
+func (_e *MockDataCoord_Expecter) ListExports(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListExports_Call {
+	return &MockDataCoord_ListExports_Call{Call: _e.mock.On("ListExports", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ListExports_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListExportsRequestInternal)) *MockDataCoord_ListExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListExportsRequestInternal))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ListExports_Call) Return(_a0 *internalpb.ListExportsResponse, _a1 error) *MockDataCoord_ListExports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ListExports_Call) RunAndReturn(run func(context.Context, *internalpb.ListExportsRequestInternal) (*internalpb.ListExportsResponse, error)) *MockDataCoord_ListExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImports provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) ListImports(_a0 context.Context, _a1 *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error) {
 	ret := _m.Called(_a0, _a1)
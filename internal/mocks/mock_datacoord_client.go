@@ -704,6 +704,76 @@ func (_c *MockDataCoordClient_DropVirtualChannel_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ExportV2 provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ExportV2(ctx context.Context, in *internalpb.ExportRequestInternal, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequestInternal, ...grpc.CallOption) (*internalpb.ExportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequestInternal, ...grpc.CallOption) *internalpb.ExportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ExportRequestInternal, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockDataCoordClient_ExportV2_Call struct {
+	*mock.Call
+}
+
+// ExportV2 is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *internalpb.ExportRequestInternal
+//  - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ExportV2(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ExportV2_Call {
+	return &MockDataCoordClient_ExportV2_Call{Call: _e.mock.On("ExportV2",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ExportV2_Call) Run(run func(ctx context.Context, in *internalpb.ExportRequestInternal, opts ...grpc.CallOption)) *MockDataCoordClient_ExportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ExportRequestInternal), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ExportV2_Call) Return(_a0 *internalpb.ExportResponse, _a1 error) *MockDataCoordClient_ExportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ExportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ExportRequestInternal, ...grpc.CallOption) (*internalpb.ExportResponse, error)) *MockDataCoordClient_ExportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) Flush(ctx context.Context, in *datapb.FlushRequest, opts ...grpc.CallOption) (*datapb.FlushResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1264,6 +1334,76 @@ func (_c *MockDataCoordClient_GetComponentStates_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetExportProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.GetExportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) *internalpb.GetExportProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetExportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetExportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportProgress'
+type MockDataCoordClient_GetExportProgress_Call struct {
+	*mock.Call
+}
+
+// GetExportProgress is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *internalpb.GetExportProgressRequest
+//  - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetExportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetExportProgress_Call {
+	return &MockDataCoordClient_GetExportProgress_Call{Call: _e.mock.On("GetExportProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetExportProgress_Call) Run(run func(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetExportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetExportProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetExportProgress_Call) Return(_a0 *internalpb.GetExportProgressResponse, _a1 error) *MockDataCoordClient_GetExportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetExportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error)) *MockDataCoordClient_GetExportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlushAllState provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) GetFlushAllState(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2734,6 +2874,76 @@ func (_c *MockDataCoordClient_ImportV2_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListExports provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListExports(ctx context.Context, in *internalpb.ListExportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ListExportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequestInternal, ...grpc.CallOption) (*internalpb.ListExportsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequestInternal, ...grpc.CallOption) *internalpb.ListExportsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListExportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListExportsRequestInternal, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ListExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExports'
+type MockDataCoordClient_ListExports_Call struct {
+	*mock.Call
+}
+
+// ListExports is a helper method to define mock.On call
+//  - ctx context.Context
+//  - in *internalpb.ListExportsRequestInternal
+//  - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ListExports(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListExports_Call {
+	return &MockDataCoordClient_ListExports_Call{Call: _e.mock.On("ListExports",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ListExports_Call) Run(run func(ctx context.Context, in *internalpb.ListExportsRequestInternal, opts ...grpc.CallOption)) *MockDataCoordClient_ListExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListExportsRequestInternal), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListExports_Call) Return(_a0 *internalpb.ListExportsResponse, _a1 error) *MockDataCoordClient_ListExports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListExports_Call) RunAndReturn(run func(context.Context, *internalpb.ListExportsRequestInternal, ...grpc.CallOption) (*internalpb.ListExportsResponse, error)) *MockDataCoordClient_ListExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImports provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) ListImports(ctx context.Context, in *internalpb.ListImportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -34,6 +34,61 @@ func (_m *MockProxy) EXPECT() *MockProxy_Expecter {
 	return &MockProxy_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AddCollectionField(_a0 context.Context, _a1 *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockProxy_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.AddCollectionFieldRequest
+func (_e *MockProxy_Expecter) AddCollectionField(_a0 interface{}, _a1 interface{}) *MockProxy_AddCollectionField_Call {
+	return &MockProxy_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", _a0, _a1)}
+}
+
+func (_c *MockProxy_AddCollectionField_Call) Run(run func(_a0 context.Context, _a1 *proxypb.AddCollectionFieldRequest)) *MockProxy_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)) *MockProxy_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocTimestamp provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) AllocTimestamp(_a0 context.Context, _a1 *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -914,6 +969,61 @@ func (_c *MockProxy_CreateRole_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// CreateRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateRowPolicy(_a0 context.Context, _a1 *internalpb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateRowPolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateRowPolicyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CreateRowPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreateRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRowPolicy'
+type MockProxy_CreateRowPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateRowPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CreateRowPolicyRequest
+func (_e *MockProxy_Expecter) CreateRowPolicy(_a0 interface{}, _a1 interface{}) *MockProxy_CreateRowPolicy_Call {
+	return &MockProxy_CreateRowPolicy_Call{Call: _e.mock.On("CreateRowPolicy", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreateRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CreateRowPolicyRequest)) *MockProxy_CreateRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CreateRowPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreateRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreateRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.CreateRowPolicyRequest) (*commonpb.Status, error)) *MockProxy_CreateRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Delete(_a0 context.Context, _a1 *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1464,6 +1574,61 @@ func (_c *MockProxy_DropCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropCollectionField(_a0 context.Context, _a1 *proxypb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DropCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionField'
+type MockProxy_DropCollectionField_Call struct {
+	*mock.Call
+}
+
+// DropCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.DropCollectionFieldRequest
+func (_e *MockProxy_Expecter) DropCollectionField(_a0 interface{}, _a1 interface{}) *MockProxy_DropCollectionField_Call {
+	return &MockProxy_DropCollectionField_Call{Call: _e.mock.On("DropCollectionField", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropCollectionField_Call) Run(run func(_a0 context.Context, _a1 *proxypb.DropCollectionFieldRequest)) *MockProxy_DropCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.DropCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_DropCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.DropCollectionFieldRequest) (*commonpb.Status, error)) *MockProxy_DropCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DropDatabase(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1739,6 +1904,61 @@ func (_c *MockProxy_DropRole_Call) RunAndReturn(run func(context.Context, *milvu
 	return _c
 }
 
+// DropRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropRowPolicy(_a0 context.Context, _a1 *internalpb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropRowPolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropRowPolicyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropRowPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRowPolicy'
+type MockProxy_DropRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DropRowPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DropRowPolicyRequest
+func (_e *MockProxy_Expecter) DropRowPolicy(_a0 interface{}, _a1 interface{}) *MockProxy_DropRowPolicy_Call {
+	return &MockProxy_DropRowPolicy_Call{Call: _e.mock.On("DropRowPolicy", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DropRowPolicyRequest)) *MockProxy_DropRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DropRowPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_DropRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.DropRowPolicyRequest) (*commonpb.Status, error)) *MockProxy_DropRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Dummy provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Dummy(_a0 context.Context, _a1 *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1794,6 +2014,61 @@ func (_c *MockProxy_Dummy_Call) RunAndReturn(run func(context.Context, *milvuspb
 	return _c
 }
 
+// ExportV2 provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ExportV2(_a0 context.Context, _a1 *internalpb.ExportRequest) (*internalpb.ExportResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequest) (*internalpb.ExportResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequest) *internalpb.ExportResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ExportRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockProxy_ExportV2_Call struct {
+	*mock.Call
+}
+
+// ExportV2 is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ExportRequest
+func (_e *MockProxy_Expecter) ExportV2(_a0 interface{}, _a1 interface{}) *MockProxy_ExportV2_Call {
+	return &MockProxy_ExportV2_Call{Call: _e.mock.On("ExportV2", _a0, _a1)}
+}
+
+func (_c *MockProxy_ExportV2_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ExportRequest)) *MockProxy_ExportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ExportRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ExportV2_Call) Return(_a0 *internalpb.ExportResponse, _a1 error) *MockProxy_ExportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ExportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ExportRequest) (*internalpb.ExportResponse, error)) *MockProxy_ExportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Flush(_a0 context.Context, _a1 *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2220,6 +2495,61 @@ func (_c *MockProxy_GetDdChannel_Call) RunAndReturn(run func(context.Context, *i
 	return _c
 }
 
+// GetExportProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) GetExportProgress(_a0 context.Context, _a1 *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.GetExportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest) *internalpb.GetExportProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetExportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetExportProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GetExportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportProgress'
+type MockProxy_GetExportProgress_Call struct {
+	*mock.Call
+}
+
+// GetExportProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetExportProgressRequest
+func (_e *MockProxy_Expecter) GetExportProgress(_a0 interface{}, _a1 interface{}) *MockProxy_GetExportProgress_Call {
+	return &MockProxy_GetExportProgress_Call{Call: _e.mock.On("GetExportProgress", _a0, _a1)}
+}
+
+func (_c *MockProxy_GetExportProgress_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetExportProgressRequest)) *MockProxy_GetExportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetExportProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetExportProgress_Call) Return(_a0 *internalpb.GetExportProgressResponse, _a1 error) *MockProxy_GetExportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_GetExportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)) *MockProxy_GetExportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlushAllState provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) GetFlushAllState(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3964,6 +4294,61 @@ func (_c *MockProxy_ListDatabases_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// ListExports provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListExports(_a0 context.Context, _a1 *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListExportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequest) *internalpb.ListExportsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListExportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListExportsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExports'
+type MockProxy_ListExports_Call struct {
+	*mock.Call
+}
+
+// ListExports is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListExportsRequest
+func (_e *MockProxy_Expecter) ListExports(_a0 interface{}, _a1 interface{}) *MockProxy_ListExports_Call {
+	return &MockProxy_ListExports_Call{Call: _e.mock.On("ListExports", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListExports_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListExportsRequest)) *MockProxy_ListExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListExportsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListExports_Call) Return(_a0 *internalpb.ListExportsResponse, _a1 error) *MockProxy_ListExports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListExports_Call) RunAndReturn(run func(context.Context, *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error)) *MockProxy_ListExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImportTasks provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListImportTasks(_a0 context.Context, _a1 *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4184,6 +4569,61 @@ func (_c *MockProxy_ListResourceGroups_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListRowPolicies provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListRowPolicies(_a0 context.Context, _a1 *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListRowPoliciesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest) *internalpb.ListRowPoliciesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListRowPoliciesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListRowPoliciesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type MockProxy_ListRowPolicies_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicies is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListRowPoliciesRequest
+func (_e *MockProxy_Expecter) ListRowPolicies(_a0 interface{}, _a1 interface{}) *MockProxy_ListRowPolicies_Call {
+	return &MockProxy_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListRowPolicies_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListRowPoliciesRequest)) *MockProxy_ListRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListRowPoliciesRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListRowPolicies_Call) Return(_a0 *internalpb.ListRowPoliciesResponse, _a1 error) *MockProxy_ListRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListRowPolicies_Call) RunAndReturn(run func(context.Context, *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error)) *MockProxy_ListRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadBalance provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) LoadBalance(_a0 context.Context, _a1 *milvuspb.LoadBalanceRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4995,6 +5435,61 @@ func (_c *MockProxy_Search_Call) RunAndReturn(run func(context.Context, *milvusp
 	return _c
 }
 
+// SearchCollections provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) SearchCollections(_a0 context.Context, _a1 *proxypb.SearchCollectionsRequest) (*proxypb.SearchCollectionsResults, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.SearchCollectionsResults
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SearchCollectionsRequest) (*proxypb.SearchCollectionsResults, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SearchCollectionsRequest) *proxypb.SearchCollectionsResults); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.SearchCollectionsResults)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.SearchCollectionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_SearchCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchCollections'
+type MockProxy_SearchCollections_Call struct {
+	*mock.Call
+}
+
+// SearchCollections is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.SearchCollectionsRequest
+func (_e *MockProxy_Expecter) SearchCollections(_a0 interface{}, _a1 interface{}) *MockProxy_SearchCollections_Call {
+	return &MockProxy_SearchCollections_Call{Call: _e.mock.On("SearchCollections", _a0, _a1)}
+}
+
+func (_c *MockProxy_SearchCollections_Call) Run(run func(_a0 context.Context, _a1 *proxypb.SearchCollectionsRequest)) *MockProxy_SearchCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.SearchCollectionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_SearchCollections_Call) Return(_a0 *proxypb.SearchCollectionsResults, _a1 error) *MockProxy_SearchCollections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_SearchCollections_Call) RunAndReturn(run func(context.Context, *proxypb.SearchCollectionsRequest) (*proxypb.SearchCollectionsResults, error)) *MockProxy_SearchCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) SelectGrant(_a0 context.Context, _a1 *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	ret := _m.Called(_a0, _a1)
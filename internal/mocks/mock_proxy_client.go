@@ -31,6 +31,76 @@ func (_m *MockProxyClient) EXPECT() *MockProxyClient_Expecter {
 	return &MockProxyClient_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) AddCollectionField(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockProxyClient_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.AddCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) AddCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_AddCollectionField_Call {
+	return &MockProxyClient_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_AddCollectionField_Call) Run(run func(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption)) *MockProxyClient_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.AddCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_AddCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *MockProxyClient) Close() error {
 	ret := _m.Called()
@@ -72,6 +142,286 @@ func (_c *MockProxyClient_Close_Call) RunAndReturn(run func() error) *MockProxyC
 	return _c
 }
 
+// CreateRowPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) CreateRowPolicy(ctx context.Context, in *internalpb.CreateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateRowPolicyRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CreateRowPolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_CreateRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRowPolicy'
+type MockProxyClient_CreateRowPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.CreateRowPolicyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) CreateRowPolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_CreateRowPolicy_Call {
+	return &MockProxyClient_CreateRowPolicy_Call{Call: _e.mock.On("CreateRowPolicy",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_CreateRowPolicy_Call) Run(run func(ctx context.Context, in *internalpb.CreateRowPolicyRequest, opts ...grpc.CallOption)) *MockProxyClient_CreateRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.CreateRowPolicyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_CreateRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_CreateRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_CreateRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.CreateRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_CreateRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DropCollectionField(ctx context.Context, in *proxypb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DropCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DropCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionField'
+type MockProxyClient_DropCollectionField_Call struct {
+	*mock.Call
+}
+
+// DropCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.DropCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DropCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DropCollectionField_Call {
+	return &MockProxyClient_DropCollectionField_Call{Call: _e.mock.On("DropCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DropCollectionField_Call) Run(run func(ctx context.Context, in *proxypb.DropCollectionFieldRequest, opts ...grpc.CallOption)) *MockProxyClient_DropCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.DropCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DropCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_DropCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DropCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.DropCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_DropCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropRowPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DropRowPolicy(ctx context.Context, in *internalpb.DropRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropRowPolicyRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropRowPolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DropRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRowPolicy'
+type MockProxyClient_DropRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DropRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.DropRowPolicyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DropRowPolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DropRowPolicy_Call {
+	return &MockProxyClient_DropRowPolicy_Call{Call: _e.mock.On("DropRowPolicy",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DropRowPolicy_Call) Run(run func(ctx context.Context, in *internalpb.DropRowPolicyRequest, opts ...grpc.CallOption)) *MockProxyClient_DropRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.DropRowPolicyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DropRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_DropRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DropRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.DropRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_DropRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportV2 provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ExportV2(ctx context.Context, in *internalpb.ExportRequest, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequest, ...grpc.CallOption) (*internalpb.ExportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ExportRequest, ...grpc.CallOption) *internalpb.ExportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockProxyClient_ExportV2_Call struct {
+	*mock.Call
+}
+
+// ExportV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ExportRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ExportV2(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ExportV2_Call {
+	return &MockProxyClient_ExportV2_Call{Call: _e.mock.On("ExportV2",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ExportV2_Call) Run(run func(ctx context.Context, in *internalpb.ExportRequest, opts ...grpc.CallOption)) *MockProxyClient_ExportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ExportV2_Call) Return(_a0 *internalpb.ExportResponse, _a1 error) *MockProxyClient_ExportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ExportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ExportRequest, ...grpc.CallOption) (*internalpb.ExportResponse, error)) *MockProxyClient_ExportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	_va := make([]interface{}, len(opts))
@@ -212,6 +562,76 @@ func (_c *MockProxyClient_GetDdChannel_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetExportProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) GetExportProgress(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.GetExportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) *internalpb.GetExportProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetExportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_GetExportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportProgress'
+type MockProxyClient_GetExportProgress_Call struct {
+	*mock.Call
+}
+
+// GetExportProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetExportProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) GetExportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetExportProgress_Call {
+	return &MockProxyClient_GetExportProgress_Call{Call: _e.mock.On("GetExportProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_GetExportProgress_Call) Run(run func(ctx context.Context, in *internalpb.GetExportProgressRequest, opts ...grpc.CallOption)) *MockProxyClient_GetExportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetExportProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_GetExportProgress_Call) Return(_a0 *internalpb.GetExportProgressResponse, _a1 error) *MockProxyClient_GetExportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_GetExportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetExportProgressRequest, ...grpc.CallOption) (*internalpb.GetExportProgressResponse, error)) *MockProxyClient_GetExportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportProgress provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -772,6 +1192,76 @@ func (_c *MockProxyClient_ListClientInfos_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// ListExports provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ListExports(ctx context.Context, in *internalpb.ListExportsRequest, opts ...grpc.CallOption) (*internalpb.ListExportsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ListExportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequest, ...grpc.CallOption) (*internalpb.ListExportsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListExportsRequest, ...grpc.CallOption) *internalpb.ListExportsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListExportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListExportsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ListExports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExports'
+type MockProxyClient_ListExports_Call struct {
+	*mock.Call
+}
+
+// ListExports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ListExportsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ListExports(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListExports_Call {
+	return &MockProxyClient_ListExports_Call{Call: _e.mock.On("ListExports",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ListExports_Call) Run(run func(ctx context.Context, in *internalpb.ListExportsRequest, opts ...grpc.CallOption)) *MockProxyClient_ListExports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListExportsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ListExports_Call) Return(_a0 *internalpb.ListExportsResponse, _a1 error) *MockProxyClient_ListExports_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ListExports_Call) RunAndReturn(run func(context.Context, *internalpb.ListExportsRequest, ...grpc.CallOption) (*internalpb.ListExportsResponse, error)) *MockProxyClient_ListExports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImports provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) ListImports(ctx context.Context, in *internalpb.ListImportsRequest, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -842,6 +1332,76 @@ func (_c *MockProxyClient_ListImports_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ListRowPolicies provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ListRowPolicies(ctx context.Context, in *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ListRowPoliciesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) *internalpb.ListRowPoliciesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListRowPoliciesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type MockProxyClient_ListRowPolicies_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ListRowPoliciesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ListRowPolicies(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListRowPolicies_Call {
+	return &MockProxyClient_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ListRowPolicies_Call) Run(run func(ctx context.Context, in *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption)) *MockProxyClient_ListRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListRowPoliciesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ListRowPolicies_Call) Return(_a0 *internalpb.ListRowPoliciesResponse, _a1 error) *MockProxyClient_ListRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ListRowPolicies_Call) RunAndReturn(run func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error)) *MockProxyClient_ListRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshPolicyInfoCache provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) RefreshPolicyInfoCache(ctx context.Context, in *proxypb.RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
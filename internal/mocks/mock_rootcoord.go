@@ -36,6 +36,61 @@ func (_m *RootCoord) EXPECT() *RootCoord_Expecter {
 	return &RootCoord_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AddCollectionField(_a0 context.Context, _a1 *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type RootCoord_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.AddCollectionFieldRequest
+func (_e *RootCoord_Expecter) AddCollectionField(_a0 interface{}, _a1 interface{}) *RootCoord_AddCollectionField_Call {
+	return &RootCoord_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", _a0, _a1)}
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Run(run func(_a0 context.Context, _a1 *proxypb.AddCollectionFieldRequest)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) AllocID(_a0 context.Context, _a1 *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -696,6 +751,61 @@ func (_c *RootCoord_CreateRole_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// CreateRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) CreateRowPolicy(_a0 context.Context, _a1 *internalpb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateRowPolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateRowPolicyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CreateRowPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CreateRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRowPolicy'
+type RootCoord_CreateRowPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateRowPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CreateRowPolicyRequest
+func (_e *RootCoord_Expecter) CreateRowPolicy(_a0 interface{}, _a1 interface{}) *RootCoord_CreateRowPolicy_Call {
+	return &RootCoord_CreateRowPolicy_Call{Call: _e.mock.On("CreateRowPolicy", _a0, _a1)}
+}
+
+func (_c *RootCoord_CreateRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CreateRowPolicyRequest)) *RootCoord_CreateRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CreateRowPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CreateRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CreateRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_CreateRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.CreateRowPolicyRequest) (*commonpb.Status, error)) *RootCoord_CreateRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteCredential provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) DeleteCredential(_a0 context.Context, _a1 *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1081,6 +1191,61 @@ func (_c *RootCoord_DropCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DropCollectionField(_a0 context.Context, _a1 *proxypb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DropCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionField'
+type RootCoord_DropCollectionField_Call struct {
+	*mock.Call
+}
+
+// DropCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.DropCollectionFieldRequest
+func (_e *RootCoord_Expecter) DropCollectionField(_a0 interface{}, _a1 interface{}) *RootCoord_DropCollectionField_Call {
+	return &RootCoord_DropCollectionField_Call{Call: _e.mock.On("DropCollectionField", _a0, _a1)}
+}
+
+func (_c *RootCoord_DropCollectionField_Call) Run(run func(_a0 context.Context, _a1 *proxypb.DropCollectionFieldRequest)) *RootCoord_DropCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.DropCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DropCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.DropCollectionFieldRequest) (*commonpb.Status, error)) *RootCoord_DropCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) DropDatabase(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1246,6 +1411,61 @@ func (_c *RootCoord_DropRole_Call) RunAndReturn(run func(context.Context, *milvu
 	return _c
 }
 
+// DropRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DropRowPolicy(_a0 context.Context, _a1 *internalpb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropRowPolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropRowPolicyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropRowPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRowPolicy'
+type RootCoord_DropRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DropRowPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DropRowPolicyRequest
+func (_e *RootCoord_Expecter) DropRowPolicy(_a0 interface{}, _a1 interface{}) *RootCoord_DropRowPolicy_Call {
+	return &RootCoord_DropRowPolicy_Call{Call: _e.mock.On("DropRowPolicy", _a0, _a1)}
+}
+
+func (_c *RootCoord_DropRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DropRowPolicyRequest)) *RootCoord_DropRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DropRowPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DropRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.DropRowPolicyRequest) (*commonpb.Status, error)) *RootCoord_DropRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2002,6 +2222,61 @@ func (_c *RootCoord_ListPolicy_Call) RunAndReturn(run func(context.Context, *int
 	return _c
 }
 
+// ListRowPolicies provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListRowPolicies(_a0 context.Context, _a1 *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListRowPoliciesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest) *internalpb.ListRowPoliciesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListRowPoliciesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListRowPoliciesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type RootCoord_ListRowPolicies_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicies is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListRowPoliciesRequest
+func (_e *RootCoord_Expecter) ListRowPolicies(_a0 interface{}, _a1 interface{}) *RootCoord_ListRowPolicies_Call {
+	return &RootCoord_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies", _a0, _a1)}
+}
+
+func (_c *RootCoord_ListRowPolicies_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListRowPoliciesRequest)) *RootCoord_ListRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListRowPoliciesRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListRowPolicies_Call) Return(_a0 *internalpb.ListRowPoliciesResponse, _a1 error) *RootCoord_ListRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ListRowPolicies_Call) RunAndReturn(run func(context.Context, *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error)) *RootCoord_ListRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperatePrivilege provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) OperatePrivilege(_a0 context.Context, _a1 *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -33,6 +33,76 @@ func (_m *MockRootCoordClient) EXPECT() *MockRootCoordClient_Expecter {
 	return &MockRootCoordClient_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) AddCollectionField(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockRootCoordClient_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.AddCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) AddCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_AddCollectionField_Call {
+	return &MockRootCoordClient_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) Run(run func(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption)) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.AddCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) AllocID(ctx context.Context, in *rootcoordpb.AllocIDRequest, opts ...grpc.CallOption) (*rootcoordpb.AllocIDResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -914,6 +984,76 @@ func (_c *MockRootCoordClient_CreateRole_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CreateRowPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateRowPolicy(ctx context.Context, in *internalpb.CreateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateRowPolicyRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CreateRowPolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_CreateRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRowPolicy'
+type MockRootCoordClient_CreateRowPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.CreateRowPolicyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) CreateRowPolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateRowPolicy_Call {
+	return &MockRootCoordClient_CreateRowPolicy_Call{Call: _e.mock.On("CreateRowPolicy",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_CreateRowPolicy_Call) Run(run func(ctx context.Context, in *internalpb.CreateRowPolicyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.CreateRowPolicyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_CreateRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_CreateRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.CreateRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteCredential provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1404,6 +1544,76 @@ func (_c *MockRootCoordClient_DropCollection_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// DropCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropCollectionField(ctx context.Context, in *proxypb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DropCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DropCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DropCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionField'
+type MockRootCoordClient_DropCollectionField_Call struct {
+	*mock.Call
+}
+
+// DropCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.DropCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DropCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropCollectionField_Call {
+	return &MockRootCoordClient_DropCollectionField_Call{Call: _e.mock.On("DropCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DropCollectionField_Call) Run(run func(ctx context.Context, in *proxypb.DropCollectionFieldRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.DropCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.DropCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1614,6 +1824,76 @@ func (_c *MockRootCoordClient_DropRole_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// DropRowPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropRowPolicy(ctx context.Context, in *internalpb.DropRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropRowPolicyRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropRowPolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DropRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRowPolicy'
+type MockRootCoordClient_DropRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DropRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.DropRowPolicyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DropRowPolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropRowPolicy_Call {
+	return &MockRootCoordClient_DropRowPolicy_Call{Call: _e.mock.On("DropRowPolicy",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DropRowPolicy_Call) Run(run func(ctx context.Context, in *internalpb.DropRowPolicyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.DropRowPolicyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.DropRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	_va := make([]interface{}, len(opts))
@@ -2524,6 +2804,76 @@ func (_c *MockRootCoordClient_ListPolicy_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListRowPolicies provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListRowPolicies(ctx context.Context, in *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ListRowPoliciesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) *internalpb.ListRowPoliciesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListRowPoliciesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type MockRootCoordClient_ListRowPolicies_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ListRowPoliciesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) ListRowPolicies(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListRowPolicies_Call {
+	return &MockRootCoordClient_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_ListRowPolicies_Call) Run(run func(ctx context.Context, in *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListRowPoliciesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListRowPolicies_Call) Return(_a0 *internalpb.ListRowPoliciesResponse, _a1 error) *MockRootCoordClient_ListRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListRowPolicies_Call) RunAndReturn(run func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error)) *MockRootCoordClient_ListRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperatePrivilege provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
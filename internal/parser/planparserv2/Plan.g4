@@ -7,21 +7,25 @@ expr:
 	| StringLiteral											                     # String
 	| Identifier											                     # Identifier
 	| JSONIdentifier                                                             # JSONIdentifier
+	| '{' Identifier '}'                                                         # TemplateVariable
 	| '(' expr ')'											                     # Parens
 	| '[' expr (',' expr)* ','? ']'                                              # Array
 	| expr LIKE StringLiteral                                                    # Like
 	| expr POW expr											                     # Power
 	| op = (ADD | SUB | BNOT | NOT) expr					                     # Unary
-//	| '(' typeName ')' expr									                     # Cast
+	| '(' Identifier ')' expr								                     # Cast
 	| expr op = (MUL | DIV | MOD) expr						                     # MulDivMod
 	| expr op = (ADD | SUB) expr							                     # AddSub
 	| expr op = (SHL | SHR) expr							                     # Shift
 	| expr op = (IN | NIN) ('[' expr (',' expr)* ','? ']')                       # Term
 	| expr op = (IN | NIN) EmptyTerm                                             # EmptyTerm
+	| expr op = (IN | NIN) '{' Identifier '}'                                    # TemplateTerm
 	| (JSONContains | ArrayContains)'('expr',' expr')'                           # JSONContains
 	| (JSONContainsAll | ArrayContainsAll)'('expr',' expr')'                     # JSONContainsAll
 	| (JSONContainsAny | ArrayContainsAny)'('expr',' expr')'                     # JSONContainsAny
 	| ArrayLength'('(Identifier | JSONIdentifier)')'                             # ArrayLength
+	| Identifier '(' (expr (',' expr)* ','?)? ')'                                # Call
+	| expr IS NOT? NULL                                                          # IsNull
 	| expr op1 = (LT | LE) (Identifier | JSONIdentifier) op2 = (LT | LE) expr	 # Range
 	| expr op1 = (GT | GE) (Identifier | JSONIdentifier) op2 = (GT | GE) expr    # ReverseRange
 	| expr op = (LT | LE | GT | GE) expr					                     # Relational
@@ -33,16 +37,6 @@ expr:
 	| expr OR expr											                     # LogicalOr
 	| EXISTS expr                                                                # Exists;
 
-// typeName: ty = (BOOL | INT8 | INT16 | INT32 | INT64 | FLOAT | DOUBLE);
-
-// BOOL: 'bool';
-// INT8: 'int8';
-// INT16: 'int16';
-// INT32: 'int32';
-// INT64: 'int64';
-// FLOAT: 'float';
-// DOUBLE: 'double';
-
 LT: '<';
 LE: '<=';
 GT: '>';
@@ -52,6 +46,8 @@ NE: '!=';
 
 LIKE: 'like' | 'LIKE';
 EXISTS: 'exists' | 'EXISTS';
+IS: [iI] [sS];
+NULL: [nN] [uU] [lL] [lL];
 
 ADD: '+';
 SUB: '-';
@@ -69,7 +65,7 @@ AND: '&&' | 'and';
 OR: '||' | 'or';
 
 BNOT: '~';
-NOT: '!' | 'not';
+NOT: '!' | [nN] [oO] [tT];
 
 IN: 'in';
 NIN: 'not in';
@@ -0,0 +1,118 @@
+package planparserv2
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// isArithCompare returns whether the comparison involves arithmetic between multiple fields,
+// the arithmetic between a single field and constants is translated to BinaryArithOpEvalRangeExpr.
+func isArithCompare(left, right *ExprWithType) bool {
+	if left.expr.GetBinaryArithExpr() == nil && right.expr.GetBinaryArithExpr() == nil {
+		return false
+	}
+	return countColumns(left.expr)+countColumns(right.expr) > 1
+}
+
+func countColumns(expr *planpb.Expr) int {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_ColumnExpr:
+		return 1
+	case *planpb.Expr_BinaryArithExpr:
+		return countColumns(e.BinaryArithExpr.GetLeft()) + countColumns(e.BinaryArithExpr.GetRight())
+	default:
+		return 0
+	}
+}
+
+// handleArithCompare translates the comparison between arithmetic of fields, e.g. `end_ts - start_ts < 3600`.
+// The compute type is promoted over all the operands of both sides:
+//   - integers of any width are evaluated in int64, overflow wraps around and division truncates toward zero;
+//   - if any operand is floating or a json path, all are evaluated in double;
+//   - modulo requires the compute type to be int64.
+func handleArithCompare(op planpb.OpType, left, right *ExprWithType) (*planpb.Expr, error) {
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("unsupported op type: %s", op)
+	}
+	operands := &arithOperands{}
+	if err := operands.collect(left.expr); err != nil {
+		return nil, err
+	}
+	if err := operands.collect(right.expr); err != nil {
+		return nil, err
+	}
+
+	computeType := schemapb.DataType_Int64
+	if operands.hasFloating || operands.hasJSON {
+		computeType = schemapb.DataType_Double
+	}
+	if operands.hasModulo && computeType != schemapb.DataType_Int64 {
+		return nil, fmt.Errorf("modulo can only apply on integer types")
+	}
+
+	return &planpb.Expr{
+		Expr: &planpb.Expr_ArithCompareExpr{
+			ArithCompareExpr: &planpb.ArithCompareExpr{
+				Left:        left.expr,
+				Right:       right.expr,
+				Op:          op,
+				ComputeType: computeType,
+			},
+		},
+	}, nil
+}
+
+type arithOperands struct {
+	hasFloating bool
+	hasJSON     bool
+	hasModulo   bool
+}
+
+func (o *arithOperands) collect(expr *planpb.Expr) error {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryArithExpr:
+		switch e.BinaryArithExpr.GetOp() {
+		case planpb.ArithOpType_ArrayLength:
+			return fmt.Errorf("array_length is not supported in arithmetic between fields")
+		case planpb.ArithOpType_Mod:
+			o.hasModulo = true
+		}
+		if err := o.collect(e.BinaryArithExpr.GetLeft()); err != nil {
+			return err
+		}
+		return o.collect(e.BinaryArithExpr.GetRight())
+	case *planpb.Expr_ColumnExpr:
+		columnInfo := e.ColumnExpr.GetInfo()
+		if err := checkDirectComparisonBinaryField(columnInfo); err != nil {
+			return err
+		}
+		if typeutil.IsArrayType(columnInfo.GetDataType()) {
+			return fmt.Errorf("array element is not supported in arithmetic between fields")
+		}
+		dataType := getColumnDataType(columnInfo)
+		switch {
+		case typeutil.IsIntegerType(dataType):
+		case typeutil.IsFloatingType(dataType):
+			o.hasFloating = true
+		case typeutil.IsJSONType(dataType):
+			o.hasJSON = true
+		default:
+			return fmt.Errorf("arithmetic between fields can only be used on integer or floating or json fields, but got: %s", dataType.String())
+		}
+	case *planpb.Expr_ValueExpr:
+		value := e.ValueExpr.GetValue()
+		switch {
+		case IsInteger(value):
+		case IsFloating(value):
+			o.hasFloating = true
+		default:
+			return fmt.Errorf("arithmetic between fields can only be compared with numbers, but got: %s", value)
+		}
+	default:
+		return fmt.Errorf("complicated arithmetic operations are not supported")
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package planparserv2
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const supportedCastTypes = "bool, int64, double, varchar"
+
+var castTypeMap = map[string]schemapb.DataType{
+	"bool":    schemapb.DataType_Bool,
+	"int64":   schemapb.DataType_Int64,
+	"double":  schemapb.DataType_Double,
+	"varchar": schemapb.DataType_VarChar,
+}
+
+// castConstant casts the constant value to the target type, the cast is evaluated while parsing.
+func castConstant(targetType schemapb.DataType, value *planpb.GenericValue) (*planpb.GenericValue, error) {
+	switch targetType {
+	case schemapb.DataType_Bool:
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_BoolVal:
+			return value, nil
+		case *planpb.GenericValue_StringVal:
+			b, err := strconv.ParseBool(v.StringVal)
+			if err != nil {
+				return nil, fmt.Errorf("cannot cast \"%s\" to %s", v.StringVal, targetType)
+			}
+			return NewBool(b), nil
+		}
+	case schemapb.DataType_Int64:
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return value, nil
+		case *planpb.GenericValue_FloatVal:
+			if math.IsNaN(v.FloatVal) || v.FloatVal >= math.MaxInt64 || v.FloatVal < math.MinInt64 {
+				return nil, fmt.Errorf("cannot cast %v to %s, out of range", v.FloatVal, targetType)
+			}
+			return NewInt(int64(v.FloatVal)), nil
+		case *planpb.GenericValue_StringVal:
+			i, err := strconv.ParseInt(v.StringVal, 0, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot cast \"%s\" to %s", v.StringVal, targetType)
+			}
+			return NewInt(i), nil
+		}
+	case schemapb.DataType_Double:
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return NewFloat(float64(v.Int64Val)), nil
+		case *planpb.GenericValue_FloatVal:
+			return value, nil
+		case *planpb.GenericValue_StringVal:
+			f, err := strconv.ParseFloat(v.StringVal, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot cast \"%s\" to %s", v.StringVal, targetType)
+			}
+			return NewFloat(f), nil
+		}
+	case schemapb.DataType_VarChar:
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_BoolVal:
+			return NewString(strconv.FormatBool(v.BoolVal)), nil
+		case *planpb.GenericValue_Int64Val:
+			return NewString(strconv.FormatInt(v.Int64Val, 10)), nil
+		case *planpb.GenericValue_FloatVal:
+			return NewString(strconv.FormatFloat(v.FloatVal, 'g', -1, 64)), nil
+		case *planpb.GenericValue_StringVal:
+			return value, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot cast %s to %s", toValueExpr(value).dataType, targetType)
+}
+
+// canCastColumn checks if the column can be cast to the target type.
+// Json keys can be cast to any supported type, while numeric fields can only be cast between numeric types,
+// other fields can only be cast to their own types.
+func canCastColumn(column *planpb.ColumnInfo, targetType schemapb.DataType) error {
+	dataType := getColumnDataType(column)
+	switch {
+	case typeutil.IsJSONType(dataType):
+		if len(column.GetNestedPath()) == 0 {
+			return fmt.Errorf("cast is only supported on json key, but got the whole json field")
+		}
+		return nil
+	case typeutil.IsArithmetic(dataType):
+		if typeutil.IsArithmetic(targetType) {
+			return nil
+		}
+	case typeutil.IsStringType(dataType):
+		if typeutil.IsStringType(targetType) {
+			return nil
+		}
+	case typeutil.IsBoolType(dataType):
+		if typeutil.IsBoolType(targetType) {
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot cast field of type %s to %s", dataType, targetType)
+}
+
+// castColumn returns the column which is cast to the target type and the data type to evaluate it.
+// The cast is only recorded in the column info when it changes the values, that is on json keys and
+// between integers and floating numbers, the other casts leave the column as it is so that its index
+// can still be used.
+func castColumn(column *planpb.ColumnInfo, targetType schemapb.DataType) (*planpb.ColumnInfo, schemapb.DataType, error) {
+	if err := canCastColumn(column, targetType); err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+	dataType := getColumnDataType(column)
+	if !typeutil.IsJSONType(dataType) && typeutil.IsIntegerType(dataType) == typeutil.IsIntegerType(targetType) {
+		return column, dataType, nil
+	}
+	if typeutil.IsArrayType(column.GetDataType()) {
+		return nil, schemapb.DataType_None, fmt.Errorf("cannot cast array element of type %s to %s", dataType, targetType)
+	}
+	casted := proto.Clone(column).(*planpb.ColumnInfo)
+	casted.CastType = targetType
+	return casted, targetType, nil
+}
+
+// checkCastColumns checks that the casted columns are only used by the predicates which evaluate the cast,
+// i.e. comparing with a constant, like and term.
+func checkCastColumns(expr *planpb.Expr) error {
+	var check func(expr *planpb.Expr) error
+	check = func(expr *planpb.Expr) error {
+		var columns []*planpb.ColumnInfo
+		switch e := expr.GetExpr().(type) {
+		case *planpb.Expr_UnaryExpr:
+			return check(e.UnaryExpr.GetChild())
+		case *planpb.Expr_BinaryExpr:
+			if err := check(e.BinaryExpr.GetLeft()); err != nil {
+				return err
+			}
+			return check(e.BinaryExpr.GetRight())
+		case *planpb.Expr_TermExpr, *planpb.Expr_UnaryRangeExpr:
+			return nil
+		case *planpb.Expr_BinaryRangeExpr:
+			columns = append(columns, e.BinaryRangeExpr.GetColumnInfo())
+		case *planpb.Expr_CompareExpr:
+			columns = append(columns, e.CompareExpr.GetLeftColumnInfo(), e.CompareExpr.GetRightColumnInfo())
+		case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+			columns = append(columns, e.BinaryArithOpEvalRangeExpr.GetColumnInfo())
+		case *planpb.Expr_JsonContainsExpr:
+			columns = append(columns, e.JsonContainsExpr.GetColumnInfo())
+		case *planpb.Expr_StringFunctionExpr:
+			columns = append(columns, e.StringFunctionExpr.GetColumnInfo())
+		case *planpb.Expr_ExistsExpr:
+			columns = append(columns, e.ExistsExpr.GetInfo())
+		case *planpb.Expr_NullExpr:
+			columns = append(columns, e.NullExpr.GetColumnInfo())
+		case *planpb.Expr_ColumnExpr:
+			columns = append(columns, e.ColumnExpr.GetInfo())
+		case *planpb.Expr_BinaryArithExpr:
+			if err := check(e.BinaryArithExpr.GetLeft()); err != nil {
+				return err
+			}
+			return check(e.BinaryArithExpr.GetRight())
+		case *planpb.Expr_ArithCompareExpr:
+			if err := check(e.ArithCompareExpr.GetLeft()); err != nil {
+				return err
+			}
+			return check(e.ArithCompareExpr.GetRight())
+		}
+		for _, column := range columns {
+			if column.GetCastType() != schemapb.DataType_None {
+				return fmt.Errorf("cast on field is only supported when it's compared with constants, matched by like or in a list")
+			}
+		}
+		return nil
+	}
+	return check(expr)
+}
+
+// getColumnDataType returns the data type of the column used for evaluation, the cast type takes precedence
+// and the element type is used for array elements.
+func getColumnDataType(column *planpb.ColumnInfo) schemapb.DataType {
+	if column.GetCastType() != schemapb.DataType_None {
+		return column.GetCastType()
+	}
+	dataType := column.GetDataType()
+	if typeutil.IsArrayType(dataType) && len(column.GetNestedPath()) != 0 {
+		dataType = column.GetElementType()
+	}
+	return dataType
+}
@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
+
+	parser "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
 )
 
 type errorListener interface {
@@ -18,9 +20,28 @@ type errorListenerImpl struct {
 }
 
 func (l *errorListenerImpl) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	if inCastContext(recognizer) {
+		msg = "invalid cast expression, " + msg + ", the cast should be like (type) expr and the supported types are: " + supportedCastTypes
+	}
 	l.err = fmt.Errorf("line " + strconv.Itoa(line) + ":" + strconv.Itoa(column) + " " + msg)
 }
 
 func (l *errorListenerImpl) Error() error {
 	return l.err
 }
+
+// inCastContext checks if the syntax error occurs while parsing a cast expression.
+func inCastContext(recognizer antlr.Recognizer) bool {
+	p, ok := recognizer.(antlr.Parser)
+	if !ok {
+		return false
+	}
+	var tree antlr.Tree = p.GetParserRuleContext()
+	for tree != nil {
+		if _, ok := tree.(*parser.CastContext); ok {
+			return true
+		}
+		tree = tree.GetParent()
+	}
+	return false
+}
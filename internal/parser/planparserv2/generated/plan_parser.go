@@ -15,83 +15,101 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 48, 131,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 52, 165,
 	4, 2, 9, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
-	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 20, 10, 2, 12, 2, 14, 2, 23, 11, 2,
-	3, 2, 5, 2, 26, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 23, 10, 2, 12, 2,
+	14, 2, 26, 11, 2, 3, 2, 5, 2, 29, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
 	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
-	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 5, 2,
-	59, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
 	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 69, 10, 2,
+	12, 2, 14, 2, 72, 11, 2, 3, 2, 5, 2, 75, 10, 2, 5, 2, 77, 10, 2, 3, 2,
+	3, 2, 3, 2, 5, 2, 82, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
 	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
 	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
-	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 113, 10, 2, 12, 2, 14, 2, 116,
-	11, 2, 3, 2, 5, 2, 119, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 126,
-	10, 2, 12, 2, 14, 2, 129, 11, 2, 3, 2, 2, 3, 2, 3, 2, 2, 15, 4, 2, 16,
-	17, 29, 30, 4, 2, 34, 34, 37, 37, 4, 2, 35, 35, 38, 38, 4, 2, 36, 36, 39,
-	39, 4, 2, 44, 44, 46, 46, 3, 2, 18, 20, 3, 2, 16, 17, 3, 2, 22, 23, 3,
-	2, 8, 9, 3, 2, 10, 11, 3, 2, 8, 11, 3, 2, 12, 13, 3, 2, 31, 32, 2, 162,
-	2, 58, 3, 2, 2, 2, 4, 5, 8, 2, 1, 2, 5, 59, 7, 42, 2, 2, 6, 59, 7, 43,
-	2, 2, 7, 59, 7, 41, 2, 2, 8, 59, 7, 45, 2, 2, 9, 59, 7, 44, 2, 2, 10, 59,
-	7, 46, 2, 2, 11, 12, 7, 3, 2, 2, 12, 13, 5, 2, 2, 2, 13, 14, 7, 4, 2, 2,
-	14, 59, 3, 2, 2, 2, 15, 16, 7, 5, 2, 2, 16, 21, 5, 2, 2, 2, 17, 18, 7,
-	6, 2, 2, 18, 20, 5, 2, 2, 2, 19, 17, 3, 2, 2, 2, 20, 23, 3, 2, 2, 2, 21,
-	19, 3, 2, 2, 2, 21, 22, 3, 2, 2, 2, 22, 25, 3, 2, 2, 2, 23, 21, 3, 2, 2,
-	2, 24, 26, 7, 6, 2, 2, 25, 24, 3, 2, 2, 2, 25, 26, 3, 2, 2, 2, 26, 27,
-	3, 2, 2, 2, 27, 28, 7, 7, 2, 2, 28, 59, 3, 2, 2, 2, 29, 30, 9, 2, 2, 2,
-	30, 59, 5, 2, 2, 22, 31, 32, 9, 3, 2, 2, 32, 33, 7, 3, 2, 2, 33, 34, 5,
-	2, 2, 2, 34, 35, 7, 6, 2, 2, 35, 36, 5, 2, 2, 2, 36, 37, 7, 4, 2, 2, 37,
-	59, 3, 2, 2, 2, 38, 39, 9, 4, 2, 2, 39, 40, 7, 3, 2, 2, 40, 41, 5, 2, 2,
-	2, 41, 42, 7, 6, 2, 2, 42, 43, 5, 2, 2, 2, 43, 44, 7, 4, 2, 2, 44, 59,
-	3, 2, 2, 2, 45, 46, 9, 5, 2, 2, 46, 47, 7, 3, 2, 2, 47, 48, 5, 2, 2, 2,
-	48, 49, 7, 6, 2, 2, 49, 50, 5, 2, 2, 2, 50, 51, 7, 4, 2, 2, 51, 59, 3,
-	2, 2, 2, 52, 53, 7, 40, 2, 2, 53, 54, 7, 3, 2, 2, 54, 55, 9, 6, 2, 2, 55,
-	59, 7, 4, 2, 2, 56, 57, 7, 15, 2, 2, 57, 59, 5, 2, 2, 3, 58, 4, 3, 2, 2,
-	2, 58, 6, 3, 2, 2, 2, 58, 7, 3, 2, 2, 2, 58, 8, 3, 2, 2, 2, 58, 9, 3, 2,
-	2, 2, 58, 10, 3, 2, 2, 2, 58, 11, 3, 2, 2, 2, 58, 15, 3, 2, 2, 2, 58, 29,
-	3, 2, 2, 2, 58, 31, 3, 2, 2, 2, 58, 38, 3, 2, 2, 2, 58, 45, 3, 2, 2, 2,
-	58, 52, 3, 2, 2, 2, 58, 56, 3, 2, 2, 2, 59, 127, 3, 2, 2, 2, 60, 61, 12,
-	23, 2, 2, 61, 62, 7, 21, 2, 2, 62, 126, 5, 2, 2, 24, 63, 64, 12, 21, 2,
-	2, 64, 65, 9, 7, 2, 2, 65, 126, 5, 2, 2, 22, 66, 67, 12, 20, 2, 2, 67,
-	68, 9, 8, 2, 2, 68, 126, 5, 2, 2, 21, 69, 70, 12, 19, 2, 2, 70, 71, 9,
-	9, 2, 2, 71, 126, 5, 2, 2, 20, 72, 73, 12, 12, 2, 2, 73, 74, 9, 10, 2,
-	2, 74, 75, 9, 6, 2, 2, 75, 76, 9, 10, 2, 2, 76, 126, 5, 2, 2, 13, 77, 78,
-	12, 11, 2, 2, 78, 79, 9, 11, 2, 2, 79, 80, 9, 6, 2, 2, 80, 81, 9, 11, 2,
-	2, 81, 126, 5, 2, 2, 12, 82, 83, 12, 10, 2, 2, 83, 84, 9, 12, 2, 2, 84,
-	126, 5, 2, 2, 11, 85, 86, 12, 9, 2, 2, 86, 87, 9, 13, 2, 2, 87, 126, 5,
-	2, 2, 10, 88, 89, 12, 8, 2, 2, 89, 90, 7, 24, 2, 2, 90, 126, 5, 2, 2, 9,
-	91, 92, 12, 7, 2, 2, 92, 93, 7, 26, 2, 2, 93, 126, 5, 2, 2, 8, 94, 95,
-	12, 6, 2, 2, 95, 96, 7, 25, 2, 2, 96, 126, 5, 2, 2, 7, 97, 98, 12, 5, 2,
-	2, 98, 99, 7, 27, 2, 2, 99, 126, 5, 2, 2, 6, 100, 101, 12, 4, 2, 2, 101,
-	102, 7, 28, 2, 2, 102, 126, 5, 2, 2, 5, 103, 104, 12, 24, 2, 2, 104, 105,
-	7, 14, 2, 2, 105, 126, 7, 45, 2, 2, 106, 107, 12, 18, 2, 2, 107, 108, 9,
-	14, 2, 2, 108, 109, 7, 5, 2, 2, 109, 114, 5, 2, 2, 2, 110, 111, 7, 6, 2,
-	2, 111, 113, 5, 2, 2, 2, 112, 110, 3, 2, 2, 2, 113, 116, 3, 2, 2, 2, 114,
-	112, 3, 2, 2, 2, 114, 115, 3, 2, 2, 2, 115, 118, 3, 2, 2, 2, 116, 114,
-	3, 2, 2, 2, 117, 119, 7, 6, 2, 2, 118, 117, 3, 2, 2, 2, 118, 119, 3, 2,
-	2, 2, 119, 120, 3, 2, 2, 2, 120, 121, 7, 7, 2, 2, 121, 126, 3, 2, 2, 2,
-	122, 123, 12, 17, 2, 2, 123, 124, 9, 14, 2, 2, 124, 126, 7, 33, 2, 2, 125,
-	60, 3, 2, 2, 2, 125, 63, 3, 2, 2, 2, 125, 66, 3, 2, 2, 2, 125, 69, 3, 2,
-	2, 2, 125, 72, 3, 2, 2, 2, 125, 77, 3, 2, 2, 2, 125, 82, 3, 2, 2, 2, 125,
-	85, 3, 2, 2, 2, 125, 88, 3, 2, 2, 2, 125, 91, 3, 2, 2, 2, 125, 94, 3, 2,
-	2, 2, 125, 97, 3, 2, 2, 2, 125, 100, 3, 2, 2, 2, 125, 103, 3, 2, 2, 2,
-	125, 106, 3, 2, 2, 2, 125, 122, 3, 2, 2, 2, 126, 129, 3, 2, 2, 2, 127,
-	125, 3, 2, 2, 2, 127, 128, 3, 2, 2, 2, 128, 3, 3, 2, 2, 2, 129, 127, 3,
-	2, 2, 2, 9, 21, 25, 58, 114, 118, 125, 127,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 136, 10, 2,
+	12, 2, 14, 2, 139, 11, 2, 3, 2, 5, 2, 142, 10, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 5, 2, 157, 10,
+	2, 3, 2, 7, 2, 160, 10, 2, 12, 2, 14, 2, 163, 11, 2, 3, 2, 2, 3, 2, 3,
+	2, 2, 15, 4, 2, 20, 21, 33, 34, 4, 2, 38, 38, 41, 41, 4, 2, 39, 39, 42,
+	42, 4, 2, 40, 40, 43, 43, 4, 2, 48, 48, 50, 50, 3, 2, 22, 24, 3, 2, 20,
+	21, 3, 2, 26, 27, 3, 2, 10, 11, 3, 2, 12, 13, 3, 2, 10, 13, 3, 2, 14, 15,
+	3, 2, 35, 36, 2, 205, 2, 81, 3, 2, 2, 2, 4, 5, 8, 2, 1, 2, 5, 82, 7, 46,
+	2, 2, 6, 82, 7, 47, 2, 2, 7, 82, 7, 45, 2, 2, 8, 82, 7, 49, 2, 2, 9, 82,
+	7, 48, 2, 2, 10, 82, 7, 50, 2, 2, 11, 12, 7, 3, 2, 2, 12, 13, 7, 48, 2,
+	2, 13, 82, 7, 4, 2, 2, 14, 15, 7, 5, 2, 2, 15, 16, 5, 2, 2, 2, 16, 17,
+	7, 6, 2, 2, 17, 82, 3, 2, 2, 2, 18, 19, 7, 7, 2, 2, 19, 24, 5, 2, 2, 2,
+	20, 21, 7, 8, 2, 2, 21, 23, 5, 2, 2, 2, 22, 20, 3, 2, 2, 2, 23, 26, 3,
+	2, 2, 2, 24, 22, 3, 2, 2, 2, 24, 25, 3, 2, 2, 2, 25, 28, 3, 2, 2, 2, 26,
+	24, 3, 2, 2, 2, 27, 29, 7, 8, 2, 2, 28, 27, 3, 2, 2, 2, 28, 29, 3, 2, 2,
+	2, 29, 30, 3, 2, 2, 2, 30, 31, 7, 9, 2, 2, 31, 82, 3, 2, 2, 2, 32, 33,
+	9, 2, 2, 2, 33, 82, 5, 2, 2, 26, 34, 35, 7, 5, 2, 2, 35, 36, 7, 48, 2,
+	2, 36, 37, 7, 6, 2, 2, 37, 82, 5, 2, 2, 25, 38, 39, 9, 3, 2, 2, 39, 40,
+	7, 5, 2, 2, 40, 41, 5, 2, 2, 2, 41, 42, 7, 8, 2, 2, 42, 43, 5, 2, 2, 2,
+	43, 44, 7, 6, 2, 2, 44, 82, 3, 2, 2, 2, 45, 46, 9, 4, 2, 2, 46, 47, 7,
+	5, 2, 2, 47, 48, 5, 2, 2, 2, 48, 49, 7, 8, 2, 2, 49, 50, 5, 2, 2, 2, 50,
+	51, 7, 6, 2, 2, 51, 82, 3, 2, 2, 2, 52, 53, 9, 5, 2, 2, 53, 54, 7, 5, 2,
+	2, 54, 55, 5, 2, 2, 2, 55, 56, 7, 8, 2, 2, 56, 57, 5, 2, 2, 2, 57, 58,
+	7, 6, 2, 2, 58, 82, 3, 2, 2, 2, 59, 60, 7, 44, 2, 2, 60, 61, 7, 5, 2, 2,
+	61, 62, 9, 6, 2, 2, 62, 82, 7, 6, 2, 2, 63, 64, 7, 48, 2, 2, 64, 76, 7,
+	5, 2, 2, 65, 70, 5, 2, 2, 2, 66, 67, 7, 8, 2, 2, 67, 69, 5, 2, 2, 2, 68,
+	66, 3, 2, 2, 2, 69, 72, 3, 2, 2, 2, 70, 68, 3, 2, 2, 2, 70, 71, 3, 2, 2,
+	2, 71, 74, 3, 2, 2, 2, 72, 70, 3, 2, 2, 2, 73, 75, 7, 8, 2, 2, 74, 73,
+	3, 2, 2, 2, 74, 75, 3, 2, 2, 2, 75, 77, 3, 2, 2, 2, 76, 65, 3, 2, 2, 2,
+	76, 77, 3, 2, 2, 2, 77, 78, 3, 2, 2, 2, 78, 82, 7, 6, 2, 2, 79, 80, 7,
+	17, 2, 2, 80, 82, 5, 2, 2, 3, 81, 4, 3, 2, 2, 2, 81, 6, 3, 2, 2, 2, 81,
+	7, 3, 2, 2, 2, 81, 8, 3, 2, 2, 2, 81, 9, 3, 2, 2, 2, 81, 10, 3, 2, 2, 2,
+	81, 11, 3, 2, 2, 2, 81, 14, 3, 2, 2, 2, 81, 18, 3, 2, 2, 2, 81, 32, 3,
+	2, 2, 2, 81, 34, 3, 2, 2, 2, 81, 38, 3, 2, 2, 2, 81, 45, 3, 2, 2, 2, 81,
+	52, 3, 2, 2, 2, 81, 59, 3, 2, 2, 2, 81, 63, 3, 2, 2, 2, 81, 79, 3, 2, 2,
+	2, 82, 161, 3, 2, 2, 2, 83, 84, 12, 27, 2, 2, 84, 85, 7, 25, 2, 2, 85,
+	160, 5, 2, 2, 28, 86, 87, 12, 24, 2, 2, 87, 88, 9, 7, 2, 2, 88, 160, 5,
+	2, 2, 25, 89, 90, 12, 23, 2, 2, 90, 91, 9, 8, 2, 2, 91, 160, 5, 2, 2, 24,
+	92, 93, 12, 22, 2, 2, 93, 94, 9, 9, 2, 2, 94, 160, 5, 2, 2, 23, 95, 96,
+	12, 12, 2, 2, 96, 97, 9, 10, 2, 2, 97, 98, 9, 6, 2, 2, 98, 99, 9, 10, 2,
+	2, 99, 160, 5, 2, 2, 13, 100, 101, 12, 11, 2, 2, 101, 102, 9, 11, 2, 2,
+	102, 103, 9, 6, 2, 2, 103, 104, 9, 11, 2, 2, 104, 160, 5, 2, 2, 12, 105,
+	106, 12, 10, 2, 2, 106, 107, 9, 12, 2, 2, 107, 160, 5, 2, 2, 11, 108, 109,
+	12, 9, 2, 2, 109, 110, 9, 13, 2, 2, 110, 160, 5, 2, 2, 10, 111, 112, 12,
+	8, 2, 2, 112, 113, 7, 28, 2, 2, 113, 160, 5, 2, 2, 9, 114, 115, 12, 7,
+	2, 2, 115, 116, 7, 30, 2, 2, 116, 160, 5, 2, 2, 8, 117, 118, 12, 6, 2,
+	2, 118, 119, 7, 29, 2, 2, 119, 160, 5, 2, 2, 7, 120, 121, 12, 5, 2, 2,
+	121, 122, 7, 31, 2, 2, 122, 160, 5, 2, 2, 6, 123, 124, 12, 4, 2, 2, 124,
+	125, 7, 32, 2, 2, 125, 160, 5, 2, 2, 5, 126, 127, 12, 28, 2, 2, 127, 128,
+	7, 16, 2, 2, 128, 160, 7, 49, 2, 2, 129, 130, 12, 21, 2, 2, 130, 131, 9,
+	14, 2, 2, 131, 132, 7, 7, 2, 2, 132, 137, 5, 2, 2, 2, 133, 134, 7, 8, 2,
+	2, 134, 136, 5, 2, 2, 2, 135, 133, 3, 2, 2, 2, 136, 139, 3, 2, 2, 2, 137,
+	135, 3, 2, 2, 2, 137, 138, 3, 2, 2, 2, 138, 141, 3, 2, 2, 2, 139, 137,
+	3, 2, 2, 2, 140, 142, 7, 8, 2, 2, 141, 140, 3, 2, 2, 2, 141, 142, 3, 2,
+	2, 2, 142, 143, 3, 2, 2, 2, 143, 144, 7, 9, 2, 2, 144, 160, 3, 2, 2, 2,
+	145, 146, 12, 20, 2, 2, 146, 147, 9, 14, 2, 2, 147, 160, 7, 37, 2, 2, 148,
+	149, 12, 19, 2, 2, 149, 150, 9, 14, 2, 2, 150, 151, 7, 3, 2, 2, 151, 152,
+	7, 48, 2, 2, 152, 160, 7, 4, 2, 2, 153, 154, 12, 13, 2, 2, 154, 156, 7,
+	18, 2, 2, 155, 157, 7, 34, 2, 2, 156, 155, 3, 2, 2, 2, 156, 157, 3, 2,
+	2, 2, 157, 158, 3, 2, 2, 2, 158, 160, 7, 19, 2, 2, 159, 83, 3, 2, 2, 2,
+	159, 86, 3, 2, 2, 2, 159, 89, 3, 2, 2, 2, 159, 92, 3, 2, 2, 2, 159, 95,
+	3, 2, 2, 2, 159, 100, 3, 2, 2, 2, 159, 105, 3, 2, 2, 2, 159, 108, 3, 2,
+	2, 2, 159, 111, 3, 2, 2, 2, 159, 114, 3, 2, 2, 2, 159, 117, 3, 2, 2, 2,
+	159, 120, 3, 2, 2, 2, 159, 123, 3, 2, 2, 2, 159, 126, 3, 2, 2, 2, 159,
+	129, 3, 2, 2, 2, 159, 145, 3, 2, 2, 2, 159, 148, 3, 2, 2, 2, 159, 153,
+	3, 2, 2, 2, 160, 163, 3, 2, 2, 2, 161, 159, 3, 2, 2, 2, 161, 162, 3, 2,
+	2, 2, 162, 3, 3, 2, 2, 2, 163, 161, 3, 2, 2, 2, 13, 24, 28, 70, 74, 76,
+	81, 137, 141, 156, 159, 161,
 }
 var literalNames = []string{
-	"", "'('", "')'", "'['", "','", "']'", "'<'", "'<='", "'>'", "'>='", "'=='",
-	"'!='", "", "", "'+'", "'-'", "'*'", "'/'", "'%'", "'**'", "'<<'", "'>>'",
-	"'&'", "'|'", "'^'", "", "", "'~'", "", "'in'", "'not in'",
+	"", "'{'", "'}'", "'('", "')'", "'['", "','", "']'", "'<'", "'<='", "'>'",
+	"'>='", "'=='", "'!='", "", "", "", "", "'+'", "'-'", "'*'", "'/'", "'%'",
+	"'**'", "'<<'", "'>>'", "'&'", "'|'", "'^'", "", "", "'~'", "", "'in'",
+	"'not in'",
 }
 var symbolicNames = []string{
-	"", "", "", "", "", "", "LT", "LE", "GT", "GE", "EQ", "NE", "LIKE", "EXISTS",
-	"ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR",
-	"BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "JSONContains",
-	"JSONContainsAll", "JSONContainsAny", "ArrayContains", "ArrayContainsAll",
-	"ArrayContainsAny", "ArrayLength", "BooleanConstant", "IntegerConstant",
-	"FloatingConstant", "Identifier", "StringLiteral", "JSONIdentifier", "Whitespace",
-	"Newline",
+	"", "", "", "", "", "", "", "", "LT", "LE", "GT", "GE", "EQ", "NE", "LIKE",
+	"EXISTS", "IS", "NULL", "ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL",
+	"SHR", "BAND", "BOR", "BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN",
+	"EmptyTerm", "JSONContains", "JSONContainsAll", "JSONContainsAny", "ArrayContains",
+	"ArrayContainsAll", "ArrayContainsAny", "ArrayLength", "BooleanConstant",
+	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "JSONIdentifier",
+	"Whitespace", "Newline",
 }
 
 var ruleNames = []string{
@@ -135,47 +153,51 @@ const (
 	PlanParserT__2             = 3
 	PlanParserT__3             = 4
 	PlanParserT__4             = 5
-	PlanParserLT               = 6
-	PlanParserLE               = 7
-	PlanParserGT               = 8
-	PlanParserGE               = 9
-	PlanParserEQ               = 10
-	PlanParserNE               = 11
-	PlanParserLIKE             = 12
-	PlanParserEXISTS           = 13
-	PlanParserADD              = 14
-	PlanParserSUB              = 15
-	PlanParserMUL              = 16
-	PlanParserDIV              = 17
-	PlanParserMOD              = 18
-	PlanParserPOW              = 19
-	PlanParserSHL              = 20
-	PlanParserSHR              = 21
-	PlanParserBAND             = 22
-	PlanParserBOR              = 23
-	PlanParserBXOR             = 24
-	PlanParserAND              = 25
-	PlanParserOR               = 26
-	PlanParserBNOT             = 27
-	PlanParserNOT              = 28
-	PlanParserIN               = 29
-	PlanParserNIN              = 30
-	PlanParserEmptyTerm        = 31
-	PlanParserJSONContains     = 32
-	PlanParserJSONContainsAll  = 33
-	PlanParserJSONContainsAny  = 34
-	PlanParserArrayContains    = 35
-	PlanParserArrayContainsAll = 36
-	PlanParserArrayContainsAny = 37
-	PlanParserArrayLength      = 38
-	PlanParserBooleanConstant  = 39
-	PlanParserIntegerConstant  = 40
-	PlanParserFloatingConstant = 41
-	PlanParserIdentifier       = 42
-	PlanParserStringLiteral    = 43
-	PlanParserJSONIdentifier   = 44
-	PlanParserWhitespace       = 45
-	PlanParserNewline          = 46
+	PlanParserT__5             = 6
+	PlanParserT__6             = 7
+	PlanParserLT               = 8
+	PlanParserLE               = 9
+	PlanParserGT               = 10
+	PlanParserGE               = 11
+	PlanParserEQ               = 12
+	PlanParserNE               = 13
+	PlanParserLIKE             = 14
+	PlanParserEXISTS           = 15
+	PlanParserIS               = 16
+	PlanParserNULL             = 17
+	PlanParserADD              = 18
+	PlanParserSUB              = 19
+	PlanParserMUL              = 20
+	PlanParserDIV              = 21
+	PlanParserMOD              = 22
+	PlanParserPOW              = 23
+	PlanParserSHL              = 24
+	PlanParserSHR              = 25
+	PlanParserBAND             = 26
+	PlanParserBOR              = 27
+	PlanParserBXOR             = 28
+	PlanParserAND              = 29
+	PlanParserOR               = 30
+	PlanParserBNOT             = 31
+	PlanParserNOT              = 32
+	PlanParserIN               = 33
+	PlanParserNIN              = 34
+	PlanParserEmptyTerm        = 35
+	PlanParserJSONContains     = 36
+	PlanParserJSONContainsAll  = 37
+	PlanParserJSONContainsAny  = 38
+	PlanParserArrayContains    = 39
+	PlanParserArrayContainsAll = 40
+	PlanParserArrayContainsAny = 41
+	PlanParserArrayLength      = 42
+	PlanParserBooleanConstant  = 43
+	PlanParserIntegerConstant  = 44
+	PlanParserFloatingConstant = 45
+	PlanParserIdentifier       = 46
+	PlanParserStringLiteral    = 47
+	PlanParserJSONIdentifier   = 48
+	PlanParserWhitespace       = 49
+	PlanParserNewline          = 50
 )
 
 // PlanParserRULE_expr is the PlanParser rule.
@@ -231,6 +253,48 @@ func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) s
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
+type CastContext struct {
+	*ExprContext
+}
+
+func NewCastContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CastContext {
+	var p = new(CastContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *CastContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CastContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *CastContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *CastContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitCast(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type JSONIdentifierContext struct {
 	*ExprContext
 }
@@ -680,6 +744,38 @@ func (s *LogicalAndContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type TemplateVariableContext struct {
+	*ExprContext
+}
+
+func NewTemplateVariableContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TemplateVariableContext {
+	var p = new(TemplateVariableContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *TemplateVariableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TemplateVariableContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *TemplateVariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitTemplateVariable(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type EqualityContext struct {
 	*ExprContext
 	op antlr.Token
@@ -840,6 +936,61 @@ func (s *ShiftContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type CallContext struct {
+	*ExprContext
+}
+
+func NewCallContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallContext {
+	var p = new(CallContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *CallContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CallContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *CallContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *CallContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *CallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitCall(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type ReverseRangeContext struct {
 	*ExprContext
 	op1 antlr.Token
@@ -1772,6 +1923,111 @@ func (s *EmptyTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type TemplateTermContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewTemplateTermContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TemplateTermContext {
+	var p = new(TemplateTermContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *TemplateTermContext) GetOp() antlr.Token { return s.op }
+
+func (s *TemplateTermContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *TemplateTermContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TemplateTermContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *TemplateTermContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *TemplateTermContext) IN() antlr.TerminalNode {
+	return s.GetToken(PlanParserIN, 0)
+}
+
+func (s *TemplateTermContext) NIN() antlr.TerminalNode {
+	return s.GetToken(PlanParserNIN, 0)
+}
+
+func (s *TemplateTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitTemplateTerm(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type IsNullContext struct {
+	*ExprContext
+}
+
+func NewIsNullContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IsNullContext {
+	var p = new(IsNullContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *IsNullContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IsNullContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *IsNullContext) IS() antlr.TerminalNode {
+	return s.GetToken(PlanParserIS, 0)
+}
+
+func (s *IsNullContext) NULL() antlr.TerminalNode {
+	return s.GetToken(PlanParserNULL, 0)
+}
+
+func (s *IsNullContext) NOT() antlr.TerminalNode {
+	return s.GetToken(PlanParserNOT, 0)
+}
+
+func (s *IsNullContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitIsNull(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type PowerContext struct {
 	*ExprContext
 }
@@ -1860,11 +2116,10 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(56)
+	p.SetState(79)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case PlanParserIntegerConstant:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+	case 1:
 		localctx = NewIntegerContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1874,7 +2129,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserIntegerConstant)
 		}
 
-	case PlanParserFloatingConstant:
+	case 2:
 		localctx = NewFloatingContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1883,7 +2138,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserFloatingConstant)
 		}
 
-	case PlanParserBooleanConstant:
+	case 3:
 		localctx = NewBooleanContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1892,7 +2147,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserBooleanConstant)
 		}
 
-	case PlanParserStringLiteral:
+	case 4:
 		localctx = NewStringContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1901,7 +2156,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserStringLiteral)
 		}
 
-	case PlanParserIdentifier:
+	case 5:
 		localctx = NewIdentifierContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1910,7 +2165,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserIdentifier)
 		}
 
-	case PlanParserJSONIdentifier:
+	case 6:
 		localctx = NewJSONIdentifierContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1919,8 +2174,8 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserJSONIdentifier)
 		}
 
-	case PlanParserT__0:
-		localctx = NewParensContext(p, localctx)
+	case 7:
+		localctx = NewTemplateVariableContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
@@ -1929,67 +2184,84 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 		}
 		{
 			p.SetState(10)
-			p.expr(0)
+			p.Match(PlanParserIdentifier)
 		}
 		{
 			p.SetState(11)
 			p.Match(PlanParserT__1)
 		}
 
-	case PlanParserT__2:
-		localctx = NewArrayContext(p, localctx)
+	case 8:
+		localctx = NewParensContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(13)
+			p.SetState(12)
 			p.Match(PlanParserT__2)
 		}
+		{
+			p.SetState(13)
+			p.expr(0)
+		}
 		{
 			p.SetState(14)
+			p.Match(PlanParserT__3)
+		}
+
+	case 9:
+		localctx = NewArrayContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(16)
+			p.Match(PlanParserT__4)
+		}
+		{
+			p.SetState(17)
 			p.expr(0)
 		}
-		p.SetState(19)
+		p.SetState(22)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(15)
-					p.Match(PlanParserT__3)
+					p.SetState(18)
+					p.Match(PlanParserT__5)
 				}
 				{
-					p.SetState(16)
+					p.SetState(19)
 					p.expr(0)
 				}
 
 			}
-			p.SetState(21)
+			p.SetState(24)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
 		}
-		p.SetState(23)
+		p.SetState(26)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == PlanParserT__3 {
+		if _la == PlanParserT__5 {
 			{
-				p.SetState(22)
-				p.Match(PlanParserT__3)
+				p.SetState(25)
+				p.Match(PlanParserT__5)
 			}
 
 		}
 		{
-			p.SetState(25)
-			p.Match(PlanParserT__4)
+			p.SetState(28)
+			p.Match(PlanParserT__6)
 		}
 
-	case PlanParserADD, PlanParserSUB, PlanParserBNOT, PlanParserNOT:
+	case 10:
 		localctx = NewUnaryContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(27)
+			p.SetState(30)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -1997,7 +2269,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 
 			_la = p.GetTokenStream().LA(1)
 
-			if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<PlanParserADD)|(1<<PlanParserSUB)|(1<<PlanParserBNOT)|(1<<PlanParserNOT))) != 0) {
+			if !(((_la-18)&-(0x1f+1)) == 0 && ((1<<uint((_la-18)))&((1<<(PlanParserADD-18))|(1<<(PlanParserSUB-18))|(1<<(PlanParserBNOT-18))|(1<<(PlanParserNOT-18)))) != 0) {
 				var _ri = p.GetErrorHandler().RecoverInline(p)
 
 				localctx.(*UnaryContext).op = _ri
@@ -2007,16 +2279,37 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(28)
-			p.expr(20)
+			p.SetState(31)
+			p.expr(24)
+		}
+
+	case 11:
+		localctx = NewCastContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(32)
+			p.Match(PlanParserT__2)
+		}
+		{
+			p.SetState(33)
+			p.Match(PlanParserIdentifier)
+		}
+		{
+			p.SetState(34)
+			p.Match(PlanParserT__3)
+		}
+		{
+			p.SetState(35)
+			p.expr(23)
 		}
 
-	case PlanParserJSONContains, PlanParserArrayContains:
+	case 12:
 		localctx = NewJSONContainsContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(29)
+			p.SetState(36)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == PlanParserJSONContains || _la == PlanParserArrayContains) {
@@ -2027,32 +2320,32 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(30)
-			p.Match(PlanParserT__0)
+			p.SetState(37)
+			p.Match(PlanParserT__2)
 		}
 		{
-			p.SetState(31)
+			p.SetState(38)
 			p.expr(0)
 		}
 		{
-			p.SetState(32)
-			p.Match(PlanParserT__3)
+			p.SetState(39)
+			p.Match(PlanParserT__5)
 		}
 		{
-			p.SetState(33)
+			p.SetState(40)
 			p.expr(0)
 		}
 		{
-			p.SetState(34)
-			p.Match(PlanParserT__1)
+			p.SetState(41)
+			p.Match(PlanParserT__3)
 		}
 
-	case PlanParserJSONContainsAll, PlanParserArrayContainsAll:
+	case 13:
 		localctx = NewJSONContainsAllContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(36)
+			p.SetState(43)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == PlanParserJSONContainsAll || _la == PlanParserArrayContainsAll) {
@@ -2063,32 +2356,32 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(37)
-			p.Match(PlanParserT__0)
+			p.SetState(44)
+			p.Match(PlanParserT__2)
 		}
 		{
-			p.SetState(38)
+			p.SetState(45)
 			p.expr(0)
 		}
 		{
-			p.SetState(39)
-			p.Match(PlanParserT__3)
+			p.SetState(46)
+			p.Match(PlanParserT__5)
 		}
 		{
-			p.SetState(40)
+			p.SetState(47)
 			p.expr(0)
 		}
 		{
-			p.SetState(41)
-			p.Match(PlanParserT__1)
+			p.SetState(48)
+			p.Match(PlanParserT__3)
 		}
 
-	case PlanParserJSONContainsAny, PlanParserArrayContainsAny:
+	case 14:
 		localctx = NewJSONContainsAnyContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(43)
+			p.SetState(50)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == PlanParserJSONContainsAny || _la == PlanParserArrayContainsAny) {
@@ -2099,40 +2392,40 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(44)
-			p.Match(PlanParserT__0)
+			p.SetState(51)
+			p.Match(PlanParserT__2)
 		}
 		{
-			p.SetState(45)
+			p.SetState(52)
 			p.expr(0)
 		}
 		{
-			p.SetState(46)
-			p.Match(PlanParserT__3)
+			p.SetState(53)
+			p.Match(PlanParserT__5)
 		}
 		{
-			p.SetState(47)
+			p.SetState(54)
 			p.expr(0)
 		}
 		{
-			p.SetState(48)
-			p.Match(PlanParserT__1)
+			p.SetState(55)
+			p.Match(PlanParserT__3)
 		}
 
-	case PlanParserArrayLength:
+	case 15:
 		localctx = NewArrayLengthContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(50)
+			p.SetState(57)
 			p.Match(PlanParserArrayLength)
 		}
 		{
-			p.SetState(51)
-			p.Match(PlanParserT__0)
+			p.SetState(58)
+			p.Match(PlanParserT__2)
 		}
 		{
-			p.SetState(52)
+			p.SetState(59)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
@@ -2143,30 +2436,87 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(53)
-			p.Match(PlanParserT__1)
+			p.SetState(60)
+			p.Match(PlanParserT__3)
+		}
+
+	case 16:
+		localctx = NewCallContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(61)
+			p.Match(PlanParserIdentifier)
+		}
+		{
+			p.SetState(62)
+			p.Match(PlanParserT__2)
+		}
+		p.SetState(74)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<PlanParserT__0)|(1<<PlanParserT__2)|(1<<PlanParserT__4)|(1<<PlanParserEXISTS)|(1<<PlanParserADD)|(1<<PlanParserSUB)|(1<<PlanParserBNOT))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(PlanParserNOT-32))|(1<<(PlanParserJSONContains-32))|(1<<(PlanParserJSONContainsAll-32))|(1<<(PlanParserJSONContainsAny-32))|(1<<(PlanParserArrayContains-32))|(1<<(PlanParserArrayContainsAll-32))|(1<<(PlanParserArrayContainsAny-32))|(1<<(PlanParserArrayLength-32))|(1<<(PlanParserBooleanConstant-32))|(1<<(PlanParserIntegerConstant-32))|(1<<(PlanParserFloatingConstant-32))|(1<<(PlanParserIdentifier-32))|(1<<(PlanParserStringLiteral-32))|(1<<(PlanParserJSONIdentifier-32)))) != 0) {
+			{
+				p.SetState(63)
+				p.expr(0)
+			}
+			p.SetState(68)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext())
+
+			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+				if _alt == 1 {
+					{
+						p.SetState(64)
+						p.Match(PlanParserT__5)
+					}
+					{
+						p.SetState(65)
+						p.expr(0)
+					}
+
+				}
+				p.SetState(70)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext())
+			}
+			p.SetState(72)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PlanParserT__5 {
+				{
+					p.SetState(71)
+					p.Match(PlanParserT__5)
+				}
+
+			}
+
+		}
+		{
+			p.SetState(76)
+			p.Match(PlanParserT__3)
 		}
 
-	case PlanParserEXISTS:
+	case 17:
 		localctx = NewExistsContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(54)
+			p.SetState(77)
 			p.Match(PlanParserEXISTS)
 		}
 		{
-			p.SetState(55)
+			p.SetState(78)
 			p.expr(1)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(125)
+	p.SetState(159)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -2174,36 +2524,36 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(123)
+			p.SetState(157)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewPowerContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(58)
+				p.SetState(81)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 25)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 25)", ""))
 				}
 				{
-					p.SetState(59)
+					p.SetState(82)
 					p.Match(PlanParserPOW)
 				}
 				{
-					p.SetState(60)
-					p.expr(22)
+					p.SetState(83)
+					p.expr(26)
 				}
 
 			case 2:
 				localctx = NewMulDivModContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(61)
+				p.SetState(84)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 22)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 22)", ""))
 				}
 				{
-					p.SetState(62)
+					p.SetState(85)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2221,20 +2571,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(63)
-					p.expr(20)
+					p.SetState(86)
+					p.expr(23)
 				}
 
 			case 3:
 				localctx = NewAddSubContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(64)
+				p.SetState(87)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
 				}
 				{
-					p.SetState(65)
+					p.SetState(88)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2252,20 +2602,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(66)
-					p.expr(19)
+					p.SetState(89)
+					p.expr(22)
 				}
 
 			case 4:
 				localctx = NewShiftContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(67)
+				p.SetState(90)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
 				}
 				{
-					p.SetState(68)
+					p.SetState(91)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2283,20 +2633,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(69)
-					p.expr(18)
+					p.SetState(92)
+					p.expr(21)
 				}
 
 			case 5:
 				localctx = NewRangeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(70)
+				p.SetState(93)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
 				}
 				{
-					p.SetState(71)
+					p.SetState(94)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2314,7 +2664,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(72)
+					p.SetState(95)
 					_la = p.GetTokenStream().LA(1)
 
 					if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
@@ -2325,7 +2675,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(73)
+					p.SetState(96)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2343,20 +2693,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(74)
+					p.SetState(97)
 					p.expr(11)
 				}
 
 			case 6:
 				localctx = NewReverseRangeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(75)
+				p.SetState(98)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
 				}
 				{
-					p.SetState(76)
+					p.SetState(99)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2374,7 +2724,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(77)
+					p.SetState(100)
 					_la = p.GetTokenStream().LA(1)
 
 					if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
@@ -2385,7 +2735,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(78)
+					p.SetState(101)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2403,20 +2753,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(79)
+					p.SetState(102)
 					p.expr(10)
 				}
 
 			case 7:
 				localctx = NewRelationalContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(80)
+				p.SetState(103)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(81)
+					p.SetState(104)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2434,20 +2784,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(82)
+					p.SetState(105)
 					p.expr(9)
 				}
 
 			case 8:
 				localctx = NewEqualityContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(83)
+				p.SetState(106)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(84)
+					p.SetState(107)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2465,122 +2815,122 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(85)
+					p.SetState(108)
 					p.expr(8)
 				}
 
 			case 9:
 				localctx = NewBitAndContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(86)
+				p.SetState(109)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(87)
+					p.SetState(110)
 					p.Match(PlanParserBAND)
 				}
 				{
-					p.SetState(88)
+					p.SetState(111)
 					p.expr(7)
 				}
 
 			case 10:
 				localctx = NewBitXorContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(89)
+				p.SetState(112)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(90)
+					p.SetState(113)
 					p.Match(PlanParserBXOR)
 				}
 				{
-					p.SetState(91)
+					p.SetState(114)
 					p.expr(6)
 				}
 
 			case 11:
 				localctx = NewBitOrContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(92)
+				p.SetState(115)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 				}
 				{
-					p.SetState(93)
+					p.SetState(116)
 					p.Match(PlanParserBOR)
 				}
 				{
-					p.SetState(94)
+					p.SetState(117)
 					p.expr(5)
 				}
 
 			case 12:
 				localctx = NewLogicalAndContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(95)
+				p.SetState(118)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 				}
 				{
-					p.SetState(96)
+					p.SetState(119)
 					p.Match(PlanParserAND)
 				}
 				{
-					p.SetState(97)
+					p.SetState(120)
 					p.expr(4)
 				}
 
 			case 13:
 				localctx = NewLogicalOrContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(98)
+				p.SetState(121)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(99)
+					p.SetState(122)
 					p.Match(PlanParserOR)
 				}
 				{
-					p.SetState(100)
+					p.SetState(123)
 					p.expr(3)
 				}
 
 			case 14:
 				localctx = NewLikeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(101)
+				p.SetState(124)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 22)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 22)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 26)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 26)", ""))
 				}
 				{
-					p.SetState(102)
+					p.SetState(125)
 					p.Match(PlanParserLIKE)
 				}
 				{
-					p.SetState(103)
+					p.SetState(126)
 					p.Match(PlanParserStringLiteral)
 				}
 
 			case 15:
 				localctx = NewTermContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(104)
+				p.SetState(127)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
 				}
 				{
-					p.SetState(105)
+					p.SetState(128)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2599,59 +2949,59 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				}
 
 				{
-					p.SetState(106)
-					p.Match(PlanParserT__2)
+					p.SetState(129)
+					p.Match(PlanParserT__4)
 				}
 				{
-					p.SetState(107)
+					p.SetState(130)
 					p.expr(0)
 				}
-				p.SetState(112)
+				p.SetState(135)
 				p.GetErrorHandler().Sync(p)
-				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 
 				for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 					if _alt == 1 {
 						{
-							p.SetState(108)
-							p.Match(PlanParserT__3)
+							p.SetState(131)
+							p.Match(PlanParserT__5)
 						}
 						{
-							p.SetState(109)
+							p.SetState(132)
 							p.expr(0)
 						}
 
 					}
-					p.SetState(114)
+					p.SetState(137)
 					p.GetErrorHandler().Sync(p)
-					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
+					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 				}
-				p.SetState(116)
+				p.SetState(139)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
-				if _la == PlanParserT__3 {
+				if _la == PlanParserT__5 {
 					{
-						p.SetState(115)
-						p.Match(PlanParserT__3)
+						p.SetState(138)
+						p.Match(PlanParserT__5)
 					}
 
 				}
 				{
-					p.SetState(118)
-					p.Match(PlanParserT__4)
+					p.SetState(141)
+					p.Match(PlanParserT__6)
 				}
 
 			case 16:
 				localctx = NewEmptyTermContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(120)
+				p.SetState(143)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
 				}
 				{
-					p.SetState(121)
+					p.SetState(144)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2669,16 +3019,83 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(122)
+					p.SetState(145)
 					p.Match(PlanParserEmptyTerm)
 				}
 
+			case 17:
+				localctx = NewTemplateTermContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
+				p.SetState(146)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
+				}
+				{
+					p.SetState(147)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*TemplateTermContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == PlanParserIN || _la == PlanParserNIN) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*TemplateTermContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(148)
+					p.Match(PlanParserT__0)
+				}
+				{
+					p.SetState(149)
+					p.Match(PlanParserIdentifier)
+				}
+				{
+					p.SetState(150)
+					p.Match(PlanParserT__1)
+				}
+
+			case 18:
+				localctx = NewIsNullContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
+				p.SetState(151)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
+				}
+				{
+					p.SetState(152)
+					p.Match(PlanParserIS)
+				}
+				p.SetState(154)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == PlanParserNOT {
+					{
+						p.SetState(153)
+						p.Match(PlanParserNOT)
+					}
+
+				}
+				{
+					p.SetState(156)
+					p.Match(PlanParserNULL)
+				}
+
 			}
 
 		}
-		p.SetState(127)
+		p.SetState(161)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -2701,16 +3118,16 @@ func (p *PlanParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex in
 func (p *PlanParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 21)
+		return p.Precpred(p.GetParserRuleContext(), 25)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 19)
+		return p.Precpred(p.GetParserRuleContext(), 22)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 18)
+		return p.Precpred(p.GetParserRuleContext(), 21)
 
 	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 17)
+		return p.Precpred(p.GetParserRuleContext(), 20)
 
 	case 4:
 		return p.Precpred(p.GetParserRuleContext(), 10)
@@ -2740,13 +3157,19 @@ func (p *PlanParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) boo
 		return p.Precpred(p.GetParserRuleContext(), 2)
 
 	case 13:
-		return p.Precpred(p.GetParserRuleContext(), 22)
+		return p.Precpred(p.GetParserRuleContext(), 26)
 
 	case 14:
-		return p.Precpred(p.GetParserRuleContext(), 16)
+		return p.Precpred(p.GetParserRuleContext(), 19)
 
 	case 15:
-		return p.Precpred(p.GetParserRuleContext(), 15)
+		return p.Precpred(p.GetParserRuleContext(), 18)
+
+	case 16:
+		return p.Precpred(p.GetParserRuleContext(), 17)
+
+	case 17:
+		return p.Precpred(p.GetParserRuleContext(), 11)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -7,6 +7,9 @@ import "github.com/antlr/antlr4/runtime/Go/antlr"
 type PlanVisitor interface {
 	antlr.ParseTreeVisitor
 
+	// Visit a parse tree produced by PlanParser#Cast.
+	VisitCast(ctx *CastContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#JSONIdentifier.
 	VisitJSONIdentifier(ctx *JSONIdentifierContext) interface{}
 
@@ -37,6 +40,9 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#LogicalAnd.
 	VisitLogicalAnd(ctx *LogicalAndContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#TemplateVariable.
+	VisitTemplateVariable(ctx *TemplateVariableContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#Equality.
 	VisitEquality(ctx *EqualityContext) interface{}
 
@@ -46,6 +52,9 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#Shift.
 	VisitShift(ctx *ShiftContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#Call.
+	VisitCall(ctx *CallContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#ReverseRange.
 	VisitReverseRange(ctx *ReverseRangeContext) interface{}
 
@@ -94,6 +103,12 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#EmptyTerm.
 	VisitEmptyTerm(ctx *EmptyTermContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#TemplateTerm.
+	VisitTemplateTerm(ctx *TemplateTermContext) interface{}
+
+	// Visit a parse tree produced by PlanParser#IsNull.
+	VisitIsNull(ctx *IsNullContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#Power.
 	VisitPower(ctx *PowerContext) interface{}
 }
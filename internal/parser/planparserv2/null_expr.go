@@ -0,0 +1,194 @@
+package planparserv2
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// translateNullExpr translates `expr is null` and `expr is not null`, expr must be a field or a json path.
+func translateNullExpr(child *ExprWithType, op planpb.NullExpr_NullOp, text string) (*ExprWithType, error) {
+	columnInfo := toColumnInfo(child)
+	if columnInfo == nil {
+		return nil, fmt.Errorf("null test can only be applied on single field or json path, but got: %s", text)
+	}
+	if typeutil.IsVectorType(columnInfo.GetDataType()) {
+		return nil, fmt.Errorf("null test is not supported on vector field, but got: %s", text)
+	}
+	if columnInfo.GetDataType() == schemapb.DataType_Array && len(columnInfo.GetNestedPath()) > 0 {
+		return nil, fmt.Errorf("null test is not supported on array element, but got: %s", text)
+	}
+	return &ExprWithType{
+		expr:     newNullExpr(columnInfo, op),
+		dataType: schemapb.DataType_Bool,
+	}, nil
+}
+
+func newNullExpr(columnInfo *planpb.ColumnInfo, op planpb.NullExpr_NullOp) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_NullExpr{
+			NullExpr: &planpb.NullExpr{
+				ColumnInfo: columnInfo,
+				Op:         op,
+			},
+		},
+	}
+}
+
+func newNotExpr(expr *planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryExpr{
+			UnaryExpr: &planpb.UnaryExpr{
+				Op:    planpb.UnaryExpr_Not,
+				Child: expr,
+			},
+		},
+	}
+}
+
+func newBinaryExpr(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{
+				Op:    op,
+				Left:  left,
+				Right: right,
+			},
+		},
+	}
+}
+
+// applyThreeValuedLogic rewrites the predicate to keep the three-valued logic of sql on nullable fields.
+// The execution backend doesn't know about null in comparison, term or match predicates, a null row holds
+// the default value of the field and would be evaluated as it is. So a leaf predicate is combined with
+// `is not null` of the nullable fields it references, which makes it false rather than unknown on null.
+// The negations are pushed down to the leaf predicates by De Morgan's laws, which hold in three-valued logic,
+// so that `not (a > 1)` is not true on null either.
+// Predicates without nullable fields are not changed.
+func applyThreeValuedLogic(expr *planpb.Expr) *planpb.Expr {
+	return truthExpr(expr)
+}
+
+// truthExpr returns the predicate which is true iff expr is true.
+func truthExpr(expr *planpb.Expr) *planpb.Expr {
+	if unaryExpr := expr.GetUnaryExpr(); unaryExpr.GetOp() == planpb.UnaryExpr_Not && unaryExpr.GetChild().GetNullExpr() != nil {
+		return falsityExpr(unaryExpr.GetChild())
+	}
+	columns := collectNullableColumns(expr)
+	if len(columns) == 0 {
+		return expr
+	}
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryExpr:
+		if e.UnaryExpr.GetOp() == planpb.UnaryExpr_Not {
+			return falsityExpr(e.UnaryExpr.GetChild())
+		}
+	case *planpb.Expr_BinaryExpr:
+		return newBinaryExpr(e.BinaryExpr.GetOp(), truthExpr(e.BinaryExpr.GetLeft()), truthExpr(e.BinaryExpr.GetRight()))
+	}
+	return guardNotNull(expr, columns)
+}
+
+// falsityExpr returns the predicate which is true iff expr is false, neither true nor unknown.
+func falsityExpr(expr *planpb.Expr) *planpb.Expr {
+	if nullExpr := expr.GetNullExpr(); nullExpr != nil {
+		// a null test is never unknown
+		op := planpb.NullExpr_IsNull
+		if nullExpr.GetOp() == planpb.NullExpr_IsNull {
+			op = planpb.NullExpr_IsNotNull
+		}
+		return newNullExpr(nullExpr.GetColumnInfo(), op)
+	}
+	columns := collectNullableColumns(expr)
+	if len(columns) == 0 {
+		return newNotExpr(expr)
+	}
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryExpr:
+		if e.UnaryExpr.GetOp() == planpb.UnaryExpr_Not {
+			return truthExpr(e.UnaryExpr.GetChild())
+		}
+	case *planpb.Expr_BinaryExpr:
+		left, right := falsityExpr(e.BinaryExpr.GetLeft()), falsityExpr(e.BinaryExpr.GetRight())
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return newBinaryExpr(planpb.BinaryExpr_LogicalOr, left, right)
+		case planpb.BinaryExpr_LogicalOr:
+			return newBinaryExpr(planpb.BinaryExpr_LogicalAnd, left, right)
+		}
+	}
+	return guardNotNull(newNotExpr(expr), columns)
+}
+
+// guardNotNull combines the predicate with `is not null` of the columns.
+func guardNotNull(expr *planpb.Expr, columns []*planpb.ColumnInfo) *planpb.Expr {
+	ret := expr
+	for _, columnInfo := range columns {
+		ret = newBinaryExpr(planpb.BinaryExpr_LogicalAnd, ret, newNullExpr(columnInfo, planpb.NullExpr_IsNotNull))
+	}
+	return ret
+}
+
+// collectNullableColumns returns the nullable fields referenced by the predicate, null tests are excluded
+// since they are never unknown.
+func collectNullableColumns(expr *planpb.Expr) []*planpb.ColumnInfo {
+	var columns []*planpb.ColumnInfo
+	visited := make(map[int64]struct{})
+	add := func(columnInfo *planpb.ColumnInfo) {
+		if !columnInfo.GetNullable() {
+			return
+		}
+		if _, ok := visited[columnInfo.GetFieldId()]; ok {
+			return
+		}
+		visited[columnInfo.GetFieldId()] = struct{}{}
+		// the whole field is tested, a json path on a non-null json may be missing but is not unknown
+		columns = append(columns, &planpb.ColumnInfo{
+			FieldId:         columnInfo.GetFieldId(),
+			DataType:        columnInfo.GetDataType(),
+			IsPrimaryKey:    columnInfo.GetIsPrimaryKey(),
+			IsAutoID:        columnInfo.GetIsAutoID(),
+			IsPartitionKey:  columnInfo.GetIsPartitionKey(),
+			ElementType:     columnInfo.GetElementType(),
+			IsClusteringKey: columnInfo.GetIsClusteringKey(),
+			Nullable:        true,
+		})
+	}
+	var walk func(expr *planpb.Expr)
+	walk = func(expr *planpb.Expr) {
+		switch e := expr.GetExpr().(type) {
+		case *planpb.Expr_UnaryExpr:
+			walk(e.UnaryExpr.GetChild())
+		case *planpb.Expr_BinaryExpr:
+			walk(e.BinaryExpr.GetLeft())
+			walk(e.BinaryExpr.GetRight())
+		case *planpb.Expr_TermExpr:
+			add(e.TermExpr.GetColumnInfo())
+		case *planpb.Expr_UnaryRangeExpr:
+			add(e.UnaryRangeExpr.GetColumnInfo())
+		case *planpb.Expr_BinaryRangeExpr:
+			add(e.BinaryRangeExpr.GetColumnInfo())
+		case *planpb.Expr_CompareExpr:
+			add(e.CompareExpr.GetLeftColumnInfo())
+			add(e.CompareExpr.GetRightColumnInfo())
+		case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+			add(e.BinaryArithOpEvalRangeExpr.GetColumnInfo())
+		case *planpb.Expr_JsonContainsExpr:
+			add(e.JsonContainsExpr.GetColumnInfo())
+		case *planpb.Expr_StringFunctionExpr:
+			add(e.StringFunctionExpr.GetColumnInfo())
+		case *planpb.Expr_ColumnExpr:
+			add(e.ColumnExpr.GetInfo())
+		case *planpb.Expr_BinaryArithExpr:
+			walk(e.BinaryArithExpr.GetLeft())
+			walk(e.BinaryArithExpr.GetRight())
+		case *planpb.Expr_ArithCompareExpr:
+			walk(e.ArithCompareExpr.GetLeft())
+			walk(e.ArithCompareExpr.GetRight())
+		}
+	}
+	walk(expr)
+	return columns
+}
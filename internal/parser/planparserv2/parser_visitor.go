@@ -50,6 +50,7 @@ func (v *ParserVisitor) translateIdentifier(identifier string) (*ExprWithType, e
 						IsPartitionKey:  field.IsPartitionKey,
 						IsClusteringKey: field.IsClusteringKey,
 						ElementType:     field.GetElementType(),
+						Nullable:        field.GetNullable(),
 					},
 				},
 			},
@@ -69,6 +70,11 @@ func (v *ParserVisitor) VisitIdentifier(ctx *parser.IdentifierContext) interface
 	return expr
 }
 
+// VisitTemplateVariable translates expr to a placeholder of value, which is filled when the plan is created.
+func (v *ParserVisitor) VisitTemplateVariable(ctx *parser.TemplateVariableContext) interface{} {
+	return newTemplateVariable(ctx.Identifier().GetText())
+}
+
 // VisitBoolean translates expr to GenericValue.
 func (v *ParserVisitor) VisitBoolean(ctx *parser.BooleanContext) interface{} {
 	literal := ctx.BooleanConstant().GetText()
@@ -503,10 +509,7 @@ func (v *ParserVisitor) VisitTerm(ctx *parser.TermContext) interface{} {
 		return fmt.Errorf("'term' can only be used on single field, but got: %s", ctx.Expr(0).GetText())
 	}
 
-	dataType := columnInfo.GetDataType()
-	if typeutil.IsArrayType(dataType) && len(columnInfo.GetNestedPath()) != 0 {
-		dataType = columnInfo.GetElementType()
-	}
+	dataType := getColumnDataType(columnInfo)
 	allExpr := ctx.AllExpr()
 	lenOfAllExpr := len(allExpr)
 	values := make([]*planpb.GenericValue, 0, lenOfAllExpr)
@@ -596,6 +599,50 @@ func (v *ParserVisitor) VisitEmptyTerm(ctx *parser.EmptyTermContext) interface{}
 	}
 }
 
+// VisitTemplateTerm translates expr to term plan whose values are filled when the plan is created.
+func (v *ParserVisitor) VisitTemplateTerm(ctx *parser.TemplateTermContext) interface{} {
+	child := ctx.Expr().Accept(v)
+	if err := getError(child); err != nil {
+		return err
+	}
+
+	if childValue := getGenericValue(child); childValue != nil {
+		return fmt.Errorf("'term' can only be used on non-const expression, but got: %s", ctx.Expr().GetText())
+	}
+
+	childExpr := getExpr(child)
+	columnInfo := toColumnInfo(childExpr)
+	if columnInfo == nil {
+		return fmt.Errorf("'term' can only be used on single field, but got: %s", ctx.Expr().GetText())
+	}
+	if err := checkDirectComparisonBinaryField(columnInfo); err != nil {
+		return err
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_TermExpr{
+			TermExpr: &planpb.TermExpr{
+				ColumnInfo:           columnInfo,
+				TemplateVariableName: ctx.Identifier().GetText(),
+			},
+		},
+	}
+	if ctx.GetOp().GetTokenType() == parser.PlanParserNIN {
+		expr = &planpb.Expr{
+			Expr: &planpb.Expr_UnaryExpr{
+				UnaryExpr: &planpb.UnaryExpr{
+					Op:    planpb.UnaryExpr_Not,
+					Child: expr,
+				},
+			},
+		}
+	}
+	return &ExprWithType{
+		expr:     expr,
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
 func (v *ParserVisitor) getChildColumnInfo(identifier, child antlr.TerminalNode) (*planpb.ColumnInfo, error) {
 	if identifier != nil {
 		childExpr, err := v.translateIdentifier(identifier.GetText())
@@ -630,12 +677,12 @@ func (v *ParserVisitor) VisitRange(ctx *parser.RangeContext) interface{} {
 		return err
 	}
 
-	lowerValue := getGenericValue(lower)
-	upperValue := getGenericValue(upper)
-	if lowerValue == nil {
+	lowerValue, lowerName := getGenericValue(lower), getTemplateVariableName(lower)
+	upperValue, upperName := getGenericValue(upper), getTemplateVariableName(upper)
+	if lowerValue == nil && lowerName == "" {
 		return fmt.Errorf("lowerbound cannot be a non-const expression: %s", ctx.Expr(0).GetText())
 	}
-	if upperValue == nil {
+	if upperValue == nil && upperName == "" {
 		return fmt.Errorf("upperbound cannot be a non-const expression: %s", ctx.Expr(1).GetText())
 	}
 
@@ -644,26 +691,17 @@ func (v *ParserVisitor) VisitRange(ctx *parser.RangeContext) interface{} {
 		fieldDataType = columnInfo.GetElementType()
 	}
 
-	switch fieldDataType {
-	case schemapb.DataType_String, schemapb.DataType_VarChar:
-		if !IsString(lowerValue) || !IsString(upperValue) {
-			return fmt.Errorf("invalid range operations")
-		}
-	case schemapb.DataType_Bool:
+	if fieldDataType == schemapb.DataType_Bool {
 		return fmt.Errorf("invalid range operations on boolean expr")
-	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
-		if !IsInteger(lowerValue) || !IsInteger(upperValue) {
-			return fmt.Errorf("invalid range operations")
-		}
-	case schemapb.DataType_Float, schemapb.DataType_Double:
-		if !IsNumber(lowerValue) || !IsNumber(upperValue) {
-			return fmt.Errorf("invalid range operations")
-		}
-		if IsInteger(lowerValue) {
-			lowerValue = NewFloat(float64(lowerValue.GetInt64Val()))
+	}
+	if lowerValue != nil {
+		if lowerValue, err = castRangeValue(fieldDataType, lowerValue); err != nil {
+			return err
 		}
-		if IsInteger(upperValue) {
-			upperValue = NewFloat(float64(upperValue.GetInt64Val()))
+	}
+	if upperValue != nil {
+		if upperValue, err = castRangeValue(fieldDataType, upperValue); err != nil {
+			return err
 		}
 	}
 
@@ -683,11 +721,13 @@ func (v *ParserVisitor) VisitRange(ctx *parser.RangeContext) interface{} {
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_BinaryRangeExpr{
 			BinaryRangeExpr: &planpb.BinaryRangeExpr{
-				ColumnInfo:     columnInfo,
-				LowerInclusive: lowerInclusive,
-				UpperInclusive: upperInclusive,
-				LowerValue:     lowerValue,
-				UpperValue:     upperValue,
+				ColumnInfo:                columnInfo,
+				LowerInclusive:            lowerInclusive,
+				UpperInclusive:            upperInclusive,
+				LowerValue:                lowerValue,
+				UpperValue:                upperValue,
+				LowerTemplateVariableName: lowerName,
+				UpperTemplateVariableName: upperName,
 			},
 		},
 	}
@@ -720,35 +760,26 @@ func (v *ParserVisitor) VisitReverseRange(ctx *parser.ReverseRangeContext) inter
 		return err
 	}
 
-	lowerValue := getGenericValue(lower)
-	upperValue := getGenericValue(upper)
-	if lowerValue == nil {
+	lowerValue, lowerName := getGenericValue(lower), getTemplateVariableName(lower)
+	upperValue, upperName := getGenericValue(upper), getTemplateVariableName(upper)
+	if lowerValue == nil && lowerName == "" {
 		return fmt.Errorf("lowerbound cannot be a non-const expression: %s", ctx.Expr(0).GetText())
 	}
-	if upperValue == nil {
+	if upperValue == nil && upperName == "" {
 		return fmt.Errorf("upperbound cannot be a non-const expression: %s", ctx.Expr(1).GetText())
 	}
 
-	switch columnInfo.GetDataType() {
-	case schemapb.DataType_String, schemapb.DataType_VarChar:
-		if !IsString(lowerValue) || !IsString(upperValue) {
-			return fmt.Errorf("invalid range operations")
-		}
-	case schemapb.DataType_Bool:
+	if columnInfo.GetDataType() == schemapb.DataType_Bool {
 		return fmt.Errorf("invalid range operations on boolean expr")
-	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
-		if !IsInteger(lowerValue) || !IsInteger(upperValue) {
-			return fmt.Errorf("invalid range operations")
-		}
-	case schemapb.DataType_Float, schemapb.DataType_Double:
-		if !IsNumber(lowerValue) || !IsNumber(upperValue) {
-			return fmt.Errorf("invalid range operations")
-		}
-		if IsInteger(lowerValue) {
-			lowerValue = NewFloat(float64(lowerValue.GetInt64Val()))
+	}
+	if lowerValue != nil {
+		if lowerValue, err = castRangeValue(columnInfo.GetDataType(), lowerValue); err != nil {
+			return err
 		}
-		if IsInteger(upperValue) {
-			upperValue = NewFloat(float64(upperValue.GetInt64Val()))
+	}
+	if upperValue != nil {
+		if upperValue, err = castRangeValue(columnInfo.GetDataType(), upperValue); err != nil {
+			return err
 		}
 	}
 
@@ -768,11 +799,13 @@ func (v *ParserVisitor) VisitReverseRange(ctx *parser.ReverseRangeContext) inter
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_BinaryRangeExpr{
 			BinaryRangeExpr: &planpb.BinaryRangeExpr{
-				ColumnInfo:     columnInfo,
-				LowerInclusive: lowerInclusive,
-				UpperInclusive: upperInclusive,
-				LowerValue:     lowerValue,
-				UpperValue:     upperValue,
+				ColumnInfo:                columnInfo,
+				LowerInclusive:            lowerInclusive,
+				UpperInclusive:            upperInclusive,
+				LowerValue:                lowerValue,
+				UpperValue:                upperValue,
+				LowerTemplateVariableName: lowerName,
+				UpperTemplateVariableName: upperName,
 			},
 		},
 	}
@@ -833,6 +866,54 @@ func (v *ParserVisitor) VisitUnary(ctx *parser.UnaryContext) interface{} {
 	}
 }
 
+// VisitCast translates the explicit cast, cast on constant is evaluated directly,
+// cast on column is recorded in the column info and evaluated by the execution backend.
+// The type name is an identifier checked here, so that fields named as the types are still allowed.
+func (v *ParserVisitor) VisitCast(ctx *parser.CastContext) interface{} {
+	typeName := ctx.Identifier().GetText()
+	targetType, ok := castTypeMap[strings.ToLower(typeName)]
+	if !ok {
+		return fmt.Errorf("unsupported cast type: %s, the supported types are: %s", typeName, supportedCastTypes)
+	}
+
+	child := ctx.Expr().Accept(v)
+	if err := getError(child); err != nil {
+		return err
+	}
+
+	if childValue := getGenericValue(child); childValue != nil {
+		value, err := castConstant(targetType, childValue)
+		if err != nil {
+			return err
+		}
+		return toValueExpr(value)
+	}
+
+	columnInfo := toColumnInfo(getExpr(child))
+	if columnInfo == nil {
+		return fmt.Errorf("cast is only supported on single field or constant, but got: %s", ctx.Expr().GetText())
+	}
+	if columnInfo.GetCastType() != schemapb.DataType_None {
+		return fmt.Errorf("nested cast is unsupported: %s", ctx.GetText())
+	}
+	casted, dataType, err := castColumn(columnInfo, targetType)
+	if err != nil {
+		return err
+	}
+
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_ColumnExpr{
+				ColumnExpr: &planpb.ColumnExpr{
+					Info: casted,
+				},
+			},
+		},
+		dataType:      dataType,
+		nodeDependent: true,
+	}
+}
+
 // VisitLogicalOr apply logical or to two boolean expressions.
 func (v *ParserVisitor) VisitLogicalOr(ctx *parser.LogicalOrContext) interface{} {
 	left := ctx.Expr(0).Accept(v)
@@ -1040,6 +1121,7 @@ func (v *ParserVisitor) getColumnInfoFromJSONIdentifier(identifier string) (*pla
 		DataType:    field.DataType,
 		NestedPath:  nestedPath,
 		ElementType: field.GetElementType(),
+		Nullable:    field.GetNullable(),
 	}, nil
 }
 
@@ -1057,6 +1139,7 @@ func (v *ParserVisitor) VisitJSONIdentifier(ctx *parser.JSONIdentifierContext) i
 						DataType:    field.GetDataType(),
 						NestedPath:  field.GetNestedPath(),
 						ElementType: field.GetElementType(),
+						Nullable:    field.GetNullable(),
 					},
 				},
 			},
@@ -1340,3 +1423,76 @@ func (v *ParserVisitor) VisitArrayLength(ctx *parser.ArrayLengthContext) interfa
 		nodeDependent: true,
 	}
 }
+
+// VisitCall translates the function call to plan, only string functions are supported now.
+func (v *ParserVisitor) VisitCall(ctx *parser.CallContext) interface{} {
+	funcName := strings.ToLower(ctx.Identifier().GetText())
+	argCnt, ok := stringFunctionArgCnt[funcName]
+	if !ok {
+		return fmt.Errorf("function %s is not supported", ctx.Identifier().GetText())
+	}
+	allExpr := ctx.AllExpr()
+	if len(allExpr) != argCnt {
+		return fmt.Errorf("%s function takes %d arguments, but got %d: %s", funcName, argCnt, len(allExpr), ctx.GetText())
+	}
+
+	var arg *planpb.GenericValue
+	if argCnt > 1 {
+		child := allExpr[1].Accept(v)
+		if err := getError(child); err != nil {
+			return err
+		}
+		arg = getGenericValue(child)
+		if arg == nil || !IsString(arg) {
+			return fmt.Errorf("the second argument of %s function should be a string, got: %s", funcName, allExpr[1].GetText())
+		}
+	}
+
+	child := allExpr[0].Accept(v)
+	if err := getError(child); err != nil {
+		return err
+	}
+	if childValue := getGenericValue(child); childValue != nil {
+		if !IsString(childValue) {
+			return fmt.Errorf("%s function can only be applied to string, got: %s", funcName, allExpr[0].GetText())
+		}
+		ret, err := evalStringFunction(funcName, childValue.GetStringVal(), arg.GetStringVal())
+		if err != nil {
+			return err
+		}
+		return ret
+	}
+
+	column := toColumnInfo(getExpr(child))
+	if err := checkStringFunctionColumn(funcName, column); err != nil {
+		return err
+	}
+	ret, err := translateStringFunction(funcName, column, arg)
+	if err != nil {
+		return err
+	}
+	return ret
+}
+
+// VisitIsNull translates `expr is null` and `expr is not null` to null expr.
+func (v *ParserVisitor) VisitIsNull(ctx *parser.IsNullContext) interface{} {
+	op := planpb.NullExpr_IsNull
+	if not := ctx.NOT(); not != nil {
+		if not.GetText() == "!" {
+			return fmt.Errorf("invalid null test, should be like `expr is not null`, but got: %s", ctx.GetText())
+		}
+		op = planpb.NullExpr_IsNotNull
+	}
+	child := ctx.Expr().Accept(v)
+	if err := getError(child); err != nil {
+		return err
+	}
+	if getGenericValue(child) != nil {
+		return fmt.Errorf("null test can only be applied on field, but got: %s", ctx.Expr().GetText())
+	}
+	ret, err := translateNullExpr(getExpr(child), op, ctx.Expr().GetText())
+	if err != nil {
+		return err
+	}
+	return ret
+}
@@ -55,6 +55,24 @@ func handleExprWithErrorListener(schema *typeutil.SchemaHelper, exprStr string,
 }
 
 func ParseExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, error) {
+	return ParseExprWithValues(schema, exprStr, nil)
+}
+
+// ParseExprWithValues parses the expression and fills the template variables in it with the values.
+func ParseExprWithValues(schema *typeutil.SchemaHelper, exprStr string, values map[string]*planpb.GenericValue) (*planpb.Expr, error) {
+	expr, err := ParseExprTemplate(schema, exprStr)
+	if err != nil {
+		return nil, err
+	}
+	if err := FillExpressionValue(expr, values); err != nil {
+		return nil, fmt.Errorf("cannot parse expression: %s, error: %s", exprStr, err)
+	}
+	return expr, nil
+}
+
+// ParseExprTemplate parses the expression which may contain template variables, the returned expression
+// can be reused and must be filled by FillExpressionValue before it's executed.
+func ParseExprTemplate(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, error) {
 	ret := handleExpr(schema, exprStr)
 
 	if err := getError(ret); err != nil {
@@ -68,8 +86,11 @@ func ParseExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, err
 	if !canBeExecuted(predicate) {
 		return nil, fmt.Errorf("predicate is not a boolean expression: %s, data type: %s", exprStr, predicate.dataType)
 	}
+	if err := checkCastColumns(predicate.expr); err != nil {
+		return nil, fmt.Errorf("cannot parse expression: %s, error: %s", exprStr, err)
+	}
 
-	return predicate.expr, nil
+	return applyThreeValuedLogic(predicate.expr), nil
 }
 
 func ParseIdentifier(schema *typeutil.SchemaHelper, identifier string, checkFunc func(*planpb.Expr) error) error {
@@ -95,7 +116,11 @@ func CreateRetrievePlan(schema *typeutil.SchemaHelper, exprStr string) (*planpb.
 	if err != nil {
 		return nil, err
 	}
+	return CreateRetrievePlanByExpr(expr), nil
+}
 
+// CreateRetrievePlanByExpr creates the retrieve plan with the parsed expression.
+func CreateRetrievePlanByExpr(expr *planpb.Expr) *planpb.PlanNode {
 	planNode := &planpb.PlanNode{
 		Node: &planpb.PlanNode_Query{
 			Query: &planpb.QueryPlanNode{
@@ -103,7 +128,7 @@ func CreateRetrievePlan(schema *typeutil.SchemaHelper, exprStr string) (*planpb.
 			},
 		},
 	}
-	return planNode, nil
+	return planNode
 }
 
 func CreateSearchPlan(schema *typeutil.SchemaHelper, exprStr string, vectorFieldName string, queryInfo *planpb.QueryInfo) (*planpb.PlanNode, error) {
@@ -119,6 +144,11 @@ func CreateSearchPlan(schema *typeutil.SchemaHelper, exprStr string, vectorField
 		log.Info("CreateSearchPlan failed", zap.Error(err))
 		return nil, err
 	}
+	return CreateSearchPlanByExpr(schema, expr, vectorFieldName, queryInfo)
+}
+
+// CreateSearchPlanByExpr creates the search plan with the parsed expression, nil expr means no filter.
+func CreateSearchPlanByExpr(schema *typeutil.SchemaHelper, expr *planpb.Expr, vectorFieldName string, queryInfo *planpb.QueryInfo) (*planpb.PlanNode, error) {
 	vectorField, err := schema.GetFieldFromName(vectorFieldName)
 	if err != nil {
 		log.Info("CreateSearchPlan failed", zap.Error(err))
@@ -7,6 +7,7 @@ import (
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
@@ -773,7 +774,6 @@ func Test_InvalidExprOnJSONField(t *testing.T) {
 		`A["[""B""]"] > 10`,
 		`A[[""B""]] > 10`,
 		`A[B] > 10`,
-		`A + B == 3.3`,
 	}
 
 	for _, expr = range exprs {
@@ -1269,3 +1269,443 @@ func Test_ArrayLength(t *testing.T) {
 		assert.Error(t, err, expr)
 	}
 }
+
+func Test_StringFunction(t *testing.T) {
+	schema := newTestSchemaHelper(t)
+
+	exprs := []string{
+		`lower(VarCharField) == "abc"`,
+		`UPPER(VarCharField) != "ABC"`,
+		`"abc" == lower(StringField)`,
+		`lower(JSONField["A"]) == "abc"`,
+		`upper(A) > "ABC"`,
+		`length(VarCharField) > 10`,
+		`length($meta["A"]) <= 10`,
+		`starts_with(VarCharField, "abc")`,
+		`STARTS_WITH(VarCharField, "abc")`,
+		`ends_with(JSONField["A"], "abc")`,
+		`not starts_with(B, "abc")`,
+		`regex_match(VarCharField, "^a.*c$")`,
+		`regex_match(A, "[0-9]+") and length(A) == 3`,
+		`lower("ABC") == "abc" and Int64Field > 1`,
+		`VarCharField == upper("abc")`,
+		`Int64Field > length("abc")`,
+		`regex_match("abc", "^a.*c$") and Int64Field > 1`,
+		`starts_with("abc", "a")`,
+	}
+	for _, expr := range exprs {
+		_, err := CreateSearchPlan(schema, expr, "FloatVectorField", &planpb.QueryInfo{
+			Topk:         0,
+			MetricType:   "",
+			SearchParams: "",
+			RoundDecimal: 0,
+		})
+		assert.NoError(t, err, expr)
+	}
+
+	invalidExprs := []string{
+		`lower(VarCharField)`,
+		`length(VarCharField)`,
+		`unknown(VarCharField) == "abc"`,
+		`lower(VarCharField, "a") == "abc"`,
+		`starts_with(VarCharField)`,
+		`starts_with(VarCharField, 1)`,
+		`starts_with(VarCharField, StringField)`,
+		`lower(Int64Field) == "abc"`,
+		`lower(ArrayField) == "abc"`,
+		`lower(VarCharField) == 1`,
+		`length(VarCharField) == "abc"`,
+		`lower(VarCharField) == StringField`,
+		`lower(VarCharField + 1) == "abc"`,
+		`regex_match(VarCharField, "(")`,
+		`regex_match(VarCharField, "a") == true`,
+		`lower(1) == "1"`,
+	}
+	for _, expr := range invalidExprs {
+		_, err := CreateSearchPlan(schema, expr, "FloatVectorField", &planpb.QueryInfo{
+			Topk:         0,
+			MetricType:   "",
+			SearchParams: "",
+			RoundDecimal: 0,
+		})
+		assert.Error(t, err, expr)
+	}
+
+	expr, err := ParseExpr(schema, `lower(VarCharField) == "abc"`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.StringFunctionExpr_Lower, expr.GetStringFunctionExpr().GetFunction())
+	assert.Equal(t, planpb.OpType_Equal, expr.GetStringFunctionExpr().GetOp())
+	assert.Equal(t, "abc", expr.GetStringFunctionExpr().GetValue().GetStringVal())
+
+	expr, err = ParseExpr(schema, `ends_with(VarCharField, "abc")`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.OpType_PostfixMatch, expr.GetUnaryRangeExpr().GetOp())
+}
+
+func Test_Cast(t *testing.T) {
+	schema := newTestSchemaHelper(t)
+
+	exprs := []string{
+		`JSONField["age"] > (int64) "30"`,
+		`Int64Field == (int64) 3.7`,
+		`Int64Field == (INT64) 3.7`,
+		`DoubleField > (double) "1e3"`,
+		`VarCharField == (varchar) 100`,
+		`BoolField == (bool) "true"`,
+		`A in [(int64) "1", 2]`,
+		`Int64Field + 1 == (int64) (double) 2`,
+		`(Int64Field) - 1 == 2`,
+		`int64 > 1`,
+		`JSONField["int64"] == (int64) 1.0`,
+		`(int64) JSONField["age"] > 30`,
+		`(int64) A in [1, 2, 3]`,
+		`(double) $meta["score"] <= 0.5`,
+		`(double) Int64Field > 1.5`,
+		`(int64) FloatField == 1`,
+		`(int64) Int8Field == 1`,
+		`(varchar) JSONField["name"] == "abc"`,
+		`(varchar) A like "abc%"`,
+		`(bool) JSONField["flag"] == true`,
+		`(int64) ArrayField[0] > 1`,
+		`not ((int64) A > 1)`,
+	}
+	for _, expr := range exprs {
+		_, err := CreateSearchPlan(schema, expr, "FloatVectorField", &planpb.QueryInfo{
+			Topk:         0,
+			MetricType:   "",
+			SearchParams: "",
+			RoundDecimal: 0,
+		})
+		assert.NoError(t, err, expr)
+	}
+
+	invalidExprs := []string{
+		`(int64) JSONField["age"] > "30"`,
+		`(varchar) A > 30`,
+		`(int64) A in ["a"]`,
+		`(int64) JSONField > 1`,
+		`(varchar) Int64Field == "1"`,
+		`(int64) VarCharField == 1`,
+		`(int64) BoolField == 1`,
+		`(bool) Int64Field == true`,
+		`(int64) ArrayField == 1`,
+		`(double) ArrayField[0] > 1`,
+		`(int64) (double) A == 1`,
+		`((int64) A) + 1 == 2`,
+		`1 < (int64) A < 3`,
+		`(int64) A > Int64Field`,
+		`(int64) (A + 1) == 1`,
+		`(int64) "abc" == 1`,
+		`(bool) 1 == true`,
+		`(double) true == 1.0`,
+		`(int32) A == 1`,
+		`(Int64Field) 1 == 1`,
+		`(int64) == 1`,
+		`(int64) (== 1`,
+	}
+	for _, expr := range invalidExprs {
+		_, err := CreateSearchPlan(schema, expr, "FloatVectorField", &planpb.QueryInfo{
+			Topk:         0,
+			MetricType:   "",
+			SearchParams: "",
+			RoundDecimal: 0,
+		})
+		assert.Error(t, err, expr)
+	}
+
+	expr, err := ParseExpr(schema, `JSONField["age"] > (int64) "30"`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30), expr.GetUnaryRangeExpr().GetValue().GetInt64Val())
+
+	expr, err = ParseExpr(schema, `(int64) JSONField["age"] > 30`)
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int64, expr.GetUnaryRangeExpr().GetColumnInfo().GetCastType())
+	assert.Equal(t, schemapb.DataType_JSON, expr.GetUnaryRangeExpr().GetColumnInfo().GetDataType())
+
+	// the cast between integers doesn't change the values and isn't recorded
+	expr, err = ParseExpr(schema, `(int64) Int8Field == 1`)
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_None, expr.GetUnaryRangeExpr().GetColumnInfo().GetCastType())
+
+	_, err = ParseExpr(schema, `(int64) (A + 1) > 1`)
+	assert.ErrorContains(t, err, "cast is only supported on single field or constant")
+
+	_, err = ParseExpr(schema, `((int64) A) + 1 == 2`)
+	assert.ErrorContains(t, err, "cast on field is only supported")
+
+	_, err = ParseExpr(schema, `(int32) 1 > A`)
+	assert.ErrorContains(t, err, "unsupported cast type")
+
+	_, err = ParseExpr(schema, `(int64) (== 1`)
+	assert.ErrorContains(t, err, "invalid cast expression")
+}
+
+func newNullableTestSchemaHelper(t *testing.T) *typeutil.SchemaHelper {
+	schema := newTestSchema()
+	schema.Fields = append(schema.Fields,
+		&schemapb.FieldSchema{FieldID: 140, Name: "NullableInt64Field", DataType: schemapb.DataType_Int64, Nullable: true},
+		&schemapb.FieldSchema{FieldID: 141, Name: "NullableVarCharField", DataType: schemapb.DataType_VarChar, Nullable: true},
+		&schemapb.FieldSchema{FieldID: 142, Name: "NullableJSONField", DataType: schemapb.DataType_JSON, Nullable: true},
+	)
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	require.NoError(t, err)
+	return helper
+}
+
+func Test_NullExpr(t *testing.T) {
+	schema := newNullableTestSchemaHelper(t)
+
+	exprs := []string{
+		`NullableInt64Field is null`,
+		`NullableInt64Field IS NOT NULL`,
+		`NullableInt64Field Is Not Null`,
+		`(NullableInt64Field) is null`,
+		`NullableVarCharField is not null && NullableVarCharField like "abc%"`,
+		`NullableJSONField["a"] is null`,
+		`JSONField["a"]["b"] is not null`,
+		`$meta["a"] is null`,
+		`A is null`,
+		`Int64Field is not null`,
+		`ArrayField is null`,
+		`not (NullableInt64Field is null)`,
+	}
+	for _, expr := range exprs {
+		_, err := CreateSearchPlan(schema, expr, "FloatVectorField", &planpb.QueryInfo{})
+		assert.NoError(t, err, expr)
+	}
+
+	invalidExprs := []string{
+		`FloatVectorField is null`,
+		`1 is null`,
+		`NullableInt64Field + 1 is null`,
+		`ArrayField[0] is null`,
+		`NullableInt64Field is`,
+		`NullableInt64Field is ! null`,
+		`NullableInt64Field isnull`,
+	}
+	for _, expr := range invalidExprs {
+		_, err := CreateSearchPlan(schema, expr, "FloatVectorField", &planpb.QueryInfo{})
+		assert.Error(t, err, expr)
+	}
+
+	expr, err := ParseExpr(schema, `NullableJSONField["a"] is not null`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNotNull, expr.GetNullExpr().GetOp())
+	assert.Equal(t, []string{"a"}, expr.GetNullExpr().GetColumnInfo().GetNestedPath())
+	assert.True(t, expr.GetNullExpr().GetColumnInfo().GetNullable())
+
+	expr, err = ParseExpr(schema, `not (NullableInt64Field is null)`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNotNull, expr.GetNullExpr().GetOp())
+}
+
+func Test_ThreeValuedLogic(t *testing.T) {
+	schema := newNullableTestSchemaHelper(t)
+
+	// predicates without nullable fields are not changed
+	expr, err := ParseExpr(schema, `not (Int64Field > 1)`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.UnaryExpr_Not, expr.GetUnaryExpr().GetOp())
+	assert.NotNil(t, expr.GetUnaryExpr().GetChild().GetUnaryRangeExpr())
+
+	// negated leaf is combined with is not null
+	for _, exprStr := range []string{
+		`not (NullableInt64Field > 1)`,
+		`NullableInt64Field not in [1, 2]`,
+		`not (NullableVarCharField like "abc%")`,
+	} {
+		expr, err = ParseExpr(schema, exprStr)
+		assert.NoError(t, err, exprStr)
+		binary := expr.GetBinaryExpr()
+		assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binary.GetOp(), exprStr)
+		assert.Equal(t, planpb.UnaryExpr_Not, binary.GetLeft().GetUnaryExpr().GetOp(), exprStr)
+		assert.Equal(t, planpb.NullExpr_IsNotNull, binary.GetRight().GetNullExpr().GetOp(), exprStr)
+	}
+
+	// not (a > 1 and b > 1) => (not a > 1 and a is not null) or not b > 1
+	expr, err = ParseExpr(schema, `not (NullableInt64Field > 1 and Int64Field > 1)`)
+	assert.NoError(t, err)
+	binary := expr.GetBinaryExpr()
+	assert.Equal(t, planpb.BinaryExpr_LogicalOr, binary.GetOp())
+	assert.Equal(t, planpb.NullExpr_IsNotNull, binary.GetLeft().GetBinaryExpr().GetRight().GetNullExpr().GetOp())
+	assert.Equal(t, planpb.UnaryExpr_Not, binary.GetRight().GetUnaryExpr().GetOp())
+
+	// leaf is combined with is not null since the null rows hold the default value
+	for _, exprStr := range []string{
+		`NullableInt64Field > 1`,
+		`NullableInt64Field == 0`,
+		`not (not (NullableInt64Field > 1))`,
+	} {
+		expr, err = ParseExpr(schema, exprStr)
+		assert.NoError(t, err, exprStr)
+		binary := expr.GetBinaryExpr()
+		assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binary.GetOp(), exprStr)
+		assert.NotNil(t, binary.GetLeft().GetUnaryRangeExpr(), exprStr)
+		assert.Equal(t, planpb.NullExpr_IsNotNull, binary.GetRight().GetNullExpr().GetOp(), exprStr)
+	}
+
+	// the null test on a json path guards the whole field
+	expr, err = ParseExpr(schema, `not (NullableJSONField["a"] > 1)`)
+	assert.NoError(t, err)
+	assert.Empty(t, expr.GetBinaryExpr().GetRight().GetNullExpr().GetColumnInfo().GetNestedPath())
+}
+
+func Test_TemplateExpr(t *testing.T) {
+	schema := newTestSchemaHelper(t)
+
+	values, err := UnmarshalExpressionValues([]byte(`{"ids": [1, 2, 3], "age": 18, "score": 1.5, "name": "abc", "flag": true, "mixed": [1, "a"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(18), values["age"].GetInt64Val())
+	assert.Equal(t, 1.5, values["score"].GetFloatVal())
+	assert.Equal(t, "abc", values["name"].GetStringVal())
+	assert.True(t, values["flag"].GetBoolVal())
+	assert.Equal(t, schemapb.DataType_Int64, values["ids"].GetArrayVal().GetElementType())
+	assert.False(t, values["mixed"].GetArrayVal().GetSameType())
+
+	exprs := []string{
+		`Int64Field in {ids}`,
+		`Int64Field not in {ids}`,
+		`Int64Field > {age}`,
+		`{age} <= Int64Field`,
+		`DoubleField == {age}`,
+		`VarCharField != {name}`,
+		`BoolField == {flag}`,
+		`{age} < Int64Field < 100`,
+		`{score} > DoubleField >= {age}`,
+		`{age} < ArrayField[0] < {age}`,
+		`{name} <= StringArrayField[0] < {name}`,
+		`JSONField["a"] > {score} and Int64Field in {ids}`,
+	}
+	for _, exprStr := range exprs {
+		expr, err := ParseExprTemplate(schema, exprStr)
+		require.NoError(t, err, exprStr)
+		assert.NoError(t, FillExpressionValue(expr, values), exprStr)
+		_, err = ParseExprWithValues(schema, exprStr, values)
+		assert.NoError(t, err, exprStr)
+		// the values must be bound
+		_, err = ParseExpr(schema, exprStr)
+		assert.Error(t, err, exprStr)
+	}
+
+	expr, err := ParseExprWithValues(schema, `Int64Field in {ids}`, values)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(expr.GetTermExpr().GetValues()))
+
+	expr, err = ParseExprWithValues(schema, `DoubleField > {age}`, values)
+	require.NoError(t, err)
+	assert.Equal(t, float64(18), expr.GetUnaryRangeExpr().GetValue().GetFloatVal())
+
+	expr, err = ParseExprWithValues(schema, `{age} < Int64Field`, values)
+	require.NoError(t, err)
+	assert.Equal(t, planpb.OpType_GreaterThan, expr.GetUnaryRangeExpr().GetOp())
+
+	// the template is reusable
+	template, err := ParseExprTemplate(schema, `Int64Field < {age}`)
+	require.NoError(t, err)
+	for _, age := range []int64{1, 2} {
+		expr := proto.Clone(template).(*planpb.Expr)
+		require.NoError(t, FillExpressionValue(expr, map[string]*planpb.GenericValue{"age": NewInt(age)}))
+		assert.Equal(t, age, expr.GetUnaryRangeExpr().GetValue().GetInt64Val())
+	}
+
+	invalidExprs := []string{
+		`Int64Field in {score}`,
+		`Int64Field in {age}`,
+		`Int64Field > {name}`,
+		`Int64Field > {mixed}`,
+		`VarCharField in {ids}`,
+		`{score} < Int64Field < 100`,
+		`{age} < StringArrayField[0] < {age}`,
+		`{flag} < BoolField < {flag}`,
+		`Int64Field > {not_exist}`,
+		`{age} == {age}`,
+		`Int64Field + 1 > {age}`,
+		`{age}`,
+		`FloatVectorField > {age}`,
+	}
+	for _, exprStr := range invalidExprs {
+		_, err := ParseExprWithValues(schema, exprStr, values)
+		assert.Error(t, err, exprStr)
+	}
+
+	for _, data := range []string{`[1, 2]`, `{"a": {"b": 1}}`, `{"a": [[1]]}`, `{"a": null}`} {
+		_, err := UnmarshalExpressionValues([]byte(data))
+		assert.Error(t, err, data)
+	}
+}
+
+func Test_ArithCompareExpr(t *testing.T) {
+	schema := newTestSchemaHelper(t)
+
+	exprs := []string{
+		`Int64Field * Int32Field > Int16Field`,
+		`Int64Field - Int32Field < 3600`,
+		`3600 > Int64Field - Int32Field`,
+		`Int8Field + Int16Field + Int32Field == Int64Field`,
+		`(Int64Field + 1) * (Int32Field - 1) >= 10`,
+		`Int64Field % Int32Field == 0`,
+		`FloatField * DoubleField != 1`,
+		`Int64Field / FloatField <= 1.5`,
+		`A + B == 3.3`,
+		`JSONField["a"] - JSONField["b"] > Int64Field`,
+		`Int64Field * 2 > Int32Field`,
+		`not (Int64Field + Int32Field > 1)`,
+	}
+	for _, exprStr := range exprs {
+		expr, err := ParseExpr(schema, exprStr)
+		assert.NoError(t, err, exprStr)
+		if expr.GetUnaryExpr() == nil {
+			assert.NotNil(t, expr.GetArithCompareExpr(), exprStr)
+		}
+	}
+
+	computeType := func(exprStr string) schemapb.DataType {
+		expr, err := ParseExpr(schema, exprStr)
+		require.NoError(t, err, exprStr)
+		return expr.GetArithCompareExpr().GetComputeType()
+	}
+	// integers of any width are evaluated in int64, so Int8Field + Int8Field never overflows
+	// while the overflow of int64 wraps around.
+	assert.Equal(t, schemapb.DataType_Int64, computeType(`Int8Field + Int8Field > 200`))
+	assert.Equal(t, schemapb.DataType_Int64, computeType(`Int64Field * Int64Field > 0`))
+	// division of integers truncates toward zero
+	assert.Equal(t, schemapb.DataType_Int64, computeType(`Int64Field / Int32Field == 0`))
+	// floating operands or constants promote the computation to double
+	assert.Equal(t, schemapb.DataType_Double, computeType(`Int64Field / Int32Field == 0.5`))
+	assert.Equal(t, schemapb.DataType_Double, computeType(`FloatField + Int64Field > 1`))
+	// json values are evaluated in double
+	assert.Equal(t, schemapb.DataType_Double, computeType(`A + Int64Field > 1`))
+
+	// a single field with constants keeps the range plan
+	expr, err := ParseExpr(schema, `Int64Field + 1 > 2`)
+	require.NoError(t, err)
+	assert.NotNil(t, expr.GetBinaryArithOpEvalRangeExpr())
+	// comparison between two fields keeps the compare plan
+	expr, err = ParseExpr(schema, `Int64Field > Int32Field`)
+	require.NoError(t, err)
+	assert.NotNil(t, expr.GetCompareExpr())
+
+	expr, err = ParseExpr(schema, `Int64Field - Int32Field < 3600`)
+	require.NoError(t, err)
+	arith := expr.GetArithCompareExpr()
+	assert.Equal(t, planpb.OpType_LessThan, arith.GetOp())
+	assert.Equal(t, planpb.ArithOpType_Sub, arith.GetLeft().GetBinaryArithExpr().GetOp())
+	assert.Equal(t, int64(3600), arith.GetRight().GetValueExpr().GetValue().GetInt64Val())
+
+	invalidExprs := []string{
+		`Int64Field % FloatField == 0`,
+		`Int64Field % A == 0`,
+		`Int64Field + VarCharField > 1`,
+		`Int64Field + Int32Field > "abc"`,
+		`Int64Field + Int32Field > VarCharField`,
+		`Int64Field + BoolField > 1`,
+		`ArrayField + Int64Field > 1`,
+		`array_length(ArrayField) + Int64Field > 1`,
+		`ArrayField[0] + Int64Field > 1`,
+		`(double) Int64Field + Int32Field > 1`,
+		`Int64Field + Int32Field`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, schema, exprStr)
+	}
+}
@@ -0,0 +1,151 @@
+package planparserv2
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	lowerFunction      = "lower"
+	upperFunction      = "upper"
+	lengthFunction     = "length"
+	startsWithFunction = "starts_with"
+	endsWithFunction   = "ends_with"
+	regexMatchFunction = "regex_match"
+)
+
+// stringFunctionArgCnt is the number of arguments of each supported string function, including the column.
+var stringFunctionArgCnt = map[string]int{
+	lowerFunction:      1,
+	upperFunction:      1,
+	lengthFunction:     1,
+	startsWithFunction: 2,
+	endsWithFunction:   2,
+	regexMatchFunction: 2,
+}
+
+var stringFunctionTypeMap = map[string]planpb.StringFunctionExpr_FunctionType{
+	lowerFunction:      planpb.StringFunctionExpr_Lower,
+	upperFunction:      planpb.StringFunctionExpr_Upper,
+	lengthFunction:     planpb.StringFunctionExpr_Length,
+	regexMatchFunction: planpb.StringFunctionExpr_RegexMatch,
+}
+
+// evalStringFunction evaluates the string function on a constant string.
+func evalStringFunction(funcName string, value string, arg string) (*ExprWithType, error) {
+	switch funcName {
+	case lowerFunction:
+		return toValueExpr(NewString(strings.ToLower(value))), nil
+	case upperFunction:
+		return toValueExpr(NewString(strings.ToUpper(value))), nil
+	case lengthFunction:
+		return toValueExpr(NewInt(int64(utf8.RuneCountInString(value)))), nil
+	case startsWithFunction:
+		return toValueExpr(NewBool(strings.HasPrefix(value, arg))), nil
+	case endsWithFunction:
+		return toValueExpr(NewBool(strings.HasSuffix(value, arg))), nil
+	case regexMatchFunction:
+		re, err := regexp.Compile(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex pattern: %s, err: %w", arg, err)
+		}
+		return toValueExpr(NewBool(re.MatchString(value))), nil
+	default:
+		return nil, fmt.Errorf("function %s is not supported", funcName)
+	}
+}
+
+func checkStringFunctionColumn(funcName string, column *planpb.ColumnInfo) error {
+	if column == nil {
+		return fmt.Errorf("%s function on complicated expr is unsupported", funcName)
+	}
+	if !typeutil.IsStringType(column.GetDataType()) && !typeutil.IsJSONType(column.GetDataType()) {
+		return fmt.Errorf("%s function on non-string or non-json field is unsupported, got: %s",
+			funcName, column.GetDataType())
+	}
+	return nil
+}
+
+// translateStringFunction translates the string function on a column to plan.
+// starts_with and ends_with are translated to prefix and postfix match, the same as like does.
+func translateStringFunction(funcName string, column *planpb.ColumnInfo, arg *planpb.GenericValue) (*ExprWithType, error) {
+	switch funcName {
+	case startsWithFunction, endsWithFunction:
+		op := planpb.OpType_PrefixMatch
+		if funcName == endsWithFunction {
+			op = planpb.OpType_PostfixMatch
+		}
+		return &ExprWithType{
+			expr: &planpb.Expr{
+				Expr: &planpb.Expr_UnaryRangeExpr{
+					UnaryRangeExpr: &planpb.UnaryRangeExpr{
+						ColumnInfo: column,
+						Op:         op,
+						Value:      arg,
+					},
+				},
+			},
+			dataType: schemapb.DataType_Bool,
+		}, nil
+	case regexMatchFunction:
+		if _, err := regexp.Compile(arg.GetStringVal()); err != nil {
+			return nil, fmt.Errorf("invalid regex pattern: %s, err: %w", arg.GetStringVal(), err)
+		}
+		return &ExprWithType{
+			expr: &planpb.Expr{
+				Expr: &planpb.Expr_StringFunctionExpr{
+					StringFunctionExpr: &planpb.StringFunctionExpr{
+						ColumnInfo: column,
+						Function:   planpb.StringFunctionExpr_RegexMatch,
+						Arguments:  []*planpb.GenericValue{arg},
+					},
+				},
+			},
+			dataType: schemapb.DataType_Bool,
+		}, nil
+	case lowerFunction, upperFunction, lengthFunction:
+		// the result must be compared with a value to be a predicate, e.g. lower(name) == "abc"
+		dataType := schemapb.DataType_VarChar
+		if funcName == lengthFunction {
+			dataType = schemapb.DataType_Int64
+		}
+		return &ExprWithType{
+			expr: &planpb.Expr{
+				Expr: &planpb.Expr_StringFunctionExpr{
+					StringFunctionExpr: &planpb.StringFunctionExpr{
+						ColumnInfo: column,
+						Function:   stringFunctionTypeMap[funcName],
+					},
+				},
+			},
+			dataType:      dataType,
+			nodeDependent: true,
+		}, nil
+	default:
+		return nil, fmt.Errorf("function %s is not supported", funcName)
+	}
+}
+
+// combineStringFunctionExpr compares the result of the string function with the value.
+func combineStringFunctionExpr(op planpb.OpType, funcExpr *planpb.StringFunctionExpr, value *planpb.GenericValue) (*planpb.Expr, error) {
+	if funcExpr.GetFunction() == planpb.StringFunctionExpr_RegexMatch {
+		return nil, fmt.Errorf("the result of regex_match can't be compared")
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_StringFunctionExpr{
+			StringFunctionExpr: &planpb.StringFunctionExpr{
+				ColumnInfo: funcExpr.GetColumnInfo(),
+				Function:   funcExpr.GetFunction(),
+				Arguments:  funcExpr.GetArguments(),
+				Op:         op,
+				Value:      value,
+			},
+		},
+	}, nil
+}
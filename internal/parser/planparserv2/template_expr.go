@@ -0,0 +1,257 @@
+package planparserv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// A template variable, written as `{name}`, is a placeholder of a constant in the expression, e.g. `id in {ids} and age > {min_age}`.
+// The expression is parsed once into a plan template in which the placeholders are recorded by name, and the template
+// is filled with the values bound to the request by FillExpressionValue, so that the same template can be reused.
+// A template variable can be used as the value list of term, an operand of comparison with a single field,
+// and the bounds of range.
+
+func newTemplateVariable(name string) *ExprWithType {
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_ValueExpr{
+				ValueExpr: &planpb.ValueExpr{
+					TemplateVariableName: name,
+				},
+			},
+		},
+		dataType:      schemapb.DataType_None,
+		nodeDependent: true,
+	}
+}
+
+func getTemplateVariableName(obj interface{}) string {
+	expr := getExpr(obj)
+	if expr == nil {
+		return ""
+	}
+	return expr.expr.GetValueExpr().GetTemplateVariableName()
+}
+
+// handleCompareTemplate translates the comparison between a single field and a template variable.
+func handleCompareTemplate(op planpb.OpType, left, right *ExprWithType) (*planpb.Expr, error) {
+	column, name := left, getTemplateVariableName(right)
+	if name == "" {
+		reversed, err := reverseOrder(op)
+		if err != nil {
+			return nil, err
+		}
+		op, column, name = reversed, right, getTemplateVariableName(left)
+	}
+	if getTemplateVariableName(column) != "" {
+		return nil, fmt.Errorf("comparison between template variables is not supported")
+	}
+	if column.expr.GetBinaryArithExpr() != nil || column.expr.GetStringFunctionExpr() != nil {
+		return nil, fmt.Errorf("template variable {%s} can only be compared with a single field", name)
+	}
+	columnInfo := toColumnInfo(column)
+	if columnInfo == nil {
+		return nil, fmt.Errorf("template variable {%s} can only be compared with a single field", name)
+	}
+	if typeutil.IsVectorType(columnInfo.GetDataType()) {
+		return nil, fmt.Errorf("template variable {%s} cannot be compared with vector field", name)
+	}
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("unsupported op type: %s", op)
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo:           columnInfo,
+				Op:                   op,
+				TemplateVariableName: name,
+			},
+		},
+	}, nil
+}
+
+// castRangeValue checks the bound of range against the data type of the field.
+func castRangeValue(dataType schemapb.DataType, value *planpb.GenericValue) (*planpb.GenericValue, error) {
+	switch dataType {
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		if !IsString(value) {
+			return nil, fmt.Errorf("invalid range operations")
+		}
+	case schemapb.DataType_Bool:
+		return nil, fmt.Errorf("invalid range operations on boolean expr")
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		if !IsInteger(value) {
+			return nil, fmt.Errorf("invalid range operations")
+		}
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		if !IsNumber(value) {
+			return nil, fmt.Errorf("invalid range operations")
+		}
+		if IsInteger(value) {
+			return NewFloat(float64(value.GetInt64Val())), nil
+		}
+	}
+	return value, nil
+}
+
+// FillExpressionValue fills the template variables in the expression with the values, the expression is modified in place.
+// It returns an error if the value of a template variable is not provided or doesn't match the field.
+func FillExpressionValue(expr *planpb.Expr, values map[string]*planpb.GenericValue) error {
+	getValue := func(name string) (*planpb.GenericValue, error) {
+		value, ok := values[name]
+		if !ok || value == nil {
+			return nil, fmt.Errorf("the value of template variable {%s} is not provided", name)
+		}
+		return value, nil
+	}
+
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryExpr:
+		return FillExpressionValue(e.UnaryExpr.GetChild(), values)
+	case *planpb.Expr_BinaryExpr:
+		if err := FillExpressionValue(e.BinaryExpr.GetLeft(), values); err != nil {
+			return err
+		}
+		return FillExpressionValue(e.BinaryExpr.GetRight(), values)
+	case *planpb.Expr_UnaryRangeExpr:
+		name := e.UnaryRangeExpr.GetTemplateVariableName()
+		if name == "" {
+			return nil
+		}
+		value, err := getValue(name)
+		if err != nil {
+			return err
+		}
+		dataType := getColumnDataType(e.UnaryRangeExpr.GetColumnInfo())
+		castedValue, err := castValue(dataType, value)
+		if err != nil {
+			return fmt.Errorf("the value of template variable {%s} cannot be casted to %s", name, dataType.String())
+		}
+		e.UnaryRangeExpr.Value = castedValue
+	case *planpb.Expr_TermExpr:
+		name := e.TermExpr.GetTemplateVariableName()
+		if name == "" {
+			return nil
+		}
+		value, err := getValue(name)
+		if err != nil {
+			return err
+		}
+		if !IsArray(value) {
+			return fmt.Errorf("the value of template variable {%s} used by 'term' must be an array", name)
+		}
+		dataType := getColumnDataType(e.TermExpr.GetColumnInfo())
+		termValues := make([]*planpb.GenericValue, 0, len(value.GetArrayVal().GetArray()))
+		for _, element := range value.GetArrayVal().GetArray() {
+			castedValue, err := castValue(dataType, element)
+			if err != nil {
+				return fmt.Errorf("the value of template variable {%s} cannot be casted to %s", name, dataType.String())
+			}
+			termValues = append(termValues, castedValue)
+		}
+		e.TermExpr.Values = termValues
+	case *planpb.Expr_BinaryRangeExpr:
+		dataType := getColumnDataType(e.BinaryRangeExpr.GetColumnInfo())
+		if name := e.BinaryRangeExpr.GetLowerTemplateVariableName(); name != "" {
+			value, err := getValue(name)
+			if err != nil {
+				return err
+			}
+			if e.BinaryRangeExpr.LowerValue, err = castRangeValue(dataType, value); err != nil {
+				return err
+			}
+		}
+		if name := e.BinaryRangeExpr.GetUpperTemplateVariableName(); name != "" {
+			value, err := getValue(name)
+			if err != nil {
+				return err
+			}
+			if e.BinaryRangeExpr.UpperValue, err = castRangeValue(dataType, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// UnmarshalExpressionValues decodes the values of template variables from a JSON object, e.g.
+// `{"ids": [1, 2, 3], "min_age": 18}`. Integers and floating numbers are told apart by the literal,
+// and arrays of scalars are supported.
+func UnmarshalExpressionValues(data []byte) (map[string]*planpb.GenericValue, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var raw map[string]interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid values of template variables: %s", err)
+	}
+	values := make(map[string]*planpb.GenericValue, len(raw))
+	for name, v := range raw {
+		value, err := toGenericValue(v, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of template variable {%s}: %s", name, err)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+func toGenericValue(v interface{}, allowArray bool) (*planpb.GenericValue, error) {
+	switch value := v.(type) {
+	case bool:
+		return NewBool(value), nil
+	case string:
+		return NewString(value), nil
+	case json.Number:
+		if !strings.ContainsAny(value.String(), ".eE") {
+			i, err := value.Int64()
+			if err == nil {
+				return NewInt(i), nil
+			}
+		}
+		f, err := value.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return NewFloat(f), nil
+	case []interface{}:
+		if !allowArray {
+			return nil, fmt.Errorf("nested array is not supported")
+		}
+		array := make([]*planpb.GenericValue, 0, len(value))
+		dType := schemapb.DataType_None
+		sameType := true
+		for _, element := range value {
+			elementValue, err := toGenericValue(element, false)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, elementValue)
+			elementType := toValueExpr(elementValue).dataType
+			if dType == schemapb.DataType_None {
+				dType = elementType
+			} else if dType != elementType {
+				sameType = false
+			}
+		}
+		if !sameType {
+			dType = schemapb.DataType_None
+		}
+		return &planpb.GenericValue{
+			Val: &planpb.GenericValue_ArrayVal{
+				ArrayVal: &planpb.Array{
+					Array:       array,
+					SameType:    sameType,
+					ElementType: dType,
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
@@ -316,6 +316,10 @@ func handleCompareRightValue(op planpb.OpType, left *ExprWithType, right *planpb
 		return handleBinaryArithExpr(op, leftArithExpr, &planpb.ValueExpr{Value: castedValue})
 	}
 
+	if leftFuncExpr := left.expr.GetStringFunctionExpr(); leftFuncExpr != nil {
+		return combineStringFunctionExpr(op, leftFuncExpr, castedValue)
+	}
+
 	columnInfo := toColumnInfo(left)
 	if columnInfo == nil {
 		return nil, fmt.Errorf("not supported to combine multiple fields")
@@ -417,12 +421,18 @@ func getDataType(expr *ExprWithType) string {
 }
 
 func HandleCompare(op int, left, right *ExprWithType) (*planpb.Expr, error) {
+	if getTemplateVariableName(left) != "" || getTemplateVariableName(right) != "" {
+		return handleCompareTemplate(cmpOpMap[op], left, right)
+	}
 	if !canBeCompared(left, right) {
 		return nil, fmt.Errorf("comparisons between %s and %s are not supported",
 			getDataType(left), getDataType(right))
 	}
 
 	cmpOp := cmpOpMap[op]
+	if isArithCompare(left, right) {
+		return handleArithCompare(cmpOp, left, right)
+	}
 	if valueExpr := left.expr.GetValueExpr(); valueExpr != nil {
 		op, err := reverseOrder(cmpOp)
 		if err != nil {
@@ -463,6 +473,61 @@ func IsAlwaysTruePlan(plan *planpb.PlanNode) bool {
 	return false
 }
 
+// GetReferencedFieldIDs returns the ids of the fields referenced by the expr, each id is returned once.
+func GetReferencedFieldIDs(expr *planpb.Expr) []int64 {
+	var fieldIDs []int64
+	visited := make(map[int64]struct{})
+	add := func(columnInfo *planpb.ColumnInfo) {
+		if columnInfo == nil {
+			return
+		}
+		if _, ok := visited[columnInfo.GetFieldId()]; ok {
+			return
+		}
+		visited[columnInfo.GetFieldId()] = struct{}{}
+		fieldIDs = append(fieldIDs, columnInfo.GetFieldId())
+	}
+	var walk func(expr *planpb.Expr)
+	walk = func(expr *planpb.Expr) {
+		switch e := expr.GetExpr().(type) {
+		case *planpb.Expr_UnaryExpr:
+			walk(e.UnaryExpr.GetChild())
+		case *planpb.Expr_BinaryExpr:
+			walk(e.BinaryExpr.GetLeft())
+			walk(e.BinaryExpr.GetRight())
+		case *planpb.Expr_TermExpr:
+			add(e.TermExpr.GetColumnInfo())
+		case *planpb.Expr_UnaryRangeExpr:
+			add(e.UnaryRangeExpr.GetColumnInfo())
+		case *planpb.Expr_BinaryRangeExpr:
+			add(e.BinaryRangeExpr.GetColumnInfo())
+		case *planpb.Expr_CompareExpr:
+			add(e.CompareExpr.GetLeftColumnInfo())
+			add(e.CompareExpr.GetRightColumnInfo())
+		case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+			add(e.BinaryArithOpEvalRangeExpr.GetColumnInfo())
+		case *planpb.Expr_JsonContainsExpr:
+			add(e.JsonContainsExpr.GetColumnInfo())
+		case *planpb.Expr_StringFunctionExpr:
+			add(e.StringFunctionExpr.GetColumnInfo())
+		case *planpb.Expr_ExistsExpr:
+			add(e.ExistsExpr.GetInfo())
+		case *planpb.Expr_NullExpr:
+			add(e.NullExpr.GetColumnInfo())
+		case *planpb.Expr_ColumnExpr:
+			add(e.ColumnExpr.GetInfo())
+		case *planpb.Expr_BinaryArithExpr:
+			walk(e.BinaryArithExpr.GetLeft())
+			walk(e.BinaryArithExpr.GetRight())
+		case *planpb.Expr_ArithCompareExpr:
+			walk(e.ArithCompareExpr.GetLeft())
+			walk(e.ArithCompareExpr.GetRight())
+		}
+	}
+	walk(expr)
+	return fieldIDs
+}
+
 func canBeExecuted(e *ExprWithType) bool {
 	return typeutil.IsBoolType(e.dataType) && !e.nodeDependent
 }
@@ -515,7 +580,7 @@ func canArithmeticDataType(left, right schemapb.DataType) bool {
 		schemapb.DataType_Float, schemapb.DataType_Double:
 		return typeutil.IsArithmetic(right) || typeutil.IsJSONType(right)
 	case schemapb.DataType_JSON:
-		return typeutil.IsArithmetic(right)
+		return typeutil.IsArithmetic(right) || typeutil.IsJSONType(right)
 	default:
 		return false
 	}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
@@ -328,3 +329,22 @@ func Test_getArrayElementType(t *testing.T) {
 		assert.Equal(t, schemapb.DataType_None, getArrayElementType(expr))
 	})
 }
+
+func TestGetReferencedFieldIDs(t *testing.T) {
+	schemaHelper := newTestSchemaHelper(t)
+	cases := []struct {
+		expr     string
+		fieldIDs []int64
+	}{
+		{`Int64Field > 10 and (Int32Field < 5 or JSONField["a"] == 1) and Int64Field != 3`, []int64{105, 104, 123}},
+		{`Int64Field * Int32Field > FloatField`, []int64{105, 104, 110}},
+		{`exists JSONField["a"] or VarCharField is null`, []int64{123, 121}},
+		{`Int8Field < Int16Field`, []int64{102, 103}},
+		{`Int64Field in [1, 2, 3]`, []int64{105}},
+	}
+	for _, c := range cases {
+		expr, err := ParseExpr(schemaHelper, c.expr)
+		require.NoError(t, err, c.expr)
+		assert.Equal(t, c.fieldIDs, GetReferencedFieldIDs(expr), c.expr)
+	}
+}
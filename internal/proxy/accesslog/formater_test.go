@@ -18,6 +18,7 @@ package accesslog
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"strings"
 	"testing"
@@ -181,6 +182,61 @@ func (s *LogFormatterSuite) TestFormatMethodResult() {
 	}
 }
 
+func (s *LogFormatterSuite) TestFormatJSON() {
+	formatter := NewJSONFormatter()
+
+	for id, req := range s.reqs {
+		i := info.NewGrpcAccessInfo(s.ctx, s.serverinfo, req)
+		i.SetResult(s.resps[id], s.errs[id])
+		fs := formatter.Format(i)
+		s.True(strings.HasSuffix(fs, "\n"))
+
+		values := make(map[string]any)
+		s.NoError(json.Unmarshal([]byte(fs), &values))
+		s.Equal("test", values["method_name"])
+		s.Equal("test-db", values["database_name"])
+		s.Equal("test-collection", values["collection_name"])
+		s.IsType(float64(0), values["error_code"])
+		s.IsType(float64(0), values["response_size"])
+		s.IsType(float64(0), values["time_cost"])
+		s.IsType([]any{}, values["partition_name"])
+	}
+
+	req := &milvuspb.SearchRequest{
+		CollectionName: "test-collection",
+		Nq:             10,
+		OutputFields:   []string{"pk", "vec"},
+	}
+	i := info.NewGrpcAccessInfo(s.ctx, s.serverinfo, req)
+	values := make(map[string]any)
+	s.NoError(json.Unmarshal([]byte(formatter.Format(i)), &values))
+	s.Equal(float64(10), values["nq"])
+	s.Equal([]any{"pk", "vec"}, values["output_fields"])
+	// unknown values are omitted
+	_, ok := values["time_end"]
+	s.False(ok)
+}
+
+func (s *LogFormatterSuite) TestSample() {
+	formatter := NewFormatter("$method_name")
+	formatter.SetSampleRatio(0)
+
+	// successful requests are never logged with ratio 0
+	i := info.NewGrpcAccessInfo(s.ctx, s.serverinfo, s.reqs[0])
+	i.SetResult(s.resps[0], s.errs[0])
+	s.False(formatter.Sampled(i))
+
+	// failed requests are always logged
+	for id := 1; id < len(s.reqs); id++ {
+		i := info.NewGrpcAccessInfo(s.ctx, s.serverinfo, s.reqs[id])
+		i.SetResult(s.resps[id], s.errs[id])
+		s.True(formatter.Sampled(i))
+	}
+
+	formatter.SetSampleRatio(1)
+	s.True(formatter.Sampled(i))
+}
+
 func (s *LogFormatterSuite) TestParseConfigKeyFailed() {
 	configKey := ".testf.invalidSub"
 	_, _, err := parseConfigKey(configKey)
@@ -17,16 +17,25 @@
 package accesslog
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/rand"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 const (
-	fomaterkey = "format"
-	methodKey  = "methods"
+	fomaterkey     = "format"
+	methodKey      = "methods"
+	typeKey        = "type"
+	sampleRatioKey = "sampleRatio"
+
+	TextFormatterType = "text"
+	JSONFormatterType = "json"
 )
 
 var BaseFormatterKey = "base"
@@ -49,6 +58,10 @@ func (m *FormatterManger) Add(name, fmt string) {
 	m.formatters[name] = NewFormatter(fmt)
 }
 
+func (m *FormatterManger) AddFormatter(name string, formatter *Formatter) {
+	m.formatters[name] = formatter
+}
+
 func (m *FormatterManger) SetMethod(name string, methods ...string) {
 	for _, method := range methods {
 		m.methodMap[method] = name
@@ -72,16 +85,43 @@ type Formatter struct {
 	base   string
 	fmt    string
 	fields []string
+
+	json bool
+	// ratio of successful requests to be logged, failed requests are always logged
+	sampleRatio float64
 }
 
 func NewFormatter(base string) *Formatter {
 	formatter := &Formatter{
-		base: base,
+		base:        base,
+		sampleRatio: 1,
 	}
 	formatter.build()
 	return formatter
 }
 
+// NewJSONFormatter returns a formatter which formats the access info as one json object per line,
+// with all supported metrics as keys without the leading `$`.
+func NewJSONFormatter() *Formatter {
+	return &Formatter{
+		json:        true,
+		sampleRatio: 1,
+	}
+}
+
+// SetSampleRatio sets the ratio of successful requests to be logged.
+func (f *Formatter) SetSampleRatio(ratio float64) {
+	f.sampleRatio = ratio
+}
+
+// Sampled returns whether the access info should be logged, failed requests are always logged.
+func (f *Formatter) Sampled(i info.AccessInfo) bool {
+	if f.sampleRatio >= 1 || i.MethodStatus() != "Successful" {
+		return true
+	}
+	return rand.Float64() < f.sampleRatio
+}
+
 func (f *Formatter) buildMetric(metric string, prefixs []string) ([]string, []string) {
 	newFields := []string{}
 	newPrefixs := []string{}
@@ -120,14 +160,71 @@ func (f *Formatter) build() {
 }
 
 func (f *Formatter) Format(i info.AccessInfo) string {
+	if f.json {
+		return formatJSON(i)
+	}
 	fieldValues := info.Get(i, f.fields...)
 	return fmt.Sprintf(f.fmt, fieldValues...)
 }
 
+// jsonValueFuncs converts the metrics to typed json values,
+// other metrics are kept as strings.
+var jsonValueFuncs = map[string]func(string) (any, bool){
+	"$response_size":  parseJSONInt,
+	"$error_code":     parseJSONInt,
+	"$nq":             parseJSONInt,
+	"$time_cost":      parseJSONDurationMs,
+	"$partition_name": parseJSONList,
+	"$output_fields":  parseJSONList,
+}
+
+func formatJSON(i info.AccessInfo) string {
+	values := make(map[string]any, len(info.MetricFuncMap))
+	for metric := range info.MetricFuncMap {
+		value := info.Get(i, metric)[0].(string)
+		if value == info.Unknown || value == "" {
+			continue
+		}
+		key := strings.TrimPrefix(metric, "$")
+		values[key] = value
+		if parse, ok := jsonValueFuncs[metric]; ok {
+			if typed, ok := parse(value); ok {
+				values[key] = typed
+			}
+		}
+	}
+	bs, err := json.Marshal(values)
+	if err != nil {
+		// never happens, all values are strings, numbers or string lists
+		return fmt.Sprintf("{\"error_msg\":%q}\n", err.Error())
+	}
+	return string(bs) + "\n"
+}
+
+func parseJSONInt(value string) (any, bool) {
+	v, err := strconv.ParseInt(value, 10, 64)
+	return v, err == nil
+}
+
+// parseJSONDurationMs converts the time cost to milliseconds.
+func parseJSONDurationMs(value string) (any, bool) {
+	d, err := time.ParseDuration(value)
+	return float64(d) / float64(time.Millisecond), err == nil
+}
+
+// parseJSONList converts the fmt.Sprint formatted names like `[a b]` to a string list,
+// names of partitions and fields never contain spaces.
+func parseJSONList(value string) (any, bool) {
+	if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+		return []string{value}, true
+	}
+	return strings.Fields(strings.Trim(value, "[]")), true
+}
+
 func parseConfigKey(k string) (string, string, error) {
 	fields := strings.Split(k, ".")
-	if len(fields) != 2 || (fields[1] != fomaterkey && fields[1] != methodKey) {
-		return "", "", merr.WrapErrParameterInvalid("<FormatterName>.(format|methods)", k, "parse accsslog formatter config key failed")
+	if len(fields) != 2 || (fields[1] != fomaterkey && fields[1] != methodKey && fields[1] != typeKey && fields[1] != sampleRatioKey) {
+		return "", "", merr.WrapErrParameterInvalid("<FormatterName>.(format|methods|type|sampleRatio)", k, "parse accsslog formatter config key failed")
 	}
 	return fields[0], fields[1], nil
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
 	configEvent "github.com/milvus-io/milvus/pkg/config"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -113,7 +114,7 @@ func (l *AccessLogger) Write(info info.AccessInfo) bool {
 
 	method := info.MethodName()
 	formatter, ok := l.formatters.GetByMethod(method)
-	if !ok {
+	if !ok || !formatter.Sampled(info) {
 		return false
 	}
 	_, err := l.writer.Write([]byte(formatter.Format(info)))
@@ -151,21 +152,47 @@ func initFormatter(logCfg *paramtable.AccessLogConfig) (*FormatterManger, error)
 	formatterManger := NewFormatterManger()
 	formatMap := make(map[string]string)   // fommatter name -> formatter format
 	methodMap := make(map[string][]string) // fommatter name -> formatter owner method
+	typeMap := make(map[string]string)     // fommatter name -> formatter type
+	ratioMap := make(map[string]float64)   // fommatter name -> sample ratio of successful requests
 	for key, value := range logCfg.Formatter.GetValue() {
 		formatterName, option, err := parseConfigKey(key)
 		if err != nil {
 			return nil, err
 		}
 
-		if option == fomaterkey {
+		switch option {
+		case fomaterkey:
 			formatMap[formatterName] = value
-		} else if option == methodKey {
+		case methodKey:
 			methodMap[formatterName] = paramtable.ParseAsStings(value)
+		case typeKey:
+			if value != TextFormatterType && value != JSONFormatterType {
+				return nil, merr.WrapErrParameterInvalid("text|json", value, "invalid accesslog formatter type")
+			}
+			typeMap[formatterName] = value
+		case sampleRatioKey:
+			ratio, err := strconv.ParseFloat(value, 64)
+			if err != nil || ratio < 0 || ratio > 1 {
+				return nil, merr.WrapErrParameterInvalid("[0, 1]", value, "invalid accesslog sample ratio")
+			}
+			ratioMap[formatterName] = ratio
 		}
 	}
 
+	for name, formatterType := range typeMap {
+		if formatterType == JSONFormatterType {
+			formatterManger.AddFormatter(name, NewJSONFormatter())
+		}
+	}
 	for name, format := range formatMap {
-		formatterManger.Add(name, format)
+		if _, ok := formatterManger.formatters[name]; !ok {
+			formatterManger.Add(name, format)
+		}
+	}
+	for name, formatter := range formatterManger.formatters {
+		if ratio, ok := ratioMap[name]; ok {
+			formatter.SetSampleRatio(ratio)
+		}
 		if methods, ok := methodMap[name]; ok {
 			formatterManger.SetMethod(name, methods...)
 		}
@@ -287,3 +287,34 @@ func TestAccessLogger_WithMinio(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(logfiles))
 }
+
+func TestAccessLogger_JSONFormatter(t *testing.T) {
+	var Params paramtable.ComponentParam
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	Params.SaveGroup(map[string]string{
+		Params.ProxyCfg.AccessLog.Formatter.KeyPrefix + "json.type":        JSONFormatterType,
+		Params.ProxyCfg.AccessLog.Formatter.KeyPrefix + "json.methods":     "Search",
+		Params.ProxyCfg.AccessLog.Formatter.KeyPrefix + "json.sampleRatio": "0.5",
+	})
+
+	formatters, err := initFormatter(&Params.ProxyCfg.AccessLog)
+	assert.NoError(t, err)
+	formatter, ok := formatters.GetByMethod("Search")
+	assert.True(t, ok)
+	assert.True(t, formatter.json)
+	assert.Equal(t, 0.5, formatter.sampleRatio)
+	formatter, ok = formatters.GetByMethod("Insert")
+	assert.True(t, ok)
+	assert.False(t, formatter.json)
+
+	// invalid type
+	Params.SaveGroup(map[string]string{Params.ProxyCfg.AccessLog.Formatter.KeyPrefix + "json.type": "xml"})
+	_, err = initFormatter(&Params.ProxyCfg.AccessLog)
+	assert.Error(t, err)
+	Params.SaveGroup(map[string]string{Params.ProxyCfg.AccessLog.Formatter.KeyPrefix + "json.type": JSONFormatterType})
+
+	// invalid sample ratio
+	Params.SaveGroup(map[string]string{Params.ProxyCfg.AccessLog.Formatter.KeyPrefix + "json.sampleRatio": "2"})
+	_, err = initFormatter(&Params.ProxyCfg.AccessLog)
+	assert.Error(t, err)
+}
@@ -298,3 +298,11 @@ func (i *GrpcAccessInfo) ConsistencyLevel() string {
 	}
 	return Unknown
 }
+
+func (i *GrpcAccessInfo) Nq() string {
+	nq, ok := requestutil.GetNqFromRequest(i.req)
+	if ok {
+		return fmt.Sprint(nq)
+	}
+	return Unknown
+}
@@ -217,6 +217,17 @@ func (s *GrpcAccessInfoSuite) TestConsistencyLevel() {
 	s.Equal(commonpb.ConsistencyLevel_Bounded.String(), result[0])
 }
 
+func (s *GrpcAccessInfoSuite) TestNq() {
+	result := Get(s.info, "$nq")
+	s.Equal(Unknown, result[0])
+
+	s.info.req = &milvuspb.SearchRequest{
+		Nq: 10,
+	}
+	result = Get(s.info, "$nq")
+	s.Equal("10", result[0])
+}
+
 func (s *GrpcAccessInfoSuite) TestClusterPrefix() {
 	cluster := "instance-test"
 	paramtable.Init()
@@ -47,6 +47,7 @@ var MetricFuncMap = map[string]getMetricFunc{
 	"$sdk_version":       getSdkVersion,
 	"$cluster_prefix":    getClusterPrefix,
 	"$consistency_level": getConsistencyLevel,
+	"$nq":                getNq,
 }
 
 type AccessInfo interface {
@@ -70,6 +71,7 @@ type AccessInfo interface {
 	OutputFields() string
 	SdkVersion() string
 	ConsistencyLevel() string
+	Nq() string
 }
 
 func Get(i AccessInfo, keys ...string) []any {
@@ -165,6 +167,10 @@ func getConsistencyLevel(i AccessInfo) string {
 	return i.ConsistencyLevel()
 }
 
+func getNq(i AccessInfo) string {
+	return i.Nq()
+}
+
 func getClusterPrefix(i AccessInfo) string {
 	return ClusterPrefix.Load()
 }
@@ -207,3 +207,11 @@ func (i *RestfulInfo) ConsistencyLevel() string {
 	}
 	return Unknown
 }
+
+func (i *RestfulInfo) Nq() string {
+	nq, ok := requestutil.GetNqFromRequest(i.req)
+	if ok {
+		return fmt.Sprint(nq)
+	}
+	return Unknown
+}
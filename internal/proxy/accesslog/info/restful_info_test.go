@@ -191,6 +191,18 @@ func (s *RestfulAccessInfoSuite) TestConsistencyLevel() {
 	s.Equal(commonpb.ConsistencyLevel_Bounded.String(), result[0])
 }
 
+func (s *RestfulAccessInfoSuite) TestNq() {
+	result := Get(s.info, "$nq")
+	s.Equal(Unknown, result[0])
+
+	s.info.params.Keys[ContextRequest] = &milvuspb.SearchRequest{
+		Nq: 10,
+	}
+	s.info.InitReq()
+	result = Get(s.info, "$nq")
+	s.Equal("10", result[0])
+}
+
 func (s *RestfulAccessInfoSuite) TestClusterPrefix() {
 	cluster := "instance-test"
 	paramtable.Init()
@@ -0,0 +1,63 @@
+package proxy
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/aggregation"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// aggregateReducer merges the partial aggregation states of query nodes, the limit and offset are applied
+// on the groups.
+type aggregateReducer struct {
+	req            *internalpb.RetrieveRequest
+	params         *queryParams
+	schema         *schemapb.CollectionSchema
+	collectionName string
+}
+
+func (r *aggregateReducer) Reduce(results []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
+	aggregator, err := aggregation.NewAggregator(r.schema, r.req.GetGroupByFieldIds(), r.req.GetAggregates(),
+		paramtable.Get().QuotaConfig.MaxAggregationGroups.GetAsInt64())
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	for _, res := range results {
+		if err := aggregator.AddPartial(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	columns := aggregator.Final(r.params.offset, r.params.limit)
+
+	agg := r.params.aggregation
+	used := make([]bool, len(columns))
+	fieldsData := make([]*schemapb.FieldData, 0, len(agg.outputs))
+	for i, output := range agg.outputs {
+		column := columns[agg.columns[i]]
+		if used[agg.columns[i]] {
+			column = proto.Clone(column).(*schemapb.FieldData)
+		}
+		used[agg.columns[i]] = true
+		column.FieldName = output
+		fieldsData = append(fieldsData, column)
+	}
+
+	return &milvuspb.QueryResults{
+		Status:         merr.Success(),
+		FieldsData:     fieldsData,
+		CollectionName: r.collectionName,
+	}, nil
+}
+
+func newAggregateReducer(params *queryParams, req *internalpb.RetrieveRequest, schema *schemapb.CollectionSchema, collectionName string) *aggregateReducer {
+	return &aggregateReducer{
+		req:            req,
+		params:         params,
+		schema:         schema,
+		collectionName: collectionName,
+	}
+}
@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/aggregation"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var (
+	aggregateRegex = regexp.MustCompile(`^(?i:(count|sum|min|max|avg))\s*\(\s*(\*|[A-Za-z_][A-Za-z0-9_]*)\s*\)$`)
+	aggregateOps   = map[string]internalpb.Aggregate_Op{
+		"count": internalpb.Aggregate_Count,
+		"sum":   internalpb.Aggregate_Sum,
+		"min":   internalpb.Aggregate_Min,
+		"max":   internalpb.Aggregate_Max,
+		"avg":   internalpb.Aggregate_Avg,
+	}
+)
+
+// queryAggregation is an aggregation query, the output fields of which are the group by fields and the aggregates
+// like `sum(price)`.
+type queryAggregation struct {
+	groupByFieldIDs []int64
+	aggregates      []*internalpb.Aggregate
+	// the output names and the columns of the final aggregation result of the outputs
+	outputs []string
+	columns []int
+}
+
+// parseAggregation parses the aggregates in the output fields and the group by fields passed with the key GroupByKey
+// as a comma separated list. It returns nil if the query has neither aggregates nor group by fields, and count(*)
+// without group by fields is left to the count plan.
+func parseAggregation(outputFields []string, queryParamsPair []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) (*queryAggregation, error) {
+	agg := &queryAggregation{}
+	groupByNames := make([]string, 0)
+	groupByStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByKey, queryParamsPair)
+	if err == nil {
+		for _, name := range strings.Split(groupByStr, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			field := typeutil.GetFieldByName(schema, name)
+			if field == nil {
+				return nil, merr.WrapErrFieldNotFound(name, "group by field not found")
+			}
+			if err := aggregation.CheckGroupBy(field); err != nil {
+				return nil, merr.WrapErrParameterInvalidMsg(err.Error())
+			}
+			if funcutil.SliceContain(agg.groupByFieldIDs, field.GetFieldID()) {
+				return nil, merr.WrapErrParameterInvalidMsg("duplicated group by field [%s]", name)
+			}
+			agg.groupByFieldIDs = append(agg.groupByFieldIDs, field.GetFieldID())
+			groupByNames = append(groupByNames, field.GetName())
+		}
+	}
+
+	hasAggregate := false
+	for _, output := range outputFields {
+		if aggregateRegex.MatchString(strings.TrimSpace(output)) {
+			hasAggregate = true
+			break
+		}
+	}
+	if !hasAggregate && len(agg.groupByFieldIDs) == 0 {
+		return nil, nil
+	}
+	if len(agg.groupByFieldIDs) == 0 && matchCountRule(outputFields) {
+		return nil, nil
+	}
+
+	if len(outputFields) == 0 {
+		outputFields = groupByNames
+	}
+	for _, output := range outputFields {
+		output = strings.TrimSpace(output)
+		matches := aggregateRegex.FindStringSubmatch(output)
+		if matches == nil {
+			field := typeutil.GetFieldByName(schema, output)
+			if field == nil {
+				return nil, merr.WrapErrFieldNotFound(output, "output field not found")
+			}
+			idx := lo.IndexOf(agg.groupByFieldIDs, field.GetFieldID())
+			if idx == -1 {
+				return nil, merr.WrapErrParameterInvalidMsg("output field [%s] must be a group by field or be aggregated", output)
+			}
+			agg.outputs = append(agg.outputs, field.GetName())
+			agg.columns = append(agg.columns, idx)
+			continue
+		}
+
+		opName, arg := strings.ToLower(matches[1]), matches[2]
+		aggregate := &internalpb.Aggregate{Op: aggregateOps[opName]}
+		var field *schemapb.FieldSchema
+		if arg != "*" {
+			field = typeutil.GetFieldByName(schema, arg)
+			if field == nil {
+				return nil, merr.WrapErrFieldNotFound(arg, "aggregated field not found")
+			}
+			aggregate.FieldId = field.GetFieldID()
+		}
+		if err := aggregation.CheckAggregate(aggregate.GetOp(), field); err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg(err.Error())
+		}
+		_, idx, ok := lo.FindIndexOf(agg.aggregates, func(a *internalpb.Aggregate) bool {
+			return a.GetOp() == aggregate.GetOp() && a.GetFieldId() == aggregate.GetFieldId()
+		})
+		if !ok {
+			idx = len(agg.aggregates)
+			agg.aggregates = append(agg.aggregates, aggregate)
+		}
+		agg.outputs = append(agg.outputs, fmt.Sprintf("%s(%s)", opName, arg))
+		agg.columns = append(agg.columns, len(agg.groupByFieldIDs)+idx)
+	}
+	if len(agg.aggregates) == 0 {
+		// the distinct groups are still aggregated by query nodes
+		agg.aggregates = append(agg.aggregates, &internalpb.Aggregate{Op: internalpb.Aggregate_Count})
+	}
+	return agg, nil
+}
+
+// retrievedFieldIDs returns the fields retrieved from segments to be aggregated.
+func (agg *queryAggregation) retrievedFieldIDs() []int64 {
+	fieldIDs := make([]int64, 0, len(agg.groupByFieldIDs)+len(agg.aggregates)+1)
+	fieldIDs = append(fieldIDs, agg.groupByFieldIDs...)
+	for _, aggregate := range agg.aggregates {
+		if aggregate.GetFieldId() != 0 && !funcutil.SliceContain(fieldIDs, aggregate.GetFieldId()) {
+			fieldIDs = append(fieldIDs, aggregate.GetFieldId())
+		}
+	}
+	return append(fieldIDs, common.TimeStampField)
+}
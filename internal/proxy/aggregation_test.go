@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/aggregation"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func newAggregationTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "price", DataType: schemapb.DataType_Double},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+}
+
+func TestParseAggregation(t *testing.T) {
+	schema := newAggregationTestSchema()
+	groupBy := func(value string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: GroupByKey, Value: value}}
+	}
+
+	agg, err := parseAggregation([]string{"category", "COUNT(*)", "sum(price)", "avg( price )", "sum(price)"}, groupBy("category"), schema)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{101}, agg.groupByFieldIDs)
+	assert.Equal(t, []*internalpb.Aggregate{
+		{Op: internalpb.Aggregate_Count},
+		{Op: internalpb.Aggregate_Sum, FieldId: 102},
+		{Op: internalpb.Aggregate_Avg, FieldId: 102},
+	}, agg.aggregates)
+	assert.Equal(t, []string{"category", "count(*)", "sum(price)", "avg(price)", "sum(price)"}, agg.outputs)
+	assert.Equal(t, []int{0, 1, 2, 3, 2}, agg.columns)
+	assert.Equal(t, []int64{101, 102, common.TimeStampField}, agg.retrievedFieldIDs())
+
+	// distinct groups
+	agg, err = parseAggregation(nil, groupBy("category"), schema)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"category"}, agg.outputs)
+	assert.Equal(t, []*internalpb.Aggregate{{Op: internalpb.Aggregate_Count}}, agg.aggregates)
+
+	// count(*) without group by and plain query are not aggregation
+	agg, err = parseAggregation([]string{"count(*)"}, nil, schema)
+	assert.NoError(t, err)
+	assert.Nil(t, agg)
+	agg, err = parseAggregation([]string{"category", "price"}, nil, schema)
+	assert.NoError(t, err)
+	assert.Nil(t, agg)
+
+	invalidCases := []struct {
+		outputs []string
+		groupBy string
+	}{
+		{[]string{"price", "count(*)"}, "category"},
+		{[]string{"sum(*)"}, ""},
+		{[]string{"sum(category)"}, ""},
+		{[]string{"max(not_exist)"}, ""},
+		{[]string{"count(*)"}, "vec"},
+		{[]string{"count(*)"}, "not_exist"},
+		{[]string{"count(*)"}, "category,category"},
+	}
+	for _, c := range invalidCases {
+		_, err = parseAggregation(c.outputs, groupBy(c.groupBy), schema)
+		assert.Error(t, err, c)
+	}
+}
+
+func TestAggregateReducer(t *testing.T) {
+	schema := newAggregationTestSchema()
+	agg, err := parseAggregation([]string{"sum(price)", "category", "count(*)", "sum(price)"}, []*commonpb.KeyValuePair{{Key: GroupByKey, Value: "category"}}, schema)
+	require.NoError(t, err)
+
+	newPartial := func(categories []string, prices []float64) *internalpb.RetrieveResults {
+		aggregator, err := aggregation.NewAggregator(schema, agg.groupByFieldIDs, agg.aggregates, typeutil.Unlimited)
+		require.NoError(t, err)
+		fieldsData := []*schemapb.FieldData{
+			getFieldData("category", 101, schemapb.DataType_VarChar, categories, 1),
+			getFieldData("price", 102, schemapb.DataType_Double, prices, 1),
+		}
+		require.NoError(t, aggregator.AddRows(fieldsData, []int64{0, 1}))
+		return &internalpb.RetrieveResults{FieldsData: aggregator.Partial()}
+	}
+	results := []*internalpb.RetrieveResults{
+		newPartial([]string{"b", "a"}, []float64{1.0, 2.0}),
+		newPartial([]string{"b", "c"}, []float64{3.0, 4.0}),
+	}
+	req := &internalpb.RetrieveRequest{GroupByFieldIds: agg.groupByFieldIDs, Aggregates: agg.aggregates}
+
+	r := createMilvusReducer(context.Background(), &queryParams{limit: typeutil.Unlimited, aggregation: agg}, req, schema, nil, "test")
+	ret, err := r.Reduce(results)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(ret.GetFieldsData()))
+	assert.Equal(t, "sum(price)", ret.GetFieldsData()[0].GetFieldName())
+	assert.Equal(t, []float64{2.0, 4.0, 4.0}, ret.GetFieldsData()[0].GetScalars().GetDoubleData().GetData())
+	assert.Equal(t, []string{"a", "b", "c"}, ret.GetFieldsData()[1].GetScalars().GetStringData().GetData())
+	assert.Equal(t, []int64{1, 2, 1}, ret.GetFieldsData()[2].GetScalars().GetLongData().GetData())
+	assert.True(t, proto.Equal(ret.GetFieldsData()[0].GetScalars(), ret.GetFieldsData()[3].GetScalars()))
+
+	r = createMilvusReducer(context.Background(), &queryParams{limit: 1, offset: 1, aggregation: agg}, req, schema, nil, "test")
+	ret, err = r.Reduce(results)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, ret.GetFieldsData()[1].GetScalars().GetStringData().GetData())
+}
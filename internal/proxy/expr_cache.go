@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// globalExprCache is initialized when proxy is initialized, a nil cache parses every expression.
+var globalExprCache *exprCache
+
+type exprCacheKey struct {
+	schemaVersion uint64
+	expr          string
+}
+
+type exprCacheEntry struct {
+	key  exprCacheKey
+	expr *planpb.Expr
+}
+
+// exprCache keeps the parsed filter expression templates, keyed by the schema version and the expression text.
+// The least recently used templates are evicted when the capacity is exceeded, and the templates of a stale
+// schema are never hit again since the schema version changes with the schema.
+type exprCache struct {
+	capacity int
+
+	mu      sync.Mutex // guards entries and lru
+	entries map[exprCacheKey]*list.Element
+	lru     *list.List
+}
+
+func newExprCache(capacity int) *exprCache {
+	return &exprCache{
+		capacity: capacity,
+		entries:  make(map[exprCacheKey]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// Get returns a copy of the parsed template of the expression, which can be filled by the caller.
+// The expression is parsed and cached on miss, parse errors are not cached.
+func (c *exprCache) Get(schema *schemaInfo, exprStr string) (*planpb.Expr, error) {
+	if c == nil || c.capacity <= 0 || schema.version == 0 {
+		return planparserv2.ParseExprTemplate(schema.schemaHelper, exprStr)
+	}
+
+	key := exprCacheKey{schemaVersion: schema.version, expr: exprStr}
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		expr := elem.Value.(*exprCacheEntry).expr
+		c.mu.Unlock()
+		return proto.Clone(expr).(*planpb.Expr), nil
+	}
+	c.mu.Unlock()
+
+	expr, err := planparserv2.ParseExprTemplate(schema.schemaHelper, exprStr)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		c.entries[key] = c.lru.PushFront(&exprCacheEntry{key: key, expr: expr})
+		for c.lru.Len() > c.capacity {
+			oldest := c.lru.Back()
+			c.lru.Remove(oldest)
+			delete(c.entries, oldest.Value.(*exprCacheEntry).key)
+		}
+	}
+	return proto.Clone(expr).(*planpb.Expr), nil
+}
+
+// Len returns the number of cached templates.
+func (c *exprCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// getExprValues returns the values of the template variables in the filter expression,
+// which are passed as a JSON object with the key ExprParamsKey.
+func getExprValues(params []*commonpb.KeyValuePair) (map[string]*planpb.GenericValue, error) {
+	exprParams, err := funcutil.GetAttrByKeyFromRepeatedKV(ExprParamsKey, params)
+	if err != nil || len(exprParams) == 0 {
+		return nil, nil
+	}
+	values, err := planparserv2.UnmarshalExpressionValues([]byte(exprParams))
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %v", ExprParamsKey, err)
+	}
+	return values, nil
+}
+
+// parseExpr parses the filter expression with the cached template and fills the template variables with the values.
+func parseExpr(schema *schemaInfo, exprStr string, values map[string]*planpb.GenericValue) (*planpb.Expr, error) {
+	expr, err := globalExprCache.Get(schema, exprStr)
+	if err != nil {
+		return nil, err
+	}
+	if err := planparserv2.FillExpressionValue(expr, values); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+func TestExprCache_Get(t *testing.T) {
+	schema := newSchemaInfo(newTestSchema())
+	c := newExprCache(2)
+
+	expr1, err := c.Get(schema, "Int64Field in {ids}")
+	require.NoError(t, err)
+	assert.Equal(t, 1, c.Len())
+	expr2, err := c.Get(schema, "Int64Field in {ids}")
+	require.NoError(t, err)
+	assert.Equal(t, 1, c.Len())
+	// the cached template is copied
+	assert.True(t, proto.Equal(expr1, expr2))
+	assert.NotSame(t, expr1, expr2)
+
+	_, err = c.Get(schema, "Int64Field > {age}")
+	require.NoError(t, err)
+	_, err = c.Get(schema, "Int64Field < {age}")
+	require.NoError(t, err)
+	assert.Equal(t, 2, c.Len())
+
+	// parse errors are not cached
+	_, err = c.Get(schema, "Int64Field >")
+	assert.Error(t, err)
+	assert.Equal(t, 2, c.Len())
+
+	// a changed schema has a new version
+	newSchema := proto.Clone(schema.CollectionSchema).(*schemapb.CollectionSchema)
+	newSchema.Fields = append(newSchema.Fields, &schemapb.FieldSchema{FieldID: 1000, Name: "new_field", DataType: schemapb.DataType_Int64})
+	assert.NotEqual(t, schema.version, newSchemaInfo(newSchema).version)
+
+	// the cache is disabled
+	c = newExprCache(0)
+	_, err = c.Get(schema, "Int64Field in {ids}")
+	require.NoError(t, err)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestExprCache_ParseExpr(t *testing.T) {
+	schema := newSchemaInfo(newTestSchema())
+	globalExprCache = newExprCache(16)
+	defer func() {
+		globalExprCache = nil
+	}()
+
+	params := []*commonpb.KeyValuePair{{Key: ExprParamsKey, Value: `{"ids": [1, 2], "age": 3}`}}
+	values, err := getExprValues(params)
+	require.NoError(t, err)
+	expr, err := parseExpr(schema, "Int64Field in {ids} and Int32Field > {age}", values)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(expr.GetBinaryExpr().GetLeft().GetTermExpr().GetValues()))
+	assert.Equal(t, int64(3), expr.GetBinaryExpr().GetRight().GetUnaryRangeExpr().GetValue().GetInt64Val())
+
+	// the cached template is not filled
+	values, err = getExprValues([]*commonpb.KeyValuePair{{Key: ExprParamsKey, Value: `{"ids": [1], "age": 4}`}})
+	require.NoError(t, err)
+	expr, err = parseExpr(schema, "Int64Field in {ids} and Int32Field > {age}", values)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(expr.GetBinaryExpr().GetLeft().GetTermExpr().GetValues()))
+	assert.Equal(t, int64(4), expr.GetBinaryExpr().GetRight().GetUnaryRangeExpr().GetValue().GetInt64Val())
+	assert.Equal(t, 1, globalExprCache.Len())
+
+	_, err = parseExpr(schema, "Int64Field in {ids}", nil)
+	assert.Error(t, err)
+
+	values, err = getExprValues(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, values)
+
+	_, err = getExprValues([]*commonpb.KeyValuePair{{Key: ExprParamsKey, Value: `[1]`}})
+	assert.Error(t, err)
+}
@@ -1283,6 +1283,139 @@ func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterC
 	return act.result, nil
 }
 
+// AddCollectionField adds a nullable or defaulted field to an existing collection.
+func (node *Proxy) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AddCollectionField")
+	defer sp.End()
+	method := "AddCollectionField"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	act := &addCollectionFieldTask{
+		ctx:                       ctx,
+		Condition:                 NewTaskCondition(ctx),
+		AddCollectionFieldRequest: request,
+		rootCoord:                 node.rootCoord,
+		queryCoord:                node.queryCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	log.Info(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()),
+		zap.Uint64("timestamp", request.Base.Timestamp))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(
+		rpcDone(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// DropCollectionField drops a field of the collection, the data of the field is cleaned up by compaction.
+func (node *Proxy) DropCollectionField(ctx context.Context, request *proxypb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DropCollectionField")
+	defer sp.End()
+	method := "DropCollectionField"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	act := &dropCollectionFieldTask{
+		ctx:                        ctx,
+		Condition:                  NewTaskCondition(ctx),
+		DropCollectionFieldRequest: request,
+		rootCoord:                  node.rootCoord,
+		queryCoord:                 node.queryCoord,
+		dataCoord:                  node.dataCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetFieldName()))
+
+	log.Info(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()),
+		zap.Uint64("timestamp", request.Base.Timestamp))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(
+		rpcDone(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
 // CreatePartition create a partition in specific collection.
 func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
@@ -2744,11 +2877,27 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 		metrics.UpsertLabel, request.GetCollectionName()).Add(float64(proto.Size(request)))
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
 
+	// the partial update mode is carried by the msg base which is replaced below
+	partialUpdate, err := getPartialUpdateMode(request)
 	request.Base = commonpbutil.NewMsgBase(
 		commonpbutil.WithMsgType(commonpb.MsgType_Upsert),
 		commonpbutil.WithSourceID(paramtable.GetNodeID()),
 	)
 
+	// the existing entities must be fetched before the upsert task is enqueued,
+	// a query waiting for the time tick held back by the task never finishes.
+	if err == nil && partialUpdate != partialUpdateDisabled {
+		err = node.fillPartialUpdate(ctx, request, partialUpdate)
+	}
+	if err != nil {
+		log.Warn("Failed to fill partial update", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &milvuspb.MutationResult{
+			Status: merr.Status(err),
+		}, nil
+	}
+
 	it := &upsertTask{
 		baseMsg: msgstream.BaseMsg{
 			HashValues: request.HashKeys,
@@ -3091,6 +3240,241 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest)
 	return qt.result, nil
 }
 
+// SearchCollections searches several collections sharing the searched vector field and the metric type under one
+// guarantee timestamp, and merges the hits of the collections by score.
+func (node *Proxy) SearchCollections(ctx context.Context, req *proxypb.SearchCollectionsRequest) (*proxypb.SearchCollectionsResults, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.SearchCollectionsResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-SearchCollections")
+	defer sp.End()
+
+	method := "SearchCollections"
+	tr := timerecord.NewTimeRecorder(method)
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.Strings("collections", req.GetCollectionNames()),
+	)
+	log.Debug(rpcReceived(method))
+
+	resp := &proxypb.SearchCollectionsResults{
+		Status: merr.Success(),
+	}
+	nodeID := paramtable.GetStringNodeID()
+	defer func() {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), "").Inc()
+		if resp.GetStatus().GetCode() != 0 {
+			log.Warn("search collections failed", zap.String("err", resp.GetStatus().GetReason()))
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), "").Inc()
+		} else {
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), "").Inc()
+		}
+		metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	}()
+
+	requests, limit, offset, err := node.newCollectionSearchRequests(ctx, req)
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	results := make([]*milvuspb.SearchResults, len(requests))
+	metricTypes := make([]string, len(requests))
+	errGroup, groupCtx := errgroup.WithContext(ctx)
+	for i, request := range requests {
+		i, request := i, request
+		errGroup.Go(func() error {
+			result, metricType, err := node.searchCollection(groupCtx, request)
+			if err != nil {
+				return err
+			}
+			if err := merr.Error(result.GetStatus()); err != nil {
+				return err
+			}
+			results[i], metricTypes[i] = result, metricType
+			return nil
+		})
+	}
+	if err := errGroup.Wait(); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	// the collections without hits may not report the metric type
+	metricType := ""
+	for i, mt := range metricTypes {
+		if mt == "" {
+			continue
+		}
+		if metricType != "" && mt != metricType {
+			resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("metric type %s of collection %s mismatches the metric type %s", mt, req.GetCollectionNames()[i], metricType))
+			return resp, nil
+		}
+		metricType = mt
+	}
+
+	subSearchResultData := lo.Map(results, func(result *milvuspb.SearchResults, _ int) *schemapb.SearchResultData {
+		return result.GetResults()
+	})
+	resp.Results, resp.CollectionNames, err = mergeCollectionSearchResults(ctx, subSearchResultData, req.GetCollectionNames(),
+		subSearchResultData[0].GetNumQueries(), limit, offset, metricType)
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	log.Debug(rpcDone(method))
+	return resp, nil
+}
+
+// newCollectionSearchRequests checks the collections to search and clones the search request for each collection.
+// The requests search under the same guarantee timestamp and return the top limit+offset hits to merge.
+func (node *Proxy) newCollectionSearchRequests(ctx context.Context, req *proxypb.SearchCollectionsRequest) ([]*milvuspb.SearchRequest, int64, int64, error) {
+	request := req.GetRequest()
+	if len(req.GetCollectionNames()) == 0 {
+		return nil, 0, 0, merr.WrapErrParameterInvalidMsg("no collection to search")
+	}
+	if request == nil {
+		return nil, 0, 0, merr.WrapErrParameterInvalidMsg("search request is empty")
+	}
+	if request.GetSearchByPrimaryKeys() || len(request.GetSubReqs()) > 0 {
+		return nil, 0, 0, merr.WrapErrParameterInvalidMsg("only support search by vectors across collections")
+	}
+	params, limit, offset, err := parseCollectionSearchParams(request.GetSearchParams())
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	annsField, _ := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, params)
+	var (
+		collectionIDs    = typeutil.NewUniqueSet()
+		consistencyLevel = request.GetConsistencyLevel()
+		vectorField      *schemapb.FieldSchema
+		pkField          *schemapb.FieldSchema
+	)
+	for i, collectionName := range req.GetCollectionNames() {
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), collectionName)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		if collectionIDs.Contain(collectionID) {
+			return nil, 0, 0, merr.WrapErrParameterInvalidMsg("collection %s is duplicated", collectionName)
+		}
+		collectionIDs.Insert(collectionID)
+
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, req.GetDbName(), collectionName)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		var field *schemapb.FieldSchema
+		if annsField != "" {
+			field = typeutil.GetFieldByName(schema.CollectionSchema, annsField)
+		} else if vecFields := typeutil.GetVectorFieldSchemas(schema.CollectionSchema); len(vecFields) == 1 {
+			field = vecFields[0]
+		}
+		if field == nil || !typeutil.IsVectorType(field.GetDataType()) {
+			return nil, 0, 0, merr.WrapErrParameterInvalidMsg("collection %s has no vector field %s to search, please specify a anns_field in search_params", collectionName, annsField)
+		}
+		pk, err := schema.GetPkField()
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		if i == 0 {
+			vectorField, pkField, annsField = field, pk, field.GetName()
+			if request.GetUseDefaultConsistency() {
+				collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, req.GetDbName(), collectionName, collectionID)
+				if err != nil {
+					return nil, 0, 0, err
+				}
+				consistencyLevel = collectionInfo.consistencyLevel
+			}
+		} else if field.GetDataType() != vectorField.GetDataType() || pk.GetDataType() != pkField.GetDataType() {
+			return nil, 0, 0, merr.WrapErrParameterInvalidMsg("the vector field or the primary key of collection %s mismatches collection %s",
+				collectionName, req.GetCollectionNames()[0])
+		}
+	}
+	params = lo.Filter(params, func(kv *commonpb.KeyValuePair, _ int) bool {
+		return kv.GetKey() != AnnsFieldKey
+	})
+	params = append(params, &commonpb.KeyValuePair{Key: AnnsFieldKey, Value: annsField})
+
+	// the guarantee timestamp is parsed once by the consistency level of the request or the first collection,
+	// all the collections are searched on the snapshot at the timestamp
+	ts, err := node.tsoAllocator.AllocOne(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	guaranteeTs := request.GetGuaranteeTimestamp()
+	if !request.GetUseDefaultConsistency() && consistencyLevel == 0 && guaranteeTs > 0 {
+		guaranteeTs = parseGuaranteeTs(guaranteeTs, ts)
+	} else {
+		guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, ts, consistencyLevel)
+	}
+	if guaranteeTs <= 1 {
+		// the snapshot of eventually consistency is bounded, which the collections have reached by the graceful time
+		guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, ts, commonpb.ConsistencyLevel_Bounded)
+	}
+
+	requests := make([]*milvuspb.SearchRequest, 0, len(req.GetCollectionNames()))
+	for _, collectionName := range req.GetCollectionNames() {
+		collectionRequest := proto.Clone(request).(*milvuspb.SearchRequest)
+		collectionRequest.DbName = req.GetDbName()
+		collectionRequest.CollectionName = collectionName
+		collectionRequest.PartitionNames = nil
+		collectionRequest.SearchParams = append([]*commonpb.KeyValuePair{}, params...)
+		collectionRequest.UseDefaultConsistency = false
+		collectionRequest.ConsistencyLevel = commonpb.ConsistencyLevel_Strong
+		collectionRequest.GuaranteeTimestamp = guaranteeTs
+		if _, err := privilegeInterceptor(ctx, collectionRequest); err != nil {
+			return nil, 0, 0, err
+		}
+		requests = append(requests, collectionRequest)
+	}
+	return requests, limit, offset, nil
+}
+
+// searchCollection executes the search of a collection and returns the result with its metric type.
+func (node *Proxy) searchCollection(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, string, error) {
+	var (
+		result     *milvuspb.SearchResults
+		metricType string
+	)
+	err := retry.Handle(ctx, func() (bool, error) {
+		qt := &searchTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			SearchRequest: &internalpb.SearchRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Search),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				ReqID: paramtable.GetNodeID(),
+			},
+			request:                request,
+			tr:                     timerecord.NewTimeRecorder("search"),
+			qc:                     node.queryCoord,
+			node:                   node,
+			lb:                     node.lbPolicy,
+			enableMaterializedView: node.enableMaterializedView,
+			mustUsePartitionKey:    Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
+			snapshotTs:             request.GetGuaranteeTimestamp(),
+		}
+		if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+			return false, err
+		}
+		if err := qt.WaitToFinish(); err != nil {
+			return errors.Is(err, merr.ErrInconsistentRequery), err
+		}
+		result, metricType = qt.result, qt.metricType
+		return false, nil
+	})
+	return result, metricType, err
+}
+
 func (node *Proxy) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
 	var err error
 	rsp := &milvuspb.SearchResults{
@@ -5287,6 +5671,80 @@ func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.Refr
 	return merr.Success(), nil
 }
 
+// CreateRowPolicy creates a row policy which restricts the rows the role can search, query and delete.
+func (node *Proxy) CreateRowPolicy(ctx context.Context, req *internalpb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CreateRowPolicy")
+	defer sp.End()
+
+	log := log.Ctx(ctx)
+
+	log.Info("CreateRowPolicy",
+		zap.Any("req", req))
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := validateRowPolicy(ctx, req.GetPolicy()); err != nil {
+		return merr.Status(err), nil
+	}
+	result, err := node.rootCoord.CreateRowPolicy(ctx, req)
+	if err != nil {
+		log.Warn("fail to create row policy", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// DropRowPolicy drops a row policy of the role.
+func (node *Proxy) DropRowPolicy(ctx context.Context, req *internalpb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DropRowPolicy")
+	defer sp.End()
+
+	log := log.Ctx(ctx)
+
+	log.Info("DropRowPolicy",
+		zap.Any("req", req))
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if req.GetPolicyName() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("the row policy name is empty")), nil
+	}
+	if err := ValidateRoleName(req.GetRoleName()); err != nil {
+		return merr.Status(err), nil
+	}
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	result, err := node.rootCoord.DropRowPolicy(ctx, req)
+	if err != nil {
+		log.Warn("fail to drop row policy", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// ListRowPolicies lists the row policies, the empty role, database or collection in the request matches all.
+func (node *Proxy) ListRowPolicies(ctx context.Context, req *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListRowPolicies")
+	defer sp.End()
+
+	log := log.Ctx(ctx)
+
+	log.Debug("ListRowPolicies",
+		zap.Any("req", req))
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListRowPoliciesResponse{Status: merr.Status(err)}, nil
+	}
+	result, err := node.rootCoord.ListRowPolicies(ctx, req)
+	if err != nil {
+		log.Warn("fail to list row policies", zap.Error(err))
+		return &internalpb.ListRowPoliciesResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return result, nil
+}
+
 // SetRates limits the rates of requests.
 func (node *Proxy) SetRates(ctx context.Context, request *proxypb.SetRatesRequest) (*commonpb.Status, error) {
 	resp := merr.Success()
@@ -6102,6 +6560,38 @@ func (node *Proxy) ImportV2(ctx context.Context, req *internalpb.ImportRequest)
 	isBackup := importutilv2.IsBackup(req.GetOptions())
 	isL0Import := importutilv2.IsL0Import(req.GetOptions())
 	hasPartitionKey := typeutil.HasPartitionKey(schema.CollectionSchema)
+	if importutilv2.IsDryRun(req.GetOptions()) && (isBackup || isL0Import) {
+		resp.Status = merr.Status(merr.WrapErrImportFailed("dry run is not supported for backup or l0 import"))
+		return resp, nil
+	}
+	if importutilv2.IsUpsert(req.GetOptions()) {
+		if isBackup || isL0Import {
+			resp.Status = merr.Status(merr.WrapErrImportFailed("upsert is not supported for backup or l0 import"))
+			return resp, nil
+		}
+		pkField, err := typeutil.GetPrimaryFieldSchema(schema.CollectionSchema)
+		if err != nil {
+			resp.Status = merr.Status(err)
+			return resp, nil
+		}
+		if pkField.GetAutoID() {
+			resp.Status = merr.Status(merr.WrapErrImportFailed("upsert import is not supported for the collection with autoID"))
+			return resp, nil
+		}
+		// The deletes of the upsert import are written into the L0 segments,
+		// which have the same restriction as the l0 import, see below.
+		if !importutilv2.IsDryRun(req.GetOptions()) {
+			loaded, err := isCollectionLoaded(ctx, node.queryCoord, collectionID)
+			if err != nil {
+				resp.Status = merr.Status(err)
+				return resp, nil
+			}
+			if loaded {
+				resp.Status = merr.Status(merr.WrapErrImportFailed("for upsert import, collection cannot be loaded, please release it first"))
+				return resp, nil
+			}
+		}
+	}
 
 	var partitionIDs []int64
 	if isBackup {
@@ -6191,6 +6681,12 @@ func (node *Proxy) ImportV2(ctx context.Context, req *internalpb.ImportRequest)
 				return resp, nil
 			}
 		}
+		// check the options of the error tolerance
+		_, err = importutilv2.NewRejector(req.GetOptions())
+		if err != nil {
+			resp.Status = merr.Status(err)
+			return resp, nil
+		}
 	}
 	importRequest := &internalpb.ImportRequestInternal{
 		CollectionID:   collectionID,
@@ -6282,6 +6778,163 @@ func (node *Proxy) ListImports(ctx context.Context, req *internalpb.ListImportsR
 	return resp, nil
 }
 
+func (node *Proxy) ExportV2(ctx context.Context, req *internalpb.ExportRequest) (*internalpb.ExportResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ExportResponse{Status: merr.Status(err)}, nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("collectionName", req.GetCollectionName()),
+		zap.String("partition name", req.GetPartitionName()),
+		zap.Uint64("startTs", req.GetStartTs()),
+		zap.Uint64("endTs", req.GetEndTs()),
+		zap.String("role", typeutil.ProxyRole),
+	)
+
+	resp := &internalpb.ExportResponse{
+		Status: merr.Success(),
+	}
+
+	method := "ExportV2"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	defer func() {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		if resp.GetStatus().GetCode() != 0 {
+			log.Warn("export failed", zap.String("err", resp.GetStatus().GetReason()))
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		} else {
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+		}
+	}()
+
+	dbInfo, err := globalMetaCache.GetDatabaseInfo(ctx, req.GetDbName())
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	// all partitions are exported if the partition is not specified
+	var partitionIDs []int64
+	if req.GetPartitionName() != "" {
+		if typeutil.HasPartitionKey(schema.CollectionSchema) {
+			resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("not allow to set partition name for collection with partition key"))
+			return resp, nil
+		}
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, req.GetDbName(), req.GetCollectionName(), req.GetPartitionName())
+		if err != nil {
+			resp.Status = merr.Status(err)
+			return resp, nil
+		}
+		partitionIDs = []UniqueID{partitionID}
+	}
+	if req.GetEndTs() != 0 && req.GetStartTs() > req.GetEndTs() {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("start_ts %d is larger than end_ts %d", req.GetStartTs(), req.GetEndTs()))
+		return resp, nil
+	}
+
+	exportRequest := &internalpb.ExportRequestInternal{
+		DbID:           dbInfo.dbID,
+		CollectionID:   collectionID,
+		CollectionName: req.GetCollectionName(),
+		PartitionIDs:   partitionIDs,
+		Schema:         schema.CollectionSchema,
+		StartTs:        req.GetStartTs(),
+		EndTs:          req.GetEndTs(),
+		Options:        req.GetOptions(),
+	}
+	resp, err = node.dataCoord.ExportV2(ctx, exportRequest)
+	if err != nil {
+		log.Warn("export failed", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	}
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, err
+}
+
+func (node *Proxy) GetExportProgress(ctx context.Context, req *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.GetExportProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("jobID", req.GetJobID()),
+	)
+	method := "GetExportProgress"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	resp, err := node.dataCoord.GetExportProgress(ctx, req)
+	if resp.GetStatus().GetCode() != 0 || err != nil {
+		log.Warn("get export progress failed", zap.String("reason", resp.GetStatus().GetReason()), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), "").Inc()
+	} else {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), "").Inc()
+	}
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), "").Inc()
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, err
+}
+
+func (node *Proxy) ListExports(ctx context.Context, req *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListExportsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	resp := &internalpb.ListExportsResponse{
+		Status: merr.Success(),
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("dbName", req.GetDbName()),
+		zap.String("collectionName", req.GetCollectionName()),
+	)
+	method := "ListExports"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Info(rpcReceived(method))
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+
+	var (
+		err          error
+		collectionID UniqueID
+	)
+	if req.GetCollectionName() != "" {
+		collectionID, err = globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
+		if err != nil {
+			resp.Status = merr.Status(err)
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+			return resp, nil
+		}
+	}
+	resp, err = node.dataCoord.ListExports(ctx, &internalpb.ListExportsRequestInternal{
+		CollectionID: collectionID,
+	})
+	if resp.GetStatus().GetCode() != 0 || err != nil {
+		log.Warn("list exports", zap.String("reason", resp.GetStatus().GetReason()), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	} else {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, req.GetDbName(), req.GetCollectionName()).Inc()
+	}
+	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
 // DeregisterSubLabel must add the sub-labels here if using other labels for the sub-labels
 func DeregisterSubLabel(subLabel string) {
 	rateCol.DeregisterSubLabel(internalpb.RateType_DQLQuery.String(), subLabel)
@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/importutilv2"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -1698,6 +1699,26 @@ func TestProxy_ImportV2(t *testing.T) {
 		})
 		assert.NoError(t, err)
 		assert.Equal(t, int32(0), rsp.GetStatus().GetCode())
+
+		// upsert import with autoID
+		mc = NewMockCache(t)
+		mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+		mc.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, mock.Anything).Return(&schemaInfo{
+			CollectionSchema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+				{IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+			}},
+		}, nil)
+		globalMetaCache = mc
+		rsp, err = node.ImportV2(ctx, &internalpb.ImportRequest{
+			CollectionName: "aaa",
+			Files: []*internalpb.ImportFile{{
+				Id:    1,
+				Paths: []string{"a.json"},
+			}},
+			Options: []*commonpb.KeyValuePair{{Key: importutilv2.Upsert, Value: "true"}},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, int32(0), rsp.GetStatus().GetCode())
 	})
 
 	t.Run("GetImportProgress", func(t *testing.T) {
@@ -1740,6 +1761,39 @@ func TestProxy_ImportV2(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, int32(0), rsp.GetStatus().GetCode())
 	})
+
+	t.Run("ExportV2", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+		// no such database
+		mc := NewMockCache(t)
+		mc.EXPECT().GetDatabaseInfo(mock.Anything, mock.Anything).Return(nil, mockErr)
+		globalMetaCache = mc
+		rsp, err := node.ExportV2(ctx, &internalpb.ExportRequest{DbName: "db", CollectionName: "col"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, int32(0), rsp.GetStatus().GetCode())
+
+		// normal case, the database is passed to the datacoord
+		mc = NewMockCache(t)
+		mc.EXPECT().GetDatabaseInfo(mock.Anything, mock.Anything).Return(&databaseInfo{dbID: 1}, nil)
+		mc.EXPECT().GetCollectionID(mock.Anything, mock.Anything, mock.Anything).Return(2, nil)
+		mc.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, mock.Anything).Return(&schemaInfo{
+			CollectionSchema: &schemapb.CollectionSchema{},
+		}, nil)
+		globalMetaCache = mc
+		dataCoord := mocks.NewMockDataCoordClient(t)
+		dataCoord.EXPECT().ExportV2(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *internalpb.ExportRequestInternal, opts ...grpc.CallOption) (*internalpb.ExportResponse, error) {
+				assert.Equal(t, int64(1), req.GetDbID())
+				assert.Equal(t, int64(2), req.GetCollectionID())
+				return &internalpb.ExportResponse{Status: merr.Success()}, nil
+			})
+		node.dataCoord = dataCoord
+		rsp, err = node.ExportV2(ctx, &internalpb.ExportRequest{DbName: "db", CollectionName: "col"})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), rsp.GetStatus().GetCode())
+	})
 }
 
 func TestGetCollectionRateSubLabel(t *testing.T) {
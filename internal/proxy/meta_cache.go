@@ -19,6 +19,7 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
 	"strconv"
 	"strings"
@@ -28,6 +29,7 @@ import (
 	"github.com/samber/lo"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -87,6 +89,9 @@ type Cache interface {
 	GetUserRole(username string) []string
 	RefreshPolicyInfo(op typeutil.CacheOp) error
 	InitPolicyInfo(info []string, userRoles []string)
+	// GetRowPolicies get the row policies of the roles in the database.
+	GetRowPolicies(database string, roleNames []string) []*internalpb.RowPolicy
+	InitRowPolicies(policies []*internalpb.RowPolicy)
 
 	RemoveDatabase(ctx context.Context, database string)
 	HasDatabase(ctx context.Context, database string) bool
@@ -126,6 +131,7 @@ type schemaInfo struct {
 	hasPartitionKeyField bool
 	pkField              *schemapb.FieldSchema
 	schemaHelper         *typeutil.SchemaHelper
+	version              uint64 // hash of the schema, 0 means unknown
 }
 
 func newSchemaInfo(schema *schemapb.CollectionSchema) *schemaInfo {
@@ -149,9 +155,21 @@ func newSchemaInfo(schema *schemapb.CollectionSchema) *schemaInfo {
 		hasPartitionKeyField: hasPartitionkey,
 		pkField:              pkField,
 		schemaHelper:         schemaHelper,
+		version:              getSchemaVersion(schema),
 	}
 }
 
+// getSchemaVersion returns the hash of the schema, the expressions parsed with the same schema version are identical.
+func getSchemaVersion(schema *schemapb.CollectionSchema) uint64 {
+	bs, err := proto.MarshalOptions{Deterministic: true}.Marshal(schema)
+	if err != nil {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write(bs)
+	return h.Sum64()
+}
+
 func (s *schemaInfo) MapFieldID(name string) (int64, bool) {
 	return s.fieldMap.Get(name)
 }
@@ -262,6 +280,7 @@ type MetaCache struct {
 	credMap          map[string]*internalpb.CredentialInfo   // cache for credential, lazy load
 	privilegeInfos   map[string]struct{}                     // privileges cache
 	userToRoles      map[string]map[string]struct{}          // user to role cache
+	rowPolicies      []*internalpb.RowPolicy                 // row policies cache
 	mu               sync.RWMutex
 	credMut          sync.RWMutex
 	leaderMut        sync.RWMutex
@@ -293,6 +312,7 @@ func InitMetaCache(ctx context.Context, rootCoord types.RootCoordClient, queryCo
 		return err
 	}
 	globalMetaCache.InitPolicyInfo(resp.PolicyInfos, resp.UserRoles)
+	globalMetaCache.InitRowPolicies(resp.RowPolicies)
 	log.Info("success to init meta cache", zap.Strings("policy_infos", resp.PolicyInfos))
 	return nil
 }
@@ -1067,6 +1087,21 @@ func (m *MetaCache) GetUserRole(user string) []string {
 	return util.StringList(m.userToRoles[user])
 }
 
+func (m *MetaCache) InitRowPolicies(policies []*internalpb.RowPolicy) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rowPolicies = policies
+}
+
+func (m *MetaCache) GetRowPolicies(database string, roleNames []string) []*internalpb.RowPolicy {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return lo.Filter(m.rowPolicies, func(policy *internalpb.RowPolicy, _ int) bool {
+		return policy.GetDbName() == database && lo.Contains(roleNames, policy.GetRoleName())
+	})
+}
+
 func (m *MetaCache) RefreshPolicyInfo(op typeutil.CacheOp) (err error) {
 	defer func() {
 		if err == nil {
@@ -1132,6 +1167,7 @@ func (m *MetaCache) RefreshPolicyInfo(op typeutil.CacheOp) (err error) {
 		m.userToRoles = make(map[string]map[string]struct{})
 		m.privilegeInfos = make(map[string]struct{})
 		m.unsafeInitPolicyInfo(resp.PolicyInfos, resp.UserRoles)
+		m.rowPolicies = resp.RowPolicies
 	default:
 		return fmt.Errorf("invalid opType, op_type: %d, op_key: %s", int(op.OpType), op.OpKey)
 	}
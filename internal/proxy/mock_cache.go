@@ -773,6 +773,51 @@ func (_c *MockCache_GetPrivilegeInfo_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetRowPolicies provides a mock function with given fields: database, roleNames
+func (_m *MockCache) GetRowPolicies(database string, roleNames []string) []*internalpb.RowPolicy {
+	ret := _m.Called(database, roleNames)
+
+	var r0 []*internalpb.RowPolicy
+	if rf, ok := ret.Get(0).(func(string, []string) []*internalpb.RowPolicy); ok {
+		r0 = rf(database, roleNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*internalpb.RowPolicy)
+		}
+	}
+
+	return r0
+}
+
+// MockCache_GetRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRowPolicies'
+type MockCache_GetRowPolicies_Call struct {
+	*mock.Call
+}
+
+// GetRowPolicies is a helper method to define mock.On call
+//   - database string
+//   - roleNames []string
+func (_e *MockCache_Expecter) GetRowPolicies(database interface{}, roleNames interface{}) *MockCache_GetRowPolicies_Call {
+	return &MockCache_GetRowPolicies_Call{Call: _e.mock.On("GetRowPolicies", database, roleNames)}
+}
+
+func (_c *MockCache_GetRowPolicies_Call) Run(run func(database string, roleNames []string)) *MockCache_GetRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetRowPolicies_Call) Return(_a0 []*internalpb.RowPolicy) *MockCache_GetRowPolicies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCache_GetRowPolicies_Call) RunAndReturn(run func(string, []string) []*internalpb.RowPolicy) *MockCache_GetRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetShards provides a mock function with given fields: ctx, withCache, database, collectionName, collectionID
 func (_m *MockCache) GetShards(ctx context.Context, withCache bool, database string, collectionName string, collectionID int64) (map[string][]nodeInfo, error) {
 	ret := _m.Called(ctx, withCache, database, collectionName, collectionID)
@@ -952,6 +997,39 @@ func (_c *MockCache_InitPolicyInfo_Call) RunAndReturn(run func([]string, []strin
 	return _c
 }
 
+// InitRowPolicies provides a mock function with given fields: policies
+func (_m *MockCache) InitRowPolicies(policies []*internalpb.RowPolicy) {
+	_m.Called(policies)
+}
+
+// MockCache_InitRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitRowPolicies'
+type MockCache_InitRowPolicies_Call struct {
+	*mock.Call
+}
+
+// InitRowPolicies is a helper method to define mock.On call
+//   - policies []*internalpb.RowPolicy
+func (_e *MockCache_Expecter) InitRowPolicies(policies interface{}) *MockCache_InitRowPolicies_Call {
+	return &MockCache_InitRowPolicies_Call{Call: _e.mock.On("InitRowPolicies", policies)}
+}
+
+func (_c *MockCache_InitRowPolicies_Call) Run(run func(policies []*internalpb.RowPolicy)) *MockCache_InitRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*internalpb.RowPolicy))
+	})
+	return _c
+}
+
+func (_c *MockCache_InitRowPolicies_Call) Return() *MockCache_InitRowPolicies_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCache_InitRowPolicies_Call) RunAndReturn(run func([]*internalpb.RowPolicy)) *MockCache_InitRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InvalidateShardLeaderCache provides a mock function with given fields: collections
 func (_m *MockCache) InvalidateShardLeaderCache(collections []int64) {
 	_m.Called(collections)
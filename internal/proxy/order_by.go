@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	orderByAsc  = "asc"
+	orderByDesc = "desc"
+)
+
+// parseOrderByFields parses the order by fields of query, which are passed with the key OrderByKey as
+// a comma separated list of `field[:asc|desc]`, e.g. `price:desc,id`. The direction is ascending by default.
+// Only the sortable scalar fields of the schema can be ordered by.
+func parseOrderByFields(queryParamsPair []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) ([]*internalpb.OrderByField, error) {
+	orderByStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OrderByKey, queryParamsPair)
+	if err != nil || strings.TrimSpace(orderByStr) == "" {
+		return nil, nil
+	}
+
+	orderByFields := make([]*internalpb.OrderByField, 0)
+	visited := make(map[int64]struct{})
+	for _, item := range strings.Split(orderByStr, ",") {
+		name, direction, _ := strings.Cut(strings.TrimSpace(item), ":")
+		name = strings.TrimSpace(name)
+		ascending := true
+		switch strings.ToLower(strings.TrimSpace(direction)) {
+		case "", orderByAsc:
+		case orderByDesc:
+			ascending = false
+		default:
+			return nil, merr.WrapErrParameterInvalidMsg("invalid order direction [%s] of field [%s], should be asc or desc", direction, name)
+		}
+
+		field := typeutil.GetFieldByName(schema, name)
+		if field == nil {
+			return nil, merr.WrapErrFieldNotFound(name, "order by field not found")
+		}
+		if field.GetIsDynamic() || !typeutil2.IsSortableType(field.GetDataType()) {
+			return nil, merr.WrapErrParameterInvalidMsg("field [%s] of type %s is not sortable, only bool, integer, floating and string fields can be ordered by",
+				name, field.GetDataType().String())
+		}
+		if _, ok := visited[field.GetFieldID()]; ok {
+			return nil, merr.WrapErrParameterInvalidMsg("duplicated order by field [%s]", name)
+		}
+		visited[field.GetFieldID()] = struct{}{}
+		orderByFields = append(orderByFields, &internalpb.OrderByField{
+			FieldId:   field.GetFieldID(),
+			Ascending: ascending,
+		})
+	}
+	return orderByFields, nil
+}
+
+// reduceOrderedRetrieveResults merges the retrieve results of query nodes, which are already ordered by the
+// order by fields, and applies the offset and limit.
+func reduceOrderedRetrieveResults(retrieveResults []*internalpb.RetrieveResults, queryParams *queryParams) (*milvuspb.QueryResults, error) {
+	ret := &milvuspb.QueryResults{}
+
+	orders := make([]*typeutil2.RowOrder, len(retrieveResults))
+	sorted := make([][]int64, len(retrieveResults))
+	size := 0
+	for i, r := range retrieveResults {
+		order, err := typeutil2.NewRowOrder(r.GetIds(), r.GetFieldsData(), queryParams.orderBy)
+		if err != nil {
+			return nil, merr.WrapErrServiceInternal(err.Error())
+		}
+		indexes := make([]int64, order.Len())
+		for j := range indexes {
+			indexes[j] = int64(j)
+		}
+		orders[i], sorted[i] = order, indexes
+		size += len(indexes)
+	}
+
+	limit := queryParams.limit
+	if limit == typeutil.Unlimited || limit > int64(size) {
+		ret.FieldsData = typeutil.PrepareResultFieldData(retrieveResults[0].GetFieldsData(), int64(size))
+	} else {
+		ret.FieldsData = typeutil.PrepareResultFieldData(retrieveResults[0].GetFieldsData(), limit)
+	}
+	idSet := make(map[interface{}]struct{})
+	cursors := make([]int, len(retrieveResults))
+	skipped := int64(0)
+	var retSize int64
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	for limit == typeutil.Unlimited || int64(len(idSet))-skipped < limit {
+		sel := typeutil2.SelectFirstRow(orders, sorted, cursors)
+		if sel == -1 {
+			break
+		}
+		idx := sorted[sel][cursors[sel]]
+		cursors[sel]++
+
+		pk := typeutil.GetPK(retrieveResults[sel].GetIds(), idx)
+		if _, ok := idSet[pk]; ok {
+			continue
+		}
+		idSet[pk] = struct{}{}
+		// handle offset
+		if skipped < queryParams.offset {
+			skipped++
+			continue
+		}
+		retSize += typeutil.AppendFieldData(ret.FieldsData, retrieveResults[sel].GetFieldsData(), idx)
+
+		// limit retrieve result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, fmt.Errorf("query results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+	return ret, nil
+}
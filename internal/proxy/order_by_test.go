@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestParseOrderByFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "price", DataType: schemapb.DataType_Double},
+			{FieldID: 102, Name: "title", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 104, Name: "meta", DataType: schemapb.DataType_JSON},
+			{FieldID: 105, Name: "tags", DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int64},
+		},
+	}
+	parse := func(value string) ([]*internalpb.OrderByField, error) {
+		return parseOrderByFields([]*commonpb.KeyValuePair{{Key: OrderByKey, Value: value}}, schema)
+	}
+
+	fields, err := parse("price:desc, title, id:ASC")
+	require.NoError(t, err)
+	assert.Equal(t, []*internalpb.OrderByField{
+		{FieldId: 101, Ascending: false},
+		{FieldId: 102, Ascending: true},
+		{FieldId: 100, Ascending: true},
+	}, fields)
+
+	fields, err = parseOrderByFields(nil, schema)
+	assert.NoError(t, err)
+	assert.Nil(t, fields)
+
+	for _, value := range []string{"vec", "meta", "tags", "not_exist", "price:up", "price,price:desc"} {
+		_, err = parse(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestReduceOrderedRetrieveResults(t *testing.T) {
+	newResult := func(pks []int64, prices []float64) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			FieldsData: []*schemapb.FieldData{
+				getFieldData("id", 100, schemapb.DataType_Int64, pks, 1),
+				getFieldData("price", 101, schemapb.DataType_Double, prices, 1),
+			},
+		}
+	}
+	// query nodes return the results ordered by price desc
+	results := []*internalpb.RetrieveResults{
+		newResult([]int64{3, 1, 5}, []float64{9.0, 5.0, 1.0}),
+		newResult([]int64{2, 4, 5}, []float64{7.0, 5.0, 1.0}),
+	}
+	orderBy := []*internalpb.OrderByField{{FieldId: 101, Ascending: false}}
+
+	ret, err := reduceRetrieveResults(context.Background(), results, &queryParams{limit: 3, offset: 1, orderBy: orderBy})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 1, 4}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []float64{7.0, 5.0, 5.0}, ret.GetFieldsData()[1].GetScalars().GetDoubleData().GetData())
+
+	ret, err = reduceRetrieveResults(context.Background(), results, &queryParams{limit: typeutil.Unlimited, orderBy: orderBy})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 2, 1, 4, 5}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	ret, err = reduceRetrieveResults(context.Background(), results, &queryParams{limit: 3, offset: 10, orderBy: orderBy})
+	require.NoError(t, err)
+	assert.Empty(t, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+}
@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// partialUpdateMode is how an upsert handles the fields not supplied by the request.
+type partialUpdateMode int
+
+const (
+	// the entities are replaced by the supplied fields
+	partialUpdateDisabled partialUpdateMode = iota
+	// the fields not supplied are kept, and the upsert fails if a primary key doesn't exist
+	partialUpdateErrorIfMissing
+	// the fields not supplied are kept, and the entities of the primary keys which don't exist are inserted
+	partialUpdateInsertIfMissing
+)
+
+// getPartialUpdateMode gets the partial update mode of an upsert from the properties of the msg base.
+func getPartialUpdateMode(request *milvuspb.UpsertRequest) (partialUpdateMode, error) {
+	value := request.GetBase().GetProperties()[common.PartialUpdateKey]
+	switch strings.ToLower(value) {
+	case "", "false":
+		return partialUpdateDisabled, nil
+	case "true", "error":
+		return partialUpdateErrorIfMissing, nil
+	case "insert":
+		return partialUpdateInsertIfMissing, nil
+	default:
+		return partialUpdateDisabled, merr.WrapErrParameterInvalidMsg("invalid %s [%s], it should be error or insert", common.PartialUpdateKey, value)
+	}
+}
+
+// fillPartialUpdate fetches the entities of the primary keys of an upsert, and fills the fields not supplied by the
+// request with the values of the entities, so that the upsert only modifies the supplied fields.
+// The merge is not atomic: the entities are fetched with strong consistency before the upsert is enqueued, and
+// nothing is locked or compared in between, so the concurrent writes of the same entities which land after the
+// fetch are overwritten by the fetched values, the last upsert wins. The callers must serialize the partial updates
+// of an entity themselves if they require read-modify-write semantics.
+func (node *Proxy) fillPartialUpdate(ctx context.Context, request *milvuspb.UpsertRequest, mode partialUpdateMode) error {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	pkField, err := schema.GetPkField()
+	if err != nil {
+		return err
+	}
+	pkData, ok := lo.Find(request.GetFieldsData(), func(field *schemapb.FieldData) bool {
+		return field.GetFieldName() == pkField.GetName()
+	})
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("primary key field %s must be supplied by partial update", pkField.GetName())
+	}
+	ids, err := parsePrimaryFieldData2IDs(pkData)
+	if err != nil {
+		return err
+	}
+	if typeutil.GetSizeOfIDs(ids) != int(request.GetNumRows()) {
+		return merr.WrapErrParameterInvalidMsg("the number of primary keys %d mismatches num_rows %d", typeutil.GetSizeOfIDs(ids), request.GetNumRows())
+	}
+
+	fields := lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return field.GetFieldID() >= common.StartOfUserFieldID && !field.GetIsPrimaryKey() &&
+			!lo.ContainsBy(request.GetFieldsData(), func(fieldData *schemapb.FieldData) bool {
+				return fieldData.GetFieldName() == field.GetName()
+			})
+	})
+
+	// the entities are fetched from the partition which the upsert deletes the old entities from
+	var partitionNames []string
+	if request.GetPartitionName() != "" {
+		partitionNames = []string{request.GetPartitionName()}
+	} else if !typeutil.HasPartitionKey(schema.CollectionSchema) {
+		partitionNames = []string{Params.CommonCfg.DefaultPartitionName.GetValue()}
+	}
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: &milvuspb.QueryRequest{
+			DbName:         request.GetDbName(),
+			CollectionName: request.GetCollectionName(),
+			PartitionNames: partitionNames,
+			OutputFields: lo.Map(fields, func(field *schemapb.FieldSchema, _ int) string {
+				return field.GetName()
+			}),
+			ConsistencyLevel: commonpb.ConsistencyLevel_Strong,
+		},
+		ids: ids,
+		qc:  node.queryCoord,
+		lb:  node.lbPolicy,
+	}
+	result, err := node.query(ctx, qt)
+	if err != nil {
+		return err
+	}
+	if err := merr.Error(result.GetStatus()); err != nil {
+		return err
+	}
+
+	columns, err := mergePartialUpdateFields(ids, result.GetFieldsData(), pkField, fields, mode)
+	if err != nil {
+		return err
+	}
+	request.FieldsData = append(request.FieldsData, columns...)
+	return nil
+}
+
+// mergePartialUpdateFields builds the columns of the fields not supplied by a partial update from the entities
+// fetched by the primary keys. The entities of the primary keys which don't exist are inserted with null or the
+// default values of the fields if the mode allows.
+func mergePartialUpdateFields(ids *schemapb.IDs, entities []*schemapb.FieldData, pkField *schemapb.FieldSchema, fields []*schemapb.FieldSchema, mode partialUpdateMode) ([]*schemapb.FieldData, error) {
+	findColumn := func(name string) *schemapb.FieldData {
+		column, _ := lo.Find(entities, func(fieldData *schemapb.FieldData) bool {
+			return fieldData.GetFieldName() == name
+		})
+		return column
+	}
+
+	rows := make(map[any]int64)
+	if pkColumn := findColumn(pkField.GetName()); pkColumn != nil {
+		for i := 0; i < typeutil.GetPKSize(pkColumn); i++ {
+			rows[typeutil.GetData(pkColumn, i)] = int64(i)
+		}
+	}
+	numRows := typeutil.GetSizeOfIDs(ids)
+	for i := 0; i < numRows; i++ {
+		pk := typeutil.GetPK(ids, int64(i))
+		if _, ok := rows[pk]; !ok && mode != partialUpdateInsertIfMissing {
+			return nil, merr.WrapErrParameterInvalidMsg("the entity of primary key %v doesn't exist", pk)
+		}
+	}
+
+	columns := make([]*schemapb.FieldData, 0, len(fields))
+	for _, field := range fields {
+		column, err := typeutil.GenEmptyFieldData(field)
+		if err != nil {
+			return nil, err
+		}
+		src := findColumn(field.GetName())
+		// the fields with null or default values carry the valid data of all the rows
+		withValidData := field.GetNullable() || field.GetDefaultValue() != nil
+		validData := make([]bool, 0, numRows)
+		for i := 0; i < numRows; i++ {
+			pk := typeutil.GetPK(ids, int64(i))
+			row, ok := rows[pk]
+			if !ok {
+				if !withValidData {
+					return nil, merr.WrapErrParameterInvalidMsg("field %s must be supplied to insert the entity of primary key %v", field.GetName(), pk)
+				}
+				validData = append(validData, false)
+				continue
+			}
+			if src == nil {
+				return nil, merr.WrapErrServiceInternal("field " + field.GetName() + " of the entities is not fetched")
+			}
+			if len(src.GetValidData()) > 0 && !src.GetValidData()[row] {
+				validData = append(validData, false)
+				continue
+			}
+			typeutil.AppendFieldData([]*schemapb.FieldData{column}, []*schemapb.FieldData{src}, row)
+			validData = append(validData, true)
+		}
+		if withValidData {
+			column.ValidData = validData
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestGetPartialUpdateMode(t *testing.T) {
+	mode, err := getPartialUpdateMode(&milvuspb.UpsertRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, partialUpdateDisabled, mode)
+
+	cases := map[string]partialUpdateMode{
+		"false":  partialUpdateDisabled,
+		"true":   partialUpdateErrorIfMissing,
+		"Error":  partialUpdateErrorIfMissing,
+		"insert": partialUpdateInsertIfMissing,
+	}
+	for value, expected := range cases {
+		mode, err := getPartialUpdateMode(&milvuspb.UpsertRequest{
+			Base: &commonpb.MsgBase{Properties: map[string]string{common.PartialUpdateKey: value}},
+		})
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, mode, value)
+	}
+
+	_, err = getPartialUpdateMode(&milvuspb.UpsertRequest{
+		Base: &commonpb.MsgBase{Properties: map[string]string{common.PartialUpdateKey: "ignore"}},
+	})
+	assert.Error(t, err)
+}
+
+func TestMergePartialUpdateFields(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	nameField := &schemapb.FieldSchema{FieldID: 101, Name: "name", DataType: schemapb.DataType_VarChar}
+	ageField := &schemapb.FieldSchema{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64, Nullable: true}
+
+	age := getFieldData("age", 102, schemapb.DataType_Int64, []int64{10, 0}, 1)
+	age.ValidData = []bool{true, false}
+	entities := []*schemapb.FieldData{
+		getFieldData("id", 100, schemapb.DataType_Int64, []int64{2, 1}, 1),
+		getFieldData("name", 101, schemapb.DataType_VarChar, []string{"b", "a"}, 1),
+		age,
+	}
+	ids := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}}
+
+	// the entity of primary key 3 doesn't exist
+	_, err := mergePartialUpdateFields(ids, entities, pkField, []*schemapb.FieldSchema{ageField}, partialUpdateErrorIfMissing)
+	assert.Error(t, err)
+
+	columns, err := mergePartialUpdateFields(ids, entities, pkField, []*schemapb.FieldSchema{ageField}, partialUpdateInsertIfMissing)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(columns))
+	assert.Equal(t, "age", columns[0].GetFieldName())
+	assert.Equal(t, []int64{10}, columns[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []bool{false, true, false}, columns[0].GetValidData())
+
+	// the inserted entity must supply the fields without null or default values
+	_, err = mergePartialUpdateFields(ids, entities, pkField, []*schemapb.FieldSchema{nameField}, partialUpdateInsertIfMissing)
+	assert.Error(t, err)
+
+	ids.GetIntId().Data = []int64{1, 2}
+	columns, err = mergePartialUpdateFields(ids, entities, pkField, []*schemapb.FieldSchema{nameField, ageField}, partialUpdateErrorIfMissing)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(columns))
+	assert.Equal(t, []string{"a", "b"}, columns[0].GetScalars().GetStringData().GetData())
+	assert.Nil(t, columns[0].GetValidData())
+	assert.Equal(t, []int64{10}, columns[1].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []bool{false, true}, columns[1].GetValidData())
+
+	// no entity is found
+	_, err = mergePartialUpdateFields(ids, nil, pkField, []*schemapb.FieldSchema{nameField}, partialUpdateErrorIfMissing)
+	assert.Error(t, err)
+}
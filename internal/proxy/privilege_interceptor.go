@@ -84,7 +84,16 @@ func UnaryServerInterceptor(privilegeFunc PrivilegeFunc) grpc.UnaryServerInterce
 	}
 }
 
+// PrivilegeInterceptor checks the privileges of the request, then applies the row policies to the permitted request.
 func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context, error) {
+	ctx, err := privilegeInterceptor(ctx, req)
+	if err != nil {
+		return ctx, err
+	}
+	return applyRowPolicies(ctx, req)
+}
+
+func privilegeInterceptor(ctx context.Context, req interface{}) (context.Context, error) {
 	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
 		return ctx, nil
 	}
@@ -297,6 +297,8 @@ func (node *Proxy) Init() error {
 	}
 	log.Debug("init meta cache done", zap.String("role", typeutil.ProxyRole))
 
+	globalExprCache = newExprCache(Params.ProxyCfg.ExprCacheCapacity.GetAsInt())
+
 	node.enableMaterializedView = Params.CommonCfg.EnableMaterializedView.GetAsBool()
 
 	log.Info("init proxy done", zap.Int64("nodeID", paramtable.GetNodeID()), zap.String("Address", node.address))
@@ -27,6 +27,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -192,6 +193,21 @@ func getRequestInfo(ctx context.Context, req interface{}) (int64, map[int64][]in
 			collToPartIDs[collectionID] = []int64{}
 		}
 		return db.dbID, collToPartIDs, internalpb.RateType_DDLFlush, 1, nil
+	case *proxypb.SearchCollectionsRequest:
+		db, err := globalMetaCache.GetDatabaseInfo(ctx, r.GetDbName())
+		if err != nil {
+			return util.InvalidDBID, map[int64][]int64{}, 0, 0, err
+		}
+
+		collToPartIDs := make(map[int64][]int64, 0)
+		for _, collectionName := range r.GetCollectionNames() {
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, r.GetDbName(), collectionName)
+			if err != nil {
+				return util.InvalidDBID, map[int64][]int64{}, 0, 0, err
+			}
+			collToPartIDs[collectionID] = []int64{}
+		}
+		return db.dbID, collToPartIDs, internalpb.RateType_DQLSearch, int(r.GetRequest().GetNq()), nil
 	case *milvuspb.ManualCompactionRequest:
 		dbName := GetCurDBNameFromContextOrDefault(ctx)
 		dbInfo, err := globalMetaCache.GetDatabaseInfo(ctx, dbName)
@@ -229,6 +245,10 @@ func getFailedResponse(req any, err error) any {
 		return &milvuspb.SearchResults{
 			Status: merr.Status(err),
 		}
+	case *proxypb.SearchCollectionsRequest:
+		return &proxypb.SearchCollectionsResults{
+			Status: merr.Status(err),
+		}
 	case *milvuspb.QueryRequest:
 		return &milvuspb.QueryResults{
 			Status: merr.Status(err),
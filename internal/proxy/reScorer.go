@@ -8,14 +8,17 @@ import (
 	"strings"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type rankType int
@@ -25,13 +28,50 @@ const (
 	rrfRankType                      // rrfRankType = 1
 	weightedRankType                 // weightedRankType = 2
 	udfExprRankType                  // udfExprRankType = 3
+	decayRankType                    // decayRankType = 4
+	minMaxRankType                   // minMaxRankType = 5
+	maxScoreRankType                 // maxScoreRankType = 6
 )
 
-var rankTypeMap = map[string]rankType{
-	"invalid":  invalidRankType,
-	"rrf":      rrfRankType,
-	"weighted": weightedRankType,
-	"expr":     udfExprRankType,
+const (
+	DecayFunctionKey = "function"
+	DecayFieldKey    = "field"
+	DecayOriginKey   = "origin"
+	DecayScaleKey    = "scale"
+	DecayOffsetKey   = "offset"
+	DecayDecayKey    = "decay"
+
+	gaussDecayFunction  = "gauss"
+	expDecayFunction    = "exp"
+	linearDecayFunction = "linear"
+
+	defaultDecayValue = 0.5
+)
+
+// reScorerBuilder builds the reScorers of all the sub search requests from the rank params.
+// The params are the json decoded value of RankParamsKey, nil if it's not provided.
+type reScorerBuilder func(reqCnt int, params map[string]interface{}, schema *schemapb.CollectionSchema) ([]reScorer, error)
+
+// reScorerBuilders is a map of registered rank type to reScorer builder.
+var reScorerBuilders = make(map[string]reScorerBuilder)
+
+// registerReScorer registers the reScorer builder of a rank type.
+//
+// NOTE: this function must only be called during initialization time (i.e. in
+// an init() function). If multiple builders are registered with the same name, panic will occur.
+func registerReScorer(name string, builder reScorerBuilder) {
+	if _, ok := reScorerBuilders[name]; ok {
+		panic("reScorer already registered: " + name)
+	}
+	reScorerBuilders[name] = builder
+}
+
+func init() {
+	registerReScorer("rrf", newRRFScorers)
+	registerReScorer("weighted", newWeightedScorers)
+	registerReScorer("decay", newDecayScorers)
+	registerReScorer("min_max", newMinMaxScorers)
+	registerReScorer("max_score", newMaxScoreScorers)
 }
 
 type reScorer interface {
@@ -40,6 +80,11 @@ type reScorer interface {
 	reScore(input *milvuspb.SearchResults)
 	setMetricType(metricType string)
 	getMetricType() string
+	// inputFieldIDs returns the scalar fields required by reScore,
+	// they should be output by every sub search request.
+	inputFieldIDs() []int64
+	// mergeScore merges the scores of the same entity from different sub search requests.
+	mergeScore(accumulated float32, score float32) float32
 }
 
 type baseScorer struct {
@@ -59,6 +104,14 @@ func (bs *baseScorer) getMetricType() string {
 	return bs.metricType
 }
 
+func (bs *baseScorer) inputFieldIDs() []int64 {
+	return nil
+}
+
+func (bs *baseScorer) mergeScore(accumulated float32, score float32) float32 {
+	return accumulated + score
+}
+
 type rrfScorer struct {
 	baseScorer
 	k float32
@@ -81,8 +134,8 @@ type weightedScorer struct {
 
 type activateFunc func(float32) float32
 
-func (ws *weightedScorer) getActivateFunc() activateFunc {
-	mUpper := strings.ToUpper(ws.getMetricType())
+func getActivateFunc(metricType string) activateFunc {
+	mUpper := strings.ToUpper(metricType)
 	isCosine := mUpper == strings.ToUpper(metric.COSINE)
 	isIP := mUpper == strings.ToUpper(metric.IP)
 	if isCosine {
@@ -105,6 +158,10 @@ func (ws *weightedScorer) getActivateFunc() activateFunc {
 	return f
 }
 
+func (ws *weightedScorer) getActivateFunc() activateFunc {
+	return getActivateFunc(ws.getMetricType())
+}
+
 func (ws *weightedScorer) reScore(input *milvuspb.SearchResults) {
 	activateF := ws.getActivateFunc()
 	for i, distance := range input.Results.GetScores() {
@@ -116,16 +173,141 @@ func (ws *weightedScorer) scorerType() rankType {
 	return weightedRankType
 }
 
-func NewReScorers(reqCnt int, rankParams []*commonpb.KeyValuePair) ([]reScorer, error) {
+// maxScoreScorer normalizes the scores like weightedScorer,
+// but takes the max score of the entity among all the sub search requests instead of the sum.
+type maxScoreScorer struct {
+	weightedScorer
+}
+
+func (ms *maxScoreScorer) scorerType() rankType {
+	return maxScoreRankType
+}
+
+func (ms *maxScoreScorer) mergeScore(accumulated float32, score float32) float32 {
+	if score > accumulated {
+		return score
+	}
+	return accumulated
+}
+
+// minMaxScorer normalizes the scores of each query into [0, 1] by the min and max score of the query,
+// the best hit gets 1 and the worst hit gets 0. The reduced scores are always the larger the better,
+// since the distances of non positively related metrics have been negated.
+type minMaxScorer struct {
+	baseScorer
+	weight float32
+}
+
+func (ms *minMaxScorer) reScore(input *milvuspb.SearchResults) {
+	scores := input.Results.GetScores()
+	start := int64(0)
+	for _, topk := range input.Results.GetTopks() {
+		end := start + topk
+		if topk <= 0 {
+			continue
+		}
+		minScore, maxScore := scores[start], scores[start]
+		for i := start + 1; i < end; i++ {
+			minScore = min(minScore, scores[i])
+			maxScore = max(maxScore, scores[i])
+		}
+		for i := start; i < end; i++ {
+			normalized := float32(1)
+			if maxScore > minScore {
+				normalized = (scores[i] - minScore) / (maxScore - minScore)
+			}
+			scores[i] = ms.weight * normalized
+		}
+		start = end
+	}
+}
+
+func (ms *minMaxScorer) scorerType() rankType {
+	return minMaxRankType
+}
+
+type decayFunc func(distance float64) float64
+
+// decayScorer boosts the normalized score by how close the value of a numeric field is to the origin.
+// The decay factor is 1 within origin ± offset, and equals to decay at origin ± (offset + scale).
+type decayScorer struct {
+	weightedScorer
+	fieldID int64
+	decayF  decayFunc
+	origin  float64
+	offset  float64
+}
+
+func newDecayFunc(function string, scale float64, decay float64) (decayFunc, error) {
+	switch function {
+	case gaussDecayFunction:
+		return func(distance float64) float64 {
+			return math.Exp(math.Log(decay) * distance * distance / (scale * scale))
+		}, nil
+	case expDecayFunction:
+		return func(distance float64) float64 {
+			return math.Exp(math.Log(decay) * distance / scale)
+		}, nil
+	case linearDecayFunction:
+		return func(distance float64) float64 {
+			return math.Max(0, 1-(1-decay)*distance/scale)
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported decay function %s, should be one of [%s, %s, %s]",
+			function, gaussDecayFunction, expDecayFunction, linearDecayFunction)
+	}
+}
+
+func (ds *decayScorer) inputFieldIDs() []int64 {
+	return []int64{ds.fieldID}
+}
+
+func (ds *decayScorer) decayFactor(value float64) float32 {
+	distance := math.Max(0, math.Abs(value-ds.origin)-ds.offset)
+	return float32(ds.decayF(distance))
+}
+
+func (ds *decayScorer) reScore(input *milvuspb.SearchResults) {
+	ds.weightedScorer.reScore(input)
+	fieldData, ok := lo.Find(input.Results.GetFieldsData(), func(fieldData *schemapb.FieldData) bool {
+		return fieldData.GetFieldId() == ds.fieldID
+	})
+	if !ok {
+		// no hit in the result
+		return
+	}
+	for i := range input.Results.GetScores() {
+		var value float64
+		switch v := typeutil.GetData(fieldData, i).(type) {
+		case int32:
+			value = float64(v)
+		case int64:
+			value = float64(v)
+		case float32:
+			value = float64(v)
+		case float64:
+			value = v
+		default:
+			continue
+		}
+		input.Results.Scores[i] *= ds.decayFactor(value)
+	}
+}
+
+func (ds *decayScorer) scorerType() rankType {
+	return decayRankType
+}
+
+func NewReScorers(reqCnt int, rankParams []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) ([]reScorer, error) {
 	if reqCnt == 0 {
 		return []reScorer{}, nil
 	}
 
-	res := make([]reScorer, reqCnt)
 	rankTypeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RankTypeKey, rankParams)
 	if err != nil {
 		log.Info("rank strategy not specified, use rrf instead")
 		// if not set rank strategy, use rrf rank as default
+		res := make([]reScorer, reqCnt)
 		for i := 0; i < reqCnt; i++ {
 			res[i] = &rrfScorer{
 				baseScorer: baseScorer{
@@ -137,7 +319,8 @@ func NewReScorers(reqCnt int, rankParams []*commonpb.KeyValuePair) ([]reScorer,
 		return res, nil
 	}
 
-	if _, ok := rankTypeMap[rankTypeStr]; !ok {
+	builder, ok := reScorerBuilders[rankTypeStr]
+	if !ok {
 		return nil, errors.Errorf("unsupported rank type %s", rankTypeStr)
 	}
 
@@ -151,70 +334,212 @@ func NewReScorers(reqCnt int, rankParams []*commonpb.KeyValuePair) ([]reScorer,
 	if err != nil {
 		return nil, err
 	}
+	return builder(reqCnt, params, schema)
+}
 
-	switch rankTypeMap[rankTypeStr] {
-	case rrfRankType:
-		_, ok := params[RRFParamsKey]
-		if !ok {
-			return nil, errors.New(RRFParamsKey + " not found in rank_params")
+func newRRFScorers(reqCnt int, params map[string]interface{}, _ *schemapb.CollectionSchema) ([]reScorer, error) {
+	_, ok := params[RRFParamsKey]
+	if !ok {
+		return nil, errors.New(RRFParamsKey + " not found in rank_params")
+	}
+	var k float64
+	if reflect.ValueOf(params[RRFParamsKey]).CanFloat() {
+		k = reflect.ValueOf(params[RRFParamsKey]).Float()
+	} else {
+		return nil, errors.New("The type of rank param k should be float")
+	}
+	if k <= 0 || k >= maxRRFParamsValue {
+		return nil, errors.New(fmt.Sprintf("The rank params k should be in range (0, %d)", maxRRFParamsValue))
+	}
+	log.Debug("rrf params", zap.Float64("k", k))
+	res := make([]reScorer, reqCnt)
+	for i := 0; i < reqCnt; i++ {
+		res[i] = &rrfScorer{
+			baseScorer: baseScorer{
+				scorerName: "rrf",
+			},
+			k: float32(k),
 		}
-		var k float64
-		if reflect.ValueOf(params[RRFParamsKey]).CanFloat() {
-			k = reflect.ValueOf(params[RRFParamsKey]).Float()
-		} else {
-			return nil, errors.New("The type of rank param k should be float")
+	}
+	return res, nil
+}
+
+// parseWeights parses the weights of sub search requests from the rank params,
+// if the weights are optional and not provided, all the weights are 1.
+func parseWeights(reqCnt int, params map[string]interface{}, optional bool) ([]float32, error) {
+	if _, ok := params[WeightsParamsKey]; !ok {
+		if optional {
+			return lo.RepeatBy(reqCnt, func(_ int) float32 { return 1 }), nil
 		}
-		if k <= 0 || k >= maxRRFParamsValue {
-			return nil, errors.New(fmt.Sprintf("The rank params k should be in range (0, %d)", maxRRFParamsValue))
+		return nil, errors.New(WeightsParamsKey + " not found in rank_params")
+	}
+	weights := make([]float32, 0)
+	switch reflect.TypeOf(params[WeightsParamsKey]).Kind() {
+	case reflect.Slice:
+		rs := reflect.ValueOf(params[WeightsParamsKey])
+		for i := 0; i < rs.Len(); i++ {
+			v := rs.Index(i).Elem()
+			if v.CanFloat() {
+				weight := v.Float()
+				if weight < 0 || weight > 1 {
+					return nil, errors.New("rank param weight should be in range [0, 1]")
+				}
+				weights = append(weights, float32(weight))
+			} else {
+				return nil, errors.New("The type of rank param weight should be float")
+			}
 		}
-		log.Debug("rrf params", zap.Float64("k", k))
-		for i := 0; i < reqCnt; i++ {
-			res[i] = &rrfScorer{
+	default:
+		return nil, errors.New("The weights param should be an array")
+	}
+
+	log.Debug("weights params", zap.Any("weights", weights))
+	if reqCnt != len(weights) {
+		return nil, merr.WrapErrParameterInvalid(fmt.Sprint(reqCnt), fmt.Sprint(len(weights)), "the length of weights param mismatch with ann search requests")
+	}
+	return weights, nil
+}
+
+func newWeightedScorers(reqCnt int, params map[string]interface{}, _ *schemapb.CollectionSchema) ([]reScorer, error) {
+	weights, err := parseWeights(reqCnt, params, false)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]reScorer, reqCnt)
+	for i := 0; i < reqCnt; i++ {
+		res[i] = &weightedScorer{
+			baseScorer: baseScorer{
+				scorerName: "weighted",
+			},
+			weight: weights[i],
+		}
+	}
+	return res, nil
+}
+
+func newMaxScoreScorers(reqCnt int, params map[string]interface{}, _ *schemapb.CollectionSchema) ([]reScorer, error) {
+	weights, err := parseWeights(reqCnt, params, true)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]reScorer, reqCnt)
+	for i := 0; i < reqCnt; i++ {
+		res[i] = &maxScoreScorer{
+			weightedScorer: weightedScorer{
 				baseScorer: baseScorer{
-					scorerName: "rrf",
+					scorerName: "max_score",
 				},
-				k: float32(k),
-			}
-		}
-	case weightedRankType:
-		if _, ok := params[WeightsParamsKey]; !ok {
-			return nil, errors.New(WeightsParamsKey + " not found in rank_params")
+				weight: weights[i],
+			},
 		}
-		weights := make([]float32, 0)
-		switch reflect.TypeOf(params[WeightsParamsKey]).Kind() {
-		case reflect.Slice:
-			rs := reflect.ValueOf(params[WeightsParamsKey])
-			for i := 0; i < rs.Len(); i++ {
-				v := rs.Index(i).Elem()
-				if v.CanFloat() {
-					weight := v.Float()
-					if weight < 0 || weight > 1 {
-						return nil, errors.New("rank param weight should be in range [0, 1]")
-					}
-					weights = append(weights, float32(weight))
-				} else {
-					return nil, errors.New("The type of rank param weight should be float")
-				}
-			}
-		default:
-			return nil, errors.New("The weights param should be an array")
+	}
+	return res, nil
+}
+
+func newMinMaxScorers(reqCnt int, params map[string]interface{}, _ *schemapb.CollectionSchema) ([]reScorer, error) {
+	weights, err := parseWeights(reqCnt, params, true)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]reScorer, reqCnt)
+	for i := 0; i < reqCnt; i++ {
+		res[i] = &minMaxScorer{
+			baseScorer: baseScorer{
+				scorerName: "min_max",
+			},
+			weight: weights[i],
 		}
+	}
+	return res, nil
+}
 
-		log.Debug("weights params", zap.Any("weights", weights))
-		if reqCnt != len(weights) {
-			return nil, merr.WrapErrParameterInvalid(fmt.Sprint(reqCnt), fmt.Sprint(len(weights)), "the length of weights param mismatch with ann search requests")
+// getFloatParam gets a float param from the rank params, defaultValue is returned if it's not provided.
+func getFloatParam(params map[string]interface{}, key string, defaultValue *float64) (float64, error) {
+	value, ok := params[key]
+	if !ok {
+		if defaultValue == nil {
+			return 0, errors.New(key + " not found in rank_params")
 		}
-		for i := 0; i < reqCnt; i++ {
-			res[i] = &weightedScorer{
+		return *defaultValue, nil
+	}
+	if !reflect.ValueOf(value).CanFloat() {
+		return 0, errors.Errorf("The type of rank param %s should be float", key)
+	}
+	return reflect.ValueOf(value).Float(), nil
+}
+
+func newDecayScorers(reqCnt int, params map[string]interface{}, schema *schemapb.CollectionSchema) ([]reScorer, error) {
+	function, ok := params[DecayFunctionKey].(string)
+	if !ok {
+		return nil, errors.New(DecayFunctionKey + " not found in rank_params or it's not a string")
+	}
+	fieldName, ok := params[DecayFieldKey].(string)
+	if !ok {
+		return nil, errors.New(DecayFieldKey + " not found in rank_params or it's not a string")
+	}
+	field, ok := lo.Find(schema.GetFields(), func(field *schemapb.FieldSchema) bool {
+		return field.GetName() == fieldName
+	})
+	if !ok {
+		return nil, merr.WrapErrFieldNotFound(fieldName)
+	}
+	if !typeutil.IsIntegerType(field.GetDataType()) && !typeutil.IsFloatingType(field.GetDataType()) {
+		return nil, merr.WrapErrParameterInvalid("numeric field", field.GetDataType().String(),
+			fmt.Sprintf("the decay field %s should be numeric", fieldName))
+	}
+
+	origin, err := getFloatParam(params, DecayOriginKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	scale, err := getFloatParam(params, DecayScaleKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	if scale <= 0 {
+		return nil, errors.New("rank param scale should be greater than 0")
+	}
+	defaultOffset := float64(0)
+	offset, err := getFloatParam(params, DecayOffsetKey, &defaultOffset)
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 {
+		return nil, errors.New("rank param offset should not be negative")
+	}
+	defaultDecay := float64(defaultDecayValue)
+	decay, err := getFloatParam(params, DecayDecayKey, &defaultDecay)
+	if err != nil {
+		return nil, err
+	}
+	if decay <= 0 || decay >= 1 {
+		return nil, errors.New("rank param decay should be in range (0, 1)")
+	}
+	decayF, err := newDecayFunc(function, scale, decay)
+	if err != nil {
+		return nil, err
+	}
+	weights, err := parseWeights(reqCnt, params, true)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("decay params", zap.String("function", function), zap.String("field", fieldName),
+		zap.Float64("origin", origin), zap.Float64("scale", scale), zap.Float64("offset", offset), zap.Float64("decay", decay))
+	res := make([]reScorer, reqCnt)
+	for i := 0; i < reqCnt; i++ {
+		res[i] = &decayScorer{
+			weightedScorer: weightedScorer{
 				baseScorer: baseScorer{
-					scorerName: "weighted",
+					scorerName: "decay",
 				},
 				weight: weights[i],
-			}
+			},
+			fieldID: field.GetFieldID(),
+			decayF:  decayF,
+			origin:  origin,
+			offset:  offset,
 		}
-	default:
-		return nil, errors.Errorf("unsupported rank type %s", rankTypeStr)
 	}
-
 	return res, nil
 }
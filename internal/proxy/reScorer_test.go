@@ -7,11 +7,14 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/metric"
 )
 
 func TestRescorer(t *testing.T) {
 	t.Run("default scorer", func(t *testing.T) {
-		rescorers, err := NewReScorers(2, nil)
+		rescorers, err := NewReScorers(2, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(rescorers))
 		assert.Equal(t, rrfRankType, rescorers[0].scorerType())
@@ -26,7 +29,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		_, err = NewReScorers(2, rankParams)
+		_, err = NewReScorers(2, rankParams, nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "k not found in rank_params")
 	})
@@ -41,7 +44,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		_, err = NewReScorers(2, rankParams)
+		_, err = NewReScorers(2, rankParams, nil)
 		assert.Error(t, err)
 
 		params[RRFParamsKey] = maxRRFParamsValue + 1
@@ -52,7 +55,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		_, err = NewReScorers(2, rankParams)
+		_, err = NewReScorers(2, rankParams, nil)
 		assert.Error(t, err)
 	})
 
@@ -66,7 +69,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		rescorers, err := NewReScorers(2, rankParams)
+		rescorers, err := NewReScorers(2, rankParams, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(rescorers))
 		assert.Equal(t, rrfRankType, rescorers[0].scorerType())
@@ -82,7 +85,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		_, err = NewReScorers(2, rankParams)
+		_, err = NewReScorers(2, rankParams, nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not found in rank_params")
 	})
@@ -98,7 +101,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		_, err = NewReScorers(2, rankParams)
+		_, err = NewReScorers(2, rankParams, nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "rank param weight should be in range [0, 1]")
 	})
@@ -114,10 +117,142 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		rescorers, err := NewReScorers(2, rankParams)
+		rescorers, err := NewReScorers(2, rankParams, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(rescorers))
 		assert.Equal(t, weightedRankType, rescorers[0].scorerType())
 		assert.Equal(t, float32(weights[0]), rescorers[0].(*weightedScorer).weight)
 	})
+
+	t.Run("unsupported rank type", func(t *testing.T) {
+		rankParams := []*commonpb.KeyValuePair{
+			{Key: RankTypeKey, Value: "unknown"},
+			{Key: RankParamsKey, Value: "{}"},
+		}
+
+		_, err := NewReScorers(2, rankParams, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported rank type")
+	})
+
+	t.Run("register duplicated", func(t *testing.T) {
+		assert.Panics(t, func() {
+			registerReScorer("rrf", newRRFScorers)
+		})
+	})
+
+	t.Run("max score", func(t *testing.T) {
+		rankParams := []*commonpb.KeyValuePair{
+			{Key: RankTypeKey, Value: "max_score"},
+			{Key: RankParamsKey, Value: "{}"},
+		}
+
+		rescorers, err := NewReScorers(2, rankParams, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(rescorers))
+		assert.Equal(t, maxScoreRankType, rescorers[0].scorerType())
+		assert.Equal(t, float32(1), rescorers[0].(*maxScoreScorer).weight)
+		assert.Equal(t, float32(0.8), rescorers[0].mergeScore(0.5, 0.8))
+		assert.Equal(t, float32(0.8), rescorers[0].mergeScore(0.8, 0.5))
+
+		rankParams[1].Value = `{"weights": [0.5]}`
+		_, err = NewReScorers(2, rankParams, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("min max", func(t *testing.T) {
+		rankParams := []*commonpb.KeyValuePair{
+			{Key: RankTypeKey, Value: "min_max"},
+			{Key: RankParamsKey, Value: `{"weights": [0.5, 1]}`},
+		}
+
+		rescorers, err := NewReScorers(2, rankParams, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, minMaxRankType, rescorers[0].scorerType())
+		assert.Equal(t, float32(0.5), rescorers[0].(*minMaxScorer).weight)
+
+		result := &milvuspb.SearchResults{
+			Results: &schemapb.SearchResultData{
+				Topks:  []int64{3, 1},
+				Scores: []float32{-1, -3, -5, 2},
+			},
+		}
+		rescorers[1].setMetricType(metric.L2)
+		rescorers[1].reScore(result)
+		assert.Equal(t, []float32{1, 0.5, 0, 1}, result.Results.Scores)
+	})
+
+	t.Run("decay", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "price", DataType: schemapb.DataType_Double},
+				{FieldID: 102, Name: "name", DataType: schemapb.DataType_VarChar},
+			},
+		}
+		newRankParams := func(params string) []*commonpb.KeyValuePair {
+			return []*commonpb.KeyValuePair{
+				{Key: RankTypeKey, Value: "decay"},
+				{Key: RankParamsKey, Value: params},
+			}
+		}
+
+		invalidParams := map[string]string{
+			`{"field": "price", "origin": 0, "scale": 10}`:                                    "function not found",
+			`{"function": "gauss", "origin": 0, "scale": 10}`:                                 "field not found",
+			`{"function": "gauss", "field": "none", "origin": 0, "scale": 10}`:                "field not found",
+			`{"function": "gauss", "field": "name", "origin": 0, "scale": 10}`:                "should be numeric",
+			`{"function": "gauss", "field": "price", "scale": 10}`:                            "origin not found",
+			`{"function": "gauss", "field": "price", "origin": 0, "scale": 0}`:                "scale should be greater than 0",
+			`{"function": "gauss", "field": "price", "origin": 0, "scale": "a"}`:              "should be float",
+			`{"function": "gauss", "field": "price", "origin": 0, "scale": 10, "offset": -1}`: "offset should not be negative",
+			`{"function": "gauss", "field": "price", "origin": 0, "scale": 10, "decay": 1}`:   "decay should be in range (0, 1)",
+			`{"function": "sigmoid", "field": "price", "origin": 0, "scale": 10}`:             "unsupported decay function",
+		}
+		for params, expectErr := range invalidParams {
+			_, err := NewReScorers(2, newRankParams(params), schema)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), expectErr)
+		}
+
+		for _, function := range []string{"gauss", "exp", "linear"} {
+			params := `{"function": "` + function + `", "field": "price", "origin": 100, "scale": 10, "offset": 5, "decay": 0.5}`
+			rescorers, err := NewReScorers(2, newRankParams(params), schema)
+			assert.NoError(t, err)
+			assert.Equal(t, decayRankType, rescorers[0].scorerType())
+			assert.Equal(t, []int64{101}, rescorers[0].inputFieldIDs())
+
+			ds := rescorers[0].(*decayScorer)
+			assert.InDelta(t, 1, ds.decayFactor(104), 1e-6)
+			assert.InDelta(t, 0.5, ds.decayFactor(85), 1e-6)
+			assert.InDelta(t, 0.5, ds.decayFactor(115), 1e-6)
+			assert.Less(t, ds.decayFactor(130), float32(0.5))
+		}
+
+		rescorers, err := NewReScorers(2, newRankParams(`{"function": "linear", "field": "price", "origin": 100, "scale": 10}`), schema)
+		assert.NoError(t, err)
+		result := &milvuspb.SearchResults{
+			Results: &schemapb.SearchResultData{
+				Topks:  []int64{2},
+				Scores: []float32{1, 1},
+				FieldsData: []*schemapb.FieldData{
+					{
+						Type:    schemapb.DataType_Double,
+						FieldId: 101,
+						Field: &schemapb.FieldData_Scalars{
+							Scalars: &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_DoubleData{
+									DoubleData: &schemapb.DoubleArray{Data: []float64{100, 120}},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		rescorers[0].setMetricType(metric.COSINE)
+		rescorers[0].reScore(result)
+		assert.InDelta(t, 1, result.Results.Scores[0], 1e-6)
+		assert.InDelta(t, 0, result.Results.Scores[1], 1e-6)
+	})
 }
@@ -19,5 +19,8 @@ func createMilvusReducer(ctx context.Context, params *queryParams, req *internal
 			collectionName: collectionName,
 		}
 	}
+	if len(req.GetAggregates()) > 0 {
+		return newAggregateReducer(params, req, schema, collectionName)
+	}
 	return newDefaultLimitReducer(ctx, params, req, schema, collectionName)
 }
@@ -0,0 +1,227 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/contextutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// the actions which the row policies apply to
+const (
+	RowPolicyActionSearch = "Search"
+	RowPolicyActionQuery  = "Query"
+	RowPolicyActionDelete = "Delete"
+)
+
+var rowPolicyActions = []string{RowPolicyActionSearch, RowPolicyActionQuery, RowPolicyActionDelete}
+
+func validateRowPolicyActions(actions []string) error {
+	for _, action := range actions {
+		if !lo.Contains(rowPolicyActions, action) {
+			return merr.WrapErrParameterInvalid(strings.Join(rowPolicyActions, "/"), action, "invalid row policy action")
+		}
+	}
+	return nil
+}
+
+// validateRowPolicy checks the row policy before it's created, the alias of the collection
+// is resolved into the collection name and id, and the expr must be valid for the collection.
+func validateRowPolicy(ctx context.Context, policy *internalpb.RowPolicy) error {
+	if policy == nil {
+		return merr.WrapErrParameterInvalidMsg("the row policy in the request is nil")
+	}
+	if policy.GetPolicyName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the row policy name is empty")
+	}
+	if err := ValidateRoleName(policy.GetRoleName()); err != nil {
+		return err
+	}
+	if err := validateCollectionName(policy.GetCollectionName()); err != nil {
+		return err
+	}
+	if err := validateRowPolicyActions(policy.GetActions()); err != nil {
+		return err
+	}
+	if strings.TrimSpace(policy.GetExpr()) == "" {
+		return merr.WrapErrParameterInvalidMsg("the expr of the row policy is empty")
+	}
+	if policy.GetDbName() == "" {
+		policy.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, policy.GetDbName(), policy.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, policy.GetDbName(), policy.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	policy.CollectionName = schema.GetName()
+	policy.CollectionId = collectionID
+	if _, err := planparserv2.ParseExpr(schema.schemaHelper, policy.GetExpr()); err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid expr of the row policy: %s", err.Error())
+	}
+	return nil
+}
+
+type rowPolicyExprsKey struct{}
+
+// rowPolicyExprs are the exprs of the row policies which apply to the request, keyed by the collection id.
+// The exprs are grouped by role, sorted by the role name.
+type rowPolicyExprs map[int64][][]string
+
+// applyRowPolicies attaches the exprs of the row policies of the caller's roles to the context of the search,
+// query and delete requests, which are combined into the filters by andRowPolicyExprs when the plans are created.
+// The root user and the admin role aren't restricted. The roles without any policy of the collection
+// don't grant access to all the rows, otherwise the public role would lift every policy.
+func applyRowPolicies(ctx context.Context, req interface{}) (context.Context, error) {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return ctx, nil
+	}
+	var dbName, action string
+	var collectionNames []string
+	switch r := req.(type) {
+	case *milvuspb.SearchRequest:
+		dbName, collectionNames, action = r.GetDbName(), []string{r.GetCollectionName()}, RowPolicyActionSearch
+	case *milvuspb.HybridSearchRequest:
+		dbName, collectionNames, action = r.GetDbName(), []string{r.GetCollectionName()}, RowPolicyActionSearch
+	case *proxypb.SearchCollectionsRequest:
+		dbName, collectionNames, action = r.GetDbName(), r.GetCollectionNames(), RowPolicyActionSearch
+	case *milvuspb.QueryRequest:
+		dbName, collectionNames, action = r.GetDbName(), []string{r.GetCollectionName()}, RowPolicyActionQuery
+	case *milvuspb.DeleteRequest:
+		dbName, collectionNames, action = r.GetDbName(), []string{r.GetCollectionName()}, RowPolicyActionDelete
+	default:
+		return ctx, nil
+	}
+
+	username, _, err := contextutil.GetAuthInfoFromContext(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	if username == util.UserRoot {
+		return ctx, nil
+	}
+	roleNames, err := GetRole(username)
+	if err != nil {
+		return ctx, err
+	}
+	if lo.Contains(roleNames, util.RoleAdmin) {
+		return ctx, nil
+	}
+	roleNames = append(roleNames, util.RolePublic)
+	if dbName == "" {
+		dbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	policies := globalMetaCache.GetRowPolicies(dbName, roleNames)
+	if len(policies) == 0 {
+		return ctx, nil
+	}
+
+	applied, _ := ctx.Value(rowPolicyExprsKey{}).(rowPolicyExprs)
+	collectionExprs := make(rowPolicyExprs, len(applied)+len(collectionNames))
+	for collectionID, exprs := range applied {
+		collectionExprs[collectionID] = exprs
+	}
+	for _, collectionName := range collectionNames {
+		// the policies are bound to the collection id, an alias is resolved to the collection
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+		if err != nil {
+			return ctx, err
+		}
+		matched := lo.Filter(policies, func(policy *internalpb.RowPolicy, _ int) bool {
+			return policy.GetCollectionId() == collectionID &&
+				(len(policy.GetActions()) == 0 || lo.Contains(policy.GetActions(), action))
+		})
+		if len(matched) == 0 {
+			continue
+		}
+		roleMatched := lo.GroupBy(matched, func(policy *internalpb.RowPolicy) string {
+			return policy.GetRoleName()
+		})
+		roles := lo.Keys(roleMatched)
+		sort.Strings(roles)
+		exprs := lo.Map(roles, func(role string, _ int) []string {
+			return lo.Map(roleMatched[role], func(policy *internalpb.RowPolicy, _ int) string {
+				return policy.GetExpr()
+			})
+		})
+		collectionExprs[collectionID] = exprs
+		log.Ctx(ctx).Debug("apply row policies", zap.String("username", username), zap.String("db_name", dbName),
+			zap.String("collection_name", collectionName), zap.Int64("collection_id", collectionID),
+			zap.String("action", action), zap.Strings("roles", roles), zap.Any("exprs", exprs))
+	}
+	if len(collectionExprs) == 0 {
+		return ctx, nil
+	}
+	return context.WithValue(ctx, rowPolicyExprsKey{}, collectionExprs), nil
+}
+
+// andRowPolicyExprs ANDs the row policies of the collection in the context into the filter expr.
+// The exprs of a role are ANDed and the roles are ORed, a user sees the rows allowed by any of the roles.
+// Each expr is parsed on its own and combined at plan level, so the filter can't break out of the policies,
+// the filter is nil if the request has no filter.
+func andRowPolicyExprs(ctx context.Context, collectionID int64, schema *schemaInfo, expr *planpb.Expr) (*planpb.Expr, error) {
+	collectionExprs, _ := ctx.Value(rowPolicyExprsKey{}).(rowPolicyExprs)
+	var policiesExpr *planpb.Expr
+	for _, roleExprs := range collectionExprs[collectionID] {
+		var roleExpr *planpb.Expr
+		for _, policyExpr := range roleExprs {
+			predicates, err := planparserv2.ParseExpr(schema.schemaHelper, policyExpr)
+			if err != nil {
+				return nil, merr.WrapErrParameterInvalidMsg("invalid expr of the row policy: %s", err.Error())
+			}
+			roleExpr = combineExprs(planpb.BinaryExpr_LogicalAnd, roleExpr, predicates)
+		}
+		policiesExpr = combineExprs(planpb.BinaryExpr_LogicalOr, policiesExpr, roleExpr)
+	}
+	return combineExprs(planpb.BinaryExpr_LogicalAnd, expr, policiesExpr), nil
+}
+
+// combineExprs combines the exprs by the logical op, a nil expr is skipped.
+func combineExprs(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{
+				Op:    op,
+				Left:  left,
+				Right: right,
+			},
+		},
+	}
+}
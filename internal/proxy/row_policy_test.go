@@ -0,0 +1,279 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newRowPolicyTestSchema() *schemaInfo {
+	return newSchemaInfo(&schemapb.CollectionSchema{
+		Name: "coll1",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "16"}}},
+			{FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}},
+		},
+	})
+}
+
+func TestApplyRowPolicies(t *testing.T) {
+	paramtable.Init()
+	cacheBak := globalMetaCache
+	defer func() { globalMetaCache = cacheBak }()
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	cache := NewMockCache(t)
+	cache.EXPECT().GetUserRole("alice").Return([]string{"role1"}).Maybe()
+	cache.EXPECT().GetUserRole("admin_user").Return([]string{util.RoleAdmin}).Maybe()
+	cache.EXPECT().GetRowPolicies("default", []string{"role1", util.RolePublic}).Return([]*internalpb.RowPolicy{
+		{PolicyName: "p1", RoleName: "role1", DbName: "default", CollectionName: "coll1", CollectionId: 1, Actions: []string{RowPolicyActionSearch}, Expr: `tenant == "a"`},
+		{PolicyName: "p2", RoleName: "role1", DbName: "default", CollectionName: "coll1", CollectionId: 1, Expr: "pk > 0"},
+		{PolicyName: "p3", RoleName: "role1", DbName: "default", CollectionName: "coll2", CollectionId: 2, Expr: "pk > 10"},
+		// the policy of a dropped collection with the same name
+		{PolicyName: "p4", RoleName: "role1", DbName: "default", CollectionName: "coll1", CollectionId: 3, Expr: "pk > 100"},
+	}).Maybe()
+	cache.EXPECT().GetUserRole("bob").Return([]string{"role2", "role1"}).Maybe()
+	cache.EXPECT().GetRowPolicies("default", []string{"role2", "role1", util.RolePublic}).Return([]*internalpb.RowPolicy{
+		{PolicyName: "p5", RoleName: "role2", DbName: "default", CollectionName: "coll1", CollectionId: 1, Expr: `tenant == "b"`},
+		{PolicyName: "p1", RoleName: "role1", DbName: "default", CollectionName: "coll1", CollectionId: 1, Actions: []string{RowPolicyActionSearch}, Expr: `tenant == "a"`},
+		{PolicyName: "p2", RoleName: "role1", DbName: "default", CollectionName: "coll1", CollectionId: 1, Expr: "pk > 0"},
+		{PolicyName: "p6", RoleName: "role2", DbName: "default", CollectionName: "coll1", CollectionId: 1, Expr: "pk < 100"},
+		{PolicyName: "p7", RoleName: util.RolePublic, DbName: "default", CollectionName: "coll2", CollectionId: 2, Expr: "pk > 10"},
+	}).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, "default", "coll1").Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, "default", "alias1").Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, "default", "coll2").Return(2, nil).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, "default", "coll3").Return(0, merr.WrapErrCollectionNotFound("coll3")).Maybe()
+	globalMetaCache = cache
+
+	getExprs := func(ctx context.Context) rowPolicyExprs {
+		exprs, _ := ctx.Value(rowPolicyExprsKey{}).(rowPolicyExprs)
+		return exprs
+	}
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "false")
+		req := &milvuspb.QueryRequest{CollectionName: "coll1", Expr: "pk < 10"}
+		ctx, err := applyRowPolicies(GetContext(context.Background(), "alice:123456"), req)
+		assert.NoError(t, err)
+		assert.Nil(t, getExprs(ctx))
+	})
+
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+
+	t.Run("unrestricted users", func(t *testing.T) {
+		req := &milvuspb.QueryRequest{CollectionName: "coll1", Expr: "pk < 10"}
+		ctx, err := applyRowPolicies(GetContext(context.Background(), "root:123456"), req)
+		assert.NoError(t, err)
+		assert.Nil(t, getExprs(ctx))
+
+		ctx, err = applyRowPolicies(GetContext(context.Background(), "admin_user:123456"), req)
+		assert.NoError(t, err)
+		assert.Nil(t, getExprs(ctx))
+
+		_, err = applyRowPolicies(context.Background(), req)
+		assert.Error(t, err)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		req := &milvuspb.QueryRequest{CollectionName: "alias1", Expr: "pk < 10"}
+		ctx, err := applyRowPolicies(GetContext(context.Background(), "alice:123456"), req)
+		assert.NoError(t, err)
+		assert.Equal(t, rowPolicyExprs{1: {{"pk > 0"}}}, getExprs(ctx))
+		// the request isn't changed
+		assert.Equal(t, "pk < 10", req.GetExpr())
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := &milvuspb.SearchRequest{CollectionName: "coll1", Dsl: "pk < 10"}
+		ctx, err := applyRowPolicies(GetContext(context.Background(), "alice:123456"), req)
+		assert.NoError(t, err)
+		assert.Equal(t, rowPolicyExprs{1: {{`tenant == "a"`, "pk > 0"}}}, getExprs(ctx))
+		assert.Equal(t, "pk < 10", req.GetDsl())
+
+		hybridReq := &milvuspb.HybridSearchRequest{
+			CollectionName: "coll1",
+			Requests:       []*milvuspb.SearchRequest{{Dsl: "pk < 10"}, {}},
+		}
+		ctx, err = applyRowPolicies(GetContext(context.Background(), "alice:123456"), hybridReq)
+		assert.NoError(t, err)
+		assert.Equal(t, rowPolicyExprs{1: {{`tenant == "a"`, "pk > 0"}}}, getExprs(ctx))
+
+		collectionsReq := &proxypb.SearchCollectionsRequest{CollectionNames: []string{"coll1", "coll2"}}
+		ctx, err = applyRowPolicies(GetContext(context.Background(), "alice:123456"), collectionsReq)
+		assert.NoError(t, err)
+		assert.Equal(t, rowPolicyExprs{1: {{`tenant == "a"`, "pk > 0"}}, 2: {{"pk > 10"}}}, getExprs(ctx))
+
+		collectionsReq = &proxypb.SearchCollectionsRequest{CollectionNames: []string{"coll1", "coll3"}}
+		_, err = applyRowPolicies(GetContext(context.Background(), "alice:123456"), collectionsReq)
+		assert.Error(t, err)
+	})
+
+	t.Run("multiple roles", func(t *testing.T) {
+		// grouped by role, the roles are sorted by name
+		req := &milvuspb.SearchRequest{CollectionName: "coll1", Dsl: "pk < 10"}
+		ctx, err := applyRowPolicies(GetContext(context.Background(), "bob:123456"), req)
+		assert.NoError(t, err)
+		assert.Equal(t, rowPolicyExprs{1: {{`tenant == "a"`, "pk > 0"}, {`tenant == "b"`, "pk < 100"}}}, getExprs(ctx))
+
+		queryReq := &milvuspb.QueryRequest{CollectionName: "coll1", Expr: "pk < 10"}
+		ctx, err = applyRowPolicies(GetContext(context.Background(), "bob:123456"), queryReq)
+		assert.NoError(t, err)
+		assert.Equal(t, rowPolicyExprs{1: {{"pk > 0"}, {`tenant == "b"`, "pk < 100"}}}, getExprs(ctx))
+
+		// the public role
+		queryReq = &milvuspb.QueryRequest{CollectionName: "coll2", Expr: "pk < 10"}
+		ctx, err = applyRowPolicies(GetContext(context.Background(), "bob:123456"), queryReq)
+		assert.NoError(t, err)
+		assert.Equal(t, rowPolicyExprs{2: {{"pk > 10"}}}, getExprs(ctx))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		req := &milvuspb.DeleteRequest{CollectionName: "coll2", Expr: "pk in [1, 2]"}
+		ctx, err := applyRowPolicies(GetContext(context.Background(), "alice:123456"), req)
+		assert.NoError(t, err)
+		assert.Equal(t, rowPolicyExprs{2: {{"pk > 10"}}}, getExprs(ctx))
+		assert.Equal(t, "pk in [1, 2]", req.GetExpr())
+	})
+
+	t.Run("other requests", func(t *testing.T) {
+		req := &milvuspb.LoadCollectionRequest{CollectionName: "coll1"}
+		ctx, err := applyRowPolicies(GetContext(context.Background(), "alice:123456"), req)
+		assert.NoError(t, err)
+		assert.Nil(t, getExprs(ctx))
+	})
+}
+
+func TestValidateRowPolicy(t *testing.T) {
+	cacheBak := globalMetaCache
+	defer func() { globalMetaCache = cacheBak }()
+
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, "default", "alias1").Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, "default", "coll2").Return(0, merr.WrapErrCollectionNotFound("coll2")).Maybe()
+	cache.EXPECT().GetCollectionSchema(mock.Anything, "default", "alias1").Return(newRowPolicyTestSchema(), nil).Maybe()
+	cache.EXPECT().GetCollectionSchema(mock.Anything, "default", "coll2").Return(nil, merr.WrapErrCollectionNotFound("coll2")).Maybe()
+	globalMetaCache = cache
+
+	ctx := context.Background()
+	policy := &internalpb.RowPolicy{
+		PolicyName:     "p1",
+		RoleName:       "role1",
+		CollectionName: "alias1",
+		Actions:        []string{RowPolicyActionSearch, RowPolicyActionQuery},
+		Expr:           `tenant == "a"`,
+	}
+	err := validateRowPolicy(ctx, policy)
+	assert.NoError(t, err)
+	assert.Equal(t, "default", policy.GetDbName())
+	assert.Equal(t, "coll1", policy.GetCollectionName())
+	assert.Equal(t, int64(1), policy.GetCollectionId())
+
+	err = validateRowPolicy(ctx, nil)
+	assert.Error(t, err)
+
+	invalids := []*internalpb.RowPolicy{
+		{RoleName: "role1", CollectionName: "alias1", Expr: "pk > 0"},
+		{PolicyName: "p1", CollectionName: "alias1", Expr: "pk > 0"},
+		{PolicyName: "p1", RoleName: "role1", Expr: "pk > 0"},
+		{PolicyName: "p1", RoleName: "role1", CollectionName: "alias1", Actions: []string{"Insert"}, Expr: "pk > 0"},
+		{PolicyName: "p1", RoleName: "role1", CollectionName: "alias1", Expr: " "},
+		{PolicyName: "p1", RoleName: "role1", CollectionName: "alias1", Expr: "not_exist > 0"},
+		{PolicyName: "p1", RoleName: "role1", CollectionName: "coll2", Expr: "pk > 0"},
+	}
+	for _, invalid := range invalids {
+		err = validateRowPolicy(ctx, invalid)
+		assert.Error(t, err)
+	}
+}
+
+func TestAndRowPolicyExprs(t *testing.T) {
+	schema := newRowPolicyTestSchema()
+	ctx := context.WithValue(context.Background(), rowPolicyExprsKey{}, rowPolicyExprs{1: {{"pk > 0", `tenant == "a"`}}})
+
+	// no policies of the collection
+	expr, err := parseExpr(schema, "pk < 10", nil)
+	assert.NoError(t, err)
+	ret, err := andRowPolicyExprs(ctx, 2, schema, expr)
+	assert.NoError(t, err)
+	assert.Equal(t, expr, ret)
+	ret, err = andRowPolicyExprs(context.Background(), 1, schema, expr)
+	assert.NoError(t, err)
+	assert.Equal(t, expr, ret)
+
+	// no filter
+	ret, err = andRowPolicyExprs(ctx, 1, schema, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, ret.GetBinaryExpr().GetOp())
+	assert.Equal(t, int64(100), ret.GetBinaryExpr().GetLeft().GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+	assert.Equal(t, int64(101), ret.GetBinaryExpr().GetRight().GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+
+	// the filter which used to break out of the policies when they were spliced into the filter string
+	_, err = parseExpr(schema, "pk > 1) || (true", nil)
+	assert.Error(t, err)
+	expr, err = parseExpr(schema, `pk > 1 || tenant != ""`, nil)
+	assert.NoError(t, err)
+	ret, err = andRowPolicyExprs(ctx, 1, schema, expr)
+	assert.NoError(t, err)
+	// (filter && (pk > 0 && tenant == "a")), the policies are always ANDed at the top
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, ret.GetBinaryExpr().GetOp())
+	assert.Equal(t, expr, ret.GetBinaryExpr().GetLeft())
+	right := ret.GetBinaryExpr().GetRight().GetBinaryExpr()
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, right.GetOp())
+	assert.Equal(t, int64(100), right.GetLeft().GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+	assert.Equal(t, int64(101), right.GetRight().GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+
+	// the exprs of a role are ANDed and the roles are ORed: (filter && ((pk > 0 && tenant == "a") || tenant == "b"))
+	ctx = context.WithValue(context.Background(), rowPolicyExprsKey{}, rowPolicyExprs{1: {{"pk > 0", `tenant == "a"`}, {`tenant == "b"`}}})
+	ret, err = andRowPolicyExprs(ctx, 1, schema, expr)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, ret.GetBinaryExpr().GetOp())
+	assert.Equal(t, expr, ret.GetBinaryExpr().GetLeft())
+	roles := ret.GetBinaryExpr().GetRight().GetBinaryExpr()
+	assert.Equal(t, planpb.BinaryExpr_LogicalOr, roles.GetOp())
+	role1 := roles.GetLeft().GetBinaryExpr()
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, role1.GetOp())
+	assert.Equal(t, int64(100), role1.GetLeft().GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+	assert.Equal(t, "a", role1.GetRight().GetUnaryRangeExpr().GetValue().GetStringVal())
+	assert.Equal(t, "b", roles.GetRight().GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	// no filter
+	ret, err = andRowPolicyExprs(ctx, 1, schema, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.BinaryExpr_LogicalOr, ret.GetBinaryExpr().GetOp())
+
+	// the policy is parsed on its own as well
+	ctx = context.WithValue(context.Background(), rowPolicyExprsKey{}, rowPolicyExprs{1: {{"pk > 1) || (true"}}})
+	_, err = andRowPolicyExprs(ctx, 1, schema, expr)
+	assert.Error(t, err)
+}
@@ -7,11 +7,13 @@ import (
 	"sort"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metric"
@@ -51,14 +53,15 @@ func NewReduceSearchResultInfo(
 }
 
 func reduceSearchResult(ctx context.Context, reduceInfo *reduceSearchResultInfo) (*milvuspb.SearchResults, error) {
-	if reduceInfo.queryInfo.GroupByFieldId > 0 {
+	if reduceInfo.queryInfo.GroupByFieldId > 0 || len(reduceInfo.queryInfo.GetGroupByFieldIds()) > 0 {
 		return reduceSearchResultDataWithGroupBy(ctx,
 			reduceInfo.subSearchResultData,
 			reduceInfo.nq,
 			reduceInfo.topK,
 			reduceInfo.metricType,
 			reduceInfo.pkType,
-			reduceInfo.offset)
+			reduceInfo.offset,
+			reduceInfo.queryInfo)
 	}
 	return reduceSearchResultDataNoGroupBy(ctx,
 		reduceInfo.subSearchResultData,
@@ -69,13 +72,30 @@ func reduceSearchResult(ctx context.Context, reduceInfo *reduceSearchResultInfo)
 		reduceInfo.offset)
 }
 
-func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64) (*milvuspb.SearchResults, error) {
+// searchGroup is the hits of a group in the order of score.
+type searchGroup struct {
+	subSearchIdxes  []int
+	resultDataIdxes []int64
+}
+
+// reduceSearchResultDataWithGroupBy keeps at most limit groups of group size hits, the groups are returned in the
+// order of their best hits. The groups with fewer hits than group size are skipped if the group size is strict.
+// The topk of the hits grouped by several fields is the number of candidate hits of all the groups, the groups are
+// limited by the topk of the query info.
+func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64, queryInfo *planpb.QueryInfo) (*milvuspb.SearchResults, error) {
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
 		tr.CtxElapse(ctx, "done")
 	}()
 
-	limit := topk - offset
+	groupSize := max(queryInfo.GetGroupSize(), 1)
+	groupTopK := topk
+	if len(queryInfo.GetGroupByFieldIds()) > 1 {
+		// topk is the number of candidates searched for the groups, more candidates are searched if the groups
+		// are not filled, so the groups are limited by the topk of the query
+		groupTopK = queryInfo.GetTopk() / groupSize
+	}
+	limit := groupTopK - offset
 	log.Ctx(ctx).Debug("reduceSearchResultData",
 		zap.Int("len(subSearchResultData)", len(subSearchResultData)),
 		zap.Int64("nq", nq),
@@ -131,8 +151,14 @@ func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData
 		subSearchNum = len(subSearchResultData)
 		// for results of each subSearchResultData, storing the start offset of each query of nq queries
 		subSearchNqOffset = make([][]int64, subSearchNum)
+		groupKeys         = make([]*typeutil2.SearchGroupKeys, subSearchNum)
 	)
 	for i := 0; i < subSearchNum; i++ {
+		keys, err := typeutil2.NewSearchGroupKeys(subSearchResultData[i], queryInfo.GetGroupByFieldIds())
+		if err != nil {
+			return ret, err
+		}
+		groupKeys[i] = keys
 		subSearchNqOffset[i] = make([]int64, subSearchResultData[i].GetNumQueries())
 		for j := int64(1); j < nq; j++ {
 			subSearchNqOffset[i][j] = subSearchNqOffset[i][j-1] + subSearchResultData[i].Topks[j-1]
@@ -154,13 +180,16 @@ func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData
 			// sum(cursors) == j
 			cursors = make([]int64, subSearchNum)
 
-			j             int64
-			idSet         = make(map[interface{}]struct{})
-			groupByValSet = make(map[interface{}]struct{})
+			j          int64
+			idSet      = make(map[interface{}]struct{})
+			groups     = make([]*searchGroup, 0, groupTopK)
+			groupIdxes = make(map[interface{}]int)
+			fullGroups int64
 		)
 
-		// keep limit results
-		for j = 0; j < limit; {
+		// collect groups until the groups of the page are full, the groups beyond the page may be full first
+		// if the group size is strict
+		for fullGroups < groupTopK {
 			// From all the sub-query result sets of the i-th query vector,
 			//   find the sub-query result set index of the score j-th data,
 			//   and the index of the data in schemapb.SearchResultData
@@ -168,43 +197,73 @@ func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData
 			if subSearchIdx == -1 {
 				break
 			}
-			subSearchRes := subSearchResultData[subSearchIdx]
+			cursors[subSearchIdx]++
 
-			id := typeutil.GetPK(subSearchRes.GetIds(), resultDataIdx)
-			score := subSearchRes.Scores[resultDataIdx]
-			groupByVal := typeutil.GetData(subSearchRes.GetGroupByFieldValue(), int(resultDataIdx))
+			id := typeutil.GetPK(subSearchResultData[subSearchIdx].GetIds(), resultDataIdx)
+			groupByVal := groupKeys[subSearchIdx].Key(resultDataIdx)
 			if groupByVal == nil {
 				return nil, errors.New("get nil groupByVal from subSearchRes, wrong states, as milvus doesn't support nil value," +
 					"there must be sth wrong on queryNode side")
 			}
 
 			// remove duplicates
-			if _, ok := idSet[id]; !ok {
-				_, groupByValExist := groupByValSet[groupByVal]
-				if !groupByValExist {
-					groupByValSet[groupByVal] = struct{}{}
-					if int64(len(groupByValSet)) <= offset {
-						continue
-						// skip offset groups
-					}
-					retSize += typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
-					typeutil.AppendPKs(ret.Results.Ids, id)
-					ret.Results.Scores = append(ret.Results.Scores, score)
-					idSet[id] = struct{}{}
+			if _, ok := idSet[id]; ok {
+				// skip entity with same id
+				skipDupCnt++
+				continue
+			}
+			groupIdx, ok := groupIdxes[groupByVal]
+			if !ok {
+				if !queryInfo.GetStrictGroupSize() && int64(len(groups)) >= groupTopK {
+					// skip entity of the groups beyond the page
+					skipDupCnt++
+					continue
+				}
+				groupIdx = len(groups)
+				groupIdxes[groupByVal] = groupIdx
+				groups = append(groups, &searchGroup{})
+			}
+			group := groups[groupIdx]
+			if int64(len(group.resultDataIdxes)) >= groupSize {
+				// skip entity of the full group
+				skipDupCnt++
+				continue
+			}
+			idSet[id] = struct{}{}
+			group.subSearchIdxes = append(group.subSearchIdxes, subSearchIdx)
+			group.resultDataIdxes = append(group.resultDataIdxes, resultDataIdx)
+			if int64(len(group.resultDataIdxes)) == groupSize {
+				fullGroups++
+			}
+		}
+
+		if queryInfo.GetStrictGroupSize() {
+			groups = lo.Filter(groups, func(group *searchGroup, _ int) bool {
+				return int64(len(group.resultDataIdxes)) == groupSize
+			})
+		}
+		// skip offset groups
+		if int64(len(groups)) <= offset {
+			groups = nil
+		} else {
+			groups = groups[offset:min(int64(len(groups)), groupTopK)]
+		}
+		for _, group := range groups {
+			for k, subSearchIdx := range group.subSearchIdxes {
+				subSearchRes := subSearchResultData[subSearchIdx]
+				resultDataIdx := group.resultDataIdxes[k]
+				retSize += typeutil.AppendFieldData(ret.Results.FieldsData, subSearchRes.FieldsData, resultDataIdx)
+				typeutil.AppendPKs(ret.Results.Ids, typeutil.GetPK(subSearchRes.GetIds(), resultDataIdx))
+				ret.Results.Scores = append(ret.Results.Scores, subSearchRes.Scores[resultDataIdx])
+				if !groupKeys[subSearchIdx].IsComposite() {
+					groupByVal := groupKeys[subSearchIdx].Key(resultDataIdx)
 					if err := typeutil.AppendGroupByValue(ret.Results, groupByVal, subSearchRes.GetGroupByFieldValue().GetType()); err != nil {
 						log.Ctx(ctx).Error("failed to append groupByValues", zap.Error(err))
 						return ret, err
 					}
-					j++
-				} else {
-					// skip entity with same groupby
-					skipDupCnt++
 				}
-			} else {
-				// skip entity with same id
-				skipDupCnt++
+				j++
 			}
-			cursors[subSearchIdx]++
 		}
 		if realTopK != -1 && realTopK != j {
 			log.Ctx(ctx).Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
@@ -384,11 +443,138 @@ func reduceSearchResultDataNoGroupBy(ctx context.Context, subSearchResultData []
 	return ret, nil
 }
 
+// mergeCollectionSearchResults merges the search results of several collections by score and returns the
+// collection name of each merged hit. The results of each collection are reduced already, so the primary keys
+// are not deduplicated across the collections.
+func mergeCollectionSearchResults(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, collectionNames []string, nq int64, limit int64, offset int64, metricType string) (*schemapb.SearchResultData, []string, error) {
+	tr := timerecord.NewTimeRecorder("mergeCollectionSearchResults")
+	defer func() {
+		tr.CtxElapse(ctx, "done")
+	}()
+
+	log.Ctx(ctx).Debug("mergeCollectionSearchResults",
+		zap.Strings("collections", collectionNames),
+		zap.Int64("nq", nq),
+		zap.Int64("offset", offset),
+		zap.Int64("limit", limit),
+		zap.String("metricType", metricType))
+
+	// the sample of the output fields is the result of any collection with hits
+	sample := subSearchResultData[0]
+	for _, sData := range subSearchResultData {
+		if int64(len(sData.GetTopks())) != nq {
+			return nil, nil, fmt.Errorf("search result's nq(%d) mis-match with %d", len(sData.GetTopks()), nq)
+		}
+		if len(sample.GetFieldsData()) == 0 && len(sData.GetFieldsData()) > 0 {
+			sample = sData
+		}
+	}
+	// the output fields of the collections are aligned by name as their orders may be different
+	fieldsData := make([][]*schemapb.FieldData, len(subSearchResultData))
+	for i, sData := range subSearchResultData {
+		if len(sData.GetScores()) == 0 {
+			continue
+		}
+		fieldsData[i] = make([]*schemapb.FieldData, 0, len(sample.GetFieldsData()))
+		for _, sampleField := range sample.GetFieldsData() {
+			field, ok := lo.Find(sData.GetFieldsData(), func(field *schemapb.FieldData) bool {
+				return field.GetFieldName() == sampleField.GetFieldName()
+			})
+			if !ok || field.GetType() != sampleField.GetType() {
+				return nil, nil, merr.WrapErrParameterInvalidMsg("output field %s mismatches in collection %s", sampleField.GetFieldName(), collectionNames[i])
+			}
+			fieldsData[i] = append(fieldsData[i], field)
+		}
+	}
+
+	// the scores of the metrics which are not positively related are negated to select the highest scores
+	if !metric.PositivelyRelated(metricType) {
+		subSearchResultData = lo.Map(subSearchResultData, func(sData *schemapb.SearchResultData, _ int) *schemapb.SearchResultData {
+			return &schemapb.SearchResultData{
+				Ids:            sData.GetIds(),
+				Topks:          sData.GetTopks(),
+				AllSearchCount: sData.GetAllSearchCount(),
+				Scores: lo.Map(sData.GetScores(), func(score float32, _ int) float32 {
+					return -score
+				}),
+			}
+		})
+	}
+
+	ret := &schemapb.SearchResultData{
+		NumQueries:       nq,
+		FieldsData:       typeutil.PrepareResultFieldData(sample.GetFieldsData(), limit),
+		Scores:           []float32{},
+		Ids:              &schemapb.IDs{},
+		Topks:            []int64{},
+		OutputFields:     sample.GetOutputFields(),
+		PrimaryFieldName: sample.GetPrimaryFieldName(),
+	}
+	hitCollections := make([]string, 0, limit)
+
+	var (
+		subSearchNum      = len(subSearchResultData)
+		subSearchNqOffset = make([][]int64, subSearchNum)
+	)
+	for i := 0; i < subSearchNum; i++ {
+		subSearchNqOffset[i] = make([]int64, nq)
+		for j := int64(1); j < nq; j++ {
+			subSearchNqOffset[i][j] = subSearchNqOffset[i][j-1] + subSearchResultData[i].Topks[j-1]
+		}
+		ret.AllSearchCount += subSearchResultData[i].GetAllSearchCount()
+	}
+
+	var retSize int64
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	for i := int64(0); i < nq; i++ {
+		cursors := make([]int64, subSearchNum)
+
+		// skip offset results
+		for k := int64(0); k < offset; k++ {
+			subSearchIdx, _ := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			if subSearchIdx == -1 {
+				break
+			}
+			cursors[subSearchIdx]++
+		}
+
+		// keep limit results
+		var j int64
+		for ; j < limit; j++ {
+			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			if subSearchIdx == -1 {
+				break
+			}
+			sData := subSearchResultData[subSearchIdx]
+			retSize += typeutil.AppendFieldData(ret.FieldsData, fieldsData[subSearchIdx], resultDataIdx)
+			typeutil.AppendPKs(ret.Ids, typeutil.GetPK(sData.GetIds(), resultDataIdx))
+			ret.Scores = append(ret.Scores, sData.Scores[resultDataIdx])
+			hitCollections = append(hitCollections, collectionNames[subSearchIdx])
+			cursors[subSearchIdx]++
+		}
+		ret.Topks = append(ret.Topks, j)
+		ret.TopK = j
+
+		// limit search result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, nil, fmt.Errorf("search results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+
+	if !metric.PositivelyRelated(metricType) {
+		for k := range ret.Scores {
+			ret.Scores[k] *= -1
+		}
+	}
+	return ret, hitCollections, nil
+}
+
 func rankSearchResultData(ctx context.Context,
 	nq int64,
 	params *rankParams,
 	pkType schemapb.DataType,
 	searchResults []*milvuspb.SearchResults,
+	reScorers []reScorer,
 ) (*milvuspb.SearchResults, error) {
 	tr := timerecord.NewTimeRecorder("rankSearchResultData")
 	defer func() {
@@ -440,14 +626,18 @@ func rankSearchResultData(ctx context.Context,
 		accumulatedScores[i] = make(map[interface{}]float32)
 	}
 
-	for _, result := range searchResults {
+	for index, result := range searchResults {
 		scores := result.GetResults().GetScores()
 		start := int64(0)
 		for i := int64(0); i < nq; i++ {
 			realTopk := result.GetResults().Topks[i]
 			for j := start; j < start+realTopk; j++ {
 				id := typeutil.GetPK(result.GetResults().GetIds(), j)
-				accumulatedScores[i][id] += scores[j]
+				if accumulated, ok := accumulatedScores[i][id]; ok {
+					accumulatedScores[i][id] = reScorers[index].mergeScore(accumulated, scores[j])
+				} else {
+					accumulatedScores[i][id] = scores[j]
+				}
 			}
 			start += realTopk
 		}
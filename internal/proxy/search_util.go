@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -99,42 +100,91 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair, schema *schemapb
 		searchParamStr = ""
 	}
 
-	// 5. parse group by field
+	// 5. parse group by fields, the hits may be grouped by a comma separated list of fields
 	groupByFieldName, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParamsPair)
 	if err != nil {
 		groupByFieldName = ""
 	}
 	var groupByFieldId int64 = -1
-	if groupByFieldName != "" {
-		fields := schema.GetFields()
-		for _, field := range fields {
-			if field.Name == groupByFieldName {
-				groupByFieldId = field.FieldID
+	groupByFieldIds := make([]int64, 0)
+	for _, name := range strings.Split(groupByFieldName, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		var fieldID int64 = -1
+		for _, field := range schema.GetFields() {
+			if field.Name == name {
+				fieldID = field.FieldID
 				break
 			}
 		}
-		if groupByFieldId == -1 {
-			return nil, 0, merr.WrapErrFieldNotFound(groupByFieldName, "groupBy field not found in schema")
+		if fieldID == -1 {
+			return nil, 0, merr.WrapErrFieldNotFound(name, "groupBy field not found in schema")
+		}
+		if funcutil.SliceContain(groupByFieldIds, fieldID) {
+			return nil, 0, merr.WrapErrParameterInvalidMsg("duplicated groupBy field [%s]", name)
 		}
+		groupByFieldIds = append(groupByFieldIds, fieldID)
+	}
+	if len(groupByFieldIds) == 1 {
+		// segcore groups the hits by a single field
+		groupByFieldId = groupByFieldIds[0]
 	}
+	isGroupBy := len(groupByFieldIds) > 0
 
-	// 6. disable groupBy for iterator and range search
-	if isIterator == "True" && groupByFieldId > 0 {
+	// 6. parse group size and whether the groups must be full
+	var groupSize int64 = 1
+	groupSizeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupSizeKey, searchParamsPair)
+	if err == nil {
+		groupSize, err = strconv.ParseInt(groupSizeStr, 0, 64)
+		if err != nil || groupSize <= 0 {
+			return nil, 0, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid, should be a positive integer", GroupSizeKey, groupSizeStr)
+		}
+	}
+	strictGroupSize := false
+	strictGroupSizeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(StrictGroupSizeKey, searchParamsPair)
+	if err == nil {
+		strictGroupSize, err = strconv.ParseBool(strictGroupSizeStr)
+		if err != nil {
+			return nil, 0, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid, should be true or false", StrictGroupSizeKey, strictGroupSizeStr)
+		}
+	}
+
+	// 7. disable groupBy for iterator and range search
+	if isIterator == "True" && isGroupBy {
 		return nil, 0, merr.WrapErrParameterInvalid("", "",
 			"Not allowed to do groupBy when doing iteration")
 	}
-	if strings.Contains(searchParamStr, radiusKey) && groupByFieldId > 0 {
+	if strings.Contains(searchParamStr, radiusKey) && isGroupBy {
 		return nil, 0, merr.WrapErrParameterInvalid("", "",
 			"Not allowed to do range-search when doing search-group-by")
 	}
 
-	return &planpb.QueryInfo{
+	if isGroupBy {
+		if err := validateLimit(queryTopK * groupSize); err != nil {
+			return nil, 0, fmt.Errorf("(%s+%s)*%s [%d] is invalid, %w", OffsetKey, TopKKey, GroupSizeKey, queryTopK*groupSize, err)
+		}
+	}
+	if len(groupByFieldIds) > 1 {
+		// the hits are grouped by the reducers, so segcore searches the candidates of all the groups, the proxy
+		// searches more candidates if the groups are not filled
+		queryTopK *= groupSize
+	}
+
+	queryInfo := &planpb.QueryInfo{
 		Topk:           queryTopK,
 		MetricType:     metricType,
 		SearchParams:   searchParamStr,
 		RoundDecimal:   roundDecimal,
 		GroupByFieldId: groupByFieldId,
-	}, offset, nil
+	}
+	if isGroupBy {
+		queryInfo.GroupByFieldIds = groupByFieldIds
+		queryInfo.GroupSize = groupSize
+		queryInfo.StrictGroupSize = strictGroupSize
+	}
+	return queryInfo, offset, nil
 }
 
 func getOutputFieldIDs(schema *schemaInfo, outputFields []string) (outputFieldIDs []UniqueID, err error) {
@@ -312,3 +362,42 @@ func convertHybridSearchToSearch(req *milvuspb.HybridSearchRequest) *milvuspb.Se
 	}
 	return ret
 }
+
+// parseCollectionSearchParams parses the limit and offset of a search across collections and returns the search
+// params of each collection, which searches the top limit+offset hits without offset to merge the hits by score.
+func parseCollectionSearchParams(searchParamsPair []*commonpb.KeyValuePair) ([]*commonpb.KeyValuePair, int64, int64, error) {
+	if groupByField, _ := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParamsPair); groupByField != "" {
+		return nil, 0, 0, merr.WrapErrParameterInvalidMsg("not support search group by across collections")
+	}
+	if isIterator, _ := funcutil.GetAttrByKeyFromRepeatedKV(IteratorField, searchParamsPair); isIterator == "True" {
+		return nil, 0, 0, merr.WrapErrParameterInvalidMsg("not support search iterator across collections")
+	}
+
+	limitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, searchParamsPair)
+	if err != nil {
+		return nil, 0, 0, merr.WrapErrParameterInvalidMsg(TopKKey + " not found in search_params")
+	}
+	limit, err := strconv.ParseInt(limitStr, 0, 64)
+	if err != nil {
+		return nil, 0, 0, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", TopKKey, limitStr)
+	}
+	var offset int64
+	if offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, searchParamsPair); err == nil {
+		offset, err = strconv.ParseInt(offsetStr, 0, 64)
+		if err != nil || offset < 0 {
+			return nil, 0, 0, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", OffsetKey, offsetStr)
+		}
+	}
+	if err := validateLimit(limit); err != nil {
+		return nil, 0, 0, merr.WrapErrParameterInvalidMsg("%s [%d] is invalid, %s", TopKKey, limit, err.Error())
+	}
+	if err := validateLimit(limit + offset); err != nil {
+		return nil, 0, 0, merr.WrapErrParameterInvalidMsg("%s+%s [%d] is invalid, %s", OffsetKey, TopKKey, limit+offset, err.Error())
+	}
+
+	params := lo.Filter(searchParamsPair, func(kv *commonpb.KeyValuePair, _ int) bool {
+		return kv.GetKey() != TopKKey && kv.GetKey() != OffsetKey
+	})
+	params = append(params, &commonpb.KeyValuePair{Key: TopKKey, Value: strconv.FormatInt(limit+offset, 10)})
+	return params, limit, offset, nil
+}
@@ -48,6 +48,8 @@ const (
 	ReduceStopForBestKey = "reduce_stop_for_best"
 	IteratorField        = "iterator"
 	GroupByFieldKey      = "group_by_field"
+	GroupSizeKey         = "group_size"
+	StrictGroupSizeKey   = "strict_group_size"
 	AnnsFieldKey         = "anns_field"
 	TopKKey              = "topk"
 	NQKey                = "nq"
@@ -56,6 +58,9 @@ const (
 	RoundDecimalKey      = "round_decimal"
 	OffsetKey            = "offset"
 	LimitKey             = "limit"
+	ExprParamsKey        = "expr_params"
+	OrderByKey           = "order_by"
+	GroupByKey           = "group_by"
 
 	InsertTaskName                = "InsertTask"
 	CreateCollectionTaskName      = "CreateCollectionTask"
@@ -79,6 +84,8 @@ const (
 	DescribeAliasTaskName         = "DescribeAliasTask"
 	ListAliasesTaskName           = "ListAliasesTask"
 	AlterCollectionTaskName       = "AlterCollectionTask"
+	AddCollectionFieldTaskName    = "AddCollectionFieldTask"
+	DropCollectionFieldTaskName   = "DropCollectionFieldTask"
 	UpsertTaskName                = "UpsertTask"
 	CreateResourceGroupTaskName   = "CreateResourceGroupTask"
 	UpdateResourceGroupsTaskName  = "UpdateResourceGroupsTask"
@@ -349,6 +356,10 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := validateCollectionTTLField(t.schema, t.GetProperties()...); err != nil {
+		return err
+	}
+
 	// validate clustering key
 	if err := t.validateClusteringKey(); err != nil {
 		return err
@@ -896,6 +907,15 @@ func hasMmapProp(props ...*commonpb.KeyValuePair) bool {
 	return false
 }
 
+func hasCollectionTTLFieldProp(props ...*commonpb.KeyValuePair) bool {
+	for _, p := range props {
+		if p.GetKey() == common.CollectionTTLFieldKey {
+			return true
+		}
+	}
+	return false
+}
+
 func hasLazyLoadProp(props ...*commonpb.KeyValuePair) bool {
 	for _, p := range props {
 		if p.GetKey() == common.LazyLoadEnableKey {
@@ -931,6 +951,23 @@ func validatePartitionKeyIsolation(colName string, isPartitionKeyEnabled bool, p
 	return true, nil
 }
 
+// validateCollectionTTLField checks the ttl field named in the properties is a non primary key int64 field.
+func validateCollectionTTLField(schema *schemapb.CollectionSchema, props ...*commonpb.KeyValuePair) error {
+	for _, kv := range props {
+		if kv.GetKey() != common.CollectionTTLFieldKey || kv.GetValue() == "" {
+			continue
+		}
+		field := typeutil.GetFieldByName(schema, kv.GetValue())
+		if field == nil {
+			return merr.WrapErrParameterInvalidMsg("ttl field %s doesn't exist", kv.GetValue())
+		}
+		if field.GetDataType() != schemapb.DataType_Int64 || field.GetIsPrimaryKey() {
+			return merr.WrapErrParameterInvalidMsg("ttl field %s must be an int64 field other than the primary key", kv.GetValue())
+		}
+	}
+	return nil
+}
+
 func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 	collectionID, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.CollectionName)
 	if err != nil {
@@ -957,6 +994,23 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if hasCollectionTTLFieldProp(t.Properties...) {
+		collSchema, err := globalMetaCache.GetCollectionSchema(ctx, t.GetDbName(), t.CollectionName)
+		if err != nil {
+			return err
+		}
+		if err := validateCollectionTTLField(collSchema.CollectionSchema, t.Properties...); err != nil {
+			return err
+		}
+		// the query nodes get the ttl field when the collection is loaded
+		loaded, err := isCollectionLoaded(ctx, t.queryCoord, t.CollectionID)
+		if err != nil {
+			return err
+		}
+		if loaded {
+			return merr.WrapErrCollectionLoaded(t.CollectionName, "can not alter ttl field if collection loaded")
+		}
+	}
 	collBasicInfo, err := globalMetaCache.GetCollectionInfo(t.ctx, t.GetDbName(), t.CollectionName, t.CollectionID)
 	if err != nil {
 		return err
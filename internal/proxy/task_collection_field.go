@@ -0,0 +1,274 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type addCollectionFieldTask struct {
+	baseTask
+	Condition
+	*proxypb.AddCollectionFieldRequest
+	ctx        context.Context
+	rootCoord  types.RootCoordClient
+	queryCoord types.QueryCoordClient
+	result     *commonpb.Status
+}
+
+func (t *addCollectionFieldTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *addCollectionFieldTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *addCollectionFieldTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *addCollectionFieldTask) Name() string {
+	return AddCollectionFieldTaskName
+}
+
+func (t *addCollectionFieldTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *addCollectionFieldTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *addCollectionFieldTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *addCollectionFieldTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *addCollectionFieldTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	t.Base.MsgType = commonpb.MsgType_AlterCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+	return nil
+}
+
+// validateAddedCollectionField checks the field added to an existing collection. The existing entities get null or the
+// default value of the field without being rewritten, so only the nullable or defaulted scalar fields can be added.
+func validateAddedCollectionField(schema *schemapb.CollectionSchema, field *schemapb.FieldSchema) error {
+	if field == nil {
+		return merr.WrapErrParameterInvalidMsg("the added field is not specified")
+	}
+	if err := validateFieldName(field.GetName()); err != nil {
+		return err
+	}
+	if typeutil.GetFieldByName(schema, field.GetName()) != nil {
+		return merr.WrapErrParameterInvalidMsg("field %s already exists in collection %s", field.GetName(), schema.GetName())
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return merr.WrapErrParameterInvalidMsg("can not add vector field %s to an existing collection", field.GetName())
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() || field.GetIsPartitionKey() || field.GetIsClusteringKey() || field.GetIsDynamic() {
+		return merr.WrapErrParameterInvalidMsg("the added field %s can not be primary key, partition key, clustering key or dynamic field", field.GetName())
+	}
+	if !field.GetNullable() && field.GetDefaultValue() == nil {
+		return merr.WrapErrParameterInvalidMsg("the added field %s must be nullable or have a default value", field.GetName())
+	}
+	if err := validateFieldType(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}}); err != nil {
+		return err
+	}
+	if field.GetDataType() == schemapb.DataType_VarChar ||
+		(field.GetDataType() == schemapb.DataType_Array && field.GetElementType() == schemapb.DataType_VarChar) {
+		if err := validateMaxLengthPerRow(schema.GetName(), field); err != nil {
+			return err
+		}
+	}
+	if field.GetDataType() == schemapb.DataType_Array {
+		if err := validateMaxCapacityPerRow(schema.GetName(), field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCollectionReleased returns an error if the collection is loaded, since the query nodes build the schema of
+// the collection when it is loaded.
+func checkCollectionReleased(ctx context.Context, qc types.QueryCoordClient, collectionName string, collectionID int64, msg string) error {
+	loaded, err := isCollectionLoaded(ctx, qc, collectionID)
+	if err != nil {
+		return err
+	}
+	if loaded {
+		return merr.WrapErrCollectionLoaded(collectionName, msg)
+	}
+	return nil
+}
+
+func (t *addCollectionFieldTask) PreExecute(ctx context.Context) error {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	t.CollectionID = collectionID
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	if err := validateAddedCollectionField(schema.CollectionSchema, t.GetField()); err != nil {
+		return err
+	}
+	return checkCollectionReleased(ctx, t.queryCoord, t.GetCollectionName(), collectionID, "can not add field if collection loaded")
+}
+
+func (t *addCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.AddCollectionField(ctx, t.AddCollectionFieldRequest)
+	return merr.CheckRPCCall(t.result, err)
+}
+
+func (t *addCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type dropCollectionFieldTask struct {
+	baseTask
+	Condition
+	*proxypb.DropCollectionFieldRequest
+	ctx        context.Context
+	rootCoord  types.RootCoordClient
+	queryCoord types.QueryCoordClient
+	dataCoord  types.DataCoordClient
+	result     *commonpb.Status
+}
+
+func (t *dropCollectionFieldTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *dropCollectionFieldTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *dropCollectionFieldTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *dropCollectionFieldTask) Name() string {
+	return DropCollectionFieldTaskName
+}
+
+func (t *dropCollectionFieldTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *dropCollectionFieldTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *dropCollectionFieldTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *dropCollectionFieldTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *dropCollectionFieldTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	t.Base.MsgType = commonpb.MsgType_AlterCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+	return nil
+}
+
+// validateDroppedCollectionField checks the field can be dropped from the collection, root coord checks the field is
+// not the ttl field since the collection properties are not cached.
+func validateDroppedCollectionField(schema *schemapb.CollectionSchema, fieldName string) (*schemapb.FieldSchema, error) {
+	field := typeutil.GetFieldByName(schema, fieldName)
+	if field == nil {
+		return nil, merr.WrapErrFieldNotFound(fieldName)
+	}
+	if field.GetIsPrimaryKey() || field.GetIsPartitionKey() || field.GetIsClusteringKey() || field.GetIsDynamic() {
+		return nil, merr.WrapErrParameterInvalidMsg("can not drop primary key, partition key, clustering key or dynamic field %s", fieldName)
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return nil, merr.WrapErrParameterInvalidMsg("can not drop vector field %s", fieldName)
+	}
+	return field, nil
+}
+
+func (t *dropCollectionFieldTask) PreExecute(ctx context.Context) error {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	t.CollectionID = collectionID
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	field, err := validateDroppedCollectionField(schema.CollectionSchema, t.GetFieldName())
+	if err != nil {
+		return err
+	}
+
+	// the index of the field must be dropped first
+	indexResponse, err := t.dataCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
+		CollectionID: collectionID,
+		IndexName:    "",
+	})
+	if err == nil {
+		err = merr.Error(indexResponse.GetStatus())
+	}
+	if err != nil && !errors.Is(err, merr.ErrIndexNotFound) {
+		return err
+	}
+	for _, index := range indexResponse.GetIndexInfos() {
+		if index.GetFieldID() == field.GetFieldID() {
+			return merr.WrapErrParameterInvalidMsg("can not drop field %s with index %s, drop the index first", t.GetFieldName(), index.GetIndexName())
+		}
+	}
+
+	return checkCollectionReleased(ctx, t.queryCoord, t.GetCollectionName(), collectionID, "can not drop field if collection loaded")
+}
+
+func (t *dropCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.DropCollectionField(ctx, t.DropCollectionFieldRequest)
+	return merr.CheckRPCCall(t.result, err)
+}
+
+func (t *dropCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
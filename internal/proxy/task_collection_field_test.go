@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestValidateAddedCollectionField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	maxLength := []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "16"}}
+
+	invalid := []*schemapb.FieldSchema{
+		nil,
+		{Name: "vec", DataType: schemapb.DataType_Int64, Nullable: true},
+		{Name: "1a", DataType: schemapb.DataType_Int64, Nullable: true},
+		{Name: "vec2", DataType: schemapb.DataType_FloatVector, Nullable: true},
+		{Name: "pk", DataType: schemapb.DataType_Int64, Nullable: true, IsPrimaryKey: true},
+		{Name: "age", DataType: schemapb.DataType_Int64},
+		{Name: "name", DataType: schemapb.DataType_VarChar, Nullable: true},
+		{Name: "name", DataType: schemapb.DataType_String, Nullable: true},
+	}
+	for _, field := range invalid {
+		assert.Error(t, validateAddedCollectionField(schema, field), field.GetName())
+	}
+
+	valid := []*schemapb.FieldSchema{
+		{Name: "age", DataType: schemapb.DataType_Int64, Nullable: true},
+		{Name: "age", DataType: schemapb.DataType_Int64, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}}},
+		{Name: "name", DataType: schemapb.DataType_VarChar, Nullable: true, TypeParams: maxLength},
+	}
+	for _, field := range valid {
+		assert.NoError(t, validateAddedCollectionField(schema, field), field.GetName())
+	}
+}
+
+func TestValidateDroppedCollectionField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "tenant", DataType: schemapb.DataType_Int64, IsPartitionKey: true},
+			{FieldID: 103, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	for _, name := range []string{"id", "vec", "tenant", "not_exist"} {
+		_, err := validateDroppedCollectionField(schema, name)
+		assert.Error(t, err, name)
+	}
+	field, err := validateDroppedCollectionField(schema, "age")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(103), field.GetFieldID())
+}
@@ -325,6 +325,12 @@ func (dr *deleteRunner) Run(ctx context.Context) error {
 	if planparserv2.IsAlwaysTruePlan(plan) {
 		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("delete plan can't be empty or always true : %s", dr.req.GetExpr()))
 	}
+	// the row policies are applied after the check, the delete is still restricted to the rows visible to the user
+	query := plan.GetQuery()
+	query.Predicates, err = andRowPolicyExprs(ctx, dr.collectionID, dr.schema, query.GetPredicates())
+	if err != nil {
+		return err
+	}
 
 	isSimple, pk, numRow := getPrimaryKeysFromPlan(dr.schema.CollectionSchema, plan)
 	if isSimple {
@@ -58,6 +58,9 @@ type queryTask struct {
 	schema         *schemaInfo
 
 	userOutputFields []string
+	// the order by fields which are not requested by user but retrieved to order the results
+	hiddenOutputFieldIDs []int64
+	aggregation          *queryAggregation
 
 	resultBuf *typeutil.ConcurrentSet[*internalpb.RetrieveResults]
 
@@ -77,6 +80,8 @@ type queryParams struct {
 	limit             int64
 	offset            int64
 	reduceStopForBest bool
+	orderBy           []*internalpb.OrderByField
+	aggregation       *queryAggregation
 }
 
 // translateToOutputFieldIDs translates output fields name to output fields id.
@@ -188,7 +193,7 @@ func matchCountRule(outputs []string) bool {
 	return len(outputs) == 1 && strings.ToLower(strings.TrimSpace(outputs[0])) == "count(*)"
 }
 
-func createCntPlan(expr string, schemaHelper *typeutil.SchemaHelper) (*planpb.PlanNode, error) {
+func createCntPlan(expr string, schema *schemaInfo, exprValues map[string]*planpb.GenericValue) (*planpb.PlanNode, error) {
 	if expr == "" {
 		return &planpb.PlanNode{
 			Node: &planpb.PlanNode_Query{
@@ -200,10 +205,11 @@ func createCntPlan(expr string, schemaHelper *typeutil.SchemaHelper) (*planpb.Pl
 		}, nil
 	}
 
-	plan, err := planparserv2.CreateRetrievePlan(schemaHelper, expr)
+	predicates, err := parseExpr(schema, expr, exprValues)
 	if err != nil {
 		return nil, merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err))
 	}
+	plan := planparserv2.CreateRetrievePlanByExpr(predicates)
 
 	plan.Node.(*planpb.PlanNode_Query).Query.IsCount = true
 
@@ -213,20 +219,41 @@ func createCntPlan(expr string, schemaHelper *typeutil.SchemaHelper) (*planpb.Pl
 func (t *queryTask) createPlan(ctx context.Context) error {
 	schema := t.schema
 
+	exprValues, err := getExprValues(t.request.GetQueryParams())
+	if err != nil {
+		return err
+	}
+
+	t.aggregation, err = parseAggregation(t.request.GetOutputFields(), t.request.GetQueryParams(), schema.CollectionSchema)
+	if err != nil {
+		return err
+	}
+	if t.aggregation != nil {
+		if err := t.createAggregatePlan(exprValues); err != nil {
+			return err
+		}
+		return t.applyRowPolicies(ctx)
+	}
+
 	cntMatch := matchCountRule(t.request.GetOutputFields())
 	if cntMatch {
-		var err error
-		t.plan, err = createCntPlan(t.request.GetExpr(), schema.schemaHelper)
+		t.plan, err = createCntPlan(t.request.GetExpr(), schema, exprValues)
 		t.userOutputFields = []string{"count(*)"}
-		return err
+		if err != nil {
+			return err
+		}
+		return t.applyRowPolicies(ctx)
 	}
 
-	var err error
 	if t.plan == nil {
-		t.plan, err = planparserv2.CreateRetrievePlan(schema.schemaHelper, t.request.Expr)
+		expr, err := parseExpr(schema, t.request.Expr, exprValues)
 		if err != nil {
 			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err))
 		}
+		t.plan = planparserv2.CreateRetrievePlanByExpr(expr)
+	}
+	if err := t.applyRowPolicies(ctx); err != nil {
+		return err
 	}
 
 	t.request.OutputFields, t.userOutputFields, err = translateOutputFields(t.request.OutputFields, t.schema, true)
@@ -238,6 +265,12 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	for _, orderByField := range t.RetrieveRequest.GetOrderByFields() {
+		if !lo.Contains(outputFieldIDs, orderByField.GetFieldId()) {
+			outputFieldIDs = append(outputFieldIDs, orderByField.GetFieldId())
+			t.hiddenOutputFieldIDs = append(t.hiddenOutputFieldIDs, orderByField.GetFieldId())
+		}
+	}
 	outputFieldIDs = append(outputFieldIDs, common.TimeStampField)
 	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
 	t.plan.OutputFieldIds = outputFieldIDs
@@ -248,6 +281,37 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 	return nil
 }
 
+// applyRowPolicies ANDs the row policies applied to the request into the predicates of the plan.
+func (t *queryTask) applyRowPolicies(ctx context.Context) error {
+	query := t.plan.GetQuery()
+	predicates, err := andRowPolicyExprs(ctx, t.GetCollectionID(), t.schema, query.GetPredicates())
+	if err != nil {
+		return err
+	}
+	query.Predicates = predicates
+	return nil
+}
+
+// createAggregatePlan creates the plan to retrieve the fields to be aggregated by query nodes.
+func (t *queryTask) createAggregatePlan(exprValues map[string]*planpb.GenericValue) error {
+	if t.plan == nil {
+		expr, err := parseExpr(t.schema, t.request.Expr, exprValues)
+		if err != nil {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err))
+		}
+		t.plan = planparserv2.CreateRetrievePlanByExpr(expr)
+	}
+
+	agg := t.aggregation
+	t.userOutputFields = agg.outputs
+	outputFieldIDs := agg.retrievedFieldIDs()
+	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
+	t.RetrieveRequest.GroupByFieldIds = agg.groupByFieldIDs
+	t.RetrieveRequest.Aggregates = agg.aggregates
+	t.plan.OutputFieldIds = outputFieldIDs
+	return nil
+}
+
 func (t *queryTask) CanSkipAllocTimestamp() bool {
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
@@ -353,6 +417,16 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 	t.schema = schema
 
+	orderByFields, err := parseOrderByFields(t.request.GetQueryParams(), schema.CollectionSchema)
+	if err != nil {
+		return err
+	}
+	if len(orderByFields) > 0 && queryParams.reduceStopForBest {
+		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("%s cannot be used with %s", OrderByKey, ReduceStopForBestKey))
+	}
+	t.queryParams.orderBy = orderByFields
+	t.RetrieveRequest.OrderByFields = orderByFields
+
 	if t.ids != nil {
 		pkField := ""
 		for _, field := range schema.Fields {
@@ -366,9 +440,19 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err := t.createPlan(ctx); err != nil {
 		return err
 	}
+	// the query nodes order the matched entities of a segment by the order by fields only, and keep the top
+	// offset+limit ones with all the output fields
 	t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = t.RetrieveRequest.Limit
 
-	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited {
+	t.queryParams.aggregation = t.aggregation
+	if t.aggregation != nil {
+		if len(t.RetrieveRequest.GetOrderByFields()) > 0 {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("%s cannot be used with aggregation", OrderByKey))
+		}
+		// all the matched entities are aggregated, the limit and offset are applied on the groups by proxy
+		t.RetrieveRequest.Limit = typeutil.Unlimited
+		t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = typeutil.Unlimited
+	} else if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited {
 		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("empty expression should be used with limit"))
 	}
 
@@ -398,6 +482,9 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if t.plan.GetQuery().GetIsCount() && t.queryParams.limit != typeutil.Unlimited {
 		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("count entities with pagination is not allowed"))
 	}
+	if t.plan.GetQuery().GetIsCount() && len(t.RetrieveRequest.GetOrderByFields()) > 0 {
+		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("count entities with order by is not allowed"))
+	}
 
 	t.RetrieveRequest.IsCount = t.plan.GetQuery().GetIsCount()
 	t.RetrieveRequest.SerializedExprPlan, err = proto.Marshal(t.plan)
@@ -513,6 +600,11 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		log.Warn("fail to reduce query result", zap.Error(err))
 		return err
 	}
+	if len(t.hiddenOutputFieldIDs) > 0 {
+		t.result.FieldsData = lo.Filter(t.result.GetFieldsData(), func(fieldData *schemapb.FieldData, _ int) bool {
+			return !lo.Contains(t.hiddenOutputFieldIDs, fieldData.GetFieldId())
+		})
+	}
 	t.result.OutputFields = t.userOutputFields
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
@@ -609,6 +701,10 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		return ret, nil
 	}
 
+	if queryParams != nil && len(queryParams.orderBy) > 0 {
+		return reduceOrderedRetrieveResults(validRetrieveResults, queryParams)
+	}
+
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
 
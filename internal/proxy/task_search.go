@@ -74,12 +74,20 @@ type searchTask struct {
 	queryChannelsTs map[string]Timestamp
 	queryInfos      []*planpb.QueryInfo
 	relatedDataSize int64
+	metricType      string
 
 	reScorers  []reScorer
 	rankParams *rankParams
+
+	// snapshotTs is the guarantee and mvcc timestamp of the search if it's set, the searches of several collections
+	// share it to search the same snapshot
+	snapshotTs Timestamp
 }
 
 func (t *searchTask) CanSkipAllocTimestamp() bool {
+	if t.snapshotTs > 0 {
+		return true
+	}
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
 	if !useDefaultConsistency {
@@ -241,6 +249,10 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+	if t.snapshotTs > 0 {
+		guaranteeTs = t.snapshotTs
+		t.SearchRequest.MvccTimestamp = t.snapshotTs
+	}
 	t.SearchRequest.GuaranteeTimestamp = guaranteeTs
 	t.SearchRequest.ConsistencyLevel = consistencyLevel
 
@@ -345,12 +357,18 @@ func (t *searchTask) initAdvancedSearchRequest(ctx context.Context) error {
 	// fetch search_growing from search param
 	t.SearchRequest.SubReqs = make([]*internalpb.SubSearchRequest, len(t.request.GetSubReqs()))
 	t.queryInfos = make([]*planpb.QueryInfo, len(t.request.GetSubReqs()))
+	var err error
+	t.reScorers, err = NewReScorers(len(t.request.GetSubReqs()), t.request.GetSearchParams(), t.schema.CollectionSchema)
+	if err != nil {
+		log.Info("generate reScorer failed", zap.Any("params", t.request.GetSearchParams()), zap.Error(err))
+		return err
+	}
 	for index, subReq := range t.request.GetSubReqs() {
-		plan, queryInfo, offset, err := t.tryGeneratePlan(subReq.GetSearchParams(), subReq.GetDsl(), true)
+		plan, queryInfo, offset, err := t.tryGeneratePlan(ctx, subReq.GetSearchParams(), subReq.GetDsl(), true)
 		if err != nil {
 			return err
 		}
-		if queryInfo.GetGroupByFieldId() != -1 {
+		if len(queryInfo.GetGroupByFieldIds()) > 0 {
 			return errors.New("not support search_group_by operation in the hybrid search")
 		}
 		internalSubReq := &internalpb.SubSearchRequest{
@@ -389,6 +407,8 @@ func (t *searchTask) initAdvancedSearchRequest(ctx context.Context) error {
 		} else {
 			plan.OutputFieldIds = t.SearchRequest.OutputFieldsId
 		}
+		// fields required by the reScorer must be output by the sub search
+		plan.OutputFieldIds = lo.Union(plan.OutputFieldIds, t.reScorers[index].inputFieldIDs())
 
 		internalSubReq.SerializedExprPlan, err = proto.Marshal(plan)
 		if err != nil {
@@ -404,12 +424,6 @@ func (t *searchTask) initAdvancedSearchRequest(ctx context.Context) error {
 	if t.partitionKeyMode {
 		t.SearchRequest.PartitionIDs = t.partitionIDsSet.Collect()
 	}
-	var err error
-	t.reScorers, err = NewReScorers(len(t.request.GetSubReqs()), t.request.GetSearchParams())
-	if err != nil {
-		log.Info("generate reScorer failed", zap.Any("params", t.request.GetSearchParams()), zap.Error(err))
-		return err
-	}
 	return nil
 }
 
@@ -420,12 +434,17 @@ func (t *searchTask) initSearchRequest(ctx context.Context) error {
 	log := log.Ctx(ctx).With(zap.Int64("collID", t.GetCollectionID()), zap.String("collName", t.collectionName))
 	// fetch search_growing from search param
 
-	plan, queryInfo, offset, err := t.tryGeneratePlan(t.request.GetSearchParams(), t.request.GetDsl(), false)
+	plan, queryInfo, offset, err := t.tryGeneratePlan(ctx, t.request.GetSearchParams(), t.request.GetDsl(), false)
 	if err != nil {
 		return err
 	}
 
 	t.SearchRequest.Offset = offset
+	t.SearchRequest.GroupByFieldIds = queryInfo.GetGroupByFieldIds()
+	t.SearchRequest.GroupSize = queryInfo.GetGroupSize()
+	if len(queryInfo.GetGroupByFieldIds()) > 1 {
+		t.addGroupByOutputFields(queryInfo.GetGroupByFieldIds())
+	}
 
 	if t.partitionKeyMode {
 		// isolatioin has tighter constraint, check first
@@ -447,6 +466,10 @@ func (t *searchTask) initSearchRequest(ctx context.Context) error {
 	} else {
 		plan.OutputFieldIds = t.SearchRequest.OutputFieldsId
 	}
+	if len(queryInfo.GetGroupByFieldIds()) > 1 {
+		// the reducers group the hits by the output values of the group by fields
+		plan.OutputFieldIds = lo.Union(plan.OutputFieldIds, queryInfo.GetGroupByFieldIds())
+	}
 
 	t.SearchRequest.SerializedExprPlan, err = proto.Marshal(plan)
 	if err != nil {
@@ -465,7 +488,21 @@ func (t *searchTask) initSearchRequest(ctx context.Context) error {
 	return nil
 }
 
-func (t *searchTask) tryGeneratePlan(params []*commonpb.KeyValuePair, dsl string, ignoreOffset bool) (*planpb.PlanNode, *planpb.QueryInfo, int64, error) {
+// addGroupByOutputFields outputs the fields the hits are grouped by, as the values of several group by fields are
+// returned as output fields instead of the group by field value.
+func (t *searchTask) addGroupByOutputFields(groupByFieldIDs []int64) {
+	for _, fieldID := range groupByFieldIDs {
+		if lo.Contains(t.SearchRequest.OutputFieldsId, fieldID) {
+			continue
+		}
+		field := typeutil.GetField(t.schema.CollectionSchema, fieldID)
+		t.SearchRequest.OutputFieldsId = append(t.SearchRequest.OutputFieldsId, fieldID)
+		t.request.OutputFields = append(t.request.OutputFields, field.GetName())
+		t.userOutputFields = append(t.userOutputFields, field.GetName())
+	}
+}
+
+func (t *searchTask) tryGeneratePlan(ctx context.Context, params []*commonpb.KeyValuePair, dsl string, ignoreOffset bool) (*planpb.PlanNode, *planpb.QueryInfo, int64, error) {
 	annsFieldName, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, params)
 	if err != nil || len(annsFieldName) == 0 {
 		vecFields := typeutil.GetVectorFieldSchemas(t.schema.CollectionSchema)
@@ -483,10 +520,26 @@ func (t *searchTask) tryGeneratePlan(params []*commonpb.KeyValuePair, dsl string
 		return nil, nil, 0, parseErr
 	}
 	annField := typeutil.GetFieldByName(t.schema.CollectionSchema, annsFieldName)
-	if queryInfo.GetGroupByFieldId() != -1 && annField.GetDataType() == schemapb.DataType_BinaryVector {
+	if len(queryInfo.GetGroupByFieldIds()) > 0 && annField.GetDataType() == schemapb.DataType_BinaryVector {
 		return nil, nil, 0, errors.New("not support search_group_by operation based on binary vector column")
 	}
-	plan, planErr := planparserv2.CreateSearchPlan(t.schema.schemaHelper, dsl, annsFieldName, queryInfo)
+	var expr *planpb.Expr
+	if len(dsl) > 0 {
+		exprValues, err := getExprValues(params)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		expr, err = parseExpr(t.schema, dsl, exprValues)
+		if err != nil {
+			log.Warn("failed to parse expression", zap.Error(err), zap.String("dsl", dsl))
+			return nil, nil, 0, merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err)
+		}
+	}
+	expr, err = andRowPolicyExprs(ctx, t.GetCollectionID(), t.schema, expr)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	plan, planErr := planparserv2.CreateSearchPlanByExpr(t.schema.schemaHelper, expr, annsFieldName, queryInfo)
 	if planErr != nil {
 		log.Warn("failed to create query plan", zap.Error(planErr),
 			zap.String("dsl", dsl), // may be very large if large term passed.
@@ -606,15 +659,6 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 
-	t.queryChannelsTs = make(map[string]uint64)
-	t.relatedDataSize = 0
-	for _, r := range toReduceResults {
-		t.relatedDataSize += r.GetCostAggregation().GetTotalRelatedDataSize()
-		for ch, ts := range r.GetChannelsMvcc() {
-			t.queryChannelsTs[ch] = ts
-		}
-	}
-
 	primaryFieldSchema, err := t.schema.GetPkField()
 	if err != nil {
 		log.Warn("failed to get primary field schema", zap.Error(err))
@@ -663,16 +707,40 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		t.result, err = rankSearchResultData(ctx, t.SearchRequest.GetNq(),
 			t.rankParams,
 			primaryFieldSchema.GetDataType(),
-			multipleMilvusResults)
+			multipleMilvusResults,
+			t.reScorers)
 		if err != nil {
 			log.Warn("rank search result failed", zap.Error(err))
 			return err
 		}
 	} else {
+		if len(toReduceResults) >= 1 {
+			t.metricType = toReduceResults[0].GetMetricType()
+		}
 		t.result, err = t.reduceResults(t.ctx, toReduceResults, t.SearchRequest.Nq, t.SearchRequest.GetTopk(), t.SearchRequest.GetOffset(), t.queryInfos[0])
 		if err != nil {
 			return err
 		}
+		if len(t.queryInfos[0].GetGroupByFieldIds()) > 1 {
+			toReduceResults, err = t.searchUntilGroupsFilled(ctx, toReduceResults)
+			if err != nil {
+				log.Warn("failed to search more groups", zap.Error(err))
+				return err
+			}
+			if t.requery {
+				// the fields output by the search are only used to group the hits, requery fetches the output fields
+				t.result.Results.FieldsData = nil
+			}
+		}
+	}
+
+	t.queryChannelsTs = make(map[string]uint64)
+	t.relatedDataSize = 0
+	for _, r := range toReduceResults {
+		t.relatedDataSize += r.GetCostAggregation().GetTotalRelatedDataSize()
+		for ch, ts := range r.GetChannelsMvcc() {
+			t.queryChannelsTs[ch] = ts
+		}
 	}
 
 	t.result.CollectionName = t.collectionName
@@ -696,6 +764,85 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// searchUntilGroupsFilled searches more candidates until the groups of the hits grouped by several fields are
+// filled or all the hits are searched. Segcore doesn't group the hits by several fields, the topk*group_size
+// candidates may be taken by a few groups if the hits are skewed, so the candidates are doubled for each search.
+func (t *searchTask) searchUntilGroupsFilled(ctx context.Context, toReduceResults []*internalpb.SearchResults) ([]*internalpb.SearchResults, error) {
+	queryInfo := t.queryInfos[0]
+	groupSize := max(queryInfo.GetGroupSize(), 1)
+	expectedHits := (queryInfo.GetTopk()/groupSize - t.SearchRequest.GetOffset()) * groupSize
+	topKLimit := Params.QuotaConfig.TopKLimit.GetAsInt64()
+	for {
+		filled := lo.EveryBy(t.result.GetResults().GetTopks(), func(hits int64) bool {
+			return hits >= expectedHits
+		})
+		if filled || t.SearchRequest.GetTopk() >= topKLimit {
+			return toReduceResults, nil
+		}
+		exhausted, err := isSearchExhausted(ctx, toReduceResults, t.SearchRequest.GetTopk())
+		if err != nil {
+			return nil, err
+		}
+		if exhausted {
+			return toReduceResults, nil
+		}
+
+		if err := t.setCandidateTopK(min(t.SearchRequest.GetTopk()*2, topKLimit)); err != nil {
+			return nil, err
+		}
+		log.Ctx(ctx).Debug("groups are not filled, search more candidates", zap.Int64("topk", t.SearchRequest.GetTopk()))
+		t.resultBuf = typeutil.NewConcurrentSet[*internalpb.SearchResults]()
+		if err := t.Execute(ctx); err != nil {
+			return nil, err
+		}
+		toReduceResults, err = t.collectSearchResults(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t.result, err = t.reduceResults(t.ctx, toReduceResults, t.SearchRequest.GetNq(), t.SearchRequest.GetTopk(), t.SearchRequest.GetOffset(), queryInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// setCandidateTopK sets the number of the candidates searched by segcore.
+func (t *searchTask) setCandidateTopK(topK int64) error {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(t.SearchRequest.GetSerializedExprPlan(), plan); err != nil {
+		return err
+	}
+	queryInfo := plan.GetVectorAnns().GetQueryInfo()
+	if queryInfo == nil {
+		return errors.New("no query info in the search plan")
+	}
+	queryInfo.Topk = topK
+	serializedPlan, err := proto.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	t.SearchRequest.SerializedExprPlan = serializedPlan
+	t.SearchRequest.Topk = topK
+	return nil
+}
+
+// isSearchExhausted returns whether all the hits are searched, which is true if each shard returns fewer hits than
+// topk for every query.
+func isSearchExhausted(ctx context.Context, searchResults []*internalpb.SearchResults, topK int64) (bool, error) {
+	results, err := decodeSearchResults(ctx, searchResults)
+	if err != nil {
+		return false, err
+	}
+	for _, result := range results {
+		for _, hits := range result.GetTopks() {
+			if hits >= topK {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
 func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channel string) error {
 	searchReq := typeutil.Clone(t.SearchRequest)
 	searchReq.GetBase().TargetID = nodeID
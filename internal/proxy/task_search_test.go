@@ -1772,6 +1772,218 @@ func TestTaskSearch_reduceGroupBySearchResultDataWithOffset(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestTaskSearch_reduceGroupBySearchResultDataWithGroupSize(t *testing.T) {
+	var (
+		nq    int64 = 1
+		limit int64 = 2
+	)
+	newResult := func(ids []int64, scores []float32, groupByValues []int64) *schemapb.SearchResultData {
+		result := getSearchResultData(nq, limit)
+		result.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}
+		result.Scores = scores
+		result.Topks = []int64{int64(len(ids))}
+		result.GroupByFieldValue = &schemapb.FieldData{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{
+							Data: groupByValues,
+						},
+					},
+				},
+			},
+		}
+		return result
+	}
+	results := []*schemapb.SearchResultData{
+		newResult([]int64{1, 2, 3}, []float32{10, 8, 6}, []int64{1, 2, 1}),
+		newResult([]int64{4, 5, 6}, []float32{9, 7, 5}, []int64{3, 3, 2}),
+	}
+
+	// the hits of a group are returned together in the order of the best hit of the groups
+	queryInfo := &planpb.QueryInfo{GroupByFieldId: 1, GroupByFieldIds: []int64{1}, GroupSize: 2}
+	reduced, err := reduceSearchResult(context.TODO(), NewReduceSearchResultInfo(results, nq, limit, metric.IP,
+		schemapb.DataType_Int64, 0, queryInfo))
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 3, 4, 5}, reduced.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{10, 6, 9, 7}, reduced.GetResults().GetScores())
+	assert.Equal(t, []int64{1, 1, 3, 3}, reduced.GetResults().GetGroupByFieldValue().GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{4}, reduced.GetResults().GetTopks())
+
+	// the groups which are not full are skipped if the group size is strict
+	queryInfo = &planpb.QueryInfo{GroupByFieldId: 1, GroupByFieldIds: []int64{1}, GroupSize: 3, StrictGroupSize: true}
+	reduced, err = reduceSearchResult(context.TODO(), NewReduceSearchResultInfo(results, nq, limit, metric.IP,
+		schemapb.DataType_Int64, 0, queryInfo))
+	assert.NoError(t, err)
+	assert.Empty(t, reduced.GetResults().GetIds().GetIntId().GetData())
+	queryInfo.StrictGroupSize = false
+	reduced, err = reduceSearchResult(context.TODO(), NewReduceSearchResultInfo(results, nq, limit, metric.IP,
+		schemapb.DataType_Int64, 0, queryInfo))
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 3, 4, 5}, reduced.GetResults().GetIds().GetIntId().GetData())
+
+	// strict groups after the offset
+	for _, result := range results {
+		result.TopK = limit + 1
+	}
+	queryInfo = &planpb.QueryInfo{GroupByFieldId: 1, GroupByFieldIds: []int64{1}, GroupSize: 2, StrictGroupSize: true}
+	reduced, err = reduceSearchResult(context.TODO(), NewReduceSearchResultInfo(results, nq, limit+1, metric.IP,
+		schemapb.DataType_Int64, 1, queryInfo))
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 5, 2, 6}, reduced.GetResults().GetIds().GetIntId().GetData())
+}
+
+func TestTaskSearch_reduceGroupBySearchResultDataWithMultipleFields(t *testing.T) {
+	var (
+		nq        int64 = 1
+		limit     int64 = 2
+		groupSize int64 = 2
+	)
+	newColumn := func(fieldID int64, data []string) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_VarChar,
+			FieldId:   fieldID,
+			FieldName: fmt.Sprintf("field_%d", fieldID),
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: data},
+					},
+				},
+			},
+		}
+	}
+	newResult := func(ids []int64, scores []float32, docs []string, sections []string) *schemapb.SearchResultData {
+		result := getSearchResultData(nq, limit*groupSize)
+		result.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}
+		result.Scores = scores
+		result.Topks = []int64{int64(len(ids))}
+		result.FieldsData = []*schemapb.FieldData{newColumn(101, docs), newColumn(102, sections)}
+		return result
+	}
+	results := []*schemapb.SearchResultData{
+		newResult([]int64{1, 2, 3, 4}, []float32{10, 8, 6, 4}, []string{"a", "a", "a", "b"}, []string{"x", "y", "x", "x"}),
+		newResult([]int64{5, 6, 7}, []float32{9, 7, 5}, []string{"a", "b", "a"}, []string{"x", "x", "x"}),
+	}
+
+	queryInfo := &planpb.QueryInfo{Topk: limit * groupSize, GroupByFieldId: -1, GroupByFieldIds: []int64{101, 102}, GroupSize: groupSize}
+	reduced, err := reduceSearchResult(context.TODO(), NewReduceSearchResultInfo(results, nq, limit*groupSize, metric.IP,
+		schemapb.DataType_Int64, 0, queryInfo))
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 5, 2}, reduced.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []string{"a", "a", "a"}, reduced.GetResults().GetFieldsData()[0].GetScalars().GetStringData().GetData())
+	assert.Equal(t, []string{"x", "x", "y"}, reduced.GetResults().GetFieldsData()[1].GetScalars().GetStringData().GetData())
+	assert.Nil(t, reduced.GetResults().GetGroupByFieldValue())
+
+	queryInfo.StrictGroupSize = true
+	reduced, err = reduceSearchResult(context.TODO(), NewReduceSearchResultInfo(results, nq, limit*groupSize, metric.IP,
+		schemapb.DataType_Int64, 0, queryInfo))
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 5, 6, 4}, reduced.GetResults().GetIds().GetIntId().GetData())
+}
+
+func TestSearchTask_searchUntilGroupsFilled(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	var (
+		limit     int64 = 2
+		groupSize int64 = 2
+	)
+	schema := newSchemaInfo(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "doc_id", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "chunk_section", DataType: schemapb.DataType_VarChar},
+		},
+	})
+	newColumn := func(fieldID int64, data []string) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:    schemapb.DataType_VarChar,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: data},
+					},
+				},
+			},
+		}
+	}
+	// the best hits all belong to the same group
+	newResult := func(topK int64, hits int) *internalpb.SearchResults {
+		ids, scores := make([]int64, 0, hits), make([]float32, 0, hits)
+		docs, sections := make([]string, 0, hits), make([]string, 0, hits)
+		for i := 0; i < hits; i++ {
+			ids = append(ids, int64(i+1))
+			scores = append(scores, float32(100-i))
+			if i < 4 {
+				docs = append(docs, "a")
+			} else {
+				docs = append(docs, "b")
+			}
+			sections = append(sections, "x")
+		}
+		data := getSearchResultData(1, topK)
+		data.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}
+		data.Scores = scores
+		data.Topks = []int64{int64(hits)}
+		data.FieldsData = []*schemapb.FieldData{newColumn(101, docs), newColumn(102, sections)}
+		bytes, err := proto.Marshal(data)
+		assert.NoError(t, err)
+		return &internalpb.SearchResults{MetricType: metric.IP, SlicedBlob: bytes}
+	}
+	newTask := func(lb LBPolicy) *searchTask {
+		queryInfo := &planpb.QueryInfo{Topk: limit * groupSize, GroupByFieldId: -1, GroupByFieldIds: []int64{101, 102}, GroupSize: groupSize}
+		plan, err := proto.Marshal(&planpb.PlanNode{
+			Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{QueryInfo: queryInfo}},
+		})
+		assert.NoError(t, err)
+		return &searchTask{
+			ctx: ctx,
+			SearchRequest: &internalpb.SearchRequest{
+				Base:               &commonpb.MsgBase{MsgType: commonpb.MsgType_Search},
+				Nq:                 1,
+				Topk:               limit * groupSize,
+				SerializedExprPlan: plan,
+			},
+			request:    &milvuspb.SearchRequest{},
+			schema:     schema,
+			queryInfos: []*planpb.QueryInfo{queryInfo},
+			resultBuf:  typeutil.NewConcurrentSet[*internalpb.SearchResults](),
+			tr:         timerecord.NewTimeRecorder("search"),
+			lb:         lb,
+		}
+	}
+
+	t.Run("skewed hits", func(t *testing.T) {
+		lb := NewMockLBPolicy(t)
+		task := newTask(lb)
+		lb.EXPECT().Execute(mock.Anything, mock.Anything).Run(func(ctx context.Context, workload CollectionWorkLoad) {
+			assert.Equal(t, limit*groupSize*2, task.SearchRequest.GetTopk())
+			plan := &planpb.PlanNode{}
+			assert.NoError(t, proto.Unmarshal(task.SearchRequest.GetSerializedExprPlan(), plan))
+			assert.Equal(t, limit*groupSize*2, plan.GetVectorAnns().GetQueryInfo().GetTopk())
+			task.resultBuf.Insert(newResult(task.SearchRequest.GetTopk(), 8))
+		}).Return(nil).Once()
+		// the candidates of the first search are all in a group
+		task.resultBuf.Insert(newResult(task.SearchRequest.GetTopk(), 4))
+
+		assert.NoError(t, task.PostExecute(ctx))
+		assert.Equal(t, []int64{1, 2, 5, 6}, task.result.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{4}, task.result.GetResults().GetTopks())
+	})
+
+	t.Run("all hits searched", func(t *testing.T) {
+		task := newTask(NewMockLBPolicy(t))
+		task.resultBuf.Insert(newResult(task.SearchRequest.GetTopk(), 3))
+
+		assert.NoError(t, task.PostExecute(ctx))
+		assert.Equal(t, []int64{1, 2}, task.result.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, limit*groupSize, task.SearchRequest.GetTopk())
+	})
+}
+
 func TestSearchTask_ErrExecute(t *testing.T) {
 	var (
 		err error
@@ -2104,6 +2316,53 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 		assert.Nil(t, info)
 		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
 	})
+	t.Run("check multiple groupBy fields and group size", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: int64(101), Name: "doc_id"},
+				{FieldID: int64(102), Name: "chunk_section"},
+			},
+		}
+		groupByParams := func(groupBy string, groupSize string, strict string) []*commonpb.KeyValuePair {
+			params := append(getValidSearchParams(),
+				&commonpb.KeyValuePair{Key: GroupByFieldKey, Value: groupBy},
+				&commonpb.KeyValuePair{Key: GroupSizeKey, Value: groupSize})
+			if strict != "" {
+				params = append(params, &commonpb.KeyValuePair{Key: StrictGroupSizeKey, Value: strict})
+			}
+			return params
+		}
+
+		info, _, err := parseSearchInfo(groupByParams("doc_id", "3", ""), schema, false)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(101), info.GetGroupByFieldId())
+		assert.Equal(t, []int64{101}, info.GetGroupByFieldIds())
+		assert.Equal(t, int64(3), info.GetGroupSize())
+		assert.Equal(t, int64(10), info.GetTopk())
+		assert.False(t, info.GetStrictGroupSize())
+
+		// segcore searches the candidates of all the groups
+		info, _, err = parseSearchInfo(groupByParams("doc_id, chunk_section", "3", "true"), schema, false)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-1), info.GetGroupByFieldId())
+		assert.Equal(t, []int64{101, 102}, info.GetGroupByFieldIds())
+		assert.Equal(t, int64(30), info.GetTopk())
+		assert.True(t, info.GetStrictGroupSize())
+
+		invalidParams := [][]*commonpb.KeyValuePair{
+			groupByParams("doc_id,doc_id", "1", ""),
+			groupByParams("doc_id,not_exist", "1", ""),
+			groupByParams("doc_id", "0", ""),
+			groupByParams("doc_id", "abc", ""),
+			groupByParams("doc_id", "1", "abc"),
+			groupByParams("doc_id,chunk_section", "100000", ""),
+		}
+		for _, params := range invalidParams {
+			info, _, err = parseSearchInfo(params, schema, false)
+			assert.Nil(t, info)
+			assert.Error(t, err)
+		}
+	})
 	t.Run("check iterator and topK", func(t *testing.T) {
 		normalParam := getValidSearchParams()
 		normalParam = append(normalParam, &commonpb.KeyValuePair{
@@ -2576,6 +2835,22 @@ func TestSearchTask_CanSkipAllocTimestamp(t *testing.T) {
 		assert.True(t, skip)
 	})
 
+	t.Run("snapshot_ts", func(t *testing.T) {
+		st := &searchTask{
+			request: &milvuspb.SearchRequest{
+				Base:                  nil,
+				DbName:                dbName,
+				CollectionName:        collName,
+				UseDefaultConsistency: false,
+				ConsistencyLevel:      commonpb.ConsistencyLevel_Strong,
+			},
+			snapshotTs: 100,
+		}
+
+		skip := st.CanSkipAllocTimestamp()
+		assert.True(t, skip)
+	})
+
 	t.Run("failed", func(t *testing.T) {
 		mockMetaCache.ExpectedCalls = nil
 		mockMetaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, mock.Anything).Return(collID, nil)
@@ -2805,3 +3080,82 @@ func (s *MaterializedViewTestSuite) TestMvEnabledPartitionKeyOnVarCharWithIsolat
 func TestMaterializedView(t *testing.T) {
 	suite.Run(t, new(MaterializedViewTestSuite))
 }
+
+func TestTaskSearch_parseCollectionSearchParams(t *testing.T) {
+	params, limit, offset, err := parseCollectionSearchParams([]*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: OffsetKey, Value: "5"},
+		{Key: MetricTypeKey, Value: metric.L2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), limit)
+	assert.Equal(t, int64(5), offset)
+	topk, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, params)
+	assert.NoError(t, err)
+	assert.Equal(t, "15", topk)
+	_, err = funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, params)
+	assert.Error(t, err)
+
+	invalidParams := [][]*commonpb.KeyValuePair{
+		{{Key: OffsetKey, Value: "5"}},
+		{{Key: TopKKey, Value: "a"}},
+		{{Key: TopKKey, Value: "10"}, {Key: OffsetKey, Value: "-1"}},
+		{{Key: TopKKey, Value: "10"}, {Key: GroupByFieldKey, Value: "category"}},
+		{{Key: TopKKey, Value: "10"}, {Key: IteratorField, Value: "True"}},
+	}
+	for _, searchParams := range invalidParams {
+		_, _, _, err = parseCollectionSearchParams(searchParams)
+		assert.Error(t, err)
+	}
+}
+
+func TestTaskSearch_mergeCollectionSearchResults(t *testing.T) {
+	newResult := func(ids []int64, scores []float32, topks []int64, fieldsData ...*schemapb.FieldData) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: int64(len(topks)),
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+			Scores:     scores,
+			Topks:      topks,
+			FieldsData: fieldsData,
+		}
+	}
+	// the output fields of the collections are in different orders
+	results := []*schemapb.SearchResultData{
+		newResult([]int64{1, 2, 3}, []float32{0.1, 0.5, 0.2}, []int64{2, 1},
+			getFieldData("value", 101, schemapb.DataType_Int64, []int64{10, 20, 30}, 1),
+			getFieldData("tag", 102, schemapb.DataType_VarChar, []string{"x", "y", "z"}, 1)),
+		newResult([]int64{1}, []float32{0.3}, []int64{1, 0},
+			getFieldData("tag", 202, schemapb.DataType_VarChar, []string{"p"}, 1),
+			getFieldData("value", 201, schemapb.DataType_Int64, []int64{100}, 1)),
+	}
+	names := []string{"a", "b"}
+
+	ret, hitCollections, err := mergeCollectionSearchResults(context.Background(), results, names, 2, 2, 0, metric.L2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 1, 3}, ret.GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{0.1, 0.3, 0.2}, ret.GetScores())
+	assert.Equal(t, []int64{2, 1}, ret.GetTopks())
+	assert.Equal(t, []string{"a", "b", "a"}, hitCollections)
+	assert.Equal(t, []int64{10, 100, 30}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []string{"x", "p", "z"}, ret.GetFieldsData()[1].GetScalars().GetStringData().GetData())
+	// the results of the collections are not modified
+	assert.Equal(t, []float32{0.1, 0.5, 0.2}, results[0].GetScores())
+
+	ret, hitCollections, err = mergeCollectionSearchResults(context.Background(), results, names, 2, 2, 1, metric.L2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, ret.GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{0.3, 0.5}, ret.GetScores())
+	assert.Equal(t, []int64{2, 0}, ret.GetTopks())
+	assert.Equal(t, []string{"b", "a"}, hitCollections)
+
+	// the highest scores are the best hits of IP
+	ret, hitCollections, err = mergeCollectionSearchResults(context.Background(), results, names, 2, 1, 0, metric.IP)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, ret.GetIds().GetIntId().GetData())
+	assert.Equal(t, []string{"b", "a"}, hitCollections)
+
+	// the output fields mismatch
+	results[1].FieldsData = results[1].FieldsData[:1]
+	_, _, err = mergeCollectionSearchResults(context.Background(), results, names, 2, 2, 0, metric.L2)
+	assert.Error(t, err)
+}
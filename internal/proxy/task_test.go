@@ -3876,3 +3876,22 @@ func TestTaskPartitionKeyIsolation(t *testing.T) {
 			"can not alter partition key isolation mode if the collection already has a vector index. Please drop the index first")
 	})
 }
+
+func TestValidateCollectionTTLField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "expire_at", DataType: schemapb.DataType_Int64, Nullable: true},
+			{FieldID: 102, Name: "name", DataType: schemapb.DataType_VarChar},
+		},
+	}
+	ttlField := func(name string) *commonpb.KeyValuePair {
+		return &commonpb.KeyValuePair{Key: common.CollectionTTLFieldKey, Value: name}
+	}
+	assert.NoError(t, validateCollectionTTLField(schema))
+	assert.NoError(t, validateCollectionTTLField(schema, ttlField("expire_at")))
+	assert.NoError(t, validateCollectionTTLField(schema, ttlField("")))
+	assert.Error(t, validateCollectionTTLField(schema, ttlField("not_exist")))
+	assert.Error(t, validateCollectionTTLField(schema, ttlField("name")))
+	assert.Error(t, validateCollectionTTLField(schema, ttlField("id")))
+}
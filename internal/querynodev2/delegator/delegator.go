@@ -353,7 +353,8 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 					results,
 					searchReq.Req.GetNq(),
 					searchReq.Req.GetTopk(),
-					searchReq.Req.GetMetricType())
+					searchReq.Req.GetMetricType(),
+					segments.NewSearchGroupBy(searchReq.GetReq()))
 			})
 			futures[index] = future
 		}
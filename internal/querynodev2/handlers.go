@@ -388,7 +388,7 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 	if req.GetReq().GetIsAdvanced() {
 		resp, err = segments.ReduceAdvancedSearchResults(ctx, results, req.Req.GetNq())
 	} else {
-		resp, err = segments.ReduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+		resp, err = segments.ReduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(), segments.NewSearchGroupBy(req.GetReq()))
 	}
 	if err != nil {
 		return nil, err
@@ -0,0 +1,114 @@
+package segments
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/aggregation"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func newAggregator(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) (*aggregation.Aggregator, error) {
+	aggregator, err := aggregation.NewAggregator(schema, req.GetReq().GetGroupByFieldIds(), req.GetReq().GetAggregates(),
+		paramtable.Get().QuotaConfig.MaxAggregationGroups.GetAsInt64())
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	return aggregator, nil
+}
+
+// aggregateSegmentResult accumulates the entities retrieved from a segment into the partial aggregation states,
+// so that the entities of a segment are released right after it's retrieved rather than held until all the
+// segments are retrieved. The deleted entities are already filtered out by segcore at the mvcc timestamp.
+func aggregateSegmentResult(req *querypb.QueryRequest, schema *schemapb.CollectionSchema, result *segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
+	aggregator, err := newAggregator(req, schema)
+	if err != nil {
+		return nil, err
+	}
+	size := typeutil.GetSizeOfIDs(result.GetIds())
+	if size > 0 {
+		indexes := make([]int64, size)
+		for i := range indexes {
+			indexes[i] = int64(i)
+		}
+		if err := aggregator.AddRows(result.GetFieldsData(), indexes); err != nil {
+			return nil, err
+		}
+	}
+	return &segcorepb.RetrieveResults{
+		FieldsData:       aggregator.Partial(),
+		AllRetrieveCount: result.GetAllRetrieveCount(),
+	}, nil
+}
+
+// aggregateReducer merges the partial aggregation states of segments and workers.
+type aggregateReducer struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func newAggregateReducer(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) *aggregateReducer {
+	return &aggregateReducer{
+		req:    req,
+		schema: schema,
+	}
+}
+
+func (r *aggregateReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
+	aggregator, err := newAggregator(r.req, r.schema)
+	if err != nil {
+		return nil, err
+	}
+	allRetrieveCount := int64(0)
+	relatedDataSize := int64(0)
+	for _, res := range results {
+		allRetrieveCount += res.GetAllRetrieveCount()
+		relatedDataSize += res.GetCostAggregation().GetTotalRelatedDataSize()
+		if err := aggregator.AddPartial(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	return &internalpb.RetrieveResults{
+		Status:           merr.Success(),
+		FieldsData:       aggregator.Partial(),
+		AllRetrieveCount: allRetrieveCount,
+		CostAggregation:  mergeRetrieveCost(results, relatedDataSize),
+	}, nil
+}
+
+// aggregateReducerSegcore merges the partial aggregation states of segments, which are accumulated by
+// aggregateSegmentResult once each segment is retrieved.
+type aggregateReducerSegcore struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func newAggregateReducerSegcore(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) *aggregateReducerSegcore {
+	return &aggregateReducerSegcore{
+		req:    req,
+		schema: schema,
+	}
+}
+
+func (r *aggregateReducerSegcore) Reduce(ctx context.Context, results []*segcorepb.RetrieveResults, _ []Segment, _ *RetrievePlan) (*segcorepb.RetrieveResults, error) {
+	aggregator, err := newAggregator(r.req, r.schema)
+	if err != nil {
+		return nil, err
+	}
+	allRetrieveCount := int64(0)
+	for _, res := range results {
+		allRetrieveCount += res.GetAllRetrieveCount()
+		if err := aggregator.AddPartial(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	return &segcorepb.RetrieveResults{
+		FieldsData:       aggregator.Partial(),
+		AllRetrieveCount: allRetrieveCount,
+	}, nil
+}
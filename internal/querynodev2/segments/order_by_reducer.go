@@ -0,0 +1,286 @@
+package segments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// orderBySelection is the rows selected from the retrieve results in order.
+type orderBySelection struct {
+	results []int
+	indexes []int64
+}
+
+// selectOrderByRows selects the first limit rows of the retrieve results ordered by the order by fields.
+// The stale versions of a primary key are dropped first, then the rows of each result are sorted and cut
+// to the limit, and the sorted results are merged.
+func selectOrderByRows[T interface {
+	typeutil.ResultWithID
+	GetFieldsData() []*schemapb.FieldData
+}](results []*TimestampedRetrieveResult[T], orderBy []*internalpb.OrderByField, limit int64,
+) (*orderBySelection, error) {
+	latest := make(map[any]int64)
+	for _, r := range results {
+		for i, ts := range r.Timestamps {
+			pk := typeutil.GetPK(r.GetIds(), int64(i))
+			if old, ok := latest[pk]; !ok || ts > old {
+				latest[pk] = ts
+			}
+		}
+	}
+
+	orders := make([]*typeutil2.RowOrder, len(results))
+	sorted := make([][]int64, len(results))
+	for i, r := range results {
+		order, err := typeutil2.NewRowOrder(r.GetIds(), r.Result.GetFieldsData(), orderBy)
+		if err != nil {
+			return nil, merr.WrapErrServiceInternal(err.Error())
+		}
+		indexes := make([]int64, 0, order.Len())
+		for j, ts := range r.Timestamps {
+			if latest[typeutil.GetPK(r.GetIds(), int64(j))] == ts {
+				indexes = append(indexes, int64(j))
+			}
+		}
+		order.Sort(indexes)
+		if limit != typeutil.Unlimited && int64(len(indexes)) > limit {
+			indexes = indexes[:limit]
+		}
+		orders[i], sorted[i] = order, indexes
+	}
+
+	selection := &orderBySelection{}
+	selected := make(map[any]struct{})
+	cursors := make([]int, len(results))
+	for limit == typeutil.Unlimited || int64(len(selection.indexes)) < limit {
+		sel := typeutil2.SelectFirstRow(orders, sorted, cursors)
+		if sel == -1 {
+			break
+		}
+		idx := sorted[sel][cursors[sel]]
+		cursors[sel]++
+
+		// the same version of a primary key may be retrieved from more than one segment
+		pk := typeutil.GetPK(results[sel].GetIds(), idx)
+		if _, ok := selected[pk]; ok {
+			continue
+		}
+		selected[pk] = struct{}{}
+		selection.results = append(selection.results, sel)
+		selection.indexes = append(selection.indexes, idx)
+	}
+	return selection, nil
+}
+
+// orderByReducer merges the internal retrieve results ordered by the order by fields.
+type orderByReducer struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func newOrderByReducer(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) *orderByReducer {
+	return &orderByReducer{
+		req:    req,
+		schema: schema,
+	}
+}
+
+func (r *orderByReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
+	log.Ctx(ctx).Debug("reduce internal retrieve results with order by",
+		zap.Int64("limit", r.req.GetReq().GetLimit()),
+		zap.Int("resultNum", len(results)),
+	)
+	ret := &internalpb.RetrieveResults{
+		Status: merr.Success(),
+		Ids:    &schemapb.IDs{},
+	}
+
+	validResults := make([]*TimestampedRetrieveResult[*internalpb.RetrieveResults], 0, len(results))
+	relatedDataSize := int64(0)
+	for _, result := range results {
+		ret.AllRetrieveCount += result.GetAllRetrieveCount()
+		relatedDataSize += result.GetCostAggregation().GetTotalRelatedDataSize()
+		if len(result.GetFieldsData()) == 0 || typeutil.GetSizeOfIDs(result.GetIds()) == 0 {
+			continue
+		}
+		tr, err := NewTimestampedRetrieveResult(result)
+		if err != nil {
+			return nil, err
+		}
+		validResults = append(validResults, tr)
+	}
+	ret.CostAggregation = mergeRetrieveCost(results, relatedDataSize)
+
+	if len(validResults) > 0 {
+		selection, err := selectOrderByRows(validResults, r.req.GetReq().GetOrderByFields(), r.req.GetReq().GetLimit())
+		if err != nil {
+			return nil, err
+		}
+
+		var retSize int64
+		maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+		ret.FieldsData = typeutil.PrepareResultFieldData(validResults[0].Result.GetFieldsData(), int64(len(selection.indexes)))
+		for i, sel := range selection.results {
+			idx := selection.indexes[i]
+			typeutil.AppendPKs(ret.Ids, typeutil.GetPK(validResults[sel].GetIds(), idx))
+			retSize += typeutil.AppendFieldData(ret.FieldsData, validResults[sel].Result.GetFieldsData(), idx)
+
+			// limit retrieve result to avoid oom
+			if retSize > maxOutputSize {
+				return nil, fmt.Errorf("query results exceed the maxOutputSize Limit %d", maxOutputSize)
+			}
+		}
+	}
+
+	if err := typeutil2.FillRetrieveResultIfEmpty(typeutil2.NewInternalResult(ret), r.req.GetReq().GetOutputFieldsId(), r.schema); err != nil {
+		return nil, fmt.Errorf("failed to fill internal retrieve results: %s", err.Error())
+	}
+	return ret, nil
+}
+
+// orderByReducerSegcore keeps the top limit rows of each segment ordered by the order by fields and merges them.
+// If the results only carry the order by fields, the other output fields of the selected rows are retrieved
+// by offsets from the segments.
+type orderByReducerSegcore struct {
+	req     *querypb.QueryRequest
+	schema  *schemapb.CollectionSchema
+	manager *Manager
+}
+
+func newOrderByReducerSegcore(req *querypb.QueryRequest, schema *schemapb.CollectionSchema, manager *Manager) *orderByReducerSegcore {
+	return &orderByReducerSegcore{
+		req:     req,
+		schema:  schema,
+		manager: manager,
+	}
+}
+
+func (r *orderByReducerSegcore) Reduce(ctx context.Context, results []*segcorepb.RetrieveResults, segments []Segment, plan *RetrievePlan) (*segcorepb.RetrieveResults, error) {
+	log.Ctx(ctx).Debug("reduce segcore retrieve results with order by",
+		zap.Int64("limit", r.req.GetReq().GetLimit()),
+		zap.Int("resultNum", len(results)),
+	)
+	ret := &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{},
+	}
+
+	validResults := make([]*TimestampedRetrieveResult[*segcorepb.RetrieveResults], 0, len(results))
+	validSegments := make([]Segment, 0, len(segments))
+	for i, result := range results {
+		ret.AllRetrieveCount += result.GetAllRetrieveCount()
+		if len(result.GetOffset()) == 0 || typeutil.GetSizeOfIDs(result.GetIds()) == 0 {
+			continue
+		}
+		tr, err := NewTimestampedRetrieveResult(result)
+		if err != nil {
+			return nil, err
+		}
+		validResults = append(validResults, tr)
+		if plan.ignoreNonPk {
+			validSegments = append(validSegments, segments[i])
+		}
+	}
+
+	if len(validResults) > 0 {
+		selection, err := selectOrderByRows(validResults, r.req.GetReq().GetOrderByFields(), r.req.GetReq().GetLimit())
+		if err != nil {
+			return nil, err
+		}
+
+		ret.Offset = make([]int64, 0, len(selection.indexes))
+		for i, sel := range selection.results {
+			idx := selection.indexes[i]
+			typeutil.AppendPKs(ret.Ids, typeutil.GetPK(validResults[sel].GetIds(), idx))
+			ret.Offset = append(ret.Offset, validResults[sel].Result.GetOffset()[idx])
+		}
+
+		// the rows are taken from the results in order, or from the rows retrieved by offsets
+		fieldsData := lo.Map(validResults, func(result *TimestampedRetrieveResult[*segcorepb.RetrieveResults], _ int) []*schemapb.FieldData {
+			return result.Result.GetFieldsData()
+		})
+		rows := selection.indexes
+		if plan.ignoreNonPk {
+			fieldsData, err = r.retrieveByOffsets(ctx, validResults, validSegments, plan, selection)
+			if err != nil {
+				return nil, err
+			}
+			rows = make([]int64, len(selection.results))
+			cursors := make([]int64, len(validResults))
+			for i, sel := range selection.results {
+				rows[i] = cursors[sel]
+				cursors[sel]++
+			}
+		}
+
+		var retSize int64
+		maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+		for _, fields := range fieldsData {
+			if len(fields) != 0 {
+				ret.FieldsData = typeutil.PrepareResultFieldData(fields, int64(len(selection.indexes)))
+				break
+			}
+		}
+		for i, sel := range selection.results {
+			retSize += typeutil.AppendFieldData(ret.FieldsData, fieldsData[sel], rows[i])
+
+			// limit retrieve result to avoid oom
+			if retSize > maxOutputSize {
+				return nil, fmt.Errorf("query results exceed the maxOutputSize Limit %d", maxOutputSize)
+			}
+		}
+	}
+
+	if err := typeutil2.FillRetrieveResultIfEmpty(typeutil2.NewSegcoreResults(ret), r.req.GetReq().GetOutputFieldsId(), r.schema); err != nil {
+		return nil, fmt.Errorf("failed to fill segcore retrieve results: %s", err.Error())
+	}
+	return ret, nil
+}
+
+// retrieveByOffsets retrieves all the output fields of the selected rows from the segments, the fields of each
+// result are in the order of the selection.
+func (r *orderByReducerSegcore) retrieveByOffsets(ctx context.Context, results []*TimestampedRetrieveResult[*segcorepb.RetrieveResults],
+	segments []Segment, plan *RetrievePlan, selection *orderBySelection,
+) ([][]*schemapb.FieldData, error) {
+	offsets := make([][]int64, len(results))
+	for i, sel := range selection.results {
+		offsets[sel] = append(offsets[sel], results[sel].Result.GetOffset()[selection.indexes[i]])
+	}
+
+	fieldsData := make([][]*schemapb.FieldData, len(results))
+	futures := make([]*conc.Future[any], 0, len(results))
+	for i := range offsets {
+		if len(offsets[i]) == 0 {
+			continue
+		}
+		idx := i
+		future := GetSQPool().Submit(func() (any, error) {
+			return nil, doOnSegment(ctx, r.manager, segments[idx], func(ctx context.Context, segment Segment) error {
+				result, err := segment.RetrieveByOffsets(ctx, plan, offsets[idx])
+				if err != nil {
+					return err
+				}
+				fieldsData[idx] = result.GetFieldsData()
+				return nil
+			})
+		})
+		futures = append(futures, future)
+	}
+	if err := conc.AwaitAll(futures...); err != nil {
+		return nil, err
+	}
+	return fieldsData, nil
+}
@@ -0,0 +1,168 @@
+package segments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const orderByTestFieldID = 101
+
+type OrderByReducerSuite struct {
+	suite.Suite
+}
+
+func (suite *OrderByReducerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func TestOrderByReducerSuite(t *testing.T) {
+	suite.Run(t, new(OrderByReducerSuite))
+}
+
+func newOrderByTestRequest(limit int64, ascending bool) *querypb.QueryRequest {
+	return &querypb.QueryRequest{
+		Req: &internalpb.RetrieveRequest{
+			Limit:          limit,
+			OutputFieldsId: []int64{100, orderByTestFieldID, common.TimeStampField},
+			OrderByFields:  []*internalpb.OrderByField{{FieldId: orderByTestFieldID, Ascending: ascending}},
+		},
+	}
+}
+
+func newOrderByTestFieldsData(pks []int64, values []float64, timestamps []int64) []*schemapb.FieldData {
+	return []*schemapb.FieldData{
+		{
+			Type:    schemapb.DataType_Int64,
+			FieldId: 100,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}}},
+			},
+		},
+		{
+			Type:    schemapb.DataType_Double,
+			FieldId: orderByTestFieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: values}}},
+			},
+		},
+		{
+			Type:    schemapb.DataType_Int64,
+			FieldId: common.TimeStampField,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: timestamps}}},
+			},
+		},
+	}
+}
+
+func newOrderByTestInternalResult(pks []int64, values []float64, timestamps []int64) *internalpb.RetrieveResults {
+	return &internalpb.RetrieveResults{
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		FieldsData: newOrderByTestFieldsData(pks, values, timestamps),
+	}
+}
+
+func (suite *OrderByReducerSuite) TestReduceInternal() {
+	results := []*internalpb.RetrieveResults{
+		newOrderByTestInternalResult([]int64{1, 2, 3}, []float64{3.0, 1.0, 5.0}, []int64{10, 10, 10}),
+		newOrderByTestInternalResult([]int64{4, 5, 6}, []float64{2.0, 4.0, 1.0}, []int64{10, 10, 10}),
+		// a newer version of pk 3 and a duplicate of pk 6
+		newOrderByTestInternalResult([]int64{3, 6}, []float64{0.5, 1.0}, []int64{20, 10}),
+	}
+
+	r := newOrderByReducer(newOrderByTestRequest(4, true), nil)
+	ret, err := r.Reduce(context.Background(), results)
+	suite.Require().NoError(err)
+	suite.Equal([]int64{3, 2, 6, 4}, ret.GetIds().GetIntId().GetData())
+	suite.Equal([]float64{0.5, 1.0, 1.0, 2.0}, ret.GetFieldsData()[1].GetScalars().GetDoubleData().GetData())
+
+	r = newOrderByReducer(newOrderByTestRequest(typeutil.Unlimited, false), nil)
+	ret, err = r.Reduce(context.Background(), results)
+	suite.Require().NoError(err)
+	suite.Equal([]int64{5, 1, 4, 2, 6, 3}, ret.GetIds().GetIntId().GetData())
+}
+
+func (suite *OrderByReducerSuite) TestReduceSegcore() {
+	newResult := func(pks []int64, values []float64, offsets []int64) *segcorepb.RetrieveResults {
+		return &segcorepb.RetrieveResults{
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			Offset:     offsets,
+			FieldsData: newOrderByTestFieldsData(pks, values, make([]int64, len(pks))),
+		}
+	}
+	results := []*segcorepb.RetrieveResults{
+		newResult([]int64{1, 2, 3}, []float64{3.0, 1.0, 5.0}, []int64{0, 1, 2}),
+		newResult([]int64{4, 5, 6}, []float64{2.0, 4.0, 1.0}, []int64{0, 1, 2}),
+		{Ids: &schemapb.IDs{}},
+	}
+
+	r := newOrderByReducerSegcore(newOrderByTestRequest(3, false), nil, nil)
+	ret, err := r.Reduce(context.Background(), results, nil, &RetrievePlan{})
+	suite.Require().NoError(err)
+	suite.Equal([]int64{3, 5, 1}, ret.GetIds().GetIntId().GetData())
+	suite.Equal([]int64{2, 1, 0}, ret.GetOffset())
+	suite.Equal([]float64{5.0, 4.0, 3.0}, ret.GetFieldsData()[1].GetScalars().GetDoubleData().GetData())
+}
+
+func (suite *OrderByReducerSuite) TestReduceSegcoreByOffsets() {
+	newSegment := func(pks []int64, values []float64) Segment {
+		segment := NewMockSegment(suite.T())
+		segment.EXPECT().IsLazyLoad().Return(false).Maybe()
+		segment.EXPECT().DatabaseName().Return("").Maybe()
+		segment.EXPECT().ResourceGroup().Return("").Maybe()
+		segment.EXPECT().RetrieveByOffsets(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, _ *RetrievePlan, offsets []int64) (*segcorepb.RetrieveResults, error) {
+				retPks := make([]int64, 0, len(offsets))
+				retValues := make([]float64, 0, len(offsets))
+				for _, offset := range offsets {
+					retPks = append(retPks, pks[offset])
+					retValues = append(retValues, values[offset])
+				}
+				return &segcorepb.RetrieveResults{
+					FieldsData: newOrderByTestFieldsData(retPks, retValues, make([]int64, len(offsets))),
+				}, nil
+			}).Once()
+		return segment
+	}
+	// the results only carry the primary keys, the timestamps and the order by fields
+	newResult := func(pks []int64, values []float64) *segcorepb.RetrieveResults {
+		return &segcorepb.RetrieveResults{
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			Offset:     []int64{0, 1, 2},
+			FieldsData: newOrderByTestFieldsData(pks, values, make([]int64, len(pks))),
+		}
+	}
+	pks1, values1 := []int64{1, 2, 3}, []float64{3.0, 1.0, 5.0}
+	pks2, values2 := []int64{4, 5, 6}, []float64{2.0, 4.0, 1.0}
+	results := []*segcorepb.RetrieveResults{newResult(pks1, values1), newResult(pks2, values2)}
+	segments := []Segment{newSegment(pks1, values1), newSegment(pks2, values2)}
+
+	r := newOrderByReducerSegcore(newOrderByTestRequest(3, false), nil, nil)
+	ret, err := r.Reduce(context.Background(), results, segments, &RetrievePlan{ignoreNonPk: true})
+	suite.Require().NoError(err)
+	suite.Equal([]int64{3, 5, 1}, ret.GetIds().GetIntId().GetData())
+	suite.Equal([]int64{2, 1, 0}, ret.GetOffset())
+	suite.Equal([]int64{3, 5, 1}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	suite.Equal([]float64{5.0, 4.0, 3.0}, ret.GetFieldsData()[1].GetScalars().GetDoubleData().GetData())
+}
+
+func (suite *OrderByReducerSuite) TestReduceInvalidField() {
+	results := []*internalpb.RetrieveResults{
+		newOrderByTestInternalResult([]int64{1}, []float64{1.0}, []int64{10}),
+	}
+	req := newOrderByTestRequest(1, true)
+	req.Req.OrderByFields = []*internalpb.OrderByField{{FieldId: 102}}
+	_, err := newOrderByReducer(req, nil).Reduce(context.Background(), results)
+	suite.Error(err)
+}
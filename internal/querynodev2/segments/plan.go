@@ -31,8 +31,13 @@ import (
 	"unsafe"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	. "github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -89,7 +94,10 @@ type SearchRequest struct {
 
 func NewSearchRequest(ctx context.Context, collection *Collection, req *querypb.SearchRequest, placeholderGrp []byte) (*SearchRequest, error) {
 	metricType := req.GetReq().GetMetricType()
-	expr := req.Req.SerializedExprPlan
+	expr, err := filterExpiredEntities(collection.Schema(), req.Req.SerializedExprPlan, req.GetReq().GetMvccTimestamp())
+	if err != nil {
+		return nil, err
+	}
 	plan, err := createSearchPlanByExpr(ctx, collection, expr)
 	if err != nil {
 		return nil, err
@@ -173,6 +181,9 @@ type RetrievePlan struct {
 	Timestamp     Timestamp
 	msgID         UniqueID // only used to debug.
 	ignoreNonPk   bool
+	// orderByPlan retrieves the primary keys, the timestamps and the order by fields of all the matched entities,
+	// the other output fields of the top entities are retrieved by offsets with the plan after they are ordered.
+	orderByPlan *RetrievePlan
 }
 
 func NewRetrievePlan(ctx context.Context, col *Collection, expr []byte, timestamp Timestamp, msgID UniqueID) (*RetrievePlan, error) {
@@ -183,10 +194,15 @@ func NewRetrievePlan(ctx context.Context, col *Collection, expr []byte, timestam
 		return nil, merr.WrapErrCollectionNotFound(col.id, "collection released")
 	}
 
+	expr, err := filterExpiredEntities(col.Schema(), expr, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
 	var cPlan C.CRetrievePlan
 	status := C.CreateRetrievePlanByExpr(col.collectionPtr, unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
 
-	err := HandleCStatus(ctx, &status, "Create retrieve plan by expr failed")
+	err = HandleCStatus(ctx, &status, "Create retrieve plan by expr failed")
 	if err != nil {
 		return nil, err
 	}
@@ -199,10 +215,56 @@ func NewRetrievePlan(ctx context.Context, col *Collection, expr []byte, timestam
 	return newPlan, nil
 }
 
+// NewOrderByRetrievePlan creates the retrieve plan of a query with order by. Segcore doesn't order the entities,
+// so the order by fields of all the matched entities are retrieved first, and only the top limit entities of
+// each segment are retrieved with all the output fields by offsets.
+func NewOrderByRetrievePlan(ctx context.Context, col *Collection, req *internalpb.RetrieveRequest) (*RetrievePlan, error) {
+	plan, err := NewRetrievePlan(ctx, col, req.GetSerializedExprPlan(), req.GetMvccTimestamp(), req.GetBase().GetMsgID())
+	if err != nil {
+		return nil, err
+	}
+
+	planNode := &planpb.PlanNode{}
+	if err := proto.Unmarshal(req.GetSerializedExprPlan(), planNode); err != nil {
+		plan.Delete()
+		return nil, err
+	}
+	pkField := GetPkField(col.Schema())
+	if pkField == nil {
+		plan.Delete()
+		return nil, merr.WrapErrServiceInternal("primary key field of the collection not found")
+	}
+	outputFieldIDs := []int64{pkField.GetFieldID(), common.TimeStampField}
+	for _, field := range req.GetOrderByFields() {
+		if !lo.Contains(outputFieldIDs, field.GetFieldId()) {
+			outputFieldIDs = append(outputFieldIDs, field.GetFieldId())
+		}
+	}
+	planNode.OutputFieldIds = outputFieldIDs
+	// all the matched entities are needed to order them
+	if query := planNode.GetQuery(); query != nil {
+		query.Limit = Unlimited
+	}
+	expr, err := proto.Marshal(planNode)
+	if err != nil {
+		plan.Delete()
+		return nil, err
+	}
+	plan.orderByPlan, err = NewRetrievePlan(ctx, col, expr, req.GetMvccTimestamp(), req.GetBase().GetMsgID())
+	if err != nil {
+		plan.Delete()
+		return nil, err
+	}
+	return plan, nil
+}
+
 func (plan *RetrievePlan) ShouldIgnoreNonPk() bool {
 	return bool(C.ShouldIgnoreNonPk(plan.cRetrievePlan))
 }
 
 func (plan *RetrievePlan) Delete() {
+	if plan.orderByPlan != nil {
+		plan.orderByPlan.Delete()
+	}
 	C.DeleteRetrievePlan(plan.cRetrievePlan)
 }
@@ -22,6 +22,12 @@ func CreateInternalReducer(req *querypb.QueryRequest, schema *schemapb.Collectio
 	if req.GetReq().GetIsCount() {
 		return &cntReducer{}
 	}
+	if len(req.GetReq().GetAggregates()) > 0 {
+		return newAggregateReducer(req, schema)
+	}
+	if len(req.GetReq().GetOrderByFields()) > 0 {
+		return newOrderByReducer(req, schema)
+	}
 	return newDefaultLimitReducer(req, schema)
 }
 
@@ -33,6 +39,12 @@ func CreateSegCoreReducer(req *querypb.QueryRequest, schema *schemapb.Collection
 	if req.GetReq().GetIsCount() {
 		return &cntReducerSegCore{}
 	}
+	if len(req.GetReq().GetAggregates()) > 0 {
+		return newAggregateReducerSegcore(req, schema)
+	}
+	if len(req.GetReq().GetOrderByFields()) > 0 {
+		return newOrderByReducerSegcore(req, schema, manager)
+	}
 	return newDefaultLimitReducerSegcore(req, schema, manager)
 }
 
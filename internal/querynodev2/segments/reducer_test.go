@@ -40,6 +40,17 @@ func (suite *ReducerFactorySuite) TestCreateInternalReducer() {
 	suite.ir = CreateInternalReducer(req, nil)
 	_, suite.ok = suite.ir.(*cntReducer)
 	suite.True(suite.ok)
+
+	req.Req.IsCount = false
+	req.Req.OrderByFields = []*internalpb.OrderByField{{FieldId: 100}}
+	suite.ir = CreateInternalReducer(req, nil)
+	_, suite.ok = suite.ir.(*orderByReducer)
+	suite.True(suite.ok)
+
+	req.Req.Aggregates = []*internalpb.Aggregate{{Op: internalpb.Aggregate_Count}}
+	suite.ir = CreateInternalReducer(req, nil)
+	_, suite.ok = suite.ir.(*aggregateReducer)
+	suite.True(suite.ok)
 }
 
 func (suite *ReducerFactorySuite) TestCreateSegCoreReducer() {
@@ -57,4 +68,15 @@ func (suite *ReducerFactorySuite) TestCreateSegCoreReducer() {
 	suite.sr = CreateSegCoreReducer(req, nil, nil)
 	_, suite.ok = suite.sr.(*cntReducerSegCore)
 	suite.True(suite.ok)
+
+	req.Req.IsCount = false
+	req.Req.OrderByFields = []*internalpb.OrderByField{{FieldId: 100}}
+	suite.sr = CreateSegCoreReducer(req, nil, nil)
+	_, suite.ok = suite.sr.(*orderByReducerSegcore)
+	suite.True(suite.ok)
+
+	req.Req.Aggregates = []*internalpb.Aggregate{{Op: internalpb.Aggregate_Count}}
+	suite.sr = CreateSegCoreReducer(req, nil, nil)
+	_, suite.ok = suite.sr.(*aggregateReducerSegcore)
+	suite.True(suite.ok)
 }
@@ -42,7 +42,20 @@ var _ typeutil.ResultWithID = &internalpb.RetrieveResults{}
 
 var _ typeutil.ResultWithID = &segcorepb.RetrieveResults{}
 
-func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
+// SearchGroupBy is how the hits of a search request are grouped, at most GroupSize hits of each group are kept.
+type SearchGroupBy struct {
+	FieldIDs  []int64
+	GroupSize int64
+}
+
+func NewSearchGroupBy(req *internalpb.SearchRequest) *SearchGroupBy {
+	return &SearchGroupBy{
+		FieldIDs:  req.GetGroupByFieldIds(),
+		GroupSize: req.GetGroupSize(),
+	}
+}
+
+func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string, groupBy *SearchGroupBy) (*internalpb.SearchResults, error) {
 	results = lo.Filter(results, func(result *internalpb.SearchResults, _ int) bool {
 		return result != nil && result.GetSlicedBlob() != nil
 	})
@@ -80,7 +93,7 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 			zap.Int64("topk", sData.TopK))
 	}
 
-	reducedResultData, err := ReduceSearchResultData(ctx, searchResultData, nq, topk)
+	reducedResultData, err := ReduceSearchResultData(ctx, searchResultData, nq, topk, groupBy)
 	if err != nil {
 		log.Warn("shard leader reduce errors", zap.Error(err))
 		return nil, err
@@ -207,7 +220,10 @@ func MergeToAdvancedResults(ctx context.Context, results []*internalpb.SearchRes
 	return searchResults, nil
 }
 
-func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+// ReduceSearchResultData merges the hits of the search results. If the hits are grouped by a single field, at most
+// topk groups are kept, otherwise topk is the number of hits to keep as segcore doesn't group the hits by several
+// fields, and the hits of each group are limited to keep more groups for the proxy.
+func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64, groupBy *SearchGroupBy) (*schemapb.SearchResultData, error) {
 	ctx, sp := otel.Tracer(typeutil.QueryNodeRole).Start(ctx, "ReduceSearchResultData")
 	defer sp.End()
 	log := log.Ctx(ctx)
@@ -231,8 +247,20 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		Topks:      make([]int64, 0),
 	}
 
+	var groupByFieldIDs []int64
+	groupSize := int64(1)
+	if groupBy != nil {
+		groupByFieldIDs = groupBy.FieldIDs
+		groupSize = max(groupBy.GroupSize, 1)
+	}
+	groupKeys := make([]*typeutil2.SearchGroupKeys, len(searchResultData))
 	resultOffsets := make([][]int64, len(searchResultData))
 	for i := 0; i < len(searchResultData); i++ {
+		keys, err := typeutil2.NewSearchGroupKeys(searchResultData[i], groupByFieldIDs)
+		if err != nil {
+			return nil, err
+		}
+		groupKeys[i] = keys
 		resultOffsets[i] = make([]int64, len(searchResultData[i].Topks))
 		for j := int64(1); j < nq; j++ {
 			resultOffsets[i][j] = resultOffsets[i][j-1] + searchResultData[i].Topks[j-1]
@@ -240,6 +268,15 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		ret.AllSearchCount += searchResultData[i].GetAllSearchCount()
 	}
 
+	// the hits grouped by a single field are limited by the number of groups
+	maxGroups, limit := int64(0), topk
+	for _, keys := range groupKeys {
+		if !keys.IsComposite() && keys.GroupByValue() != nil {
+			maxGroups, limit = topk, topk*groupSize
+			break
+		}
+	}
+
 	var skipDupCnt int64
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
@@ -247,9 +284,9 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		offsets := make([]int64, len(searchResultData))
 
 		idSet := make(map[interface{}]struct{})
-		groupByValueSet := make(map[interface{}]struct{})
+		groupCounter := typeutil2.NewGroupCounter(maxGroups, groupSize)
 		var j int64
-		for j = 0; j < topk; {
+		for j = 0; j < limit; {
 			sel := SelectSearchResultData(searchResultData, resultOffsets, offsets, i)
 			if sel == -1 {
 				break
@@ -257,22 +294,17 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 			idx := resultOffsets[sel][i] + offsets[sel]
 
 			id := typeutil.GetPK(searchResultData[sel].GetIds(), idx)
-			groupByVal := typeutil.GetData(searchResultData[sel].GetGroupByFieldValue(), int(idx))
+			groupByVal := groupKeys[sel].Key(idx)
 			score := searchResultData[sel].Scores[idx]
 
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
-				groupByValExist := false
-				if groupByVal != nil {
-					_, groupByValExist = groupByValueSet[groupByVal]
-				}
-				if !groupByValExist {
+				if groupByVal == nil || groupCounter.Add(groupByVal) {
 					retSize += typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
 					typeutil.AppendPKs(ret.Ids, id)
 					ret.Scores = append(ret.Scores, score)
-					if groupByVal != nil {
-						groupByValueSet[groupByVal] = struct{}{}
-						if err := typeutil.AppendGroupByValue(ret, groupByVal, searchResultData[sel].GetGroupByFieldValue().GetType()); err != nil {
+					if groupByVal != nil && !groupKeys[sel].IsComposite() {
+						if err := typeutil.AppendGroupByValue(ret, groupByVal, groupKeys[sel].GroupByValue().GetType()); err != nil {
 							log.Error("Failed to append groupByValues", zap.Error(err))
 							return ret, err
 						}
@@ -468,6 +500,11 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 		log.Debug("skip duplicated query result while reducing internal.RetrieveResults", zap.Int64("dupCount", skipDupCnt))
 	}
 
+	ret.CostAggregation = mergeRetrieveCost(retrieveResults, relatedDataSize)
+	return ret, nil
+}
+
+func mergeRetrieveCost(retrieveResults []*internalpb.RetrieveResults, relatedDataSize int64) *internalpb.CostAggregation {
 	requestCosts := lo.FilterMap(retrieveResults, func(result *internalpb.RetrieveResults, _ int) (*internalpb.CostAggregation, bool) {
 		if paramtable.Get().QueryNodeCfg.EnableWorkerSQCostMetrics.GetAsBool() {
 			return result.GetCostAggregation(), true
@@ -479,12 +516,12 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 
 		return nil, false
 	})
-	ret.CostAggregation = mergeRequestCost(requestCosts)
-	if ret.CostAggregation == nil {
-		ret.CostAggregation = &internalpb.CostAggregation{}
+	cost := mergeRequestCost(requestCosts)
+	if cost == nil {
+		cost = &internalpb.CostAggregation{}
 	}
-	ret.CostAggregation.TotalRelatedDataSize = relatedDataSize
-	return ret, nil
+	cost.TotalRelatedDataSize = relatedDataSize
+	return cost
 }
 
 func getTS(i *internalpb.RetrieveResults, idx int64) uint64 {
@@ -717,7 +717,7 @@ func (suite *ResultSuite) TestResult_ReduceSearchResultData() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, nil)
 		suite.Nil(err)
 		suite.Equal(ids, res.Ids.GetIntId().Data)
 		suite.Equal(scores, res.Scores)
@@ -734,7 +734,7 @@ func (suite *ResultSuite) TestResult_ReduceSearchResultData() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, nil)
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 5, 2, 3}, res.Ids.GetIntId().Data)
 	})
@@ -781,7 +781,7 @@ func (suite *ResultSuite) TestResult_SearchGroupByResult() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, nil)
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 2, 3, 4}, res.Ids.GetIntId().Data)
 		suite.ElementsMatch([]float32{-1.0, -2.0, -3.0, -4.0}, res.Scores)
@@ -823,7 +823,7 @@ func (suite *ResultSuite) TestResult_SearchGroupByResult() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, nil)
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 4}, res.Ids.GetIntId().Data)
 		suite.ElementsMatch([]float32{-1.0, -1.0}, res.Scores)
@@ -865,12 +865,85 @@ func (suite *ResultSuite) TestResult_SearchGroupByResult() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, nil)
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 2, 3, 4}, res.Ids.GetIntId().Data)
 		suite.ElementsMatch([]float32{-1.0, -2.0, -3.0, -4.0}, res.Scores)
 		suite.ElementsMatch([]string{"1", "2", "3", "4"}, res.GroupByFieldValue.GetScalars().GetStringData().Data)
 	})
+	suite.Run("reduce_group_by_group_size", func() {
+		data1 := genSearchResultData(nq, topk, []int64{1, 2, 3, 4}, []float32{-1.0, -2.0, -3.0, -4.0}, []int64{4})
+		data2 := genSearchResultData(nq, topk, []int64{5, 6, 7, 8}, []float32{-1.5, -2.5, -3.5, -4.5}, []int64{4})
+		data1.GroupByFieldValue = &schemapb.FieldData{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{
+							Data: []int64{1, 1, 1, 2},
+						},
+					},
+				},
+			},
+		}
+		data2.GroupByFieldValue = &schemapb.FieldData{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{
+							Data: []int64{2, 3, 3, 3},
+						},
+					},
+				},
+			},
+		}
+		// at most 2 groups of 2 hits
+		res, err := ReduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data1, data2}, nq, 2,
+			&SearchGroupBy{FieldIDs: []int64{101}, GroupSize: 2})
+		suite.NoError(err)
+		suite.Equal([]int64{1, 5, 2, 4}, res.Ids.GetIntId().Data)
+		suite.Equal([]int64{1, 2, 1, 2}, res.GroupByFieldValue.GetScalars().GetLongData().Data)
+		suite.Equal([]int64{4}, res.Topks)
+	})
+	suite.Run("reduce_group_by_composite", func() {
+		newColumn := func(fieldID int64, data []string) *schemapb.FieldData {
+			return &schemapb.FieldData{
+				Type:    schemapb.DataType_VarChar,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{
+							StringData: &schemapb.StringArray{Data: data},
+						},
+					},
+				},
+			}
+		}
+		data1 := genSearchResultData(nq, topk, []int64{1, 2, 3, 4}, []float32{-1.0, -2.0, -3.0, -4.0}, []int64{4})
+		data1.FieldsData = []*schemapb.FieldData{
+			newColumn(101, []string{"a", "a", "a", "b"}),
+			newColumn(102, []string{"x", "x", "y", "x"}),
+		}
+		data2 := genSearchResultData(nq, topk, []int64{5, 6}, []float32{-1.5, -2.5}, []int64{2})
+		data2.FieldsData = []*schemapb.FieldData{
+			newColumn(101, []string{"a", "b"}),
+			newColumn(102, []string{"y", "x"}),
+		}
+		// topk is the number of hits, and at most 1 hit of each group is kept
+		res, err := ReduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data1, data2}, nq, topk,
+			&SearchGroupBy{FieldIDs: []int64{101, 102}, GroupSize: 1})
+		suite.NoError(err)
+		suite.Equal([]int64{1, 5, 6}, res.Ids.GetIntId().Data)
+		suite.Equal([]string{"a", "a", "b"}, res.FieldsData[0].GetScalars().GetStringData().Data)
+		suite.Equal([]string{"x", "y", "x"}, res.FieldsData[1].GetScalars().GetStringData().Data)
+		suite.Nil(res.GroupByFieldValue)
+
+		data2.FieldsData = data2.FieldsData[:1]
+		_, err = ReduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data1, data2}, nq, topk,
+			&SearchGroupBy{FieldIDs: []int64{101, 102}, GroupSize: 1})
+		suite.Error(err)
+	})
 }
 
 func (suite *ResultSuite) TestResult_SelectSearchResultData_int() {
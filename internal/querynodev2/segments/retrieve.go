@@ -24,6 +24,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
@@ -54,7 +55,25 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 		}
 		return false
 	}()
-	plan.ignoreNonPk = !anySegIsLazyLoad && len(segments) > 1 && req.GetReq().GetLimit() != typeutil.Unlimited && plan.ShouldIgnoreNonPk()
+	// the order by fields and the aggregated fields are needed to reduce the results
+	plan.ignoreNonPk = !anySegIsLazyLoad && len(segments) > 1 && req.GetReq().GetLimit() != typeutil.Unlimited &&
+		len(req.GetReq().GetOrderByFields()) == 0 && len(req.GetReq().GetAggregates()) == 0 && plan.ShouldIgnoreNonPk()
+	retrievePlan := plan
+	if plan.orderByPlan != nil {
+		// only the order by fields are retrieved to order the entities, the other output fields of the top entities
+		// are retrieved by offsets on reducing
+		plan.ignoreNonPk = true
+		retrievePlan = plan.orderByPlan
+	}
+
+	var schema *schemapb.CollectionSchema
+	if len(req.GetReq().GetAggregates()) > 0 {
+		collection := mgr.Collection.Get(req.GetReq().GetCollectionID())
+		if collection == nil {
+			return nil, merr.WrapErrCollectionNotFound(req.GetReq().GetCollectionID())
+		}
+		schema = collection.Schema()
+	}
 
 	label := metrics.SealedSegmentLabel
 	if segType == commonpb.SegmentState_Growing {
@@ -63,10 +82,16 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 
 	retriever := func(ctx context.Context, s Segment) error {
 		tr := timerecord.NewTimeRecorder("retrieveOnSegments")
-		result, err := s.Retrieve(ctx, plan)
+		result, err := s.Retrieve(ctx, retrievePlan)
 		if err != nil {
 			return err
 		}
+		if len(req.GetReq().GetAggregates()) > 0 {
+			result, err = aggregateSegmentResult(req, schema, result)
+			if err != nil {
+				return err
+			}
+		}
 		resultCh <- RetrieveSegmentResult{
 			result,
 			s,
@@ -936,6 +936,55 @@ func (s *LocalSegment) LoadFieldData(ctx context.Context, fieldID int64, rowCoun
 	return nil
 }
 
+// LoadDefaultFieldData loads the field added to the collection after the segment was written, every row of which is
+// the default value of the field, or null if the field has no default value.
+func (s *LocalSegment) LoadDefaultFieldData(ctx context.Context, field *schemapb.FieldSchema, rowCount int64) error {
+	if !s.ptrLock.RLockIf(state.IsNotReleased) {
+		return merr.WrapErrSegmentNotLoaded(s.ID(), "segment released")
+	}
+	defer s.ptrLock.RUnlock()
+
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", s.Collection()),
+		zap.Int64("partitionID", s.Partition()),
+		zap.Int64("segmentID", s.ID()),
+		zap.Int64("fieldID", field.GetFieldID()),
+		zap.Int64("rowCount", rowCount),
+	)
+
+	fieldData, err := typeutil.GenDefaultFieldData(field, int(rowCount))
+	if err != nil {
+		return err
+	}
+	blob, err := proto.Marshal(fieldData)
+	if err != nil {
+		return err
+	}
+	if len(blob) == 0 {
+		return merr.WrapErrServiceInternal("empty default field data")
+	}
+
+	var status C.CStatus
+	GetLoadPool().Submit(func() (any, error) {
+		status = C.LoadFieldDataArray(s.ptr,
+			C.int64_t(field.GetFieldID()),
+			(*C.uint8_t)(unsafe.Pointer(&blob[0])),
+			C.uint64_t(len(blob)),
+			C.int64_t(rowCount))
+		return nil, nil
+	}).Await()
+	if err := HandleCStatus(ctx, &status, "LoadFieldDataArray failed",
+		zap.Int64("collectionID", s.Collection()),
+		zap.Int64("partitionID", s.Partition()),
+		zap.Int64("segmentID", s.ID()),
+		zap.Int64("fieldID", field.GetFieldID())); err != nil {
+		return err
+	}
+
+	log.Info("load default field data done")
+	return nil
+}
+
 func (s *LocalSegment) AddFieldDataInfo(ctx context.Context, rowCount int64, fields []*datapb.FieldBinlog) error {
 	if !s.ptrLock.RLockIf(state.IsNotReleased) {
 		return merr.WrapErrSegmentNotLoaded(s.ID(), "segment released")
@@ -603,6 +603,34 @@ func separateIndexAndBinlog(loadInfo *querypb.SegmentLoadInfo) (map[int64]*Index
 	return indexedFieldInfos, fieldBinlogs
 }
 
+// filterDroppedFieldBinlogs filters out the binlogs of the fields not in the schema, which are dropped from the
+// collection.
+func filterDroppedFieldBinlogs(schema *schemapb.CollectionSchema, fieldBinlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
+	fieldIDs := typeutil.NewSet[int64]()
+	for _, field := range schema.GetFields() {
+		fieldIDs.Insert(field.GetFieldID())
+	}
+	return lo.Filter(fieldBinlogs, func(fieldBinlog *datapb.FieldBinlog, _ int) bool {
+		return fieldBinlog.GetFieldID() < common.StartOfUserFieldID || fieldIDs.Contain(fieldBinlog.GetFieldID())
+	})
+}
+
+// getAddedFields gets the scalar fields of the schema which have neither binlog nor index in the segment, they are
+// added to the collection after the segment was written.
+func getAddedFields(schema *schemapb.CollectionSchema, loadInfo *querypb.SegmentLoadInfo) []*schemapb.FieldSchema {
+	loaded := typeutil.NewSet[int64]()
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		loaded.Insert(fieldBinlog.GetFieldID())
+	}
+	for _, indexInfo := range loadInfo.GetIndexInfos() {
+		loaded.Insert(indexInfo.GetFieldID())
+	}
+	return lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return field.GetFieldID() >= common.StartOfUserFieldID && !loaded.Contain(field.GetFieldID()) &&
+			!typeutil.IsVectorType(field.GetDataType()) && (field.GetNullable() || field.GetDefaultValue() != nil)
+	})
+}
+
 func (loader *segmentLoader) loadSealedSegment(ctx context.Context, loadInfo *querypb.SegmentLoadInfo, segment *LocalSegment) (err error) {
 	// TODO: we should create a transaction-like api to load segment for segment interface,
 	// but not do many things in segment loader.
@@ -625,8 +653,10 @@ func (loader *segmentLoader) loadSealedSegment(ctx context.Context, loadInfo *qu
 	collection := segment.GetCollection()
 
 	indexedFieldInfos, fieldBinlogs := separateIndexAndBinlog(loadInfo)
+	// the binlogs of the dropped fields are cleaned up by compaction, they are not loaded
+	fieldBinlogs = filterDroppedFieldBinlogs(collection.Schema(), fieldBinlogs)
 	schemaHelper, _ := typeutil.CreateSchemaHelper(collection.Schema())
-	if err := segment.AddFieldDataInfo(ctx, loadInfo.GetNumOfRows(), loadInfo.GetBinlogPaths()); err != nil {
+	if err := segment.AddFieldDataInfo(ctx, loadInfo.GetNumOfRows(), filterDroppedFieldBinlogs(collection.Schema(), loadInfo.GetBinlogPaths())); err != nil {
 		return err
 	}
 
@@ -663,6 +693,14 @@ func (loader *segmentLoader) loadSealedSegment(ctx context.Context, loadInfo *qu
 	if err := loadSealedSegmentFields(ctx, collection, segment, fieldBinlogs, loadInfo.GetNumOfRows()); err != nil {
 		return err
 	}
+	// 3. fill the fields added to the collection after the segment was written
+	for _, field := range getAddedFields(collection.Schema(), loadInfo) {
+		log.Info("field has no binlog, load default values...", zap.Int64("fieldID", field.GetFieldID()))
+		if err := segment.LoadDefaultFieldData(ctx, field, loadInfo.GetNumOfRows()); err != nil {
+			log.Warn("load default values failed", zap.Int64("fieldID", field.GetFieldID()), zap.Error(err))
+			return err
+		}
+	}
 	loadRawDataSpan := tr.RecordSpan()
 
 	// 4. rectify entries number for binlog in very rare cases
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/atomic"
@@ -900,6 +901,35 @@ func (suite *SegmentLoaderDetailSuite) TestRequestResource() {
 	})
 }
 
+func (suite *SegmentLoaderSuite) TestAddedAndDroppedFields() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 103, Name: "added", DataType: schemapb.DataType_Int64, Nullable: true},
+			{FieldID: 104, Name: "indexed", DataType: schemapb.DataType_VarChar, Nullable: true},
+		},
+	}
+	loadInfo := &querypb.SegmentLoadInfo{
+		BinlogPaths: []*datapb.FieldBinlog{
+			{FieldID: common.RowIDField},
+			{FieldID: 100},
+			{FieldID: 101},
+			{FieldID: 102},
+		},
+		IndexInfos: []*querypb.FieldIndexInfo{{FieldID: 104}},
+	}
+
+	binlogs := filterDroppedFieldBinlogs(schema, loadInfo.GetBinlogPaths())
+	suite.ElementsMatch([]int64{common.RowIDField, 100, 101}, lo.Map(binlogs, func(binlog *datapb.FieldBinlog, _ int) int64 {
+		return binlog.GetFieldID()
+	}))
+
+	added := getAddedFields(schema, loadInfo)
+	suite.Len(added, 1)
+	suite.EqualValues(103, added[0].GetFieldID())
+}
+
 func TestSegmentLoader(t *testing.T) {
 	suite.Run(t, &SegmentLoaderSuite{})
 	suite.Run(t, &SegmentLoaderDetailSuite{})
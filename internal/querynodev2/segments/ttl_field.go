@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// filterExpiredEntities adds the filter of the unexpired entities to the predicates of the serialized plan if the
+// collection has a ttl field. The entities expire once the unix time in the ttl field is not after the physical
+// time of ts, so that the queries at the same timestamp see the same entities.
+func filterExpiredEntities(schema *schemapb.CollectionSchema, expr []byte, ts typeutil.Timestamp) ([]byte, error) {
+	fieldID, ok := common.GetCollectionTTLFieldID(schema, schema.GetProperties()...)
+	if !ok {
+		return expr, nil
+	}
+	field := typeutil.GetField(schema, fieldID)
+
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(expr, plan); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("failed to unmarshal plan: %s", err.Error())
+	}
+
+	now := time.Now()
+	if ts != 0 {
+		now = tsoutil.PhysicalTime(ts)
+	}
+	columnInfo := &planpb.ColumnInfo{
+		FieldId:  field.GetFieldID(),
+		DataType: field.GetDataType(),
+		Nullable: field.GetNullable(),
+	}
+	filter := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: columnInfo,
+				Op:         planpb.OpType_GreaterThan,
+				Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: now.Unix()}},
+			},
+		},
+	}
+	if field.GetNullable() {
+		// null expiration never expires
+		filter = combinePredicates(planpb.BinaryExpr_LogicalOr, &planpb.Expr{
+			Expr: &planpb.Expr_NullExpr{
+				NullExpr: &planpb.NullExpr{
+					ColumnInfo: columnInfo,
+					Op:         planpb.NullExpr_IsNull,
+				},
+			},
+		}, filter)
+	}
+
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		node.VectorAnns.Predicates = combinePredicates(planpb.BinaryExpr_LogicalAnd, node.VectorAnns.GetPredicates(), filter)
+	case *planpb.PlanNode_Query:
+		node.Query.Predicates = combinePredicates(planpb.BinaryExpr_LogicalAnd, node.Query.GetPredicates(), filter)
+	case *planpb.PlanNode_Predicates:
+		node.Predicates = combinePredicates(planpb.BinaryExpr_LogicalAnd, node.Predicates, filter)
+	default:
+		return expr, nil
+	}
+	return proto.Marshal(plan)
+}
+
+func combinePredicates(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+	if left == nil {
+		return right
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{
+				Op:    op,
+				Left:  left,
+				Right: right,
+			},
+		},
+	}
+}
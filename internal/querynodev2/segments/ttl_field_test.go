@@ -0,0 +1,156 @@
+package segments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestFilterExpiredEntities(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "expire_at", DataType: schemapb.DataType_Int64, Nullable: true},
+		},
+	}
+	predicates := &planpb.Expr{Expr: &planpb.Expr_AlwaysTrueExpr{AlwaysTrueExpr: &planpb.AlwaysTrueExpr{}}}
+	expr, err := proto.Marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{Predicates: predicates}},
+	})
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	ts := tsoutil.ComposeTSByTime(now, 0)
+
+	// the collection has no ttl field
+	filtered, err := filterExpiredEntities(schema, expr, ts)
+	assert.NoError(t, err)
+	assert.Equal(t, expr, filtered)
+
+	schema.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTTLFieldKey, Value: "expire_at"}}
+	filtered, err = filterExpiredEntities(schema, expr, ts)
+	require.NoError(t, err)
+	plan := &planpb.PlanNode{}
+	require.NoError(t, proto.Unmarshal(filtered, plan))
+
+	and := plan.GetQuery().GetPredicates().GetBinaryExpr()
+	require.NotNil(t, and)
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, and.GetOp())
+	assert.True(t, proto.Equal(predicates, and.GetLeft()))
+	or := and.GetRight().GetBinaryExpr()
+	require.NotNil(t, or)
+	assert.Equal(t, planpb.BinaryExpr_LogicalOr, or.GetOp())
+	assert.Equal(t, planpb.NullExpr_IsNull, or.GetLeft().GetNullExpr().GetOp())
+	unexpired := or.GetRight().GetUnaryRangeExpr()
+	assert.Equal(t, int64(101), unexpired.GetColumnInfo().GetFieldId())
+	assert.Equal(t, planpb.OpType_GreaterThan, unexpired.GetOp())
+	assert.Equal(t, now.Unix(), unexpired.GetValue().GetInt64Val())
+
+	// the search without predicates only filters the expired entities
+	schema.Fields[1].Nullable = false
+	expr, err = proto.Marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{FieldId: 102}},
+	})
+	require.NoError(t, err)
+	filtered, err = filterExpiredEntities(schema, expr, ts)
+	require.NoError(t, err)
+	plan = &planpb.PlanNode{}
+	require.NoError(t, proto.Unmarshal(filtered, plan))
+	assert.Equal(t, now.Unix(), plan.GetVectorAnns().GetPredicates().GetUnaryRangeExpr().GetValue().GetInt64Val())
+}
+
+func TestFilterExpiredEntitiesOnSegment(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	collectionID, partitionID := int64(100), int64(10)
+	schema := &schemapb.CollectionSchema{
+		Name: "test-ttl-field",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "expire_at", DataType: schemapb.DataType_Int64, Nullable: true},
+			{
+				FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+		},
+		Properties: []*commonpb.KeyValuePair{{Key: common.CollectionTTLFieldKey, Value: "expire_at"}},
+	}
+	manager := NewManager()
+	manager.Collection.PutOrRef(collectionID, schema, GenTestIndexMeta(collectionID, schema), &querypb.LoadMetaInfo{
+		LoadType:     querypb.LoadType_LoadCollection,
+		CollectionID: collectionID,
+		PartitionIDs: []int64{partitionID},
+	})
+	collection := manager.Collection.Get(collectionID)
+	defer DeleteCollection(collection)
+
+	segment, err := NewSegment(ctx, collection, SegmentTypeGrowing, 0, &querypb.SegmentLoadInfo{
+		SegmentID:     1,
+		CollectionID:  collectionID,
+		PartitionID:   partitionID,
+		InsertChannel: "by-dev-rootcoord-dml_0_100v0",
+		Level:         datapb.SegmentLevel_Legacy,
+	})
+	require.NoError(t, err)
+	defer segment.Release(ctx)
+
+	now := time.Unix(1700000000, 0)
+	// expired, unexpired, never expires, expired at now
+	ids := []int64{1, 2, 3, 4}
+	expireAt := []int64{now.Unix() - 10, now.Unix() + 10, 0, now.Unix()}
+	validData := []bool{true, true, false, true}
+	record := &segcorepb.InsertRecord{
+		NumRows: int64(len(ids)),
+		FieldsData: []*schemapb.FieldData{
+			{
+				FieldId: 100, FieldName: "id", Type: schemapb.DataType_Int64,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: ids}},
+				}},
+			},
+			{
+				FieldId: 101, FieldName: "expire_at", Type: schemapb.DataType_Int64,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: expireAt}},
+				}},
+				ValidData: validData,
+			},
+			{
+				FieldId: 102, FieldName: "vec", Type: schemapb.DataType_FloatVector,
+				Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+					Dim:  2,
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: make([]float32, 2*len(ids))}},
+				}},
+			},
+		},
+	}
+	timestamps := []typeutil.Timestamp{1, 1, 1, 1}
+	require.NoError(t, segment.Insert(ctx, ids, timestamps, record))
+
+	expr, err := proto.Marshal(&planpb.PlanNode{
+		Node:           &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{}},
+		OutputFieldIds: []int64{100},
+	})
+	require.NoError(t, err)
+	plan, err := NewRetrievePlan(ctx, collection, expr, tsoutil.ComposeTSByTime(now, 0), 100)
+	require.NoError(t, err)
+	defer plan.Delete()
+
+	result, err := segment.Retrieve(ctx, plan)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{2, 3}, result.GetIds().GetIntId().GetData())
+}
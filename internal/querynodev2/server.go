@@ -311,6 +311,17 @@ func (node *QueryNode) Init() error {
 			initError = err
 			return
 		}
+		if paramtable.Get().QueryNodeCfg.RemoteCacheEnabled.GetAsBool() {
+			node.chunkManager, err = storage.NewCachedChunkManager(node.chunkManager,
+				paramtable.Get().QueryNodeCfg.RemoteCacheDirPath.GetValue(),
+				paramtable.Get().QueryNodeCfg.RemoteCacheCapacity.GetAsInt64()*1024*1024,
+				paramtable.Get().QueryNodeCfg.RemoteCacheTTL.GetAsDuration(time.Second))
+			if err != nil {
+				log.Error("QueryNode init remote object cache failed", zap.Error(err))
+				initError = err
+				return
+			}
+		}
 
 		schedulePolicy := paramtable.Get().QueryNodeCfg.SchedulePolicyName.GetValue()
 		node.scheduler = tasks.NewScheduler(
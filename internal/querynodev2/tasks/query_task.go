@@ -103,13 +103,19 @@ func (t *QueryTask) Execute() error {
 	}
 	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "QueryTask")
 
-	retrievePlan, err := segments.NewRetrievePlan(
-		t.ctx,
-		t.collection,
-		t.req.Req.GetSerializedExprPlan(),
-		t.req.Req.GetMvccTimestamp(),
-		t.req.Req.Base.GetMsgID(),
-	)
+	var retrievePlan *segments.RetrievePlan
+	var err error
+	if len(t.req.GetReq().GetOrderByFields()) > 0 {
+		retrievePlan, err = segments.NewOrderByRetrievePlan(t.ctx, t.collection, t.req.GetReq())
+	} else {
+		retrievePlan, err = segments.NewRetrievePlan(
+			t.ctx,
+			t.collection,
+			t.req.Req.GetSerializedExprPlan(),
+			t.req.Req.GetMvccTimestamp(),
+			t.req.Req.Base.GetMsgID(),
+		)
+	}
 	if err != nil {
 		return err
 	}
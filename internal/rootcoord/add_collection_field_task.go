@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type addCollectionFieldTask struct {
+	baseTask
+	Req *proxypb.AddCollectionFieldRequest
+}
+
+func (a *addCollectionFieldTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(a.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterCollection); err != nil {
+		return err
+	}
+	if a.Req.GetCollectionName() == "" {
+		return fmt.Errorf("add collection field failed, collection name does not exists")
+	}
+	return validateAddedField(a.Req.GetField())
+}
+
+// validateAddedField checks the field can be added to an existing collection. The existing entities get null or the
+// default value of the added field, so it must be a nullable or defaulted scalar field.
+func validateAddedField(field *schemapb.FieldSchema) error {
+	if field == nil || field.GetName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the added field must have a name")
+	}
+	if hasSystemFields(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}}, []string{RowIDFieldName, TimeStampFieldName, MetaFieldName}) {
+		return merr.WrapErrParameterInvalidMsg("can not add system field %s", field.GetName())
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return merr.WrapErrParameterInvalidMsg("can not add vector field %s to an existing collection", field.GetName())
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() || field.GetIsPartitionKey() || field.GetIsClusteringKey() || field.GetIsDynamic() {
+		return merr.WrapErrParameterInvalidMsg("the added field %s can not be primary key, partition key, clustering key or dynamic field", field.GetName())
+	}
+	if !field.GetNullable() && field.GetDefaultValue() == nil {
+		return merr.WrapErrParameterInvalidMsg("the added field %s must be nullable or have a default value", field.GetName())
+	}
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}}
+	if err := validateFieldDataType(schema); err != nil {
+		return err
+	}
+	return checkDefaultValue(schema)
+}
+
+func (a *addCollectionFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := a.core.meta.GetCollectionByName(ctx, a.Req.GetDbName(), a.Req.GetCollectionName(), a.ts)
+	if err != nil {
+		log.Warn("get collection failed during adding collection field",
+			zap.String("collectionName", a.Req.GetCollectionName()), zap.Uint64("ts", a.ts))
+		return err
+	}
+	if err := checkCollectionReleased(ctx, a.core, oldColl); err != nil {
+		return err
+	}
+	for _, field := range oldColl.GetAvailableFields() {
+		if field.Name == a.Req.GetField().GetName() {
+			return merr.WrapErrParameterInvalidMsg("field %s already exists in collection %s", field.Name, a.Req.GetCollectionName())
+		}
+	}
+
+	newColl := oldColl.Clone()
+	field := model.UnmarshalFieldModel(a.Req.GetField())
+	field.FieldID = nextFieldID(oldColl.Fields)
+	field.State = schemapb.FieldState_FieldCreated
+	newColl.Fields = append(newColl.Fields, field)
+	log.Info("add collection field", zap.String("collectionName", a.Req.GetCollectionName()),
+		zap.String("fieldName", field.Name), zap.Int64("fieldID", field.FieldID))
+
+	return executeAlterCollectionFields(ctx, a.core, a.Req.GetDbName(), a.Req.GetCollectionName(), oldColl, newColl, a.GetTs())
+}
+
+// checkCollectionReleased returns an error if the collection is loaded, since the query nodes build the schema of
+// the collection when it is loaded. The proxy checks it as well, but only the check under the ddl lock is reliable.
+func checkCollectionReleased(ctx context.Context, core *Core, coll *model.Collection) error {
+	loaded, err := core.broker.IsCollectionLoaded(ctx, coll.CollectionID)
+	if err != nil {
+		log.Warn("failed to check whether the collection is loaded",
+			zap.String("collectionName", coll.Name), zap.Int64("collectionID", coll.CollectionID), zap.Error(err))
+		return err
+	}
+	if loaded {
+		return merr.WrapErrCollectionLoaded(coll.Name, "can not alter the fields if collection loaded")
+	}
+	return nil
+}
+
+// executeAlterCollectionFields saves the altered fields of the collection, broadcasts the new schema to datacoord
+// and expires the collection in the proxy meta caches.
+func executeAlterCollectionFields(ctx context.Context, core *Core, dbName, collectionName string, oldColl, newColl *model.Collection, ts Timestamp) error {
+	redoTask := newBaseRedoTask(core.stepExecutor)
+	redoTask.AddSyncStep(&AlterCollectionStep{
+		baseStep: baseStep{core: core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	})
+
+	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: core},
+		req: &milvuspb.AlterCollectionRequest{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			CollectionID:   oldColl.CollectionID,
+		},
+		core: core,
+	})
+
+	// the schema needs to be refreshed in the cache
+	aliases := core.meta.ListAliasesByID(oldColl.CollectionID)
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: core},
+		dbName:          dbName,
+		collectionNames: append(aliases, collectionName),
+		collectionID:    oldColl.CollectionID,
+		opts:            []proxyutil.ExpireCacheOpt{proxyutil.SetMsgType(commonpb.MsgType_AlterCollection)},
+	})
+
+	return redoTask.Execute(ctx)
+}
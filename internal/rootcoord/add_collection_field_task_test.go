@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_addCollectionFieldTask_Prepare(t *testing.T) {
+	newTask := func(field *schemapb.FieldSchema) *addCollectionFieldTask {
+		return &addCollectionFieldTask{Req: &proxypb.AddCollectionFieldRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+			CollectionName: "cn",
+			Field:          field,
+		}}
+	}
+
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := newTask(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, Nullable: true})
+		task.Req.Base.MsgType = commonpb.MsgType_DropCollection
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		fields := []*schemapb.FieldSchema{
+			nil,
+			{Name: RowIDFieldName, DataType: schemapb.DataType_Int64, Nullable: true},
+			{Name: "f", DataType: schemapb.DataType_FloatVector, Nullable: true},
+			{Name: "f", DataType: schemapb.DataType_Int64, Nullable: true, IsPartitionKey: true},
+			{Name: "f", DataType: schemapb.DataType_Int64},
+			{Name: "f", DataType: schemapb.DataType_Int64, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_BoolData{BoolData: true}}},
+		}
+		for _, field := range fields {
+			assert.Error(t, newTask(field).Prepare(context.Background()), field.GetName())
+		}
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		assert.NoError(t, newTask(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, Nullable: true}).Prepare(context.Background()))
+		assert.NoError(t, newTask(&schemapb.FieldSchema{
+			Name:         "f",
+			DataType:     schemapb.DataType_Int64,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}},
+		}).Prepare(context.Background()))
+	})
+}
+
+func Test_addCollectionFieldTask_Execute(t *testing.T) {
+	coll := &model.Collection{
+		CollectionID: 1,
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "dropped", DataType: schemapb.DataType_Int64, State: schemapb.FieldState_FieldDropped},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	newTask := func(core *Core, name string) *addCollectionFieldTask {
+		return &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &proxypb.AddCollectionFieldRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Field:          &schemapb.FieldSchema{Name: name, DataType: schemapb.DataType_Int64, Nullable: true},
+			},
+		}
+	}
+
+	newReleasedBroker := func() *mockBroker {
+		broker := newMockBroker()
+		broker.IsCollectionLoadedFunc = func(ctx context.Context, collectionID UniqueID) (bool, error) {
+			return false, nil
+		}
+		return broker
+	}
+
+	t.Run("collection loaded", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(coll, nil)
+		broker := newMockBroker()
+		broker.IsCollectionLoadedFunc = func(ctx context.Context, collectionID UniqueID) (bool, error) {
+			return true, nil
+		}
+		core := newTestCore(withMeta(meta), withBroker(broker))
+		err := newTask(core, "f").Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrCollectionLoaded)
+
+		broker.IsCollectionLoadedFunc = func(ctx context.Context, collectionID UniqueID) (bool, error) {
+			return false, errors.New("mock error")
+		}
+		core = newTestCore(withMeta(meta), withBroker(broker))
+		assert.Error(t, newTask(core, "f").Execute(context.Background()))
+	})
+
+	t.Run("field exists", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(coll, nil)
+		core := newTestCore(withMeta(meta), withBroker(newReleasedBroker()))
+		assert.Error(t, newTask(core, "vec").Execute(context.Background()))
+	})
+
+	t.Run("add successfully", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(coll, nil)
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+				assert.Equal(t, 3, len(oldColl.Fields))
+				assert.Equal(t, 4, len(newColl.Fields))
+				added := newColl.Fields[3]
+				assert.Equal(t, "dropped", added.Name)
+				// the id of the dropped field is not reused
+				assert.Equal(t, int64(103), added.FieldID)
+				assert.True(t, added.Available())
+				return nil
+			})
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{})
+
+		broker := newReleasedBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			assert.Equal(t, int64(1), req.GetCollectionID())
+			return nil
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		assert.NoError(t, newTask(core, "dropped").Execute(context.Background()))
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -51,6 +52,7 @@ type Broker interface {
 	ReleasePartitions(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error
 	SyncNewCreatedPartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID) error
 	GetQuerySegmentInfo(ctx context.Context, collectionID int64, segIDs []int64) (retResp *querypb.GetSegmentInfoResponse, retErr error)
+	IsCollectionLoaded(ctx context.Context, collectionID UniqueID) (bool, error)
 
 	WatchChannels(ctx context.Context, info *watchInfo) error
 	UnwatchChannels(ctx context.Context, info *watchInfo) error
@@ -58,6 +60,7 @@ type Broker interface {
 	GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool
 
 	DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
+	DescribeIndex(ctx context.Context, collID UniqueID) ([]*indexpb.IndexInfo, error)
 	// notify observer to clean their meta cache
 	BroadcastAlteredCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
 }
@@ -145,6 +148,20 @@ func (b *ServerBroker) GetQuerySegmentInfo(ctx context.Context, collectionID int
 	return resp, err
 }
 
+// IsCollectionLoaded returns whether the collection is loaded or being loaded by querycoord.
+func (b *ServerBroker) IsCollectionLoaded(ctx context.Context, collectionID UniqueID) (bool, error) {
+	resp, err := b.s.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowCollections),
+			commonpbutil.WithSourceID(b.s.session.ServerID),
+		),
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return false, err
+	}
+	return lo.Contains(resp.GetCollectionIDs(), collectionID), nil
+}
+
 func toKeyDataPairs(m map[string][]byte) []*commonpb.KeyDataPair {
 	ret := make([]*commonpb.KeyDataPair, 0, len(m))
 	for k, data := range m {
@@ -224,6 +241,20 @@ func (b *ServerBroker) GetSegmentIndexState(ctx context.Context, collID UniqueID
 	return resp.GetStates(), nil
 }
 
+// DescribeIndex returns the indexes of the collection, no error is returned if the collection has no index.
+func (b *ServerBroker) DescribeIndex(ctx context.Context, collID UniqueID) ([]*indexpb.IndexInfo, error) {
+	resp, err := b.s.dataCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
+		CollectionID: collID,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		if errors.Is(err, merr.ErrIndexNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.GetIndexInfos(), nil
+}
+
 func (b *ServerBroker) BroadcastAlteredCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
 	log.Info("broadcasting request to alter collection", zap.String("collectionName", req.GetCollectionName()), zap.Int64("collectionID", req.GetCollectionID()), zap.Any("props", req.GetProperties()))
 
@@ -244,10 +275,11 @@ func (b *ServerBroker) BroadcastAlteredCollection(ctx context.Context, req *milv
 	dcReq := &datapb.AlterCollectionRequest{
 		CollectionID: req.GetCollectionID(),
 		Schema: &schemapb.CollectionSchema{
-			Name:        colMeta.Name,
-			Description: colMeta.Description,
-			AutoID:      colMeta.AutoID,
-			Fields:      model.MarshalFieldModels(colMeta.Fields),
+			Name:               colMeta.Name,
+			Description:        colMeta.Description,
+			AutoID:             colMeta.AutoID,
+			Fields:             model.MarshalFieldModels(colMeta.GetAvailableFields()),
+			EnableDynamicField: colMeta.EnableDynamicField,
 		},
 		PartitionIDs:   partitionIDs,
 		StartPositions: colMeta.StartPositions,
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
@@ -89,6 +90,41 @@ func TestServerBroker_GetSegmentInfo(t *testing.T) {
 	})
 }
 
+func TestServerBroker_IsCollectionLoaded(t *testing.T) {
+	t.Run("failed to execute", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoordClient(t)
+		qc.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
+		b := newServerBroker(newTestCore(withQueryCoord(qc)))
+		_, err := b.IsCollectionLoaded(context.Background(), 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoordClient(t)
+		qc.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(&querypb.ShowCollectionsResponse{
+			Status: merr.Status(errors.New("mock error")),
+		}, nil)
+		b := newServerBroker(newTestCore(withQueryCoord(qc)))
+		_, err := b.IsCollectionLoaded(context.Background(), 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoordClient(t)
+		qc.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(&querypb.ShowCollectionsResponse{
+			Status:        merr.Success(),
+			CollectionIDs: []int64{1, 2},
+		}, nil)
+		b := newServerBroker(newTestCore(withQueryCoord(qc)))
+		loaded, err := b.IsCollectionLoaded(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.True(t, loaded)
+		loaded, err = b.IsCollectionLoaded(context.Background(), 3)
+		assert.NoError(t, err)
+		assert.False(t, loaded)
+	})
+}
+
 func TestServerBroker_WatchChannels(t *testing.T) {
 	t.Run("failed to execute", func(t *testing.T) {
 		defer cleanTestEnv()
@@ -154,6 +190,48 @@ func TestServerBroker_DropCollectionIndex(t *testing.T) {
 	})
 }
 
+func TestServerBroker_DescribeIndex(t *testing.T) {
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withInvalidDataCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		_, err := b.DescribeIndex(ctx, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		c := newTestCore(withFailedDataCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		_, err := b.DescribeIndex(ctx, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("index not found", func(t *testing.T) {
+		dc := newMockDataCoord()
+		dc.DescribeIndexFunc = func(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+			return &indexpb.DescribeIndexResponse{
+				Status: merr.Status(merr.WrapErrIndexNotFound("")),
+			}, nil
+		}
+		c := newTestCore(withDataCoord(dc))
+		b := newServerBroker(c)
+		ctx := context.Background()
+		indexes, err := b.DescribeIndex(ctx, 1)
+		assert.NoError(t, err)
+		assert.Empty(t, indexes)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newTestCore(withValidDataCoord())
+		b := newServerBroker(c)
+		ctx := context.Background()
+		indexes, err := b.DescribeIndex(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(indexes))
+	})
+}
+
 func TestServerBroker_GetSegmentIndexState(t *testing.T) {
 	t.Run("failed to execute", func(t *testing.T) {
 		c := newTestCore(withInvalidDataCoord())
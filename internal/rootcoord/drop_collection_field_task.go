@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type dropCollectionFieldTask struct {
+	baseTask
+	Req *proxypb.DropCollectionFieldRequest
+}
+
+func (d *dropCollectionFieldTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(d.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterCollection); err != nil {
+		return err
+	}
+	if d.Req.GetCollectionName() == "" {
+		return fmt.Errorf("drop collection field failed, collection name does not exists")
+	}
+	if d.Req.GetFieldName() == "" {
+		return fmt.Errorf("drop collection field failed, field name is empty")
+	}
+	return nil
+}
+
+// validateDroppedField checks the field can be dropped from the collection, the field must not be referenced by the
+// collection ttl or the row policies of the collection.
+func validateDroppedField(coll *model.Collection, field *model.Field, policies []*internalpb.RowPolicy) error {
+	if field.IsPrimaryKey || field.IsPartitionKey || field.IsClusteringKey || field.IsDynamic {
+		return merr.WrapErrParameterInvalidMsg("can not drop primary key, partition key, clustering key or dynamic field %s", field.Name)
+	}
+	if typeutil.IsVectorType(field.DataType) {
+		return merr.WrapErrParameterInvalidMsg("can not drop vector field %s", field.Name)
+	}
+	for _, kv := range coll.Properties {
+		if kv.GetKey() == common.CollectionTTLFieldKey && kv.GetValue() == field.Name {
+			return merr.WrapErrParameterInvalidMsg("can not drop ttl field %s", field.Name)
+		}
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+	schemaHelper, err := typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{
+		Name:               coll.Name,
+		Fields:             model.MarshalFieldModels(coll.GetAvailableFields()),
+		EnableDynamicField: coll.EnableDynamicField,
+	})
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		expr, err := planparserv2.ParseExpr(schemaHelper, policy.GetExpr())
+		if err != nil {
+			return merr.WrapErrParameterInvalidMsg("failed to check the row policy %s of the role %s: %s",
+				policy.GetPolicyName(), policy.GetRoleName(), err.Error())
+		}
+		if lo.Contains(planparserv2.GetReferencedFieldIDs(expr), field.FieldID) {
+			return merr.WrapErrParameterInvalidMsg("can not drop field %s referenced by the row policy %s of the role %s, drop the policy first",
+				field.Name, policy.GetPolicyName(), policy.GetRoleName())
+		}
+	}
+	return nil
+}
+
+func (d *dropCollectionFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := d.core.meta.GetCollectionByName(ctx, d.Req.GetDbName(), d.Req.GetCollectionName(), d.ts)
+	if err != nil {
+		log.Warn("get collection failed during dropping collection field",
+			zap.String("collectionName", d.Req.GetCollectionName()), zap.Uint64("ts", d.ts))
+		return err
+	}
+	if err := checkCollectionReleased(ctx, d.core, oldColl); err != nil {
+		return err
+	}
+
+	// the dropped field is kept in meta so that its id is never reused, the data is cleaned up by compaction
+	newColl := oldColl.Clone()
+	var dropped *model.Field
+	for _, field := range newColl.GetAvailableFields() {
+		if field.Name == d.Req.GetFieldName() {
+			dropped = field
+			break
+		}
+	}
+	if dropped == nil {
+		return merr.WrapErrFieldNotFound(d.Req.GetFieldName())
+	}
+	policies, err := d.core.meta.ListRowPolicies(util.DefaultTenant, "", "", "")
+	if err != nil {
+		return err
+	}
+	policies = lo.Filter(policies, func(policy *internalpb.RowPolicy, _ int) bool {
+		return policy.GetCollectionId() == oldColl.CollectionID
+	})
+	if err := validateDroppedField(oldColl, dropped, policies); err != nil {
+		return err
+	}
+	indexes, err := d.core.broker.DescribeIndex(ctx, oldColl.CollectionID)
+	if err != nil {
+		return err
+	}
+	for _, index := range indexes {
+		if index.GetFieldID() == dropped.FieldID {
+			return merr.WrapErrParameterInvalidMsg("can not drop field %s with index %s, drop the index first", dropped.Name, index.GetIndexName())
+		}
+	}
+	dropped.State = schemapb.FieldState_FieldDropped
+	log.Info("drop collection field", zap.String("collectionName", d.Req.GetCollectionName()),
+		zap.String("fieldName", dropped.Name), zap.Int64("fieldID", dropped.FieldID))
+
+	return executeAlterCollectionFields(ctx, d.core, d.Req.GetDbName(), d.Req.GetCollectionName(), oldColl, newColl, d.GetTs())
+}
@@ -0,0 +1,176 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_dropCollectionFieldTask_Prepare(t *testing.T) {
+	task := &dropCollectionFieldTask{Req: &proxypb.DropCollectionFieldRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+		CollectionName: "cn",
+	}}
+	assert.Error(t, task.Prepare(context.Background()))
+
+	task.Req.FieldName = "f"
+	assert.NoError(t, task.Prepare(context.Background()))
+}
+
+func Test_dropCollectionFieldTask_Execute(t *testing.T) {
+	newColl := func() *model.Collection {
+		return &model.Collection{
+			CollectionID: 1,
+			Fields: []*model.Field{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+				{FieldID: 102, Name: "expire_at", DataType: schemapb.DataType_Int64},
+				{FieldID: 103, Name: "age", DataType: schemapb.DataType_Int64},
+			},
+			Properties: []*commonpb.KeyValuePair{{Key: common.CollectionTTLFieldKey, Value: "expire_at"}},
+		}
+	}
+	newTask := func(core *Core, name string) *dropCollectionFieldTask {
+		return &dropCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &proxypb.DropCollectionFieldRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				FieldName:      name,
+			},
+		}
+	}
+
+	newReleasedBroker := func() *mockBroker {
+		broker := newMockBroker()
+		broker.IsCollectionLoadedFunc = func(ctx context.Context, collectionID UniqueID) (bool, error) {
+			return false, nil
+		}
+		return broker
+	}
+
+	t.Run("collection loaded", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+		broker := newMockBroker()
+		broker.IsCollectionLoadedFunc = func(ctx context.Context, collectionID UniqueID) (bool, error) {
+			return true, nil
+		}
+		core := newTestCore(withMeta(meta), withBroker(broker))
+		err := newTask(core, "age").Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrCollectionLoaded)
+
+		broker.IsCollectionLoadedFunc = func(ctx context.Context, collectionID UniqueID) (bool, error) {
+			return false, errors.New("mock error")
+		}
+		core = newTestCore(withMeta(meta), withBroker(broker))
+		assert.Error(t, newTask(core, "age").Execute(context.Background()))
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		for _, name := range []string{"pk", "vec", "expire_at", "not_exist"} {
+			meta := mockrootcoord.NewIMetaTable(t)
+			meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+			meta.EXPECT().ListRowPolicies(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+			core := newTestCore(withMeta(meta), withBroker(newReleasedBroker()))
+			assert.Error(t, newTask(core, name).Execute(context.Background()), name)
+		}
+	})
+
+	t.Run("field referenced by row policy", func(t *testing.T) {
+		for _, expr := range []string{"age > 18", "pk > 0 and age in [1, 2]", "expire_at - age > 10", "not_exist > 1"} {
+			meta := mockrootcoord.NewIMetaTable(t)
+			meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+			meta.EXPECT().ListRowPolicies(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*internalpb.RowPolicy{
+				{PolicyName: "p1", RoleName: "r1", CollectionId: 1, Expr: "pk > 0"},
+				{PolicyName: "p2", RoleName: "r2", CollectionId: 1, Expr: expr},
+			}, nil)
+			core := newTestCore(withMeta(meta), withBroker(newReleasedBroker()))
+			assert.Error(t, newTask(core, "age").Execute(context.Background()), expr)
+		}
+
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+		meta.EXPECT().ListRowPolicies(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
+		core := newTestCore(withMeta(meta), withBroker(newReleasedBroker()))
+		assert.Error(t, newTask(core, "age").Execute(context.Background()))
+	})
+
+	t.Run("indexed field", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+		meta.EXPECT().ListRowPolicies(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		broker := newReleasedBroker()
+		broker.DescribeIndexFunc = func(ctx context.Context, collID UniqueID) ([]*indexpb.IndexInfo, error) {
+			return []*indexpb.IndexInfo{{FieldID: 103, IndexName: "age_index"}}, nil
+		}
+		core := newTestCore(withMeta(meta), withBroker(broker))
+		assert.Error(t, newTask(core, "age").Execute(context.Background()))
+
+		broker.DescribeIndexFunc = func(ctx context.Context, collID UniqueID) ([]*indexpb.IndexInfo, error) {
+			return nil, errors.New("mock error")
+		}
+		core = newTestCore(withMeta(meta), withBroker(broker))
+		assert.Error(t, newTask(core, "age").Execute(context.Background()))
+	})
+
+	t.Run("drop successfully", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+		// the row policies of other collections are ignored
+		meta.EXPECT().ListRowPolicies(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*internalpb.RowPolicy{
+			{PolicyName: "p1", RoleName: "r1", CollectionId: 1, Expr: "pk > 0"},
+			{PolicyName: "p2", RoleName: "r1", CollectionId: 2, Expr: "age > 18"},
+		}, nil)
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+				assert.True(t, oldColl.Fields[3].Available())
+				// the dropped field is kept in meta
+				assert.Equal(t, 4, len(newColl.Fields))
+				assert.Equal(t, schemapb.FieldState_FieldDropped, newColl.Fields[3].State)
+				assert.Equal(t, 3, len(newColl.GetAvailableFields()))
+				return nil
+			})
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{})
+
+		broker := newReleasedBroker()
+		broker.DescribeIndexFunc = func(ctx context.Context, collID UniqueID) ([]*indexpb.IndexInfo, error) {
+			return []*indexpb.IndexInfo{{FieldID: 101, IndexName: "vec_index"}}, nil
+		}
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		assert.NoError(t, newTask(core, "age").Execute(context.Background()))
+	})
+}
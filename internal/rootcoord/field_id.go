@@ -16,7 +16,10 @@
 
 package rootcoord
 
-import "github.com/milvus-io/milvus/pkg/common"
+import (
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/common"
+)
 
 // system field id:
 // 0: unique row id
@@ -44,3 +47,15 @@ const (
 	// MetaFieldName name of the dynamic schema field
 	MetaFieldName = common.MetaFieldName
 )
+
+// nextFieldID returns the id of the field added to a collection. The ids of the dropped fields are never reused, since
+// their binlogs may not be cleaned up yet.
+func nextFieldID(fields []*model.Field) int64 {
+	next := int64(StartOfUserFieldID)
+	for _, field := range fields {
+		if field.FieldID >= next {
+			next = field.FieldID + 1
+		}
+	}
+	return next
+}
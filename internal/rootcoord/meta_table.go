@@ -97,6 +97,9 @@ type IMetaTable interface {
 	DropGrant(tenant string, role *milvuspb.RoleEntity) error
 	ListPolicy(tenant string) ([]string, error)
 	ListUserRole(tenant string) ([]string, error)
+	CreateRowPolicy(tenant string, policy *internalpb.RowPolicy) error
+	DropRowPolicy(tenant string, policy *internalpb.RowPolicy) error
+	ListRowPolicies(tenant string, roleName string, dbName string, collectionName string) ([]*internalpb.RowPolicy, error)
 }
 
 // MetaTable is a persistent meta set of all databases, collections and partitions.
@@ -1435,3 +1438,87 @@ func (mt *MetaTable) ListUserRole(tenant string) ([]string, error) {
 
 	return mt.catalog.ListUserRole(mt.ctx, tenant)
 }
+
+func checkRowPolicyEntity(policy *internalpb.RowPolicy) error {
+	if funcutil.IsEmptyString(policy.GetPolicyName()) {
+		return fmt.Errorf("the policy name in the row policy is empty")
+	}
+	if funcutil.IsEmptyString(policy.GetRoleName()) {
+		return fmt.Errorf("the role name in the row policy is empty")
+	}
+	if funcutil.IsEmptyString(policy.GetCollectionName()) {
+		return fmt.Errorf("the collection name in the row policy is empty")
+	}
+	if policy.GetDbName() == "" {
+		policy.DbName = util.DefaultDBName
+	}
+	return nil
+}
+
+func isSameRowPolicy(a, b *internalpb.RowPolicy) bool {
+	return a.GetRoleName() == b.GetRoleName() && a.GetDbName() == b.GetDbName() &&
+		a.GetCollectionName() == b.GetCollectionName() && a.GetPolicyName() == b.GetPolicyName()
+}
+
+// CreateRowPolicy creates the row policy, the name of the policy is unique in the role and the collection.
+func (mt *MetaTable) CreateRowPolicy(tenant string, policy *internalpb.RowPolicy) error {
+	if err := checkRowPolicyEntity(policy); err != nil {
+		return err
+	}
+	if funcutil.IsEmptyString(policy.GetExpr()) {
+		return fmt.Errorf("the expr in the row policy is empty")
+	}
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	policies, err := mt.catalog.ListRowPolicies(mt.ctx, tenant)
+	if err != nil {
+		log.Warn("fail to list row policies", zap.Error(err))
+		return err
+	}
+	for _, p := range policies {
+		if isSameRowPolicy(p, policy) {
+			return fmt.Errorf("row policy [%s] of the role [%s] on the collection [%s] already exists",
+				policy.GetPolicyName(), policy.GetRoleName(), policy.GetCollectionName())
+		}
+	}
+	return mt.catalog.SaveRowPolicy(mt.ctx, tenant, policy)
+}
+
+// DropRowPolicy drops the row policy, it's ignorable if the policy doesn't exist.
+func (mt *MetaTable) DropRowPolicy(tenant string, policy *internalpb.RowPolicy) error {
+	if err := checkRowPolicyEntity(policy); err != nil {
+		return err
+	}
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	policies, err := mt.catalog.ListRowPolicies(mt.ctx, tenant)
+	if err != nil {
+		log.Warn("fail to list row policies", zap.Error(err))
+		return err
+	}
+	for _, p := range policies {
+		if isSameRowPolicy(p, policy) {
+			return mt.catalog.DropRowPolicy(mt.ctx, tenant, policy)
+		}
+	}
+	return common.NewIgnorableError(errors.Newf("row policy [%s] of the role [%s] on the collection [%s] doesn't exist",
+		policy.GetPolicyName(), policy.GetRoleName(), policy.GetCollectionName()))
+}
+
+// ListRowPolicies lists the row policies, the empty role name, db name or collection name matches all.
+func (mt *MetaTable) ListRowPolicies(tenant string, roleName string, dbName string, collectionName string) ([]*internalpb.RowPolicy, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	policies, err := mt.catalog.ListRowPolicies(mt.ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Filter(policies, func(p *internalpb.RowPolicy, _ int) bool {
+		return (roleName == "" || p.GetRoleName() == roleName) &&
+			(dbName == "" || p.GetDbName() == dbName) &&
+			(collectionName == "" || p.GetCollectionName() == collectionName)
+	}), nil
+}
@@ -448,6 +448,55 @@ func TestRbacListPolicy(t *testing.T) {
 	assert.Equal(t, 0, len(userRoles))
 }
 
+func TestRbacRowPolicy(t *testing.T) {
+	catalog := mocks.NewRootCoordCatalog(t)
+	mt := &MetaTable{catalog: catalog}
+	existed := &internalpb.RowPolicy{
+		PolicyName:     "p1",
+		RoleName:       "foo",
+		DbName:         util.DefaultDBName,
+		CollectionName: "coll",
+		Expr:           "tenant == 'a'",
+	}
+	catalog.EXPECT().ListRowPolicies(mock.Anything, mock.Anything).Return([]*internalpb.RowPolicy{existed}, nil)
+
+	t.Run("create", func(t *testing.T) {
+		err := mt.CreateRowPolicy(util.DefaultTenant, &internalpb.RowPolicy{RoleName: "foo", CollectionName: "coll", Expr: "a > 1"})
+		assert.Error(t, err, "empty policy name")
+		err = mt.CreateRowPolicy(util.DefaultTenant, &internalpb.RowPolicy{PolicyName: "p2", RoleName: "foo", CollectionName: "coll"})
+		assert.Error(t, err, "empty expr")
+		err = mt.CreateRowPolicy(util.DefaultTenant, &internalpb.RowPolicy{PolicyName: "p1", RoleName: "foo", CollectionName: "coll", Expr: "a > 1"})
+		assert.Error(t, err, "the policy exists in the default database")
+
+		policy := &internalpb.RowPolicy{PolicyName: "p2", RoleName: "foo", CollectionName: "coll", Expr: "a > 1"}
+		catalog.EXPECT().SaveRowPolicy(mock.Anything, mock.Anything, policy).Return(nil).Once()
+		err = mt.CreateRowPolicy(util.DefaultTenant, policy)
+		assert.NoError(t, err)
+		assert.Equal(t, util.DefaultDBName, policy.GetDbName())
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		err := mt.DropRowPolicy(util.DefaultTenant, &internalpb.RowPolicy{PolicyName: "p2", RoleName: "foo", CollectionName: "coll"})
+		assert.True(t, common.IsIgnorableError(err))
+
+		catalog.EXPECT().DropRowPolicy(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		err = mt.DropRowPolicy(util.DefaultTenant, &internalpb.RowPolicy{PolicyName: "p1", RoleName: "foo", CollectionName: "coll"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		policies, err := mt.ListRowPolicies(util.DefaultTenant, "", "", "")
+		assert.NoError(t, err)
+		assert.Len(t, policies, 1)
+		policies, err = mt.ListRowPolicies(util.DefaultTenant, "foo", util.DefaultDBName, "coll")
+		assert.NoError(t, err)
+		assert.Len(t, policies, 1)
+		policies, err = mt.ListRowPolicies(util.DefaultTenant, "bar", "", "")
+		assert.NoError(t, err)
+		assert.Empty(t, policies)
+	})
+}
+
 func TestMetaTable_getCollectionByIDInternal(t *testing.T) {
 	t.Run("failed to get from catalog", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
@@ -96,6 +96,9 @@ type mockMetaTable struct {
 	DropGrantFunc                    func(tenant string, role *milvuspb.RoleEntity) error
 	ListPolicyFunc                   func(tenant string) ([]string, error)
 	ListUserRoleFunc                 func(tenant string) ([]string, error)
+	CreateRowPolicyFunc              func(tenant string, policy *internalpb.RowPolicy) error
+	DropRowPolicyFunc                func(tenant string, policy *internalpb.RowPolicy) error
+	ListRowPoliciesFunc              func(tenant string, roleName string, dbName string, collectionName string) ([]*internalpb.RowPolicy, error)
 	DescribeDatabaseFunc             func(ctx context.Context, dbName string) (*model.Database, error)
 }
 
@@ -251,6 +254,18 @@ func (m mockMetaTable) ListUserRole(tenant string) ([]string, error) {
 	return m.ListUserRoleFunc(tenant)
 }
 
+func (m mockMetaTable) CreateRowPolicy(tenant string, policy *internalpb.RowPolicy) error {
+	return m.CreateRowPolicyFunc(tenant, policy)
+}
+
+func (m mockMetaTable) DropRowPolicy(tenant string, policy *internalpb.RowPolicy) error {
+	return m.DropRowPolicyFunc(tenant, policy)
+}
+
+func (m mockMetaTable) ListRowPolicies(tenant string, roleName string, dbName string, collectionName string) ([]*internalpb.RowPolicy, error) {
+	return m.ListRowPoliciesFunc(tenant, roleName, dbName, collectionName)
+}
+
 func newMockMetaTable() *mockMetaTable {
 	return &mockMetaTable{}
 }
@@ -263,6 +278,7 @@ type mockDataCoord struct {
 	broadCastAlteredCollectionFunc func(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error)
 	GetSegmentIndexStateFunc       func(ctx context.Context, req *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)
 	DropIndexFunc                  func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error)
+	DescribeIndexFunc              func(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)
 }
 
 func newMockDataCoord() *mockDataCoord {
@@ -299,6 +315,10 @@ func (m *mockDataCoord) DropIndex(ctx context.Context, req *indexpb.DropIndexReq
 	return m.DropIndexFunc(ctx, req)
 }
 
+func (m *mockDataCoord) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error) {
+	return m.DescribeIndexFunc(ctx, req)
+}
+
 type mockQueryCoord struct {
 	types.QueryCoordClient
 	GetSegmentInfoFunc     func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
@@ -516,6 +536,15 @@ func withInvalidMeta() Opt {
 	meta.ListUserRoleFunc = func(tenant string) ([]string, error) {
 		return nil, errors.New("error mock ListUserRole")
 	}
+	meta.CreateRowPolicyFunc = func(tenant string, policy *internalpb.RowPolicy) error {
+		return errors.New("error mock CreateRowPolicy")
+	}
+	meta.DropRowPolicyFunc = func(tenant string, policy *internalpb.RowPolicy) error {
+		return errors.New("error mock DropRowPolicy")
+	}
+	meta.ListRowPoliciesFunc = func(tenant string, roleName string, dbName string, collectionName string) ([]*internalpb.RowPolicy, error) {
+		return nil, errors.New("error mock ListRowPolicies")
+	}
 	meta.DescribeAliasFunc = func(ctx context.Context, dbName, alias string, ts Timestamp) (string, error) {
 		return "", errors.New("error mock DescribeAlias")
 	}
@@ -704,6 +733,9 @@ func withInvalidDataCoord() Opt {
 	dc.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 		return nil, errors.New("error mock DropIndexFunc")
 	}
+	dc.DescribeIndexFunc = func(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		return nil, errors.New("error mock DescribeIndexFunc")
+	}
 	return withDataCoord(dc)
 }
 
@@ -737,6 +769,11 @@ func withFailedDataCoord() Opt {
 	dc.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 		return merr.Status(err), nil
 	}
+	dc.DescribeIndexFunc = func(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		return &indexpb.DescribeIndexResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
 	return withDataCoord(dc)
 }
 
@@ -769,6 +806,12 @@ func withValidDataCoord() Opt {
 	dc.DropIndexFunc = func(ctx context.Context, req *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 		return merr.Success(), nil
 	}
+	dc.DescribeIndexFunc = func(ctx context.Context, req *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+		return &indexpb.DescribeIndexResponse{
+			Status:     merr.Success(),
+			IndexInfos: []*indexpb.IndexInfo{{CollectionID: req.GetCollectionID(), FieldID: 101, IndexName: "index"}},
+		}, nil
+	}
 	return withDataCoord(dc)
 }
 
@@ -880,6 +923,7 @@ type mockBroker struct {
 	ReleasePartitionsFunc       func(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error
 	SyncNewCreatedPartitionFunc func(ctx context.Context, collectionID UniqueID, partitionID UniqueID) error
 	GetQuerySegmentInfoFunc     func(ctx context.Context, collectionID int64, segIDs []int64) (retResp *querypb.GetSegmentInfoResponse, retErr error)
+	IsCollectionLoadedFunc      func(ctx context.Context, collectionID UniqueID) (bool, error)
 
 	WatchChannelsFunc     func(ctx context.Context, info *watchInfo) error
 	UnwatchChannelsFunc   func(ctx context.Context, info *watchInfo) error
@@ -889,6 +933,7 @@ type mockBroker struct {
 
 	DropCollectionIndexFunc  func(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
 	GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
+	DescribeIndexFunc        func(ctx context.Context, collID UniqueID) ([]*indexpb.IndexInfo, error)
 
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
 
@@ -919,6 +964,10 @@ func (b mockBroker) SyncNewCreatedPartition(ctx context.Context, collectionID Un
 	return b.SyncNewCreatedPartitionFunc(ctx, collectionID, partitionID)
 }
 
+func (b mockBroker) IsCollectionLoaded(ctx context.Context, collectionID UniqueID) (bool, error) {
+	return b.IsCollectionLoadedFunc(ctx, collectionID)
+}
+
 func (b mockBroker) DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
 	return b.DropCollectionIndexFunc(ctx, collID, partIDs)
 }
@@ -927,6 +976,10 @@ func (b mockBroker) GetSegmentIndexState(ctx context.Context, collID UniqueID, i
 	return b.GetSegmentIndexStateFunc(ctx, collID, indexName, segIDs)
 }
 
+func (b mockBroker) DescribeIndex(ctx context.Context, collID UniqueID) ([]*indexpb.IndexInfo, error) {
+	return b.DescribeIndexFunc(ctx, collID)
+}
+
 func (b mockBroker) BroadcastAlteredCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
 	return b.BroadcastAlteredCollectionFunc(ctx, req)
 }
@@ -560,6 +560,49 @@ func (_c *IMetaTable_CreateRole_Call) RunAndReturn(run func(string, *milvuspb.Ro
 	return _c
 }
 
+// CreateRowPolicy provides a mock function with given fields: tenant, policy
+func (_m *IMetaTable) CreateRowPolicy(tenant string, policy *internalpb.RowPolicy) error {
+	ret := _m.Called(tenant, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *internalpb.RowPolicy) error); ok {
+		r0 = rf(tenant, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_CreateRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRowPolicy'
+type IMetaTable_CreateRowPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateRowPolicy is a helper method to define mock.On call
+//   - tenant string
+//   - policy *internalpb.RowPolicy
+func (_e *IMetaTable_Expecter) CreateRowPolicy(tenant interface{}, policy interface{}) *IMetaTable_CreateRowPolicy_Call {
+	return &IMetaTable_CreateRowPolicy_Call{Call: _e.mock.On("CreateRowPolicy", tenant, policy)}
+}
+
+func (_c *IMetaTable_CreateRowPolicy_Call) Run(run func(tenant string, policy *internalpb.RowPolicy)) *IMetaTable_CreateRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*internalpb.RowPolicy))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_CreateRowPolicy_Call) Return(_a0 error) *IMetaTable_CreateRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_CreateRowPolicy_Call) RunAndReturn(run func(string, *internalpb.RowPolicy) error) *IMetaTable_CreateRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteCredential provides a mock function with given fields: username
 func (_m *IMetaTable) DeleteCredential(username string) error {
 	ret := _m.Called(username)
@@ -832,6 +875,49 @@ func (_c *IMetaTable_DropRole_Call) RunAndReturn(run func(string, string) error)
 	return _c
 }
 
+// DropRowPolicy provides a mock function with given fields: tenant, policy
+func (_m *IMetaTable) DropRowPolicy(tenant string, policy *internalpb.RowPolicy) error {
+	ret := _m.Called(tenant, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *internalpb.RowPolicy) error); ok {
+		r0 = rf(tenant, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_DropRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRowPolicy'
+type IMetaTable_DropRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DropRowPolicy is a helper method to define mock.On call
+//   - tenant string
+//   - policy *internalpb.RowPolicy
+func (_e *IMetaTable_Expecter) DropRowPolicy(tenant interface{}, policy interface{}) *IMetaTable_DropRowPolicy_Call {
+	return &IMetaTable_DropRowPolicy_Call{Call: _e.mock.On("DropRowPolicy", tenant, policy)}
+}
+
+func (_c *IMetaTable_DropRowPolicy_Call) Run(run func(tenant string, policy *internalpb.RowPolicy)) *IMetaTable_DropRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*internalpb.RowPolicy))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_DropRowPolicy_Call) Return(_a0 error) *IMetaTable_DropRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_DropRowPolicy_Call) RunAndReturn(run func(string, *internalpb.RowPolicy) error) *IMetaTable_DropRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionByID provides a mock function with given fields: ctx, dbName, collectionID, ts, allowUnavailable
 func (_m *IMetaTable) GetCollectionByID(ctx context.Context, dbName string, collectionID int64, ts uint64, allowUnavailable bool) (*model.Collection, error) {
 	ret := _m.Called(ctx, dbName, collectionID, ts, allowUnavailable)
@@ -1706,6 +1792,63 @@ func (_c *IMetaTable_ListPolicy_Call) RunAndReturn(run func(string) ([]string, e
 	return _c
 }
 
+// ListRowPolicies provides a mock function with given fields: tenant, roleName, dbName, collectionName
+func (_m *IMetaTable) ListRowPolicies(tenant string, roleName string, dbName string, collectionName string) ([]*internalpb.RowPolicy, error) {
+	ret := _m.Called(tenant, roleName, dbName, collectionName)
+
+	var r0 []*internalpb.RowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) ([]*internalpb.RowPolicy, error)); ok {
+		return rf(tenant, roleName, dbName, collectionName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) []*internalpb.RowPolicy); ok {
+		r0 = rf(tenant, roleName, dbName, collectionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*internalpb.RowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(tenant, roleName, dbName, collectionName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type IMetaTable_ListRowPolicies_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicies is a helper method to define mock.On call
+//   - tenant string
+//   - roleName string
+//   - dbName string
+//   - collectionName string
+func (_e *IMetaTable_Expecter) ListRowPolicies(tenant interface{}, roleName interface{}, dbName interface{}, collectionName interface{}) *IMetaTable_ListRowPolicies_Call {
+	return &IMetaTable_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies", tenant, roleName, dbName, collectionName)}
+}
+
+func (_c *IMetaTable_ListRowPolicies_Call) Run(run func(tenant string, roleName string, dbName string, collectionName string)) *IMetaTable_ListRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListRowPolicies_Call) Return(_a0 []*internalpb.RowPolicy, _a1 error) *IMetaTable_ListRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListRowPolicies_Call) RunAndReturn(run func(string, string, string, string) ([]*internalpb.RowPolicy, error)) *IMetaTable_ListRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUserRole provides a mock function with given fields: tenant
 func (_m *IMetaTable) ListUserRole(tenant string) ([]string, error) {
 	ret := _m.Called(tenant)
@@ -1119,7 +1119,7 @@ func convertModelToDesc(collInfo *model.Collection, aliases []string, dbName str
 		Name:               collInfo.Name,
 		Description:        collInfo.Description,
 		AutoID:             collInfo.AutoID,
-		Fields:             model.MarshalFieldModels(collInfo.Fields),
+		Fields:             model.MarshalFieldModels(collInfo.GetAvailableFields()),
 		EnableDynamicField: collInfo.EnableDynamicField,
 	}
 	resp.CollectionID = collInfo.CollectionID
@@ -1299,6 +1299,106 @@ func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollection
 	return merr.Success(), nil
 }
 
+func (c *Core) AddCollectionField(ctx context.Context, in *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("AddCollectionField")
+
+	log.Ctx(ctx).Info("received request to add collection field",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.String("field", in.GetField().GetName()))
+
+	t := &addCollectionFieldTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to add collection field",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to add collection field",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AddCollectionField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("AddCollectionField").Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to add collection field",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+func (c *Core) DropCollectionField(ctx context.Context, in *proxypb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionField", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("DropCollectionField")
+
+	log.Ctx(ctx).Info("received request to drop collection field",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.String("field", in.GetFieldName()))
+
+	t := &dropCollectionFieldTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to drop collection field",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to drop collection field",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("DropCollectionField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("DropCollectionField").Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to drop collection field",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
 func (c *Core) AlterDatabase(ctx context.Context, in *rootcoordpb.AlterDatabaseRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
@@ -2253,6 +2353,17 @@ func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*com
 		ctxLog.Warn(errMsg, zap.Error(err))
 		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
 	}
+	rowPolicies, err := c.meta.ListRowPolicies(util.DefaultTenant, in.RoleName, "", "")
+	if err != nil {
+		errMsg := "fail to list the row policies of the role"
+		ctxLog.Warn(errMsg, zap.Error(err))
+		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
+	}
+	if len(rowPolicies) != 0 {
+		errMsg := "fail to drop the role that it has row policies. Use DropRowPolicy API to drop row policies"
+		ctxLog.Warn(errMsg)
+		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
+	}
 	redoTask := newBaseRedoTask(c.stepExecutor)
 	redoTask.AddSyncStep(NewSimpleStep("drop role meta data", func(ctx context.Context) ([]nestedStep, error) {
 		err := c.meta.DropRole(util.DefaultTenant, in.RoleName)
@@ -2695,6 +2806,14 @@ func (c *Core) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest)
 			Status: merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_ListPolicyFailure),
 		}, nil
 	}
+	rowPolicies, err := c.meta.ListRowPolicies(util.DefaultTenant, "", "", "")
+	if err != nil {
+		errMsg := "fail to list row policies"
+		ctxLog.Warn(errMsg, zap.Any("in", in), zap.Error(err))
+		return &internalpb.ListPolicyResponse{
+			Status: merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_ListPolicyFailure),
+		}, nil
+	}
 
 	ctxLog.Debug(method + " success")
 	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
@@ -2703,6 +2822,148 @@ func (c *Core) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest)
 		Status:      merr.Success(),
 		PolicyInfos: policies,
 		UserRoles:   userRoles,
+		RowPolicies: rowPolicies,
+	}, nil
+}
+
+// CreateRowPolicy creates a row policy of a role on a collection
+// - check the node health
+// - check if the role is valid
+// - save the row policy by the meta api
+// - refresh the policy cache
+func (c *Core) CreateRowPolicy(ctx context.Context, in *internalpb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	method := "CreateRowPolicy"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.Any("in", in))
+	ctxLog.Debug(method)
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	policy := in.GetPolicy()
+	if policy == nil {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("the row policy in the request is nil")), nil
+	}
+	if policy.GetRoleName() == util.RoleAdmin {
+		err := merr.WrapErrPrivilegeNotPermitted("the role[%s] can access all the rows, which can't have row policies", policy.GetRoleName())
+		return merr.Status(err), nil
+	}
+	if err := c.isValidRole(&milvuspb.RoleEntity{Name: policy.GetRoleName()}); err != nil {
+		ctxLog.Warn("", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	redoTask := newBaseRedoTask(c.stepExecutor)
+	redoTask.AddSyncStep(NewSimpleStep("create row policy meta data", func(ctx context.Context) ([]nestedStep, error) {
+		err := c.meta.CreateRowPolicy(util.DefaultTenant, policy)
+		if err != nil {
+			ctxLog.Warn("fail to create the row policy", zap.Error(err))
+		}
+		return nil, err
+	}))
+	redoTask.AddAsyncStep(NewSimpleStep("refresh row policy cache", func(ctx context.Context) ([]nestedStep, error) {
+		err := c.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+			OpType: int32(typeutil.CacheRefresh),
+		})
+		if err != nil {
+			ctxLog.Warn("fail to refresh policy info cache", zap.Error(err))
+		}
+		return nil, err
+	}))
+	if err := redoTask.Execute(ctx); err != nil {
+		ctxLog.Warn("fail to execute task when creating the row policy", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	ctxLog.Debug(method + " success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// DropRowPolicy drops a row policy of a role on a collection
+// - check the node health
+// - drop the row policy by the meta api
+// - refresh the policy cache
+func (c *Core) DropRowPolicy(ctx context.Context, in *internalpb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	method := "DropRowPolicy"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.Any("in", in))
+	ctxLog.Debug(method)
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	policy := &internalpb.RowPolicy{
+		PolicyName:     in.GetPolicyName(),
+		RoleName:       in.GetRoleName(),
+		DbName:         in.GetDbName(),
+		CollectionName: in.GetCollectionName(),
+	}
+	redoTask := newBaseRedoTask(c.stepExecutor)
+	redoTask.AddSyncStep(NewSimpleStep("drop row policy meta data", func(ctx context.Context) ([]nestedStep, error) {
+		err := c.meta.DropRowPolicy(util.DefaultTenant, policy)
+		if err != nil && !common.IsIgnorableError(err) {
+			ctxLog.Warn("fail to drop the row policy", zap.Error(err))
+			return nil, err
+		}
+		return nil, nil
+	}))
+	redoTask.AddAsyncStep(NewSimpleStep("refresh row policy cache", func(ctx context.Context) ([]nestedStep, error) {
+		err := c.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+			OpType: int32(typeutil.CacheRefresh),
+		})
+		if err != nil {
+			ctxLog.Warn("fail to refresh policy info cache", zap.Error(err))
+		}
+		return nil, err
+	}))
+	if err := redoTask.Execute(ctx); err != nil {
+		ctxLog.Warn("fail to execute task when dropping the row policy", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	ctxLog.Debug(method + " success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// ListRowPolicies lists the row policies, filtered by the role, the database and the collection if provided
+func (c *Core) ListRowPolicies(ctx context.Context, in *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	method := "ListRowPolicies"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.Any("in", in))
+	ctxLog.Debug(method)
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.ListRowPoliciesResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	dbName := in.GetDbName()
+	if dbName == "" && in.GetCollectionName() != "" {
+		dbName = util.DefaultDBName
+	}
+	policies, err := c.meta.ListRowPolicies(util.DefaultTenant, in.GetRoleName(), dbName, in.GetCollectionName())
+	if err != nil {
+		ctxLog.Warn("fail to list row policies", zap.Error(err))
+		return &internalpb.ListRowPoliciesResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	ctxLog.Debug(method + " success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &internalpb.ListRowPoliciesResponse{
+		Status:   merr.Success(),
+		Policies: policies,
 	}, nil
 }
 
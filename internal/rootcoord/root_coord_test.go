@@ -40,7 +40,9 @@ import (
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -1230,6 +1232,24 @@ func TestCore_Rbac(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 	}
+
+	{
+		resp, err := c.CreateRowPolicy(ctx, &internalpb.CreateRowPolicyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	}
+
+	{
+		resp, err := c.DropRowPolicy(ctx, &internalpb.DropRowPolicyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	}
+
+	{
+		resp, err := c.ListRowPolicies(ctx, &internalpb.ListRowPoliciesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	}
 }
 
 func TestCore_sendMinDdlTsAsTt(t *testing.T) {
@@ -1929,6 +1949,99 @@ func TestCore_Stop(t *testing.T) {
 	})
 }
 
+func TestRootCoord_RowPolicy(t *testing.T) {
+	ctx := context.Background()
+	policy := &internalpb.RowPolicy{
+		PolicyName:     "p1",
+		RoleName:       "foo",
+		CollectionName: "coll",
+		Expr:           "tenant == 'a'",
+	}
+
+	t.Run("create row policy", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		pcm := proxyutil.NewMockProxyClientManager(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		c.proxyClientManager = pcm
+
+		resp, err := c.CreateRowPolicy(ctx, &internalpb.CreateRowPolicyRequest{})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+
+		resp, err = c.CreateRowPolicy(ctx, &internalpb.CreateRowPolicyRequest{Policy: &internalpb.RowPolicy{RoleName: util.RoleAdmin}})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrPrivilegeNotPermitted)
+
+		meta.EXPECT().SelectRole(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("mock error")).Once()
+		resp, err = c.CreateRowPolicy(ctx, &internalpb.CreateRowPolicyRequest{Policy: policy})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+
+		meta.EXPECT().SelectRole(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		meta.EXPECT().CreateRowPolicy(mock.Anything, mock.Anything).Return(errors.New("mock error")).Once()
+		resp, err = c.CreateRowPolicy(ctx, &internalpb.CreateRowPolicyRequest{Policy: policy})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+
+		meta.EXPECT().CreateRowPolicy(mock.Anything, mock.Anything).Return(nil).Once()
+		pcm.EXPECT().RefreshPolicyInfoCache(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) error {
+				assert.Equal(t, int32(typeutil.CacheRefresh), req.GetOpType())
+				return nil
+			}).Once()
+		resp, err = c.CreateRowPolicy(ctx, &internalpb.CreateRowPolicyRequest{Policy: policy})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+	})
+
+	t.Run("drop row policy", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		pcm := proxyutil.NewMockProxyClientManager(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		c.proxyClientManager = pcm
+
+		meta.EXPECT().DropRowPolicy(mock.Anything, mock.Anything).Return(errors.New("mock error")).Once()
+		resp, err := c.DropRowPolicy(ctx, &internalpb.DropRowPolicyRequest{PolicyName: "p1", RoleName: "foo", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+
+		// dropping a nonexistent row policy is ignorable
+		meta.EXPECT().DropRowPolicy(mock.Anything, mock.Anything).Return(common.NewIgnorableError(errors.New("mock error"))).Once()
+		pcm.EXPECT().RefreshPolicyInfoCache(mock.Anything, mock.Anything).Return(nil).Once()
+		resp, err = c.DropRowPolicy(ctx, &internalpb.DropRowPolicyRequest{PolicyName: "p1", RoleName: "foo", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+	})
+
+	t.Run("list row policies", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+
+		meta.EXPECT().ListRowPolicies(mock.Anything, "foo", util.DefaultDBName, "coll").Return([]*internalpb.RowPolicy{policy}, nil).Once()
+		resp, err := c.ListRowPolicies(ctx, &internalpb.ListRowPoliciesRequest{RoleName: "foo", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetPolicies(), 1)
+
+		meta.EXPECT().ListRowPolicies(mock.Anything, "", "", "").Return(nil, errors.New("mock error")).Once()
+		resp, err = c.ListRowPolicies(ctx, &internalpb.ListRowPoliciesRequest{})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("drop role with row policies", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+
+		meta.EXPECT().SelectRole(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		meta.EXPECT().SelectGrant(mock.Anything, mock.Anything).Return(nil, nil)
+		meta.EXPECT().ListRowPolicies(mock.Anything, "foo", "", "").Return([]*internalpb.RowPolicy{policy}, nil).Once()
+		resp, err := c.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: "foo"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_DropRoleFailure, resp.GetErrorCode())
+	})
+}
+
 func TestCore_InitRBAC(t *testing.T) {
 	paramtable.Init()
 	t.Run("init default role and public role privilege", func(t *testing.T) {
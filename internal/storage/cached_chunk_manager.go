@@ -0,0 +1,386 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// cachedObject is an object of the underlying ChunkManager cached on local disk.
+type cachedObject struct {
+	filePath  string
+	localPath string
+	size      int64
+	expireAt  time.Time
+}
+
+// CachedChunkManager is a ChunkManager decorator which keeps the objects read from the underlying
+// ChunkManager on local disk. The least recently used objects are evicted when the capacity is exceeded,
+// and the cached objects are invalidated when they are written or removed through this ChunkManager.
+type CachedChunkManager struct {
+	ChunkManager
+
+	cacheDir string
+	capacity int64
+	ttl      time.Duration
+
+	mu      sync.Mutex // guards size, objects, lru and epoch
+	size    int64
+	objects map[string]*list.Element
+	lru     *list.List
+	// epoch is increased on every invalidation, objects loaded across an invalidation are not cached
+	epoch uint64
+
+	sf conc.Singleflight[struct{}]
+}
+
+var _ ChunkManager = (*CachedChunkManager)(nil)
+
+// NewCachedChunkManager creates a CachedChunkManager in front of @cm, the objects are cached under @cacheDir
+// and take up to @capacity bytes, a @ttl of 0 means the cached objects never expire.
+// The cache directory is cleaned up since the cached objects are not tracked across restarts.
+func NewCachedChunkManager(cm ChunkManager, cacheDir string, capacity int64, ttl time.Duration) (*CachedChunkManager, error) {
+	if err := os.RemoveAll(cacheDir); err != nil {
+		return nil, merr.WrapErrIoFailed(cacheDir, err)
+	}
+	if err := os.MkdirAll(cacheDir, os.ModePerm); err != nil {
+		return nil, merr.WrapErrIoFailed(cacheDir, err)
+	}
+	metrics.PersistentDataCacheSize.Set(0)
+	return &CachedChunkManager{
+		ChunkManager: cm,
+		cacheDir:     cacheDir,
+		capacity:     capacity,
+		ttl:          ttl,
+		objects:      make(map[string]*list.Element),
+		lru:          list.New(),
+	}, nil
+}
+
+// Write writes @content to @filePath and invalidates the cached object.
+func (c *CachedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	defer c.invalidate(filePath)
+	return c.ChunkManager.Write(ctx, filePath, content)
+}
+
+// MultiWrite writes multi @content and invalidates the cached objects.
+func (c *CachedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	defer func() {
+		for filePath := range contents {
+			c.invalidate(filePath)
+		}
+	}()
+	return c.ChunkManager.MultiWrite(ctx, contents)
+}
+
+// Read reads @filePath from the cache, the object is loaded from the underlying ChunkManager on miss.
+func (c *CachedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	file, err := c.openCached(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return c.ChunkManager.Read(ctx, filePath)
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, merr.WrapErrIoFailed(filePath, err)
+	}
+	return data, nil
+}
+
+// MultiRead reads @filePaths from the cache.
+func (c *CachedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	results := make([][]byte, len(filePaths))
+	var el error
+	for i, filePath := range filePaths {
+		content, err := c.Read(ctx, filePath)
+		if err != nil {
+			el = merr.Combine(el, errors.Wrapf(err, "failed to read %s", filePath))
+		}
+		results[i] = content
+	}
+	return results, el
+}
+
+// Reader returns a reader of the cached @filePath.
+func (c *CachedChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	file, err := c.openCached(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return c.ChunkManager.Reader(ctx, filePath)
+	}
+	return file, nil
+}
+
+// ReadAt reads the cached @filePath by offset @off, it reads from the underlying ChunkManager if it's not cached.
+func (c *CachedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+	// the object is not loaded on miss, only the requested range is read from the underlying ChunkManager
+	var file *os.File
+	cached, err := c.lookup(filePath, func(localPath string) error {
+		var err error
+		file, err = Open(localPath)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !cached {
+		metrics.PersistentDataCacheCounter.WithLabelValues(metrics.CacheMissLabel).Inc()
+		return c.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	metrics.PersistentDataCacheCounter.WithLabelValues(metrics.CacheHitLabel).Inc()
+	defer file.Close()
+	res := make([]byte, length)
+	if _, err = file.ReadAt(res, off); err != nil {
+		return nil, merr.WrapErrIoFailed(filePath, err)
+	}
+	return res, nil
+}
+
+// Mmap maps the cached @filePath into memory.
+func (c *CachedChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	var reader *mmap.ReaderAt
+	cached, err := c.withCachedObject(ctx, filePath, func(localPath string) error {
+		var err error
+		reader, err = mmap.Open(localPath)
+		return merr.WrapErrIoFailed(filePath, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !cached {
+		return c.ChunkManager.Mmap(ctx, filePath)
+	}
+	return reader, nil
+}
+
+// Remove deletes @filePath and invalidates the cached object.
+func (c *CachedChunkManager) Remove(ctx context.Context, filePath string) error {
+	defer c.invalidate(filePath)
+	return c.ChunkManager.Remove(ctx, filePath)
+}
+
+// MultiRemove deletes @filePaths and invalidates the cached objects.
+func (c *CachedChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	defer func() {
+		for _, filePath := range filePaths {
+			c.invalidate(filePath)
+		}
+	}()
+	return c.ChunkManager.MultiRemove(ctx, filePaths)
+}
+
+// RemoveWithPrefix removes the files with @prefix and invalidates the cached objects.
+func (c *CachedChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	defer c.invalidatePrefix(prefix)
+	return c.ChunkManager.RemoveWithPrefix(ctx, prefix)
+}
+
+// openCached opens the cached file of @filePath, a nil file means the object can't be cached
+// and should be read from the underlying ChunkManager.
+// The file stays readable even if the object is evicted after it's opened.
+func (c *CachedChunkManager) openCached(ctx context.Context, filePath string) (*os.File, error) {
+	var file *os.File
+	cached, err := c.withCachedObject(ctx, filePath, func(localPath string) error {
+		var err error
+		file, err = Open(localPath)
+		return err
+	})
+	if err != nil || !cached {
+		return nil, err
+	}
+	return file, nil
+}
+
+// withCachedObject calls @open with the local path of the cached object while holding the lock,
+// the object is loaded from the underlying ChunkManager on miss.
+// It returns false if the object is not cached, e.g. it's larger than the capacity.
+func (c *CachedChunkManager) withCachedObject(ctx context.Context, filePath string, open func(localPath string) error) (bool, error) {
+	if cached, err := c.lookup(filePath, open); cached {
+		metrics.PersistentDataCacheCounter.WithLabelValues(metrics.CacheHitLabel).Inc()
+		return true, err
+	}
+	metrics.PersistentDataCacheCounter.WithLabelValues(metrics.CacheMissLabel).Inc()
+	_, err, _ := c.sf.Do(filePath, func() (struct{}, error) {
+		return struct{}{}, c.load(ctx, filePath)
+	})
+	if err != nil {
+		return false, err
+	}
+	return c.lookup(filePath, open)
+}
+
+func (c *CachedChunkManager) lookup(filePath string, open func(localPath string) error) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.objects[filePath]
+	if !ok {
+		return false, nil
+	}
+	object := elem.Value.(*cachedObject)
+	if c.expired(object) {
+		c.evict(elem)
+		return false, nil
+	}
+	c.lru.MoveToFront(elem)
+	return true, open(object.localPath)
+}
+
+// load reads @filePath from the underlying ChunkManager and puts it into the cache.
+func (c *CachedChunkManager) load(ctx context.Context, filePath string) error {
+	c.mu.Lock()
+	epoch := c.epoch
+	c.mu.Unlock()
+
+	// the objects larger than the capacity are never cached, don't read them twice
+	size, err := c.ChunkManager.Size(ctx, filePath)
+	if err != nil {
+		return err
+	}
+	if size > c.capacity {
+		return nil
+	}
+	data, err := c.ChunkManager.Read(ctx, filePath)
+	if err != nil {
+		return err
+	}
+	// the object may be overwritten after Size
+	size = int64(len(data))
+	if size > c.capacity {
+		return nil
+	}
+
+	tmpFile, err := os.CreateTemp(c.cacheDir, "tmp-*")
+	if err != nil {
+		return merr.WrapErrIoFailed(c.cacheDir, err)
+	}
+	tmpPath := tmpFile.Name()
+	_, err = tmpFile.Write(data)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return merr.WrapErrIoFailed(tmpPath, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if epoch != c.epoch {
+		// the object was written or removed while loading, the loaded content may be stale
+		os.Remove(tmpPath)
+		return nil
+	}
+	if elem, ok := c.objects[filePath]; ok {
+		c.remove(elem)
+	}
+	for c.size+size > c.capacity && c.lru.Len() > 0 {
+		c.evict(c.lru.Back())
+	}
+	object := &cachedObject{
+		filePath:  filePath,
+		localPath: c.localPath(filePath),
+		size:      size,
+	}
+	if c.ttl > 0 {
+		object.expireAt = time.Now().Add(c.ttl)
+	}
+	if err = os.Rename(tmpPath, object.localPath); err != nil {
+		os.Remove(tmpPath)
+		return merr.WrapErrIoFailed(object.localPath, err)
+	}
+	c.objects[filePath] = c.lru.PushFront(object)
+	c.size += size
+	metrics.PersistentDataCacheSize.Set(float64(c.size))
+	return nil
+}
+
+func (c *CachedChunkManager) invalidate(filePath string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.epoch++
+	c.sf.Forget(filePath)
+	if elem, ok := c.objects[filePath]; ok {
+		c.remove(elem)
+	}
+}
+
+func (c *CachedChunkManager) invalidatePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.epoch++
+	for filePath, elem := range c.objects {
+		if strings.HasPrefix(filePath, prefix) {
+			c.sf.Forget(filePath)
+			c.remove(elem)
+		}
+	}
+}
+
+func (c *CachedChunkManager) expired(object *cachedObject) bool {
+	return !object.expireAt.IsZero() && time.Now().After(object.expireAt)
+}
+
+// evict removes the object from the cache to make room or because it's expired, the lock must be held.
+func (c *CachedChunkManager) evict(elem *list.Element) {
+	c.remove(elem)
+	metrics.PersistentDataCacheEvictCounter.Inc()
+}
+
+// remove removes the object and its local file from the cache, the lock must be held.
+func (c *CachedChunkManager) remove(elem *list.Element) {
+	object := c.lru.Remove(elem).(*cachedObject)
+	delete(c.objects, object.filePath)
+	c.size -= object.size
+	metrics.PersistentDataCacheSize.Set(float64(c.size))
+	if err := os.Remove(object.localPath); err != nil && !os.IsNotExist(err) {
+		log.Warn("failed to remove cached object", zap.String("path", object.filePath),
+			zap.String("localPath", object.localPath), zap.Error(err))
+	}
+}
+
+// localPath returns the path of the cached object, object keys are hashed so that
+// keys like "a" and "a/b" don't conflict on the file system.
+func (c *CachedChunkManager) localPath(filePath string) string {
+	sum := sha256.Sum256([]byte(filePath))
+	return path.Join(c.cacheDir, hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,225 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+// countingChunkManager counts the reads reaching the underlying ChunkManager.
+type countingChunkManager struct {
+	ChunkManager
+	reads   atomic.Int64
+	readAts atomic.Int64
+}
+
+func (cm *countingChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	cm.reads.Inc()
+	return cm.ChunkManager.Read(ctx, filePath)
+}
+
+func (cm *countingChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	cm.readAts.Inc()
+	return cm.ChunkManager.ReadAt(ctx, filePath, off, length)
+}
+
+func newTestCachedChunkManager(t *testing.T, capacity int64, ttl time.Duration) (*CachedChunkManager, *countingChunkManager, string) {
+	root := t.TempDir()
+	underlying := &countingChunkManager{ChunkManager: NewLocalChunkManager(RootPath(root))}
+	cm, err := NewCachedChunkManager(underlying, path.Join(t.TempDir(), "cache"), capacity, ttl)
+	require.NoError(t, err)
+	return cm, underlying, root
+}
+
+func TestCachedChunkManager_Read(t *testing.T) {
+	ctx := context.Background()
+	cm, underlying, root := newTestCachedChunkManager(t, 1024, 0)
+
+	key := path.Join(root, "a")
+	require.NoError(t, cm.Write(ctx, key, []byte("12345")))
+
+	data, err := cm.Read(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("12345"), data)
+	assert.Equal(t, int64(1), underlying.reads.Load())
+
+	// served from cache
+	data, err = cm.Read(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("12345"), data)
+	data, err = cm.ReadAt(ctx, key, 1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("234"), data)
+	_, err = cm.ReadAt(ctx, key, 3, 3)
+	assert.Error(t, err)
+	_, err = cm.ReadAt(ctx, key, -1, 3)
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, int64(0), underlying.readAts.Load())
+
+	reader, err := cm.Reader(ctx, key)
+	assert.NoError(t, err)
+	data, err = io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("12345"), data)
+	reader.Close()
+
+	mmapReader, err := cm.Mmap(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, mmapReader.Len())
+	mmapReader.Close()
+
+	results, err := cm.MultiRead(ctx, []string{key, key})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("12345"), []byte("12345")}, results)
+	assert.Equal(t, int64(1), underlying.reads.Load())
+
+	// not exist
+	_, err = cm.Read(ctx, path.Join(root, "b"))
+	assert.Error(t, err)
+}
+
+func TestCachedChunkManager_ReadAtMiss(t *testing.T) {
+	ctx := context.Background()
+	cm, underlying, root := newTestCachedChunkManager(t, 1024, 0)
+
+	key := path.Join(root, "a")
+	require.NoError(t, cm.Write(ctx, key, []byte("12345")))
+
+	// only the range is read on miss, the object is not loaded
+	data, err := cm.ReadAt(ctx, key, 1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("234"), data)
+	assert.Equal(t, int64(1), underlying.readAts.Load())
+	assert.Equal(t, int64(0), underlying.reads.Load())
+	assert.NotContains(t, cm.objects, key)
+
+	// served from cache once it's loaded
+	_, err = cm.Read(ctx, key)
+	assert.NoError(t, err)
+	data, err = cm.ReadAt(ctx, key, 2, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("34"), data)
+	assert.Equal(t, int64(1), underlying.readAts.Load())
+	assert.Equal(t, int64(1), underlying.reads.Load())
+}
+
+func TestCachedChunkManager_Invalidate(t *testing.T) {
+	ctx := context.Background()
+	cm, underlying, root := newTestCachedChunkManager(t, 1024, 0)
+
+	key := path.Join(root, "prefix", "a")
+	require.NoError(t, cm.Write(ctx, key, []byte("123")))
+	_, err := cm.Read(ctx, key)
+	assert.NoError(t, err)
+
+	// write invalidates the cached object
+	require.NoError(t, cm.Write(ctx, key, []byte("456")))
+	data, err := cm.Read(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("456"), data)
+	assert.Equal(t, int64(2), underlying.reads.Load())
+
+	require.NoError(t, cm.MultiWrite(ctx, map[string][]byte{key: []byte("789")}))
+	data, err = cm.Read(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("789"), data)
+
+	require.NoError(t, cm.Remove(ctx, key))
+	_, err = cm.Read(ctx, key)
+	assert.Error(t, err)
+
+	require.NoError(t, cm.Write(ctx, key, []byte("123")))
+	_, err = cm.Read(ctx, key)
+	assert.NoError(t, err)
+	require.NoError(t, cm.MultiRemove(ctx, []string{key}))
+	_, err = cm.Read(ctx, key)
+	assert.Error(t, err)
+
+	require.NoError(t, cm.Write(ctx, key, []byte("123")))
+	_, err = cm.Read(ctx, key)
+	assert.NoError(t, err)
+	require.NoError(t, cm.RemoveWithPrefix(ctx, path.Join(root, "prefix")))
+	_, err = cm.Read(ctx, key)
+	assert.Error(t, err)
+	assert.Equal(t, 0, len(cm.objects))
+	assert.Equal(t, int64(0), cm.size)
+}
+
+func TestCachedChunkManager_Evict(t *testing.T) {
+	ctx := context.Background()
+	cm, underlying, root := newTestCachedChunkManager(t, 10, 0)
+
+	keyA, keyB, keyC := path.Join(root, "a"), path.Join(root, "b"), path.Join(root, "c")
+	require.NoError(t, cm.Write(ctx, keyA, []byte("1234")))
+	require.NoError(t, cm.Write(ctx, keyB, []byte("1234")))
+	require.NoError(t, cm.Write(ctx, keyC, []byte("1234")))
+
+	_, err := cm.Read(ctx, keyA)
+	assert.NoError(t, err)
+	_, err = cm.Read(ctx, keyB)
+	assert.NoError(t, err)
+	// a is the most recently used one
+	_, err = cm.Read(ctx, keyA)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), underlying.reads.Load())
+
+	// b is evicted to make room for c
+	_, err = cm.Read(ctx, keyC)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), cm.size)
+	assert.Contains(t, cm.objects, keyA)
+	assert.NotContains(t, cm.objects, keyB)
+	assert.Contains(t, cm.objects, keyC)
+
+	// larger than the capacity, read from the underlying ChunkManager directly and only once
+	keyD := path.Join(root, "d")
+	require.NoError(t, cm.Write(ctx, keyD, []byte("12345678901")))
+	data, err := cm.Read(ctx, keyD)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("12345678901"), data)
+	assert.Equal(t, int64(4), underlying.reads.Load())
+	data, err = cm.ReadAt(ctx, keyD, 10, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), data)
+	assert.NotContains(t, cm.objects, keyD)
+}
+
+func TestCachedChunkManager_TTL(t *testing.T) {
+	ctx := context.Background()
+	cm, underlying, root := newTestCachedChunkManager(t, 1024, 100*time.Millisecond)
+
+	key := path.Join(root, "a")
+	require.NoError(t, cm.Write(ctx, key, []byte("123")))
+	_, err := cm.Read(ctx, key)
+	assert.NoError(t, err)
+	_, err = cm.Read(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), underlying.reads.Load())
+
+	time.Sleep(200 * time.Millisecond)
+	_, err = cm.Read(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), underlying.reads.Load())
+}
@@ -483,7 +483,8 @@ func RowBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *schemap
 // on provided CollectionSchema collSchema.
 //
 // This function checks whether all fields are provided in the collSchema.Fields.
-// If any field is missing in the msg, an error will be returned.
+// If any field is missing in the msg, an error will be returned, unless the field
+// is nullable or has default value, which is filled for the rows.
 //
 // This funcion also checks the length of each column. All columns shall have the same length.
 // Also, the InsertData.Infos shall have BlobInfo with this length returned.
@@ -501,7 +502,14 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	for _, field := range collSchema.Fields {
 		srcField, ok := srcFields[field.GetFieldID()]
 		if !ok && field.GetFieldID() >= common.StartOfUserFieldID {
-			return nil, merr.WrapErrFieldNotFound(field.GetFieldID(), fmt.Sprintf("field %s not found when converting insert msg to insert data", field.GetName()))
+			// the msg is written before the field is added to the collection
+			if !field.GetNullable() && field.GetDefaultValue() == nil {
+				return nil, merr.WrapErrFieldNotFound(field.GetFieldID(), fmt.Sprintf("field %s not found when converting insert msg to insert data", field.GetName()))
+			}
+			srcField, err = typeutil.GenDefaultFieldData(field, int(msg.NumRows))
+			if err != nil {
+				return nil, err
+			}
 		}
 		var fieldData FieldData
 		switch field.DataType {
@@ -1266,7 +1274,26 @@ func TransferInsertMsgToInsertRecord(schema *schemapb.CollectionSchema, msg *msg
 		NumRows: int64(msg.NumRows),
 	}
 
-	insertRecord.FieldsData = append(insertRecord.FieldsData, msg.FieldsData...)
+	// the fields dropped from the collection are skipped, and the fields added after the msg is written
+	// are filled with the default values
+	srcFields := make(map[FieldID]*schemapb.FieldData)
+	for _, field := range msg.FieldsData {
+		srcFields[field.GetFieldId()] = field
+	}
+	for _, field := range schema.GetFields() {
+		fieldData, ok := srcFields[field.GetFieldID()]
+		if !ok {
+			if field.GetFieldID() < common.StartOfUserFieldID {
+				continue
+			}
+			var err error
+			fieldData, err = typeutil.GenDefaultFieldData(field, int(msg.NumRows))
+			if err != nil {
+				return nil, merr.WrapErrFieldNotFound(field.GetFieldID(), fmt.Sprintf("field %s not found when converting insert msg to insert record", field.GetName()))
+			}
+		}
+		insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
+	}
 
 	return insertRecord, nil
 }
@@ -1179,6 +1179,38 @@ func TestColumnBasedInsertMsgToInsertDataNullable(t *testing.T) {
 	}
 }
 
+func TestColumnBasedInsertMsgWithAddedField(t *testing.T) {
+	numRows, fVecDim, bVecDim, f16VecDim, bf16VecDim := 2, 2, 8, 2, 2
+	schema, _, _ := genAllFieldsSchema(fVecDim, bVecDim, f16VecDim, bf16VecDim, true)
+	msg, _, _ := genColumnBasedInsertMsg(schema, numRows, fVecDim, bVecDim, f16VecDim, bf16VecDim)
+
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:      1000,
+		Name:         "added",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 7}},
+	})
+	idata, err := ColumnBasedInsertMsgToInsertData(msg, schema)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7, 7}, idata.Data[1000].(*Int64FieldData).Data)
+
+	record, err := TransferInsertMsgToInsertRecord(schema, msg)
+	require.NoError(t, err)
+	added, ok := lo.Find(record.GetFieldsData(), func(fieldData *schemapb.FieldData) bool {
+		return fieldData.GetFieldId() == 1000
+	})
+	require.True(t, ok)
+	assert.Equal(t, []int64{7, 7}, added.GetScalars().GetLongData().GetData())
+
+	// the field without null or default value can't be filled
+	schema.Fields[len(schema.Fields)-1].DefaultValue = nil
+	_, err = ColumnBasedInsertMsgToInsertData(msg, schema)
+	assert.Error(t, err)
+	_, err = TransferInsertMsgToInsertRecord(schema, msg)
+	assert.Error(t, err)
+}
+
 func TestColumnBasedInsertMsgToInsertFloat16VectorDataError(t *testing.T) {
 	msg := &msgstream.InsertMsg{
 		BaseMsg: msgstream.BaseMsg{
@@ -223,11 +223,16 @@ type ProxyClient interface {
 type Proxy interface {
 	Component
 	proxypb.ProxyServer
+	proxypb.FederatedSearchServer
 	milvuspb.MilvusServiceServer
 
 	ImportV2(context.Context, *internalpb.ImportRequest) (*internalpb.ImportResponse, error)
 	GetImportProgress(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)
 	ListImports(context.Context, *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error)
+
+	ExportV2(context.Context, *internalpb.ExportRequest) (*internalpb.ExportResponse, error)
+	GetExportProgress(context.Context, *internalpb.GetExportProgressRequest) (*internalpb.GetExportProgressResponse, error)
+	ListExports(context.Context, *internalpb.ListExportsRequest) (*internalpb.ListExportsResponse, error)
 }
 
 // ProxyComponent defines the interface of proxy component.
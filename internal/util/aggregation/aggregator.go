@@ -0,0 +1,526 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// Aggregator computes the aggregates of entities by the groups of the group by fields in two phases:
+// the query nodes accumulate the retrieved entities into partial states, then the proxy merges the partial
+// states of query nodes and finalizes the aggregates. Null values are ignored by the aggregates except count(*),
+// and the aggregate of a group without any value is null except count.
+//
+// The partial states are passed as columns: the values of the group by fields, followed by the states of
+// each aggregate, which are the number of the aggregated values and the accumulated value except for count.
+type Aggregator struct {
+	groupByFields []*schemapb.FieldSchema
+	aggregates    []*internalpb.Aggregate
+	// the aggregated fields, nil for count(*)
+	aggFields []*schemapb.FieldSchema
+
+	groups map[string]*group
+	// the maximum number of the groups, no limit if it's not positive
+	maxGroups int64
+}
+
+type group struct {
+	values []any
+	states []*state
+}
+
+type state struct {
+	count int64
+	// int64, float64 or string, nil if no value is accumulated
+	value any
+}
+
+// NewAggregator creates an aggregator of the aggregates by the groups of the group by fields, the accumulation
+// fails once the groups exceed maxGroups, which bounds the memory of the partial states.
+func NewAggregator(schema *schemapb.CollectionSchema, groupBy []int64, aggregates []*internalpb.Aggregate, maxGroups int64) (*Aggregator, error) {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	a := &Aggregator{
+		groupByFields: make([]*schemapb.FieldSchema, 0, len(groupBy)),
+		aggregates:    aggregates,
+		aggFields:     make([]*schemapb.FieldSchema, 0, len(aggregates)),
+		groups:        make(map[string]*group),
+		maxGroups:     maxGroups,
+	}
+	for _, fieldID := range groupBy {
+		field, err := helper.GetFieldFromID(fieldID)
+		if err != nil {
+			return nil, err
+		}
+		if err := CheckGroupBy(field); err != nil {
+			return nil, err
+		}
+		a.groupByFields = append(a.groupByFields, field)
+	}
+	for _, aggregate := range aggregates {
+		var field *schemapb.FieldSchema
+		if aggregate.GetFieldId() != 0 {
+			field, err = helper.GetFieldFromID(aggregate.GetFieldId())
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := CheckAggregate(aggregate.GetOp(), field); err != nil {
+			return nil, err
+		}
+		a.aggFields = append(a.aggFields, field)
+	}
+	return a, nil
+}
+
+// CheckGroupBy checks whether the entities can be grouped by the field.
+func CheckGroupBy(field *schemapb.FieldSchema) error {
+	dataType := field.GetDataType()
+	if field.GetIsDynamic() || !(typeutil.IsBoolType(dataType) || typeutil.IsIntegerType(dataType) ||
+		typeutil.IsFloatingType(dataType) || typeutil.IsStringType(dataType)) {
+		return fmt.Errorf("cannot group by field %s of type %s", field.GetName(), dataType.String())
+	}
+	return nil
+}
+
+// CheckAggregate checks whether the aggregate can be applied on the field, field is nil for count(*).
+func CheckAggregate(op internalpb.Aggregate_Op, field *schemapb.FieldSchema) error {
+	if field == nil {
+		if op != internalpb.Aggregate_Count {
+			return fmt.Errorf("%s(*) is not supported", op.String())
+		}
+		return nil
+	}
+	dataType := field.GetDataType()
+	ok := false
+	switch op {
+	case internalpb.Aggregate_Count:
+		ok = !field.GetIsDynamic() && !typeutil.IsVectorType(dataType)
+	case internalpb.Aggregate_Sum, internalpb.Aggregate_Avg:
+		ok = typeutil.IsIntegerType(dataType) || typeutil.IsFloatingType(dataType)
+	case internalpb.Aggregate_Min, internalpb.Aggregate_Max:
+		ok = typeutil.IsIntegerType(dataType) || typeutil.IsFloatingType(dataType) || typeutil.IsStringType(dataType)
+	}
+	if !ok {
+		return fmt.Errorf("%s cannot be applied on field %s of type %s", op.String(), field.GetName(), dataType.String())
+	}
+	return nil
+}
+
+// AddRows accumulates the rows of the retrieved fields data at the indexes.
+func (a *Aggregator) AddRows(fieldsData []*schemapb.FieldData, indexes []int64) error {
+	findColumn := func(fieldID int64) (*schemapb.FieldData, error) {
+		for _, fieldData := range fieldsData {
+			if fieldData.GetFieldId() == fieldID {
+				return fieldData, nil
+			}
+		}
+		return nil, merr.WrapErrServiceInternal(fmt.Sprintf("field %d is not retrieved for aggregation", fieldID))
+	}
+	groupByColumns := make([]*schemapb.FieldData, len(a.groupByFields))
+	for i, field := range a.groupByFields {
+		column, err := findColumn(field.GetFieldID())
+		if err != nil {
+			return err
+		}
+		groupByColumns[i] = column
+	}
+	aggColumns := make([]*schemapb.FieldData, len(a.aggregates))
+	for i, field := range a.aggFields {
+		if field == nil {
+			continue
+		}
+		column, err := findColumn(field.GetFieldID())
+		if err != nil {
+			return err
+		}
+		aggColumns[i] = column
+	}
+
+	for _, idx := range indexes {
+		values := make([]any, len(groupByColumns))
+		for i, column := range groupByColumns {
+			values[i] = getValue(column, idx)
+		}
+		g, err := a.getGroup(values)
+		if err != nil {
+			return err
+		}
+		for i, aggregate := range a.aggregates {
+			if aggColumns[i] == nil {
+				g.states[i].count++
+				continue
+			}
+			value := getValue(aggColumns[i], idx)
+			if value == nil {
+				continue
+			}
+			g.states[i].merge(aggregate.GetOp(), 1, value)
+		}
+	}
+	return nil
+}
+
+// AddPartial merges the partial states produced by Partial.
+func (a *Aggregator) AddPartial(fieldsData []*schemapb.FieldData) error {
+	expected := len(a.groupByFields)
+	for _, aggregate := range a.aggregates {
+		expected += stateColumnNum(aggregate.GetOp())
+	}
+	if len(fieldsData) != expected {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("partial aggregation result should have %d columns, but got %d", expected, len(fieldsData)))
+	}
+	if expected == 0 {
+		return nil
+	}
+	rowNum, err := funcutil.GetNumRowOfFieldData(fieldsData[0])
+	if err != nil {
+		return err
+	}
+	for idx := int64(0); idx < int64(rowNum); idx++ {
+		values := make([]any, len(a.groupByFields))
+		for i := range a.groupByFields {
+			values[i] = getValue(fieldsData[i], idx)
+		}
+		g, err := a.getGroup(values)
+		if err != nil {
+			return err
+		}
+		col := len(a.groupByFields)
+		for i, aggregate := range a.aggregates {
+			count := fieldsData[col].GetScalars().GetLongData().GetData()[idx]
+			var value any
+			if stateColumnNum(aggregate.GetOp()) > 1 {
+				value = getValue(fieldsData[col+1], idx)
+			}
+			if value == nil {
+				g.states[i].count += count
+			} else {
+				g.states[i].merge(aggregate.GetOp(), count, value)
+			}
+			col += stateColumnNum(aggregate.GetOp())
+		}
+	}
+	return nil
+}
+
+// Partial returns the partial states of the groups.
+func (a *Aggregator) Partial() []*schemapb.FieldData {
+	groups := a.sortedGroups()
+	columns := a.groupByColumns(groups)
+	for i, aggregate := range a.aggregates {
+		counts := make([]any, len(groups))
+		values := make([]any, len(groups))
+		for j, g := range groups {
+			counts[j] = g.states[i].count
+			values[j] = g.states[i].value
+		}
+		columns = append(columns, newColumn(schemapb.DataType_Int64, counts))
+		if stateColumnNum(aggregate.GetOp()) > 1 {
+			columns = append(columns, newColumn(a.stateType(i), values))
+		}
+	}
+	return columns
+}
+
+// Final returns the columns of the group by fields followed by the columns of the aggregates, the groups are
+// ordered by the values of the group by fields, and the groups in [offset, offset+limit) are returned.
+// The aggregates of all the entities are returned as one row if there is no group by field.
+func (a *Aggregator) Final(offset, limit int64) []*schemapb.FieldData {
+	if len(a.groupByFields) == 0 && len(a.groups) == 0 {
+		a.newGroup([]any{})
+	}
+	groups := a.sortedGroups()
+	if offset >= int64(len(groups)) {
+		groups = groups[:0]
+	} else {
+		groups = groups[offset:]
+	}
+	if limit != typeutil.Unlimited && limit < int64(len(groups)) {
+		groups = groups[:limit]
+	}
+
+	columns := a.groupByColumns(groups)
+	for i, aggregate := range a.aggregates {
+		values := make([]any, len(groups))
+		dataType := a.stateType(i)
+		for j, g := range groups {
+			st := g.states[i]
+			switch aggregate.GetOp() {
+			case internalpb.Aggregate_Count:
+				values[j] = st.count
+			case internalpb.Aggregate_Avg:
+				if st.value != nil {
+					values[j] = st.value.(float64) / float64(st.count)
+				}
+			default:
+				values[j] = st.value
+			}
+		}
+		columns = append(columns, newColumn(dataType, values))
+	}
+	return columns
+}
+
+func (a *Aggregator) getGroup(values []any) (*group, error) {
+	key := fmt.Sprintf("%#v", values)
+	if g, ok := a.groups[key]; ok {
+		return g, nil
+	}
+	if a.maxGroups > 0 && int64(len(a.groups)) >= a.maxGroups {
+		return nil, merr.WrapErrParameterTooLarge("aggregation groups",
+			fmt.Sprintf("the number of groups exceeds the limit %d, narrow down the filter or the group by fields", a.maxGroups))
+	}
+	return a.newGroup(values), nil
+}
+
+func (a *Aggregator) newGroup(values []any) *group {
+	g := &group{
+		values: values,
+		states: make([]*state, len(a.aggregates)),
+	}
+	for i := range g.states {
+		g.states[i] = &state{}
+	}
+	a.groups[fmt.Sprintf("%#v", values)] = g
+	return g
+}
+
+func (a *Aggregator) sortedGroups() []*group {
+	groups := make([]*group, 0, len(a.groups))
+	for _, g := range a.groups {
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		for k := range a.groupByFields {
+			if c := compareValues(groups[i].values[k], groups[j].values[k]); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	return groups
+}
+
+func (a *Aggregator) groupByColumns(groups []*group) []*schemapb.FieldData {
+	columns := make([]*schemapb.FieldData, 0, len(a.groupByFields))
+	for i, field := range a.groupByFields {
+		values := make([]any, len(groups))
+		for j, g := range groups {
+			values[j] = g.values[i]
+		}
+		column := newColumn(field.GetDataType(), values)
+		column.FieldName = field.GetName()
+		column.FieldId = field.GetFieldID()
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// stateType returns the data type of the accumulated value of the i-th aggregate.
+func (a *Aggregator) stateType(i int) schemapb.DataType {
+	field := a.aggFields[i]
+	switch a.aggregates[i].GetOp() {
+	case internalpb.Aggregate_Count:
+		return schemapb.DataType_Int64
+	case internalpb.Aggregate_Sum:
+		if typeutil.IsIntegerType(field.GetDataType()) {
+			return schemapb.DataType_Int64
+		}
+		return schemapb.DataType_Double
+	case internalpb.Aggregate_Avg:
+		return schemapb.DataType_Double
+	default:
+		return field.GetDataType()
+	}
+}
+
+func stateColumnNum(op internalpb.Aggregate_Op) int {
+	if op == internalpb.Aggregate_Count {
+		return 1
+	}
+	return 2
+}
+
+// merge merges count values accumulated into value.
+func (s *state) merge(op internalpb.Aggregate_Op, count int64, value any) {
+	s.count += count
+	if op == internalpb.Aggregate_Avg {
+		if i, ok := value.(int64); ok {
+			value = float64(i)
+		}
+	}
+	if s.value == nil {
+		s.value = value
+		return
+	}
+	switch op {
+	case internalpb.Aggregate_Sum, internalpb.Aggregate_Avg:
+		switch v := value.(type) {
+		case int64:
+			s.value = s.value.(int64) + v
+		case float64:
+			s.value = s.value.(float64) + v
+		}
+	case internalpb.Aggregate_Min:
+		if compareValues(value, s.value) < 0 {
+			s.value = value
+		}
+	case internalpb.Aggregate_Max:
+		if compareValues(value, s.value) > 0 {
+			s.value = value
+		}
+	}
+}
+
+// getValue returns the idx-th value of the column as bool, int64, float64 or string, nil if it is null.
+func getValue(fieldData *schemapb.FieldData, idx int64) any {
+	if validData := fieldData.GetValidData(); len(validData) > 0 && !validData[idx] {
+		return nil
+	}
+	scalars := fieldData.GetScalars()
+	switch scalars.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return scalars.GetBoolData().GetData()[idx]
+	case *schemapb.ScalarField_IntData:
+		return int64(scalars.GetIntData().GetData()[idx])
+	case *schemapb.ScalarField_LongData:
+		return scalars.GetLongData().GetData()[idx]
+	case *schemapb.ScalarField_FloatData:
+		return float64(scalars.GetFloatData().GetData()[idx])
+	case *schemapb.ScalarField_DoubleData:
+		return scalars.GetDoubleData().GetData()[idx]
+	case *schemapb.ScalarField_StringData:
+		return scalars.GetStringData().GetData()[idx]
+	}
+	return nil
+}
+
+// compareValues compares two values of the same type, nulls go last.
+func compareValues(a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	switch x := a.(type) {
+	case bool:
+		y := b.(bool)
+		if x == y {
+			return 0
+		} else if !x {
+			return -1
+		}
+		return 1
+	case int64:
+		return cmp.Compare(x, b.(int64))
+	case float64:
+		return cmp.Compare(x, b.(float64))
+	case string:
+		return cmp.Compare(x, b.(string))
+	}
+	return 0
+}
+
+// newColumn creates a column of the values, nil values are marked as invalid.
+func newColumn(dataType schemapb.DataType, values []any) *schemapb.FieldData {
+	var validData []bool
+	for i, value := range values {
+		if value == nil {
+			if validData == nil {
+				validData = make([]bool, len(values))
+				for j := 0; j < i; j++ {
+					validData[j] = true
+				}
+			}
+			continue
+		}
+		if validData != nil {
+			validData[i] = true
+		}
+	}
+
+	scalars := &schemapb.ScalarField{}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		data := make([]bool, len(values))
+		for i, value := range values {
+			if value != nil {
+				data[i] = value.(bool)
+			}
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := make([]int32, len(values))
+		for i, value := range values {
+			if value != nil {
+				data[i] = int32(value.(int64))
+			}
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, len(values))
+		for i, value := range values {
+			if value != nil {
+				data[i] = value.(int64)
+			}
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_Float:
+		data := make([]float32, len(values))
+		for i, value := range values {
+			if value != nil {
+				data[i] = float32(value.(float64))
+			}
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case schemapb.DataType_Double:
+		data := make([]float64, len(values))
+		for i, value := range values {
+			if value != nil {
+				data[i] = value.(float64)
+			}
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, len(values))
+		for i, value := range values {
+			if value != nil {
+				data[i] = value.(string)
+			}
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	}
+	return &schemapb.FieldData{
+		Type:      dataType,
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+		ValidData: validData,
+	}
+}
@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func newTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "price", DataType: schemapb.DataType_Double, Nullable: true},
+			{FieldID: 103, Name: "qty", DataType: schemapb.DataType_Int32},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+}
+
+func newTestRows(categories []string, prices []any, qty []int32) []*schemapb.FieldData {
+	category := newColumn(schemapb.DataType_VarChar, lo2any(categories))
+	category.FieldId = 101
+	price := newColumn(schemapb.DataType_Double, prices)
+	price.FieldId = 102
+	quantity := newColumn(schemapb.DataType_Int32, lo2any(qty))
+	quantity.FieldId = 103
+	return []*schemapb.FieldData{category, price, quantity}
+}
+
+func lo2any[T any](values []T) []any {
+	ret := make([]any, len(values))
+	for i, v := range values {
+		switch x := any(v).(type) {
+		case int32:
+			ret[i] = int64(x)
+		default:
+			ret[i] = x
+		}
+	}
+	return ret
+}
+
+func TestAggregator(t *testing.T) {
+	schema := newTestSchema()
+	groupBy := []int64{101}
+	aggregates := []*internalpb.Aggregate{
+		{Op: internalpb.Aggregate_Count},
+		{Op: internalpb.Aggregate_Count, FieldId: 102},
+		{Op: internalpb.Aggregate_Sum, FieldId: 103},
+		{Op: internalpb.Aggregate_Avg, FieldId: 102},
+		{Op: internalpb.Aggregate_Min, FieldId: 102},
+		{Op: internalpb.Aggregate_Max, FieldId: 103},
+	}
+
+	// two query nodes accumulate their entities
+	node1, err := NewAggregator(schema, groupBy, aggregates, typeutil.Unlimited)
+	require.NoError(t, err)
+	require.NoError(t, node1.AddRows(newTestRows([]string{"a", "b", "a"}, []any{1.0, nil, 3.0}, []int32{1, 2, 3}), []int64{0, 1, 2}))
+	node2, err := NewAggregator(schema, groupBy, aggregates, typeutil.Unlimited)
+	require.NoError(t, err)
+	require.NoError(t, node2.AddRows(newTestRows([]string{"b", "c", "a"}, []any{nil, 4.0, 0.5}, []int32{4, 5, 6}), []int64{0, 1}))
+
+	proxy, err := NewAggregator(schema, groupBy, aggregates, typeutil.Unlimited)
+	require.NoError(t, err)
+	require.NoError(t, proxy.AddPartial(node1.Partial()))
+	require.NoError(t, proxy.AddPartial(node2.Partial()))
+	columns := proxy.Final(0, typeutil.Unlimited)
+	require.Equal(t, 7, len(columns))
+
+	assert.Equal(t, []string{"a", "b", "c"}, columns[0].GetScalars().GetStringData().GetData())
+	assert.Equal(t, []int64{2, 2, 1}, columns[1].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{2, 0, 1}, columns[2].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{4, 6, 5}, columns[3].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []float64{2.0, 0, 4.0}, columns[4].GetScalars().GetDoubleData().GetData())
+	assert.Equal(t, []bool{true, false, true}, columns[4].GetValidData())
+	assert.Equal(t, []float64{1.0, 0, 4.0}, columns[5].GetScalars().GetDoubleData().GetData())
+	assert.Equal(t, []bool{true, false, true}, columns[5].GetValidData())
+	assert.Equal(t, schemapb.DataType_Int32, columns[6].GetType())
+	assert.Equal(t, []int32{3, 4, 5}, columns[6].GetScalars().GetIntData().GetData())
+
+	columns = proxy.Final(1, 1)
+	assert.Equal(t, []string{"b"}, columns[0].GetScalars().GetStringData().GetData())
+	columns = proxy.Final(5, 1)
+	assert.Empty(t, columns[0].GetScalars().GetStringData().GetData())
+
+	err = proxy.AddPartial(node1.Partial()[:2])
+	assert.Error(t, err)
+	err = proxy.AddRows(newTestRows([]string{"a"}, []any{1.0}, []int32{1})[:1], []int64{0})
+	assert.Error(t, err)
+}
+
+func TestAggregator_NoGroupBy(t *testing.T) {
+	aggregates := []*internalpb.Aggregate{
+		{Op: internalpb.Aggregate_Count},
+		{Op: internalpb.Aggregate_Sum, FieldId: 102},
+	}
+	a, err := NewAggregator(newTestSchema(), nil, aggregates, typeutil.Unlimited)
+	require.NoError(t, err)
+
+	// the aggregates of no entity
+	columns := a.Final(0, typeutil.Unlimited)
+	assert.Equal(t, []int64{0}, columns[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []bool{false}, columns[1].GetValidData())
+
+	require.NoError(t, a.AddRows(newTestRows([]string{"a", "b"}, []any{1.5, 2.0}, []int32{1, 2}), []int64{0, 1}))
+	columns = a.Final(0, typeutil.Unlimited)
+	assert.Equal(t, []int64{2}, columns[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []float64{3.5}, columns[1].GetScalars().GetDoubleData().GetData())
+}
+
+func TestAggregator_MaxGroups(t *testing.T) {
+	aggregates := []*internalpb.Aggregate{{Op: internalpb.Aggregate_Count}}
+	node, err := NewAggregator(newTestSchema(), []int64{101}, aggregates, 2)
+	require.NoError(t, err)
+	require.NoError(t, node.AddRows(newTestRows([]string{"a", "b", "a"}, []any{1.0, 2.0, 3.0}, []int32{1, 2, 3}), []int64{0, 1, 2}))
+	err = node.AddRows(newTestRows([]string{"c"}, []any{1.0}, []int32{1}), []int64{0})
+	assert.ErrorIs(t, err, merr.ErrParameterTooLarge)
+
+	proxy, err := NewAggregator(newTestSchema(), []int64{101}, aggregates, 1)
+	require.NoError(t, err)
+	err = proxy.AddPartial(node.Partial())
+	assert.ErrorIs(t, err, merr.ErrParameterTooLarge)
+}
+
+func TestCheckAggregate(t *testing.T) {
+	schema := newTestSchema()
+	_, err := NewAggregator(schema, []int64{104}, nil, typeutil.Unlimited)
+	assert.Error(t, err)
+	_, err = NewAggregator(schema, nil, []*internalpb.Aggregate{{Op: internalpb.Aggregate_Sum}}, typeutil.Unlimited)
+	assert.Error(t, err)
+	_, err = NewAggregator(schema, nil, []*internalpb.Aggregate{{Op: internalpb.Aggregate_Avg, FieldId: 101}}, typeutil.Unlimited)
+	assert.Error(t, err)
+	_, err = NewAggregator(schema, nil, []*internalpb.Aggregate{{Op: internalpb.Aggregate_Count, FieldId: 104}}, typeutil.Unlimited)
+	assert.Error(t, err)
+	_, err = NewAggregator(schema, nil, []*internalpb.Aggregate{{Op: internalpb.Aggregate_Max, FieldId: 101}}, typeutil.Unlimited)
+	assert.NoError(t, err)
+}
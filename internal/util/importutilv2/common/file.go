@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/compressor"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const (
+	GzipFileExt = ".gz"
+	ZstdFileExt = ".zst"
+)
+
+// GetCompressType gets the compress type of the file by the extension, and the path without the compress extension.
+// The compress type is empty if the file isn't compressed.
+func GetCompressType(path string) (compressor.CompressType, string) {
+	switch ext := filepath.Ext(path); ext {
+	case GzipFileExt:
+		return compressor.CompressTypeGzip, path[:len(path)-len(ext)]
+	case ZstdFileExt:
+		return compressor.CompressTypeZstd, path[:len(path)-len(ext)]
+	}
+	return "", path
+}
+
+type fileReader struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r *fileReader) Close() error {
+	var err error
+	for i := len(r.closers) - 1; i >= 0; i-- {
+		if e := r.closers[i].Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// OpenFile opens the import file, the file compressed by gzip or zstd is decompressed transparently.
+func OpenFile(ctx context.Context, cm storage.ChunkManager, path string) (io.ReadCloser, error) {
+	r, err := cm.Reader(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	compressType, _ := GetCompressType(path)
+	if compressType == "" {
+		return r, nil
+	}
+	dr, err := compressor.NewDecompressReader(r, compressType)
+	if err != nil {
+		r.Close()
+		return nil, fmt.Errorf("failed to decompress %s file: %w", compressType, err)
+	}
+	return &fileReader{Reader: dr, closers: []io.Closer{r, dr}}, nil
+}
+
+// estimatedCompressRatio is the compress ratio assumed for the compressed file which doesn't record the size
+// before compression.
+const estimatedCompressRatio = 4
+
+// GetFileSize gets the size of the import file, the size of the compressed file is the size before compression,
+// which reflects the amount of the data to import. The file isn't decompressed to get it: the size recorded by
+// the gzip trailer or the zstd frame header is used, or it's estimated by the compressed size if not recorded.
+func GetFileSize(ctx context.Context, cm storage.ChunkManager, path string) (int64, error) {
+	size, err := cm.Size(ctx, path)
+	if err != nil {
+		return 0, err
+	}
+	compressType, _ := GetCompressType(path)
+	if compressType == "" || size == 0 {
+		return size, nil
+	}
+	var recorded int64
+	switch compressType {
+	case compressor.CompressTypeGzip:
+		recorded, err = getGzipRecordedSize(ctx, cm, path, size)
+	case compressor.CompressTypeZstd:
+		recorded, err = getZstdRecordedSize(ctx, cm, path, size)
+	}
+	if err != nil {
+		return 0, merr.WrapErrImportFailed(fmt.Sprintf("read file failed, path=%s, err=%s", path, err.Error()))
+	}
+	if recorded > 0 {
+		return recorded, nil
+	}
+	return size * estimatedCompressRatio, nil
+}
+
+// getGzipRecordedSize gets the size before compression from the ISIZE of the gzip trailer, which is the size
+// modulo 2^32, so it's lifted above the compressed size for the file larger than 4GB. Only the last member of
+// a multi-member gzip file is counted, and 0 is returned if the file is too small to have a trailer.
+func getGzipRecordedSize(ctx context.Context, cm storage.ChunkManager, path string, size int64) (int64, error) {
+	const trailerSize = 4
+	if size < trailerSize {
+		return 0, nil
+	}
+	trailer, err := cm.ReadAt(ctx, path, size-trailerSize, trailerSize)
+	if err != nil {
+		return 0, err
+	}
+	recorded := int64(binary.LittleEndian.Uint32(trailer))
+	for recorded < size {
+		recorded += 1 << 32
+	}
+	return recorded, nil
+}
+
+// getZstdRecordedSize gets the size before compression from the frame content size of the first zstd frame,
+// 0 is returned if the frame header doesn't record it, e.g. the file compressed from a stream.
+func getZstdRecordedSize(ctx context.Context, cm storage.ChunkManager, path string, size int64) (int64, error) {
+	header, err := cm.ReadAt(ctx, path, 0, min(size, zstd.HeaderMaxSize))
+	if err != nil {
+		return 0, err
+	}
+	var h zstd.Header
+	if err := h.Decode(header); err != nil {
+		return 0, err
+	}
+	if !h.HasFCS {
+		return 0, nil
+	}
+	return int64(h.FrameContentSize), nil
+}
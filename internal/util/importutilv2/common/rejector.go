@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// RejectedRow is an invalid row skipped by the import.
+type RejectedRow struct {
+	File string `json:"file"`
+	// the row number in the file, zero if the row is identified by the primary key
+	Row    int64  `json:"row,omitempty"`
+	PK     any    `json:"pk,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// rejectsPartSize is the max size of a part of the rejects file, the rejected rows are buffered
+// up to the size before they are written out, so the memory used by the rejector is bounded.
+const rejectsPartSize = 4 * 1024 * 1024
+
+// RejectsWriter writes a part of the rejected rows, the parts are numbered from 0.
+type RejectsWriter func(part int, content []byte) error
+
+// Rejector decides whether the invalid rows of an import file are skipped, and records the skipped rows.
+// A nil Rejector skips no row, the first invalid row fails the import.
+// The rejected rows are only counted unless a RejectsWriter is set.
+type Rejector struct {
+	// the max number of the invalid rows, negative means no limit
+	maxErrorRows int64
+	// the max ratio of the invalid rows to all the rows
+	maxErrorRatio float64
+
+	rejectedRows int64
+	writer       RejectsWriter
+	buf          bytes.Buffer
+	parts        int
+}
+
+func NewRejector(maxErrorRows int64, maxErrorRatio float64) *Rejector {
+	return &Rejector{
+		maxErrorRows:  maxErrorRows,
+		maxErrorRatio: maxErrorRatio,
+	}
+}
+
+// WithWriter sets the writer of the rejected rows, the rows are written as line-delimited JSON.
+func (r *Rejector) WithWriter(writer RejectsWriter) *Rejector {
+	if r != nil {
+		r.writer = writer
+	}
+	return r
+}
+
+// Reject records the invalid row, it returns an error if the row can't be skipped.
+// The row number starts from 1, it's the line number for the line-based files.
+func (r *Rejector) Reject(file string, row int64, err error) error {
+	if r == nil {
+		return err
+	}
+	return r.record(&RejectedRow{File: file, Row: row, Reason: err.Error()}, err)
+}
+
+// RejectPK records the invalid row identified by the primary key, pk is nil if the primary key is auto-generated.
+func (r *Rejector) RejectPK(file string, pk any, err error) error {
+	if r == nil {
+		return err
+	}
+	return r.record(&RejectedRow{File: file, PK: pk, Reason: err.Error()}, err)
+}
+
+func (r *Rejector) record(row *RejectedRow, err error) error {
+	r.rejectedRows++
+	if r.maxErrorRows >= 0 && r.rejectedRows > r.maxErrorRows {
+		return merr.WrapErrImportFailed(fmt.Sprintf("the number of invalid rows exceeds max_error_rows %d, the last error: %s",
+			r.maxErrorRows, err.Error()))
+	}
+	if r.writer == nil {
+		return nil
+	}
+	if err := json.NewEncoder(&r.buf).Encode(row); err != nil {
+		return err
+	}
+	if r.buf.Len() >= rejectsPartSize {
+		return r.Flush()
+	}
+	return nil
+}
+
+// Flush writes out the buffered rejected rows as a new part.
+func (r *Rejector) Flush() error {
+	if r == nil || r.writer == nil || r.buf.Len() == 0 {
+		return nil
+	}
+	if err := r.writer(r.parts, r.buf.Bytes()); err != nil {
+		return err
+	}
+	r.parts++
+	r.buf.Reset()
+	return nil
+}
+
+// Check checks the ratio of the invalid rows after all the rows are read.
+func (r *Rejector) Check(acceptedRows int64) error {
+	return r.CheckLimits(r.RejectedRows(), acceptedRows)
+}
+
+// CheckLimits checks the numbers of the invalid rows and the valid rows of a file against the limits,
+// a nil Rejector allows no invalid row.
+func (r *Rejector) CheckLimits(rejectedRows, acceptedRows int64) error {
+	if rejectedRows == 0 {
+		return nil
+	}
+	if r == nil {
+		return merr.WrapErrImportFailed(fmt.Sprintf("%d invalid rows are found", rejectedRows))
+	}
+	if r.maxErrorRows >= 0 && rejectedRows > r.maxErrorRows {
+		return merr.WrapErrImportFailed(fmt.Sprintf("the number of invalid rows %d exceeds max_error_rows %d",
+			rejectedRows, r.maxErrorRows))
+	}
+	if ratio := float64(rejectedRows) / float64(rejectedRows+acceptedRows); ratio > r.maxErrorRatio {
+		return merr.WrapErrImportFailed(fmt.Sprintf("the ratio of invalid rows %d/%d exceeds max_error_ratio %v",
+			rejectedRows, rejectedRows+acceptedRows, r.maxErrorRatio))
+	}
+	return nil
+}
+
+func (r *Rejector) RejectedRows() int64 {
+	if r == nil {
+		return 0
+	}
+	return r.rejectedRows
+}
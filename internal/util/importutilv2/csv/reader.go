@@ -0,0 +1,193 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type Row = map[storage.FieldID]any
+
+type reader struct {
+	ctx    context.Context
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+
+	fileSize *atomic.Int64
+	filePath string
+	r        io.ReadCloser
+	cr       *csv.Reader
+	quote    byte
+
+	bufferSize int
+	count      int64
+
+	parser   RowParser
+	rejector *common.Rejector
+}
+
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema,
+	path string, bufferSize int, sep rune, quote byte, nullKey string, rejector *common.Rejector,
+) (*reader, error) {
+	r, err := common.OpenFile(ctx, cm, path)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("read csv file failed, path=%s, err=%s", path, err.Error()))
+	}
+	count, err := estimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var src io.Reader = r
+	if quote != DefaultQuote {
+		src = &quoteSwapReader{r: r, quote: quote}
+	}
+	cr := csv.NewReader(src)
+	cr.Comma = sep
+	cr.ReuseRecord = true
+
+	reader := &reader{
+		ctx:        ctx,
+		cm:         cm,
+		schema:     schema,
+		fileSize:   atomic.NewInt64(0),
+		filePath:   path,
+		r:          r,
+		cr:         cr,
+		quote:      quote,
+		bufferSize: bufferSize,
+		count:      count,
+		rejector:   rejector,
+	}
+	header, err := reader.readRecord()
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read csv header, path=%s, err=%v", path, err))
+	}
+	// the record is reused by the csv reader, copy the header out
+	header = append([]string(nil), header...)
+	log.Info("csv header parsed", zap.String("path", path), zap.Strings("header", header))
+
+	reader.parser, err = NewRowParser(schema, header, nullKey)
+	if err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (r *reader) readRecord() ([]string, error) {
+	record, err := r.cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	if r.quote != DefaultQuote {
+		for i := range record {
+			record[i] = swapQuote(record[i], r.quote)
+		}
+	}
+	return record, nil
+}
+
+func (r *reader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertData(r.schema)
+	if err != nil {
+		return nil, err
+	}
+	var cnt int64 = 0
+	for {
+		record, err := r.readRecord()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			// the reader continues with the next record after a malformed one
+			if err = r.rejector.Reject(r.filePath, int64(parseErr.StartLine), err); err != nil {
+				return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read csv record, err=%v", err))
+			}
+			continue
+		}
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read csv record, err=%v", err))
+		}
+		row, err := r.parser.Parse(record)
+		if err != nil {
+			line, _ := r.cr.FieldPos(0)
+			if err = r.rejector.Reject(r.filePath, int64(line), err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		err = insertData.Append(row)
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, err=%s", err.Error()))
+		}
+		cnt++
+		if cnt >= r.count {
+			cnt = 0
+			if insertData.GetMemorySize() >= r.bufferSize {
+				break
+			}
+		}
+	}
+	if insertData.GetRowNum() == 0 {
+		return nil, io.EOF
+	}
+	return insertData, nil
+}
+
+func (r *reader) Size() (int64, error) {
+	if size := r.fileSize.Load(); size != 0 {
+		return size, nil
+	}
+	size, err := common.GetFileSize(r.ctx, r.cm, r.filePath)
+	if err != nil {
+		return 0, err
+	}
+	r.fileSize.Store(size)
+	return size, nil
+}
+
+func (r *reader) Close() {
+	if r.r != nil {
+		r.r.Close()
+	}
+}
+
+func estimateReadCountPerBatch(bufferSize int, schema *schemapb.CollectionSchema) (int64, error) {
+	sizePerRecord, err := typeutil.EstimateMaxSizePerRecord(schema)
+	if err != nil {
+		return 0, err
+	}
+	if 1000*sizePerRecord <= bufferSize {
+		return 1000, nil
+	}
+	return int64(bufferSize) / int64(sizePerRecord), nil
+}
@@ -0,0 +1,468 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type RowParser interface {
+	Parse(record []string) (Row, error)
+}
+
+type rowParser struct {
+	header  []string
+	nullKey string
+
+	id2Dim   map[int64]int
+	id2Field map[int64]*schemapb.FieldSchema
+	// index of the column in the header -> field id, only for the columns that map to a schema field
+	index2FieldID map[int]int64
+	// field id -> default value, for the fields that are absent from the header
+	id2Default   map[int64]any
+	pkField      *schemapb.FieldSchema
+	dynamicField *schemapb.FieldSchema
+}
+
+// NewRowParser creates a RowParser that maps the csv columns to the schema fields by the header.
+// A column whose value equals nullKey is treated as absent, nullKey is ignored if it is empty.
+func NewRowParser(schema *schemapb.CollectionSchema, header []string, nullKey string) (RowParser, error) {
+	id2Field := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) int64 {
+		return field.GetFieldID()
+	})
+
+	id2Dim := make(map[int64]int)
+	for id, field := range id2Field {
+		if typeutil.IsVectorType(field.GetDataType()) && !typeutil.IsSparseFloatVectorType(field.GetDataType()) {
+			dim, err := typeutil.GetDim(field)
+			if err != nil {
+				return nil, err
+			}
+			id2Dim[id] = int(dim)
+		}
+	}
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	dynamicField := typeutil.GetDynamicField(schema)
+
+	name2Field := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) string {
+		return field.GetName()
+	})
+
+	index2FieldID := make(map[int]int64)
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if _, ok := columns[name]; ok {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("duplicated column '%s' in the csv header", name))
+		}
+		columns[name] = i
+		field, ok := name2Field[name]
+		if !ok {
+			if dynamicField == nil {
+				return nil, merr.WrapErrImportFailed(fmt.Sprintf("the field '%s' is not defined in schema", name))
+			}
+			continue
+		}
+		if field.GetIsPrimaryKey() && field.GetAutoID() {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("the primary key '%s' is auto-generated, no need to provide", name))
+		}
+		if field.GetIsDynamic() {
+			// the dynamic field column is merged with the unknown columns
+			continue
+		}
+		index2FieldID[i] = field.GetFieldID()
+	}
+
+	id2Default := make(map[int64]any)
+	for _, field := range schema.GetFields() {
+		if _, ok := columns[field.GetName()]; ok || field.GetIsDynamic() ||
+			(field.GetIsPrimaryKey() && field.GetAutoID()) {
+			continue
+		}
+		value, err := getDefaultValue(field)
+		if err != nil {
+			return nil, err
+		}
+		id2Default[field.GetFieldID()] = value
+	}
+
+	return &rowParser{
+		header:        header,
+		nullKey:       nullKey,
+		id2Dim:        id2Dim,
+		id2Field:      id2Field,
+		index2FieldID: index2FieldID,
+		id2Default:    id2Default,
+		pkField:       pkField,
+		dynamicField:  dynamicField,
+	}, nil
+}
+
+func getDefaultValue(field *schemapb.FieldSchema) (any, error) {
+	defaultValue := field.GetDefaultValue()
+	if defaultValue == nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("value of field '%s' is missed", field.GetName()))
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return defaultValue.GetBoolData(), nil
+	case schemapb.DataType_Int8:
+		return int8(defaultValue.GetIntData()), nil
+	case schemapb.DataType_Int16:
+		return int16(defaultValue.GetIntData()), nil
+	case schemapb.DataType_Int32:
+		return defaultValue.GetIntData(), nil
+	case schemapb.DataType_Int64:
+		return defaultValue.GetLongData(), nil
+	case schemapb.DataType_Float:
+		return defaultValue.GetFloatData(), nil
+	case schemapb.DataType_Double:
+		return defaultValue.GetDoubleData(), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return defaultValue.GetStringData(), nil
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("default value is not supported for field '%s' with type '%s'",
+			field.GetName(), field.GetDataType().String()))
+	}
+}
+
+func (r *rowParser) wrapTypeError(v any, fieldID int64) error {
+	field := r.id2Field[fieldID]
+	return merr.WrapErrImportFailed(fmt.Sprintf("expected type '%s' for field '%s', got value '%v'",
+		field.GetDataType().String(), field.GetName(), v))
+}
+
+func (r *rowParser) wrapDimError(actualDim int, fieldID int64) error {
+	field := r.id2Field[fieldID]
+	return merr.WrapErrImportFailed(fmt.Sprintf("expected dim '%d' for field '%s' with type '%s', got dim '%d'",
+		r.id2Dim[fieldID], field.GetName(), field.GetDataType().String(), actualDim))
+}
+
+func (r *rowParser) Parse(record []string) (Row, error) {
+	if len(record) != len(r.header) {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("the number of values %d doesn't match the number of columns %d",
+			len(record), len(r.header)))
+	}
+	row := make(Row)
+	dynamicValues := make(map[string]string)
+	for i, value := range record {
+		isNull := r.nullKey != "" && value == r.nullKey
+		fieldID, ok := r.index2FieldID[i]
+		if !ok {
+			// unknown column or the dynamic field column
+			if !isNull {
+				dynamicValues[r.header[i]] = value
+			}
+			continue
+		}
+		if isNull {
+			data, err := getDefaultValue(r.id2Field[fieldID])
+			if err != nil {
+				return nil, merr.WrapErrImportFailed(fmt.Sprintf("the field '%s' doesn't accept null value",
+					r.id2Field[fieldID].GetName()))
+			}
+			row[fieldID] = data
+			continue
+		}
+		data, err := r.parseEntity(fieldID, value)
+		if err != nil {
+			return nil, err
+		}
+		row[fieldID] = data
+	}
+	for fieldID, value := range r.id2Default {
+		row[fieldID] = value
+	}
+	if r.dynamicField == nil {
+		return row, nil
+	}
+	err := r.combineDynamicRow(dynamicValues, row)
+	if err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func (r *rowParser) combineDynamicRow(dynamicValues map[string]string, row Row) error {
+	// Combine the dynamic field value
+	// valid inputs:
+	// case 1: id,vector,x,$meta        1,[],8,"{""y"": 8}" ==>> {"id": 1, "vector": [], "$meta": "{\"y\": 8, \"x\": 8}"}
+	// case 2: id,vector,$meta          1,[],"{""x"": 8}"   ==>> {"id": 1, "vector": [], "$meta": "{\"x\": 8}"}
+	// case 3: id,vector,x              1,[],8              ==>> {"id": 1, "vector": [], "$meta": "{\"x\": 8}"}
+	// case 4: id,vector                1,[]                ==>> {"id": 1, "vector": [], "$meta": "{}"}
+	// invalid inputs:
+	// case 5: id,vector,x,$meta        1,[],6,"{""x"": 8}" ==>> duplicated key is not allowed
+	// case 6: id,vector,$meta          1,[],8              ==>> not a JSON object
+	dynamicFieldID := r.dynamicField.GetFieldID()
+	mp := make(map[string]any)
+	if str, ok := dynamicValues[r.dynamicField.GetName()]; ok {
+		if err := decodeJSON(str, &mp); err != nil {
+			return merr.WrapErrImportFailed("illegal value for dynamic field, not a JSON object")
+		}
+		if mp == nil {
+			mp = make(map[string]any)
+		}
+		delete(dynamicValues, r.dynamicField.GetName())
+	}
+	for k, v := range dynamicValues {
+		if _, ok := mp[k]; ok {
+			return merr.WrapErrImportFailed(fmt.Sprintf("duplicated key is not allowed, key=%s", k))
+		}
+		mp[k] = parseDynamicValue(v)
+	}
+	bs, err := json.Marshal(mp)
+	if err != nil {
+		return merr.WrapErrImportFailed(fmt.Sprintf("failed to marshal dynamic field, err=%v", err))
+	}
+	row[dynamicFieldID] = bs
+	return nil
+}
+
+// parseDynamicValue keeps the JSON type of the value if it is a valid JSON literal,
+// e.g. 8 is stored as number and true is stored as bool, otherwise the raw string is stored.
+func parseDynamicValue(value string) any {
+	dec := json.NewDecoder(strings.NewReader(value))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return value
+	}
+	return v
+}
+
+// decodeJSON decodes a JSON array or object cell, numbers are kept as json.Number to avoid precision loss.
+func decodeJSON(value string, v any) error {
+	dec := json.NewDecoder(strings.NewReader(value))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func (r *rowParser) parseEntity(fieldID int64, value string) (any, error) {
+	field := r.id2Field[fieldID]
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return b, nil
+	case schemapb.DataType_Int8:
+		num, err := strconv.ParseInt(value, 0, 8)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return int8(num), nil
+	case schemapb.DataType_Int16:
+		num, err := strconv.ParseInt(value, 0, 16)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return int16(num), nil
+	case schemapb.DataType_Int32:
+		num, err := strconv.ParseInt(value, 0, 32)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return int32(num), nil
+	case schemapb.DataType_Int64:
+		num, err := strconv.ParseInt(value, 0, 64)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return num, nil
+	case schemapb.DataType_Float:
+		num, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		if err = typeutil.VerifyFloat(num); err != nil {
+			return nil, err
+		}
+		return float32(num), nil
+	case schemapb.DataType_Double:
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		if err = typeutil.VerifyFloat(num); err != nil {
+			return nil, err
+		}
+		return num, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return value, nil
+	case schemapb.DataType_JSON:
+		var dummy any
+		if err := json.Unmarshal([]byte(value), &dummy); err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return []byte(value), nil
+	case schemapb.DataType_BinaryVector:
+		// json.Unmarshal decodes []uint8 from a base64 string, decode into []uint16 to accept a number array
+		var arr []uint16
+		if err := json.Unmarshal([]byte(value), &arr); err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		if len(arr) != r.id2Dim[fieldID]/8 {
+			return nil, r.wrapDimError(len(arr)*8, fieldID)
+		}
+		vec := make([]byte, 0, len(arr))
+		for _, v := range arr {
+			if v > 255 {
+				return nil, r.wrapTypeError(value, fieldID)
+			}
+			vec = append(vec, byte(v))
+		}
+		return vec, nil
+	case schemapb.DataType_FloatVector:
+		var vec []float32
+		if err := json.Unmarshal([]byte(value), &vec); err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		if len(vec) != r.id2Dim[fieldID] {
+			return nil, r.wrapDimError(len(vec), fieldID)
+		}
+		return vec, nil
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		var arr []float32
+		if err := json.Unmarshal([]byte(value), &arr); err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		if len(arr) != r.id2Dim[fieldID] {
+			return nil, r.wrapDimError(len(arr), fieldID)
+		}
+		vec := make([]byte, len(arr)*2)
+		for i, f := range arr {
+			if field.GetDataType() == schemapb.DataType_Float16Vector {
+				copy(vec[i*2:], typeutil.Float32ToFloat16Bytes(f))
+			} else {
+				copy(vec[i*2:], typeutil.Float32ToBFloat16Bytes(f))
+			}
+		}
+		return vec, nil
+	case schemapb.DataType_SparseFloatVector:
+		var mp map[string]any
+		if err := decodeJSON(value, &mp); err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		vec, err := typeutil.CreateSparseFloatRowFromMap(mp)
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid sparse vector for field '%s', err=%v", field.GetName(), err))
+		}
+		return vec, nil
+	case schemapb.DataType_Array:
+		scalarFieldData, err := r.arrayToFieldData(value, field.GetElementType())
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return scalarFieldData, nil
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("parse csv failed, unsupport data type: %s",
+			field.GetDataType().String()))
+	}
+}
+
+func (r *rowParser) arrayToFieldData(value string, eleType schemapb.DataType) (*schemapb.ScalarField, error) {
+	switch eleType {
+	case schemapb.DataType_Bool:
+		var values []bool
+		if err := json.Unmarshal([]byte(value), &values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_BoolData{
+				BoolData: &schemapb.BoolArray{
+					Data: values,
+				},
+			},
+		}, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		var values []int32
+		if err := json.Unmarshal([]byte(value), &values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_IntData{
+				IntData: &schemapb.IntArray{
+					Data: values,
+				},
+			},
+		}, nil
+	case schemapb.DataType_Int64:
+		var values []int64
+		if err := json.Unmarshal([]byte(value), &values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{
+				LongData: &schemapb.LongArray{
+					Data: values,
+				},
+			},
+		}, nil
+	case schemapb.DataType_Float:
+		var values []float32
+		if err := json.Unmarshal([]byte(value), &values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_FloatData{
+				FloatData: &schemapb.FloatArray{
+					Data: values,
+				},
+			},
+		}, nil
+	case schemapb.DataType_Double:
+		var values []float64
+		if err := json.Unmarshal([]byte(value), &values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_DoubleData{
+				DoubleData: &schemapb.DoubleArray{
+					Data: values,
+				},
+			},
+		}, nil
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		var values []string
+		if err := json.Unmarshal([]byte(value), &values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{
+				StringData: &schemapb.StringArray{
+					Data: values,
+				},
+			},
+		}, nil
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("unsupported array data type '%s'", eleType.String()))
+	}
+}
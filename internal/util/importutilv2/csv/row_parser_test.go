@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func newTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      1,
+				Name:         "id",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    2,
+				Name:       "vector",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+			{
+				FieldID:  3,
+				Name:     "age",
+				DataType: schemapb.DataType_Int32,
+				DefaultValue: &schemapb.ValueField{
+					Data: &schemapb.ValueField_IntData{IntData: 18},
+				},
+			},
+			{
+				FieldID:   4,
+				Name:      "$meta",
+				IsDynamic: true,
+				DataType:  schemapb.DataType_JSON,
+			},
+		},
+	}
+}
+
+func TestRowParser_Parse_Valid(t *testing.T) {
+	schema := newTestSchema()
+
+	type testCase struct {
+		header   []string
+		record   []string
+		age      int32
+		dyFields map[string]any
+	}
+
+	cases := []testCase{
+		{
+			header:   []string{"id", "vector", "age", "x", "$meta"},
+			record:   []string{"1", "[0.1, 0.2]", "20", "8", `{"y": "a"}`},
+			age:      20,
+			dyFields: map[string]any{"x": float64(8), "y": "a"},
+		},
+		{
+			header:   []string{"id", "vector", "$meta"},
+			record:   []string{"1", "[0.1, 0.2]", `{"x": 8}`},
+			age:      18,
+			dyFields: map[string]any{"x": float64(8)},
+		},
+		{
+			header:   []string{"id", "vector", "age", "x", "z"},
+			record:   []string{"1", "[0.1, 0.2]", "NULL", "abc", "NULL"},
+			age:      18,
+			dyFields: map[string]any{"x": "abc"},
+		},
+		{
+			header:   []string{"id", "vector"},
+			record:   []string{"1", "[0.1, 0.2]"},
+			age:      18,
+			dyFields: map[string]any{},
+		},
+	}
+
+	for _, c := range cases {
+		r, err := NewRowParser(schema, c.header, "NULL")
+		assert.NoError(t, err)
+		row, err := r.Parse(c.record)
+		assert.NoError(t, err)
+
+		assert.Equal(t, int64(1), row[1])
+		assert.Equal(t, []float32{0.1, 0.2}, row[2])
+		assert.Equal(t, c.age, row[3])
+
+		var dynamicFields map[string]any
+		err = json.Unmarshal(row[4].([]byte), &dynamicFields)
+		assert.NoError(t, err)
+		assert.Equal(t, c.dyFields, dynamicFields)
+	}
+}
+
+func TestRowParser_Parse_Invalid(t *testing.T) {
+	schema := newTestSchema()
+
+	type testCase struct {
+		header    []string
+		record    []string
+		expectErr string
+	}
+
+	cases := []testCase{
+		{header: []string{"id", "vector", "x", "$meta"}, record: []string{"1", "[0.1, 0.2]", "6", `{"x": 8}`}, expectErr: "duplicated key is not allowed"},
+		{header: []string{"id", "vector", "$meta"}, record: []string{"1", "[0.1, 0.2]", "8"}, expectErr: "not a JSON object"},
+		{header: []string{"id", "vector"}, record: []string{"1", "[0.1, 0.2, 0.3]"}, expectErr: "expected dim '2'"},
+		{header: []string{"id", "vector"}, record: []string{"a", "[0.1, 0.2]"}, expectErr: "expected type 'Int64'"},
+		{header: []string{"id", "vector"}, record: []string{"NULL", "[0.1, 0.2]"}, expectErr: "doesn't accept null value"},
+		{header: []string{"id", "vector"}, record: []string{"1"}, expectErr: "doesn't match the number of columns"},
+	}
+
+	for _, c := range cases {
+		r, err := NewRowParser(schema, c.header, "NULL")
+		assert.NoError(t, err)
+		_, err = r.Parse(c.record)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), c.expectErr)
+	}
+}
+
+func TestRowParser_InvalidHeader(t *testing.T) {
+	schema := newTestSchema()
+
+	_, err := NewRowParser(schema, []string{"id", "vector", "id"}, "")
+	assert.ErrorContains(t, err, "duplicated column")
+
+	_, err = NewRowParser(schema, []string{"vector"}, "")
+	assert.ErrorContains(t, err, "value of field 'id' is missed")
+
+	schema.Fields = schema.Fields[:3]
+	_, err = NewRowParser(schema, []string{"id", "vector", "x"}, "")
+	assert.ErrorContains(t, err, "not defined in schema")
+
+	schema.Fields[0].AutoID = true
+	_, err = NewRowParser(schema, []string{"id", "vector"}, "")
+	assert.ErrorContains(t, err, "auto-generated")
+}
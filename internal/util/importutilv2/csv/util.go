@@ -0,0 +1,65 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"io"
+	"strings"
+)
+
+const (
+	DefaultSep    = ','
+	DefaultTSVSep = '\t'
+	DefaultQuote  = '"'
+)
+
+// quoteSwapReader exchanges the custom quote character with the standard double quote,
+// so that the standard csv reader, which only recognizes '"', can parse the content.
+// The parsed values must be swapped back by swapQuote.
+type quoteSwapReader struct {
+	r     io.Reader
+	quote byte
+}
+
+func (q *quoteSwapReader) Read(p []byte) (int, error) {
+	n, err := q.r.Read(p)
+	for i := 0; i < n; i++ {
+		switch p[i] {
+		case q.quote:
+			p[i] = DefaultQuote
+		case DefaultQuote:
+			p[i] = q.quote
+		}
+	}
+	return n, err
+}
+
+func swapQuote(value string, quote byte) string {
+	if strings.IndexByte(value, quote) < 0 && strings.IndexByte(value, DefaultQuote) < 0 {
+		return value
+	}
+	bs := []byte(value)
+	for i := range bs {
+		switch bs[i] {
+		case quote:
+			bs[i] = DefaultQuote
+		case DefaultQuote:
+			bs[i] = quote
+		}
+	}
+	return string(bs)
+}
@@ -0,0 +1,170 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// jsonlReader reads the line-delimited JSON file, every non-empty line of which is a row,
+// so the file can be produced without buffering all the rows.
+type jsonlReader struct {
+	ctx    context.Context
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+
+	fileSize *atomic.Int64
+	filePath string
+	r        io.ReadCloser
+	br       *bufio.Reader
+
+	bufferSize int
+	count      int64
+	line       int64
+
+	parser   RowParser
+	rejector *common.Rejector
+}
+
+func NewJSONLReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int, rejector *common.Rejector) (*jsonlReader, error) {
+	r, err := common.OpenFile(ctx, cm, path)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("read jsonl file failed, path=%s, err=%s", path, err.Error()))
+	}
+	count, err := estimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	parser, err := NewRowParser(schema)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return &jsonlReader{
+		ctx:        ctx,
+		cm:         cm,
+		schema:     schema,
+		fileSize:   atomic.NewInt64(0),
+		filePath:   path,
+		r:          r,
+		br:         bufio.NewReader(r),
+		bufferSize: bufferSize,
+		count:      count,
+		parser:     parser,
+		rejector:   rejector,
+	}, nil
+}
+
+// readLine reads the next non-empty line, it returns io.EOF at the end of the file.
+func (j *jsonlReader) readLine() ([]byte, error) {
+	for {
+		line, err := j.br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if len(line) > 0 {
+			j.line++
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			return line, nil
+		}
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+	}
+}
+
+func (j *jsonlReader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertData(j.schema)
+	if err != nil {
+		return nil, err
+	}
+	var cnt int64 = 0
+	for {
+		line, err := j.readLine()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read jsonl file, error: %v", err))
+		}
+		// Treat number value as a string instead of a float64, same as the JSON reader.
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.UseNumber()
+		var value any
+		if err = dec.Decode(&value); err != nil {
+			err = merr.WrapErrImportFailed(fmt.Sprintf("failed to parse row at line %d, error: %v", j.line, err))
+		} else if dec.More() {
+			err = merr.WrapErrImportFailed(fmt.Sprintf("invalid JSONL format, line %d contains more than one row", j.line))
+		}
+		var row Row
+		if err == nil {
+			row, err = j.parser.Parse(value)
+		}
+		if err != nil {
+			// the lines are independent, so the malformed lines can be skipped as well
+			if err = j.rejector.Reject(j.filePath, j.line, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		err = insertData.Append(row)
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, err=%s", err.Error()))
+		}
+		cnt++
+		if cnt >= j.count {
+			cnt = 0
+			if insertData.GetMemorySize() >= j.bufferSize {
+				break
+			}
+		}
+	}
+	if insertData.GetRowNum() == 0 {
+		return nil, io.EOF
+	}
+	return insertData, nil
+}
+
+func (j *jsonlReader) Size() (int64, error) {
+	if size := j.fileSize.Load(); size != 0 {
+		return size, nil
+	}
+	size, err := common.GetFileSize(j.ctx, j.cm, j.filePath)
+	if err != nil {
+		return 0, err
+	}
+	j.fileSize.Store(size)
+	return size, nil
+}
+
+func (j *jsonlReader) Close() {
+	j.r.Close()
+}
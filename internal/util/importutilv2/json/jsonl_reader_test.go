@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	importcommon "github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/testutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/compressor"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestJSONLReader(t *testing.T) {
+	paramtable.Init()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{
+				FieldID:    101,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "8"}},
+			},
+			{
+				FieldID:    102,
+				Name:       "str",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "128"}},
+			},
+		},
+	}
+	insertData, err := testutil.CreateInsertData(schema, 10)
+	require.NoError(t, err)
+	rows, err := testutil.CreateInsertDataRowsForJSON(schema, insertData)
+	require.NoError(t, err)
+
+	content := new(bytes.Buffer)
+	for i, row := range rows {
+		line, err := json.Marshal(row)
+		require.NoError(t, err)
+		content.Write(line)
+		if i%2 == 0 {
+			// empty lines are skipped
+			content.WriteString("\n")
+		}
+		content.WriteString("\n")
+	}
+
+	type mockReader struct {
+		io.Reader
+		io.Closer
+		io.ReaderAt
+		io.Seeker
+	}
+	read := func(path string, data []byte) (*storage.InsertData, int64) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (storage.FileReader, error) {
+			return &mockReader{Reader: bytes.NewReader(data), Closer: io.NopCloser(nil)}, nil
+		})
+		cm.EXPECT().Size(mock.Anything, mock.Anything).Return(int64(len(data)), nil).Maybe()
+		cm.EXPECT().ReadAt(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, s string, off int64, length int64) ([]byte, error) {
+				return data[off : off+length], nil
+			}).Maybe()
+		reader, err := NewJSONLReader(context.Background(), cm, schema, path, math.MaxInt, nil)
+		require.NoError(t, err)
+		defer reader.Close()
+		res, err := reader.Read()
+		require.NoError(t, err)
+		_, err = reader.Read()
+		assert.ErrorIs(t, err, io.EOF)
+		size, err := reader.Size()
+		require.NoError(t, err)
+		return res, size
+	}
+
+	res, size := read("mock.jsonl", content.Bytes())
+	assert.Equal(t, 10, res.GetRowNum())
+	assert.Equal(t, insertData.Data[100].GetRow(9), res.Data[100].GetRow(9))
+	assert.Equal(t, insertData.Data[102].GetRow(3), res.Data[102].GetRow(3))
+	assert.Equal(t, int64(content.Len()), size)
+
+	// the size of the compressed file is the size before compression recorded by the file
+	gz := new(bytes.Buffer)
+	w := gzip.NewWriter(gz)
+	_, err = w.Write(content.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	res, size = read("mock.jsonl.gz", gz.Bytes())
+	assert.Equal(t, 10, res.GetRowNum())
+	assert.Equal(t, int64(content.Len()), size)
+
+	zst := new(bytes.Buffer)
+	require.NoError(t, compressor.ZstdCompress(bytes.NewReader(content.Bytes()), zst))
+	res, size = read("mock.jsonl.zst", zst.Bytes())
+	assert.Equal(t, 10, res.GetRowNum())
+	assert.Equal(t, int64(content.Len()), size)
+
+	// the size is estimated if the zstd frame doesn't record it
+	header := []byte{0x28, 0xb5, 0x2f, 0xfd, 0x00, 0x00}
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().Size(mock.Anything, mock.Anything).Return(int64(len(header)), nil)
+	cm.EXPECT().ReadAt(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(header, nil)
+	size, err = importcommon.GetFileSize(context.Background(), cm, "mock.jsonl.zst")
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(header)*4), size)
+
+	// more than one row in a line
+	cm = mocks.NewChunkManager(t)
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (storage.FileReader, error) {
+		return &mockReader{Reader: bytes.NewReader([]byte("{\"pk\": 1} {\"pk\": 2}\n")), Closer: io.NopCloser(nil)}, nil
+	})
+	reader, err := NewJSONLReader(context.Background(), cm, schema, "mock.jsonl", math.MaxInt, nil)
+	require.NoError(t, err)
+	_, err = reader.Read()
+	assert.Error(t, err)
+
+	// the malformed lines are skipped by the rejector
+	valid, err := json.Marshal(rows[0])
+	require.NoError(t, err)
+	cm = mocks.NewChunkManager(t)
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (storage.FileReader, error) {
+		data := "{\"pk\": 1} {\"pk\": 2}\n" + string(valid) + "\n{\"pk\": \"a\"}\n"
+		return &mockReader{Reader: bytes.NewReader([]byte(data)), Closer: io.NopCloser(nil)}, nil
+	})
+	rejects := new(bytes.Buffer)
+	rejector := importcommon.NewRejector(2, 1).WithWriter(func(part int, content []byte) error {
+		assert.Equal(t, 0, part)
+		rejects.Write(content)
+		return nil
+	})
+	reader, err = NewJSONLReader(context.Background(), cm, schema, "mock.jsonl", math.MaxInt, rejector)
+	require.NoError(t, err)
+	res, err = reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.GetRowNum())
+	assert.Equal(t, int64(2), rejector.RejectedRows())
+	assert.Error(t, rejector.Check(1))
+	ratioRejector := importcommon.NewRejector(-1, 0.7)
+	assert.NoError(t, ratioRejector.Reject("mock.jsonl", 1, io.ErrUnexpectedEOF))
+	assert.NoError(t, ratioRejector.Reject("mock.jsonl", 2, io.ErrUnexpectedEOF))
+	assert.NoError(t, ratioRejector.Check(1))
+	assert.Error(t, importcommon.NewRejector(0, 1).Reject("mock.jsonl", 1, io.ErrUnexpectedEOF), "no error row is tolerated")
+	require.NoError(t, rejector.Flush())
+	lines := bytes.Split(bytes.TrimSpace(rejects.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	rejected := &importcommon.RejectedRow{}
+	require.NoError(t, json.Unmarshal(lines[1], rejected))
+	assert.Equal(t, "mock.jsonl", rejected.File)
+	assert.Equal(t, int64(3), rejected.Row)
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -44,17 +45,20 @@ type reader struct {
 
 	fileSize *atomic.Int64
 	filePath string
+	r        io.ReadCloser
 	dec      *json.Decoder
 
 	bufferSize  int
 	count       int64
 	isOldFormat bool
+	row         int64
 
-	parser RowParser
+	parser   RowParser
+	rejector *common.Rejector
 }
 
-func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
-	r, err := cm.Reader(ctx, path)
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int, rejector *common.Rejector) (*reader, error) {
+	r, err := common.OpenFile(ctx, cm, path)
 	if err != nil {
 		return nil, merr.WrapErrImportFailed(fmt.Sprintf("read json file failed, path=%s, err=%s", path, err.Error()))
 	}
@@ -68,9 +72,11 @@ func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.Co
 		schema:     schema,
 		fileSize:   atomic.NewInt64(0),
 		filePath:   path,
+		r:          r,
 		dec:        json.NewDecoder(r),
 		bufferSize: bufferSize,
 		count:      count,
+		rejector:   rejector,
 	}
 	reader.parser, err = NewRowParser(schema)
 	if err != nil {
@@ -138,9 +144,13 @@ func (j *reader) Read() (*storage.InsertData, error) {
 		if err = j.dec.Decode(&value); err != nil {
 			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to parse row, error: %v", err))
 		}
+		j.row++
 		row, err := j.parser.Parse(value)
 		if err != nil {
-			return nil, err
+			if err = j.rejector.Reject(j.filePath, j.row, err); err != nil {
+				return nil, err
+			}
+			continue
 		}
 		err = insertData.Append(row)
 		if err != nil {
@@ -172,7 +182,7 @@ func (j *reader) Size() (int64, error) {
 	if size := j.fileSize.Load(); size != 0 {
 		return size, nil
 	}
-	size, err := j.cm.Size(j.ctx, j.filePath)
+	size, err := common.GetFileSize(j.ctx, j.cm, j.filePath)
 	if err != nil {
 		return 0, err
 	}
@@ -180,7 +190,11 @@ func (j *reader) Size() (int64, error) {
 	return size, nil
 }
 
-func (j *reader) Close() {}
+func (j *reader) Close() {
+	if j.r != nil {
+		j.r.Close()
+	}
+}
 
 func estimateReadCountPerBatch(bufferSize int, schema *schemapb.CollectionSchema) (int64, error) {
 	sizePerRecord, err := typeutil.EstimateMaxSizePerRecord(schema)
@@ -118,7 +118,7 @@ func (suite *ReaderSuite) run(dataType schemapb.DataType, elemType schemapb.Data
 		r := &mockReader{Reader: strings.NewReader(string(jsonBytes))}
 		return r, nil
 	})
-	reader, err := NewReader(context.Background(), cm, schema, "mockPath", math.MaxInt)
+	reader, err := NewReader(context.Background(), cm, schema, "mockPath", math.MaxInt, nil)
 	suite.NoError(err)
 
 	checkFn := func(actualInsertData *storage.InsertData, offsetBegin, expectRows int) {
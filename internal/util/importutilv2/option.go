@@ -21,8 +21,11 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/csv"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
@@ -36,6 +39,14 @@ const (
 	BackupFlag = "backup"
 	L0Import   = "l0_import"
 	SkipDQC    = "skip_disk_quota_check"
+	CSVSep     = "sep"
+	CSVQuote   = "quote"
+	CSVNullKey = "nullkey"
+
+	MaxErrorRows  = "max_error_rows"
+	MaxErrorRatio = "max_error_ratio"
+	DryRun        = "dry_run"
+	Upsert        = "upsert"
 )
 
 type Options []*commonpb.KeyValuePair
@@ -87,6 +98,24 @@ func IsL0Import(options Options) bool {
 	return true
 }
 
+// IsDryRun indicates whether the import only validates the files without importing them.
+func IsDryRun(options Options) bool {
+	dryRun, err := funcutil.GetAttrByKeyFromRepeatedKV(DryRun, options)
+	if err != nil || strings.ToLower(dryRun) != "true" {
+		return false
+	}
+	return true
+}
+
+// IsUpsert indicates whether the imported rows replace the existing rows with the same primary keys.
+func IsUpsert(options Options) bool {
+	upsert, err := funcutil.GetAttrByKeyFromRepeatedKV(Upsert, options)
+	if err != nil || strings.ToLower(upsert) != "true" {
+		return false
+	}
+	return true
+}
+
 // SkipDiskQuotaCheck indicates whether the import skips the disk quota check.
 // This option should only be enabled during backup restoration.
 func SkipDiskQuotaCheck(options Options) bool {
@@ -99,3 +128,79 @@ func SkipDiskQuotaCheck(options Options) bool {
 	}
 	return true
 }
+
+// GetCSVSep returns the column separator of the csv file, the default one is used if it's not specified.
+func GetCSVSep(options Options, defaultSep rune) (rune, error) {
+	sep, err := funcutil.GetAttrByKeyFromRepeatedKV(CSVSep, options)
+	if err != nil || len(sep) == 0 {
+		return defaultSep, nil
+	}
+	r, size := utf8.DecodeRuneInString(sep)
+	if size != len(sep) || r == utf8.RuneError || r == '\r' || r == '\n' || r == csv.DefaultQuote {
+		return 0, merr.WrapErrImportFailed(fmt.Sprintf("invalid csv separator '%s', it should be a single character", sep))
+	}
+	return r, nil
+}
+
+// GetCSVQuote returns the quote character of the csv file, only a single ASCII character is accepted.
+func GetCSVQuote(options Options, sep rune) (byte, error) {
+	quote, err := funcutil.GetAttrByKeyFromRepeatedKV(CSVQuote, options)
+	if err != nil || len(quote) == 0 {
+		return csv.DefaultQuote, nil
+	}
+	if len(quote) != 1 || quote[0] >= utf8.RuneSelf || quote[0] == '\r' || quote[0] == '\n' || rune(quote[0]) == sep {
+		return 0, merr.WrapErrImportFailed(fmt.Sprintf("invalid csv quote '%s', it should be a single ASCII character "+
+			"different from the separator", quote))
+	}
+	return quote[0], nil
+}
+
+// GetCSVNullKey returns the token that represents a null value in the csv file,
+// values equal to it are treated as absent. Empty means no null token.
+func GetCSVNullKey(options Options) string {
+	nullKey, err := funcutil.GetAttrByKeyFromRepeatedKV(CSVNullKey, options)
+	if err != nil {
+		return ""
+	}
+	return nullKey
+}
+
+// NewRejector creates the rejector of the invalid rows by the max_error_rows and max_error_ratio options, the invalid
+// rows are skipped until either limit is exceeded. It returns nil if neither option is specified, then the first
+// invalid row fails the import.
+func NewRejector(options Options) (*common.Rejector, error) {
+	var (
+		maxErrorRows  int64 = -1
+		maxErrorRatio       = 1.0
+	)
+	rowsValue, rowsErr := funcutil.GetAttrByKeyFromRepeatedKV(MaxErrorRows, options)
+	ratioValue, ratioErr := funcutil.GetAttrByKeyFromRepeatedKV(MaxErrorRatio, options)
+	if rowsErr != nil && ratioErr != nil {
+		return nil, nil
+	}
+	if rowsErr == nil {
+		rows, err := strconv.ParseInt(rowsValue, 10, 64)
+		if err != nil || rows < 0 {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid %s '%s', it should be a non-negative integer", MaxErrorRows, rowsValue))
+		}
+		maxErrorRows = rows
+	}
+	if ratioErr == nil {
+		ratio, err := strconv.ParseFloat(ratioValue, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid %s '%s', it should be in range [0, 1]", MaxErrorRatio, ratioValue))
+		}
+		maxErrorRatio = ratio
+	}
+	return common.NewRejector(maxErrorRows, maxErrorRatio), nil
+}
+
+// CheckErrorLimits checks the numbers of the invalid rows and the valid rows of an import file against the limits of
+// the max_error_rows and max_error_ratio options, no invalid row is allowed if neither option is specified.
+func CheckErrorLimits(options Options, rejectedRows, acceptedRows int64) error {
+	rejector, err := NewRejector(options)
+	if err != nil {
+		return err
+	}
+	return rejector.CheckLimits(rejectedRows, acceptedRows)
+}
@@ -39,6 +39,9 @@ type FieldReader struct {
 
 	dim   int
 	field *schemapb.FieldSchema
+
+	// the invalid values of the last batch, offset in the batch -> error
+	invalidRows map[int]error
 }
 
 func NewFieldReader(ctx context.Context, reader *pqarrow.FileReader, columnIndex int, field *schemapb.FieldSchema) (*FieldReader, error) {
@@ -84,7 +87,12 @@ func (c *FieldReader) Next(count int64) (any, error) {
 		if data == nil {
 			return nil, nil
 		}
-		return data, typeutil.VerifyFloats32(data.([]float32))
+		for i, value := range data.([]float32) {
+			if err = typeutil.VerifyFloat(float64(value)); err != nil {
+				c.reject(i, err)
+			}
+		}
+		return data, nil
 	case schemapb.DataType_Double:
 		data, err := ReadIntegerOrFloatData[float64](c, count)
 		if err != nil {
@@ -93,7 +101,12 @@ func (c *FieldReader) Next(count int64) (any, error) {
 		if data == nil {
 			return nil, nil
 		}
-		return data, typeutil.VerifyFloats64(data.([]float64))
+		for i, value := range data.([]float64) {
+			if err = typeutil.VerifyFloat(value); err != nil {
+				c.reject(i, err)
+			}
+		}
+		return data, nil
 	case schemapb.DataType_VarChar, schemapb.DataType_String:
 		return ReadStringData(c, count)
 	case schemapb.DataType_JSON:
@@ -122,6 +135,21 @@ func (c *FieldReader) Next(count int64) (any, error) {
 
 func (c *FieldReader) Close() {}
 
+// reject records the invalid value at the offset of the batch, the reader skips the row if the import tolerates it.
+func (c *FieldReader) reject(offset int, err error) {
+	if c.invalidRows == nil {
+		c.invalidRows = make(map[int]error)
+	}
+	c.invalidRows[offset] = merr.WrapErrImportFailed(fmt.Sprintf("invalid value for field '%s': %v", c.field.GetName(), err))
+}
+
+// TakeInvalidRows returns the invalid values of the last batch and resets them.
+func (c *FieldReader) TakeInvalidRows() map[int]error {
+	invalidRows := c.invalidRows
+	c.invalidRows = nil
+	return invalidRows
+}
+
 func ReadBoolData(pcr *FieldReader, count int64) (any, error) {
 	chunked, err := pcr.columnReader.NextBatch(count)
 	if err != nil {
@@ -225,18 +253,16 @@ func ReadJSONData(pcr *FieldReader, count int64) (any, error) {
 		return nil, nil
 	}
 	byteArr := make([][]byte, 0)
-	for _, str := range data.([]string) {
+	for i, str := range data.([]string) {
 		var dummy interface{}
 		err = json.Unmarshal([]byte(str), &dummy)
-		if err != nil {
-			return nil, err
-		}
-		if pcr.field.GetIsDynamic() {
+		if err == nil && pcr.field.GetIsDynamic() {
 			var dummy2 map[string]interface{}
 			err = json.Unmarshal([]byte(str), &dummy2)
-			if err != nil {
-				return nil, err
-			}
+		}
+		if err != nil {
+			pcr.reject(i, err)
+			str = "{}"
 		}
 		byteArr = append(byteArr, []byte(str))
 	}
@@ -288,10 +314,12 @@ func ReadSparseFloatVectorData(pcr *FieldReader, count int64) (any, error) {
 	}
 	byteArr := make([][]byte, 0, count)
 	maxDim := uint32(0)
-	for _, str := range data.([]string) {
+	for i, str := range data.([]string) {
 		rowVec, err := typeutil.CreateSparseFloatRowFromJSON([]byte(str))
 		if err != nil {
-			return nil, merr.WrapErrImportFailed(fmt.Sprintf("Invalid JSON string for SparseFloatVector: '%s', err = %v", str, err))
+			pcr.reject(i, fmt.Errorf("invalid JSON string for SparseFloatVector: '%s', err = %v", str, err))
+			byteArr = append(byteArr, []byte{})
+			continue
 		}
 		byteArr = append(byteArr, rowVec)
 		elemCount := len(rowVec) / 8
@@ -46,11 +46,14 @@ type reader struct {
 	fileSize   *atomic.Int64
 	bufferSize int
 	count      int64
+	// the number of rows read
+	offset int64
 
-	frs map[int64]*FieldReader // fieldID -> FieldReader
+	frs      map[int64]*FieldReader // fieldID -> FieldReader
+	rejector *common.Rejector
 }
 
-func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int, rejector *common.Rejector) (*reader, error) {
 	cmReader, err := cm.Reader(ctx, path)
 	if err != nil {
 		return nil, err
@@ -88,6 +91,7 @@ func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.Co
 		bufferSize: bufferSize,
 		count:      count,
 		frs:        crs,
+		rejector:   rejector,
 	}, nil
 }
 
@@ -96,29 +100,34 @@ func (r *reader) Read() (*storage.InsertData, error) {
 	if err != nil {
 		return nil, err
 	}
+	eof := false
 OUTER:
 	for {
+		columns := make(map[int64]any, len(r.frs))
+		invalidRows := make(map[int]error)
 		for fieldID, cr := range r.frs {
 			data, err := cr.Next(r.count)
 			if err != nil {
 				return nil, err
 			}
 			if data == nil {
+				eof = true
 				break OUTER
 			}
-			err = insertData.Data[fieldID].AppendRows(data)
-			if err != nil {
-				return nil, err
+			columns[fieldID] = data
+			for offset, err := range cr.TakeInvalidRows() {
+				invalidRows[offset] = err
 			}
 		}
+		if err = r.appendColumns(insertData, columns, invalidRows); err != nil {
+			return nil, err
+		}
 		if insertData.GetMemorySize() >= r.bufferSize {
 			break
 		}
 	}
-	for fieldID := range r.frs {
-		if insertData.Data[fieldID].RowNum() == 0 {
-			return nil, io.EOF
-		}
+	if eof && insertData.GetRowNum() == 0 {
+		return nil, io.EOF
 	}
 	err = common.FillDynamicData(insertData, r.schema)
 	if err != nil {
@@ -127,6 +136,50 @@ OUTER:
 	return insertData, nil
 }
 
+// appendColumns appends the rows of the columns read in a batch to the insert data, the invalid rows are rejected.
+func (r *reader) appendColumns(insertData *storage.InsertData, columns map[int64]any, invalidRows map[int]error) error {
+	if len(invalidRows) == 0 {
+		rowNum := 0
+		for fieldID, data := range columns {
+			before := insertData.Data[fieldID].RowNum()
+			if err := insertData.Data[fieldID].AppendRows(data); err != nil {
+				return err
+			}
+			rowNum = insertData.Data[fieldID].RowNum() - before
+		}
+		r.offset += int64(rowNum)
+		return nil
+	}
+	batch, err := storage.NewInsertData(r.schema)
+	if err != nil {
+		return err
+	}
+	rowNum := 0
+	for fieldID, data := range columns {
+		if err = batch.Data[fieldID].AppendRows(data); err != nil {
+			return err
+		}
+		rowNum = batch.Data[fieldID].RowNum()
+	}
+	for i := 0; i < rowNum; i++ {
+		if err, ok := invalidRows[i]; ok {
+			if err = r.rejector.Reject(r.path, r.offset+int64(i)+1, err); err != nil {
+				return err
+			}
+			continue
+		}
+		row := make(map[storage.FieldID]any, len(columns))
+		for fieldID := range columns {
+			row[fieldID] = batch.Data[fieldID].GetRow(i)
+		}
+		if err = insertData.Append(row); err != nil {
+			return err
+		}
+	}
+	r.offset += int64(rowNum)
+	return nil
+}
+
 func (r *reader) Size() (int64, error) {
 	if size := r.fileSize.Load(); size != 0 {
 		return size, nil
@@ -0,0 +1,237 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// Writer writes insert data to a parquet file in the same layout the parquet reader accepts,
+// so that the exported files can be imported again.
+type Writer struct {
+	schema      *schemapb.CollectionSchema
+	arrowSchema *arrow.Schema
+	fw          *pqarrow.FileWriter
+	rows        int64
+}
+
+func NewWriter(w io.Writer, schema *schemapb.CollectionSchema) (*Writer, error) {
+	arrowSchema, err := ConvertToArrowSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	fw, err := pqarrow.NewFileWriter(arrowSchema, w,
+		parquet.NewWriterProperties(
+			parquet.WithCompression(compress.Codecs.Zstd),
+			parquet.WithCompressionLevel(3)),
+		pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("new parquet file writer failed, err=%v", err))
+	}
+	return &Writer{
+		schema:      schema,
+		arrowSchema: arrowSchema,
+		fw:          fw,
+	}, nil
+}
+
+// Write writes the insert data as a row group, system fields in the insert data are ignored.
+func (w *Writer) Write(data *storage.InsertData) error {
+	rows := data.GetRowNum()
+	if rows == 0 {
+		return nil
+	}
+	columns := make([]arrow.Array, 0, len(w.arrowSchema.Fields()))
+	defer func() {
+		for _, column := range columns {
+			column.Release()
+		}
+	}()
+	for _, field := range w.schema.GetFields() {
+		if typeutil.IsAutoPKField(field) {
+			continue
+		}
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok {
+			return merr.WrapErrImportFailed(fmt.Sprintf("no data of field '%s'", field.GetName()))
+		}
+		column, err := buildArrowArray(field, fieldData)
+		if err != nil {
+			return err
+		}
+		columns = append(columns, column)
+	}
+	record := array.NewRecord(w.arrowSchema, columns, int64(rows))
+	defer record.Release()
+	if err := w.fw.Write(record); err != nil {
+		return merr.WrapErrImportFailed(fmt.Sprintf("write parquet failed, err=%v", err))
+	}
+	w.rows += int64(rows)
+	return nil
+}
+
+// Rows returns the number of rows written.
+func (w *Writer) Rows() int64 {
+	return w.rows
+}
+
+func (w *Writer) Close() error {
+	return w.fw.Close()
+}
+
+func buildArrowArray(field *schemapb.FieldSchema, fieldData storage.FieldData) (arrow.Array, error) {
+	mem := memory.DefaultAllocator
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		builder := array.NewBooleanBuilder(mem)
+		builder.AppendValues(fieldData.(*storage.BoolFieldData).Data, nil)
+		return builder.NewArray(), nil
+	case schemapb.DataType_Int8:
+		builder := array.NewInt8Builder(mem)
+		builder.AppendValues(fieldData.(*storage.Int8FieldData).Data, nil)
+		return builder.NewArray(), nil
+	case schemapb.DataType_Int16:
+		builder := array.NewInt16Builder(mem)
+		builder.AppendValues(fieldData.(*storage.Int16FieldData).Data, nil)
+		return builder.NewArray(), nil
+	case schemapb.DataType_Int32:
+		builder := array.NewInt32Builder(mem)
+		builder.AppendValues(fieldData.(*storage.Int32FieldData).Data, nil)
+		return builder.NewArray(), nil
+	case schemapb.DataType_Int64:
+		builder := array.NewInt64Builder(mem)
+		builder.AppendValues(fieldData.(*storage.Int64FieldData).Data, nil)
+		return builder.NewArray(), nil
+	case schemapb.DataType_Float:
+		builder := array.NewFloat32Builder(mem)
+		builder.AppendValues(fieldData.(*storage.FloatFieldData).Data, nil)
+		return builder.NewArray(), nil
+	case schemapb.DataType_Double:
+		builder := array.NewFloat64Builder(mem)
+		builder.AppendValues(fieldData.(*storage.DoubleFieldData).Data, nil)
+		return builder.NewArray(), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		builder := array.NewStringBuilder(mem)
+		builder.AppendValues(fieldData.(*storage.StringFieldData).Data, nil)
+		return builder.NewArray(), nil
+	case schemapb.DataType_JSON:
+		builder := array.NewStringBuilder(mem)
+		for _, bs := range fieldData.(*storage.JSONFieldData).Data {
+			builder.Append(string(bs))
+		}
+		return builder.NewArray(), nil
+	case schemapb.DataType_SparseFloatVector:
+		builder := array.NewStringBuilder(mem)
+		for _, content := range fieldData.(*storage.SparseFloatVectorFieldData).GetContents() {
+			bs, err := json.Marshal(typeutil.SparseFloatBytesToMap(content))
+			if err != nil {
+				return nil, err
+			}
+			builder.Append(string(bs))
+		}
+		return builder.NewArray(), nil
+	case schemapb.DataType_FloatVector:
+		vec := fieldData.(*storage.FloatVectorFieldData)
+		builder := array.NewListBuilder(mem, &arrow.Float32Type{})
+		builder.ValueBuilder().(*array.Float32Builder).AppendValues(vec.Data, nil)
+		appendFixedOffsets(builder, fieldData.RowNum(), vec.Dim)
+		return builder.NewArray(), nil
+	case schemapb.DataType_BinaryVector:
+		vec := fieldData.(*storage.BinaryVectorFieldData)
+		builder := array.NewListBuilder(mem, &arrow.Uint8Type{})
+		builder.ValueBuilder().(*array.Uint8Builder).AppendValues(vec.Data, nil)
+		appendFixedOffsets(builder, fieldData.RowNum(), vec.Dim/8)
+		return builder.NewArray(), nil
+	case schemapb.DataType_Float16Vector:
+		vec := fieldData.(*storage.Float16VectorFieldData)
+		builder := array.NewListBuilder(mem, &arrow.Uint8Type{})
+		builder.ValueBuilder().(*array.Uint8Builder).AppendValues(vec.Data, nil)
+		appendFixedOffsets(builder, fieldData.RowNum(), vec.Dim*2)
+		return builder.NewArray(), nil
+	case schemapb.DataType_BFloat16Vector:
+		vec := fieldData.(*storage.BFloat16VectorFieldData)
+		builder := array.NewListBuilder(mem, &arrow.Uint8Type{})
+		builder.ValueBuilder().(*array.Uint8Builder).AppendValues(vec.Data, nil)
+		appendFixedOffsets(builder, fieldData.RowNum(), vec.Dim*2)
+		return builder.NewArray(), nil
+	case schemapb.DataType_Array:
+		return buildArrowListArray(field, fieldData.(*storage.ArrayFieldData).Data)
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported data type '%s' of field '%s'",
+			field.GetDataType().String(), field.GetName())
+	}
+}
+
+func appendFixedOffsets(builder *array.ListBuilder, rows int, width int) {
+	offsets := make([]int32, 0, rows)
+	for i := 0; i < rows; i++ {
+		offsets = append(offsets, int32(i*width))
+	}
+	builder.AppendValues(offsets, nil)
+}
+
+func buildArrowListArray(field *schemapb.FieldSchema, data []*schemapb.ScalarField) (arrow.Array, error) {
+	mem := memory.DefaultAllocator
+	elemType, err := convertToArrowDataType(field, true)
+	if err != nil {
+		return nil, err
+	}
+	builder := array.NewListBuilder(mem, elemType)
+	for _, row := range data {
+		builder.Append(true)
+		switch vb := builder.ValueBuilder().(type) {
+		case *array.BooleanBuilder:
+			vb.AppendValues(row.GetBoolData().GetData(), nil)
+		case *array.Int8Builder:
+			for _, v := range row.GetIntData().GetData() {
+				vb.Append(int8(v))
+			}
+		case *array.Int16Builder:
+			for _, v := range row.GetIntData().GetData() {
+				vb.Append(int16(v))
+			}
+		case *array.Int32Builder:
+			vb.AppendValues(row.GetIntData().GetData(), nil)
+		case *array.Int64Builder:
+			vb.AppendValues(row.GetLongData().GetData(), nil)
+		case *array.Float32Builder:
+			vb.AppendValues(row.GetFloatData().GetData(), nil)
+		case *array.Float64Builder:
+			vb.AppendValues(row.GetDoubleData().GetData(), nil)
+		case *array.StringBuilder:
+			vb.AppendValues(row.GetStringData().GetData(), nil)
+		default:
+			return nil, merr.WrapErrParameterInvalidMsg("unsupported element type '%s' of field '%s'",
+				field.GetElementType().String(), field.GetName())
+		}
+	}
+	return builder.NewArray(), nil
+}
@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/testutil"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestWriter(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    101,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "8"}},
+			},
+			{
+				FieldID:    102,
+				Name:       "str",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "256"}},
+			},
+			{
+				FieldID:     103,
+				Name:        "arr",
+				DataType:    schemapb.DataType_Array,
+				ElementType: schemapb.DataType_Int32,
+				TypeParams:  []*commonpb.KeyValuePair{{Key: common.MaxCapacityKey, Value: "16"}},
+			},
+			{
+				FieldID:  104,
+				Name:     "json",
+				DataType: schemapb.DataType_JSON,
+			},
+		},
+	}
+	insertData, err := testutil.CreateInsertData(schema, 100)
+	assert.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, schema)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Write(insertData))
+	emptyData, err := storage.NewInsertData(schema)
+	assert.NoError(t, err)
+	assert.NoError(t, w.Write(emptyData))
+	assert.Equal(t, int64(100), w.Rows())
+	assert.NoError(t, w.Close())
+
+	ctx := context.Background()
+	f := storage.NewChunkManagerFactory("local", storage.RootPath("/tmp/milvus_test/test_parquet_writer/"))
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	filePath := fmt.Sprintf("test_%d_writer.parquet", rand.Int())
+	assert.NoError(t, cm.Write(ctx, filePath, buf.Bytes()))
+	defer cm.Remove(ctx, filePath)
+
+	reader, err := NewReader(ctx, cm, schema, filePath, 64*1024*1024, nil)
+	assert.NoError(t, err)
+	res, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, 100, res.GetRowNum())
+	for fieldID, data := range res.Data {
+		for i := 0; i < 100; i++ {
+			assert.Equal(t, insertData.Data[fieldID].GetRow(i), data.GetRow(i))
+		}
+	}
+}
@@ -18,11 +18,14 @@ package importutilv2
 
 import (
 	"context"
+	"path/filepath"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/binlog"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/csv"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/json"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/numpy"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
@@ -49,6 +52,7 @@ func NewReader(ctx context.Context,
 	importFile *internalpb.ImportFile,
 	options Options,
 	bufferSize int,
+	rejector *common.Rejector,
 ) (Reader, error) {
 	if IsBackup(options) {
 		tsStart, tsEnd, err := ParseTimeRange(options)
@@ -65,11 +69,28 @@ func NewReader(ctx context.Context,
 	}
 	switch fileType {
 	case JSON:
-		return json.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize)
+		return json.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize, rejector)
+	case JSONL:
+		return json.NewJSONLReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize, rejector)
 	case Numpy:
 		return numpy.NewReader(ctx, cm, schema, importFile.GetPaths(), bufferSize)
 	case Parquet:
-		return parquet.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize)
+		return parquet.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize, rejector)
+	case CSV:
+		path := importFile.GetPaths()[0]
+		defaultSep := csv.DefaultSep
+		if _, uncompressed := common.GetCompressType(path); filepath.Ext(uncompressed) == TSVFileExt {
+			defaultSep = csv.DefaultTSVSep
+		}
+		sep, err := GetCSVSep(options, defaultSep)
+		if err != nil {
+			return nil, err
+		}
+		quote, err := GetCSVQuote(options, sep)
+		if err != nil {
+			return nil, err
+		}
+		return csv.NewReader(ctx, cm, schema, path, bufferSize, sep, quote, GetCSVNullKey(options), rejector)
 	}
 	return nil, merr.WrapErrImportFailed("unexpected import file")
 }
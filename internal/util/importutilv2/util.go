@@ -23,6 +23,7 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
@@ -33,10 +34,15 @@ const (
 	JSON    FileType = 1
 	Numpy   FileType = 2
 	Parquet FileType = 3
+	CSV     FileType = 4
+	JSONL   FileType = 5
 
 	JSONFileExt    = ".json"
+	JSONLFileExt   = ".jsonl"
 	NumpyFileExt   = ".npy"
 	ParquetFileExt = ".parquet"
+	CSVFileExt     = ".csv"
+	TSVFileExt     = ".tsv"
 )
 
 var FileTypeName = map[int]string{
@@ -44,6 +50,8 @@ var FileTypeName = map[int]string{
 	1: "JSON",
 	2: "Numpy",
 	3: "Parquet",
+	4: "CSV",
+	5: "JSONL",
 }
 
 func (f FileType) String() string {
@@ -54,7 +62,11 @@ func GetFileType(file *internalpb.ImportFile) (FileType, error) {
 	if len(file.GetPaths()) == 0 {
 		return Invalid, merr.WrapErrImportFailed("no file to import")
 	}
+	// the extension of the compressed file is the extension before the compress extension, such as .json.gz
+	compressed := false
 	exts := lo.Map(file.GetPaths(), func(path string, _ int) string {
+		compressType, path := common.GetCompressType(path)
+		compressed = compressed || compressType != ""
 		return filepath.Ext(path)
 	})
 
@@ -73,13 +85,29 @@ func GetFileType(file *internalpb.ImportFile) (FileType, error) {
 			return Invalid, merr.WrapErrImportFailed("for JSON import, accepts only one file")
 		}
 		return JSON, nil
+	case JSONLFileExt:
+		if len(file.GetPaths()) != 1 {
+			return Invalid, merr.WrapErrImportFailed("for JSONL import, accepts only one file")
+		}
+		return JSONL, nil
 	case NumpyFileExt:
+		if compressed {
+			return Invalid, merr.WrapErrImportFailed("compressed Numpy file is not supported")
+		}
 		return Numpy, nil
 	case ParquetFileExt:
 		if len(file.GetPaths()) != 1 {
 			return Invalid, merr.WrapErrImportFailed("for Parquet import, accepts only one file")
 		}
+		if compressed {
+			return Invalid, merr.WrapErrImportFailed("compressed Parquet file is not supported")
+		}
 		return Parquet, nil
+	case CSVFileExt, TSVFileExt:
+		if len(file.GetPaths()) != 1 {
+			return Invalid, merr.WrapErrImportFailed("for CSV import, accepts only one file")
+		}
+		return CSV, nil
 	}
 	return Invalid, merr.WrapErrImportFailed(fmt.Sprintf("unexpect file type, files=%v", file.GetPaths()))
 }
@@ -550,3 +550,39 @@ func CreateInsertDataRowsForJSON(schema *schemapb.CollectionSchema, insertData *
 
 	return rows, nil
 }
+
+func CreateInsertDataForCSV(schema *schemapb.CollectionSchema, insertData *storage.InsertData) ([][]string, error) {
+	rows, err := CreateInsertDataRowsForJSON(schema, insertData)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]string, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetAutoID() {
+			continue
+		}
+		header = append(header, field.GetName())
+	}
+
+	records := make([][]string, 0, len(rows)+1)
+	records = append(records, header)
+	for _, row := range rows {
+		record := make([]string, 0, len(header))
+		for _, name := range header {
+			switch value := row[name].(type) {
+			case string:
+				record = append(record, value)
+			case bool, int8, int16, int32, int64, float32, float64:
+				record = append(record, fmt.Sprint(value))
+			default:
+				bs, err := json.Marshal(value)
+				if err != nil {
+					return nil, err
+				}
+				record = append(record, string(bs))
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
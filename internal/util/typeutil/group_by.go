@@ -0,0 +1,115 @@
+package typeutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// SearchGroupKeys gets the group keys of the hits of a search result. The hits grouped by a single field carry
+// the group by values computed by segcore, while the hits grouped by several fields are grouped by the values
+// of the fields output with the hits.
+type SearchGroupKeys struct {
+	groupByValue *schemapb.FieldData
+	columns      []*schemapb.FieldData
+	composite    bool
+}
+
+// NewSearchGroupKeys finds the group by values of the hits in the search result.
+func NewSearchGroupKeys(data *schemapb.SearchResultData, groupByFieldIDs []int64) (*SearchGroupKeys, error) {
+	if len(groupByFieldIDs) <= 1 {
+		return &SearchGroupKeys{groupByValue: data.GetGroupByFieldValue()}, nil
+	}
+	keys := &SearchGroupKeys{
+		columns:   make([]*schemapb.FieldData, 0, len(groupByFieldIDs)),
+		composite: true,
+	}
+	for _, fieldID := range groupByFieldIDs {
+		var column *schemapb.FieldData
+		for _, fieldData := range data.GetFieldsData() {
+			if fieldData.GetFieldId() == fieldID {
+				column = fieldData
+				break
+			}
+		}
+		if column == nil && len(data.GetScores()) == 0 {
+			// an empty result may have no fields data
+			return keys, nil
+		}
+		if column == nil {
+			return nil, fmt.Errorf("group by field %d is not in the search result", fieldID)
+		}
+		keys.columns = append(keys.columns, column)
+	}
+	return keys, nil
+}
+
+// IsComposite returns whether the hits are grouped by several fields.
+func (k *SearchGroupKeys) IsComposite() bool {
+	return k.composite
+}
+
+// GroupByValue returns the group by value of the hits grouped by a single field.
+func (k *SearchGroupKeys) GroupByValue() *schemapb.FieldData {
+	return k.groupByValue
+}
+
+// Key returns the comparable group key of the idx-th hit, it's nil if the hits are not grouped.
+func (k *SearchGroupKeys) Key(idx int64) any {
+	if !k.IsComposite() {
+		if k.groupByValue == nil {
+			return nil
+		}
+		return typeutil.GetData(k.groupByValue, int(idx))
+	}
+	var b strings.Builder
+	for _, column := range k.columns {
+		if isNullAt(column, idx) {
+			b.WriteString("null,")
+			continue
+		}
+		switch v := typeutil.GetData(column, int(idx)).(type) {
+		case string:
+			b.WriteString(strconv.Quote(v))
+		default:
+			fmt.Fprint(&b, v)
+		}
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+// GroupCounter counts the hits of each group to keep at most groupSize hits of each group. The number of groups
+// is unlimited if maxGroups is not positive.
+type GroupCounter struct {
+	maxGroups int64
+	groupSize int64
+	counts    map[any]int64
+}
+
+func NewGroupCounter(maxGroups int64, groupSize int64) *GroupCounter {
+	if groupSize <= 0 {
+		groupSize = 1
+	}
+	return &GroupCounter{
+		maxGroups: maxGroups,
+		groupSize: groupSize,
+		counts:    make(map[any]int64),
+	}
+}
+
+// Add counts a hit of the group and returns true if the hit is kept.
+func (c *GroupCounter) Add(key any) bool {
+	count, ok := c.counts[key]
+	if !ok && c.maxGroups > 0 && int64(len(c.counts)) >= c.maxGroups {
+		return false
+	}
+	if count >= c.groupSize {
+		return false
+	}
+	c.counts[key] = count + 1
+	return true
+}
@@ -0,0 +1,67 @@
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+func TestSearchGroupKeys(t *testing.T) {
+	newColumn := func(fieldID int64, data []string, validData []bool) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:    schemapb.DataType_VarChar,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: data},
+					},
+				},
+			},
+			ValidData: validData,
+		}
+	}
+	data := &schemapb.SearchResultData{
+		Scores: []float32{4, 3, 2, 1},
+		FieldsData: []*schemapb.FieldData{
+			newColumn(101, []string{"a,b", "a", "a", "null"}, nil),
+			newColumn(102, []string{"c", "b,c", "", ""}, []bool{true, true, false, true}),
+		},
+	}
+
+	keys, err := NewSearchGroupKeys(data, []int64{101, 102})
+	require.NoError(t, err)
+	assert.True(t, keys.IsComposite())
+	assert.NotEqual(t, keys.Key(0), keys.Key(1))
+	assert.NotEqual(t, keys.Key(2), keys.Key(3))
+	assert.NotEqual(t, keys.Key(2), keys.Key(1))
+
+	_, err = NewSearchGroupKeys(data, []int64{101, 103})
+	assert.Error(t, err)
+	_, err = NewSearchGroupKeys(&schemapb.SearchResultData{}, []int64{101, 103})
+	assert.NoError(t, err)
+
+	// the hits grouped by a single field
+	keys, err = NewSearchGroupKeys(data, []int64{101})
+	require.NoError(t, err)
+	assert.False(t, keys.IsComposite())
+	assert.Nil(t, keys.Key(0))
+}
+
+func TestGroupCounter(t *testing.T) {
+	counter := NewGroupCounter(2, 2)
+	assert.True(t, counter.Add("a"))
+	assert.True(t, counter.Add("a"))
+	assert.False(t, counter.Add("a"))
+	assert.True(t, counter.Add("b"))
+	assert.False(t, counter.Add("c"))
+
+	counter = NewGroupCounter(0, 0)
+	assert.True(t, counter.Add("a"))
+	assert.False(t, counter.Add("a"))
+	assert.True(t, counter.Add("b"))
+	assert.True(t, counter.Add("c"))
+}
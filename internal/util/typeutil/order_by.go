@@ -0,0 +1,141 @@
+package typeutil
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// IsSortableType returns whether the retrieve results can be ordered by the field of the data type.
+func IsSortableType(dataType schemapb.DataType) bool {
+	return typeutil.IsBoolType(dataType) || typeutil.IsIntegerType(dataType) ||
+		typeutil.IsFloatingType(dataType) || typeutil.IsStringType(dataType)
+}
+
+// RowOrder orders the rows of a retrieve result by the order by fields, ties are broken by the primary key
+// to keep the order deterministic. Null values go last in both directions.
+type RowOrder struct {
+	ids       *schemapb.IDs
+	columns   []*schemapb.FieldData
+	ascending []bool
+}
+
+// NewRowOrder finds the columns of the order by fields in the fields data of a retrieve result.
+func NewRowOrder(ids *schemapb.IDs, fieldsData []*schemapb.FieldData, orderBy []*internalpb.OrderByField) (*RowOrder, error) {
+	order := &RowOrder{
+		ids:       ids,
+		columns:   make([]*schemapb.FieldData, 0, len(orderBy)),
+		ascending: make([]bool, 0, len(orderBy)),
+	}
+	for _, field := range orderBy {
+		var column *schemapb.FieldData
+		for _, fieldData := range fieldsData {
+			if fieldData.GetFieldId() == field.GetFieldId() {
+				column = fieldData
+				break
+			}
+		}
+		if column == nil {
+			return nil, fmt.Errorf("order by field %d is not in the retrieve result", field.GetFieldId())
+		}
+		if !IsSortableType(column.GetType()) {
+			return nil, fmt.Errorf("retrieve results cannot be ordered by field %d of type %s", field.GetFieldId(), column.GetType().String())
+		}
+		order.columns = append(order.columns, column)
+		order.ascending = append(order.ascending, field.GetAscending())
+	}
+	return order, nil
+}
+
+// Len returns the number of rows.
+func (o *RowOrder) Len() int {
+	return typeutil.GetSizeOfIDs(o.ids)
+}
+
+// Sort sorts the row indexes in order.
+func (o *RowOrder) Sort(indexes []int64) {
+	sort.Slice(indexes, func(i, j int) bool {
+		return CompareRows(o, indexes[i], o, indexes[j]) < 0
+	})
+}
+
+// CompareRows compares the i-th row of a and the j-th row of b, it returns a negative number if the row of a
+// goes first, a positive number if the row of b goes first, and zero if they have the same primary key.
+func CompareRows(a *RowOrder, i int64, b *RowOrder, j int64) int {
+	for k := range a.columns {
+		aNull, bNull := isNullAt(a.columns[k], i), isNullAt(b.columns[k], j)
+		switch {
+		case aNull && bNull:
+			continue
+		case aNull:
+			return 1
+		case bNull:
+			return -1
+		}
+		c := compareValueAt(a.columns[k], i, b.columns[k], j)
+		if c == 0 {
+			continue
+		}
+		if !a.ascending[k] {
+			return -c
+		}
+		return c
+	}
+	switch pk := typeutil.GetPK(a.ids, i).(type) {
+	case int64:
+		return cmp.Compare(pk, typeutil.GetPK(b.ids, j).(int64))
+	case string:
+		return cmp.Compare(pk, typeutil.GetPK(b.ids, j).(string))
+	}
+	return 0
+}
+
+// SelectFirstRow selects the result whose row at the cursor goes first, sorted holds the row indexes of each result
+// in order. It returns -1 if all the results are drained.
+func SelectFirstRow(orders []*RowOrder, sorted [][]int64, cursors []int) int {
+	sel := -1
+	for i, cursor := range cursors {
+		if cursor >= len(sorted[i]) {
+			continue
+		}
+		if sel == -1 || CompareRows(orders[i], sorted[i][cursor], orders[sel], sorted[sel][cursors[sel]]) < 0 {
+			sel = i
+		}
+	}
+	return sel
+}
+
+func isNullAt(fieldData *schemapb.FieldData, idx int64) bool {
+	validData := fieldData.GetValidData()
+	return len(validData) > 0 && !validData[idx]
+}
+
+func compareValueAt(a *schemapb.FieldData, i int64, b *schemapb.FieldData, j int64) int {
+	switch a.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		x, y := a.GetScalars().GetBoolData().GetData()[i], b.GetScalars().GetBoolData().GetData()[j]
+		switch {
+		case x == y:
+			return 0
+		case !x:
+			return -1
+		default:
+			return 1
+		}
+	case *schemapb.ScalarField_IntData:
+		return cmp.Compare(a.GetScalars().GetIntData().GetData()[i], b.GetScalars().GetIntData().GetData()[j])
+	case *schemapb.ScalarField_LongData:
+		return cmp.Compare(a.GetScalars().GetLongData().GetData()[i], b.GetScalars().GetLongData().GetData()[j])
+	case *schemapb.ScalarField_FloatData:
+		return cmp.Compare(a.GetScalars().GetFloatData().GetData()[i], b.GetScalars().GetFloatData().GetData()[j])
+	case *schemapb.ScalarField_DoubleData:
+		return cmp.Compare(a.GetScalars().GetDoubleData().GetData()[i], b.GetScalars().GetDoubleData().GetData()[j])
+	case *schemapb.ScalarField_StringData:
+		return cmp.Compare(a.GetScalars().GetStringData().GetData()[i], b.GetScalars().GetStringData().GetData()[j])
+	}
+	return 0
+}
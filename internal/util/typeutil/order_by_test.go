@@ -0,0 +1,50 @@
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestRowOrder(t *testing.T) {
+	ids := &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "b", "c", "d"}}}}
+	fieldsData := []*schemapb.FieldData{
+		{
+			Type:    schemapb.DataType_Int32,
+			FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{2, 0, 1, 2}}}},
+			},
+			ValidData: []bool{true, false, true, true},
+		},
+	}
+
+	order, err := NewRowOrder(ids, fieldsData, []*internalpb.OrderByField{{FieldId: 101, Ascending: true}})
+	require.NoError(t, err)
+	indexes := []int64{0, 1, 2, 3}
+	order.Sort(indexes)
+	// nulls go last, ties are broken by the primary key
+	assert.Equal(t, []int64{2, 0, 3, 1}, indexes)
+
+	order, err = NewRowOrder(ids, fieldsData, []*internalpb.OrderByField{{FieldId: 101, Ascending: false}})
+	require.NoError(t, err)
+	indexes = []int64{0, 1, 2, 3}
+	order.Sort(indexes)
+	assert.Equal(t, []int64{0, 3, 2, 1}, indexes)
+
+	sel := SelectFirstRow([]*RowOrder{order, order}, [][]int64{{3, 1}, {0, 2}}, []int{0, 0})
+	assert.Equal(t, 1, sel)
+	sel = SelectFirstRow([]*RowOrder{order}, [][]int64{{3}}, []int{1})
+	assert.Equal(t, -1, sel)
+
+	_, err = NewRowOrder(ids, fieldsData, []*internalpb.OrderByField{{FieldId: 102}})
+	assert.Error(t, err)
+
+	assert.True(t, IsSortableType(schemapb.DataType_VarChar))
+	assert.False(t, IsSortableType(schemapb.DataType_JSON))
+	assert.False(t, IsSortableType(schemapb.DataType_FloatVector))
+}
@@ -100,6 +100,9 @@ const (
 	AnalyzeStatsPath = `analyze_stats`
 	OffsetMapping    = `offset_mapping`
 	Centroids        = "centroids"
+
+	// ImportRejectsPath storage path const for the rows rejected by import.
+	ImportRejectsPath = `import_rejects`
 )
 
 // Search, Index parameter keys
@@ -132,6 +135,9 @@ const (
 const (
 	CollectionTTLConfigKey      = "collection.ttl.seconds"
 	CollectionAutoCompactionKey = "collection.autocompaction.enabled"
+	// CollectionTTLFieldKey names the int64 field which stores the expiration time of each entity in unix seconds,
+	// the entities are invisible once expired and dropped by compaction, null expiration never expires.
+	CollectionTTLFieldKey = "collection.ttl.field"
 
 	// rate limit
 	CollectionInsertRateMaxKey   = "collection.insertRate.max.mb"
@@ -169,6 +175,14 @@ const (
 	PartitionKeyIsolationKey = "partitionkey.isolation"
 )
 
+// request properties
+const (
+	// PartialUpdateKey is the property of the msg base of an upsert request, which makes the upsert only modify
+	// the supplied fields. The value "error" fails the upsert if a primary key doesn't exist, and "insert" inserts
+	// the entities of the primary keys which don't exist.
+	PartialUpdateKey = "partial_update"
+)
+
 const (
 	PropertiesKey string = "properties"
 	TraceIDKey    string = "uber-trace-id"
@@ -253,6 +267,22 @@ func IsPartitionKeyIsolationPropEnabled(props map[string]string) (bool, error) {
 	return iso, nil
 }
 
+// GetCollectionTTLFieldID returns the id of the ttl field named in the properties, it's false if the entities of
+// the collection don't expire by a field.
+func GetCollectionTTLFieldID(schema *schemapb.CollectionSchema, kvs ...*commonpb.KeyValuePair) (int64, bool) {
+	for _, kv := range kvs {
+		if kv.GetKey() != CollectionTTLFieldKey || kv.GetValue() == "" {
+			continue
+		}
+		for _, field := range schema.GetFields() {
+			if field.GetName() == kv.GetValue() {
+				return field.GetFieldID(), true
+			}
+		}
+	}
+	return 0, false
+}
+
 const (
 	// LatestVerision is the magic number for watch latest revision
 	LatestRevision = int64(-1)
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 )
 
 func TestIsSystemField(t *testing.T) {
@@ -149,3 +150,19 @@ func TestCommonPartitionKeyIsolation(t *testing.T) {
 		assert.False(t, res)
 	})
 }
+
+func TestGetCollectionTTLFieldID(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id"},
+			{FieldID: 101, Name: "expire_at"},
+		},
+	}
+	_, ok := GetCollectionTTLFieldID(schema)
+	assert.False(t, ok)
+	_, ok = GetCollectionTTLFieldID(schema, &commonpb.KeyValuePair{Key: CollectionTTLFieldKey, Value: "not_exist"})
+	assert.False(t, ok)
+	fieldID, ok := GetCollectionTTLFieldID(schema, &commonpb.KeyValuePair{Key: CollectionTTLFieldKey, Value: "expire_at"})
+	assert.True(t, ok)
+	assert.Equal(t, int64(101), fieldID)
+}
@@ -164,6 +164,17 @@ var (
 			compactionTypeLabelName,
 		})
 
+	DataNodeCompactionExpiredEntityCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "compaction_expired_entity_count",
+			Help:      "count of expired entities dropped by compaction",
+		}, []string{
+			nodeIDLabelName,
+			compactionTypeLabelName,
+		})
+
 	DataNodeCompactionLatencyInQueue = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -249,6 +260,7 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	// compaction related
 	registry.MustRegister(DataNodeCompactionLatency)
 	registry.MustRegister(DataNodeCompactionLatencyInQueue)
+	registry.MustRegister(DataNodeCompactionExpiredEntityCount)
 	// deprecated metrics
 	registry.MustRegister(DataNodeForwardDeleteMsgTimeTaken)
 	registry.MustRegister(DataNodeNumProducers)
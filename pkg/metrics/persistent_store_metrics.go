@@ -54,6 +54,33 @@ var (
 			Name:      "op_count",
 			Help:      "count of persistent data operation",
 		}, []string{persistentDataOpType, statusLabelName})
+
+	// PersistentDataCacheCounter records the number of remote object cache hits or miss.
+	PersistentDataCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "cache_hit_count",
+			Help:      "count of remote object cache hits/miss",
+		}, []string{cacheStateLabelName})
+
+	// PersistentDataCacheSize records the disk size used by the remote object cache.
+	PersistentDataCacheSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "cache_size",
+			Help:      "disk size in bytes used by the remote object cache",
+		})
+
+	// PersistentDataCacheEvictCounter records the number of objects evicted from the remote object cache.
+	PersistentDataCacheEvictCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "cache_evict_count",
+			Help:      "count of objects evicted from the remote object cache",
+		})
 )
 
 // RegisterStorageMetrics registers storage metrics
@@ -61,4 +88,7 @@ func RegisterStorageMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(PersistentDataKvSize)
 	registry.MustRegister(PersistentDataRequestLatency)
 	registry.MustRegister(PersistentDataOpCounter)
+	registry.MustRegister(PersistentDataCacheCounter)
+	registry.MustRegister(PersistentDataCacheSize)
+	registry.MustRegister(PersistentDataCacheEvictCounter)
 }
@@ -11,6 +11,16 @@ type kafkaID struct {
 
 var _ mqcommon.MessageID = &kafkaID{}
 
+// NewKafkaID creates a kafkaID from the offset of the message.
+func NewKafkaID(messageID int64) mqcommon.MessageID {
+	return &kafkaID{messageID: messageID}
+}
+
+// KafkaID returns the offset of the message.
+func (kid *kafkaID) KafkaID() int64 {
+	return kid.messageID
+}
+
 func (kid *kafkaID) Serialize() []byte {
 	return SerializeKafkaID(kid.messageID)
 }
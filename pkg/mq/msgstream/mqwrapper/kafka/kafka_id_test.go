@@ -71,3 +71,9 @@ func Test_DeserializeKafkaID(t *testing.T) {
 	id := DeserializeKafkaID(bin)
 	assert.Equal(t, id, int64(5))
 }
+
+func TestNewKafkaID(t *testing.T) {
+	id := NewKafkaID(5)
+	assert.Equal(t, int64(5), id.(*kafkaID).KafkaID())
+	assert.Equal(t, int64(5), DeserializeKafkaID(id.Serialize()))
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/milvus-io/milvus/pkg/mq/common"
 	"github.com/milvus-io/milvus/pkg/mq/mqimpl/rocksmq/server"
+	mqkafka "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kafka"
 	mqpulsar "github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/pulsar"
 	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+	msgkafka "github.com/milvus-io/milvus/pkg/streaming/walimpls/impls/kafka"
 	msgpulsar "github.com/milvus-io/milvus/pkg/streaming/walimpls/impls/pulsar"
 	"github.com/milvus-io/milvus/pkg/streaming/walimpls/impls/rmq"
 )
@@ -20,6 +22,8 @@ func MustGetMQWrapperIDFromMessage(messageID message.MessageID) common.MessageID
 		return mqpulsar.NewPulsarID(id.PulsarID())
 	} else if id, ok := messageID.(interface{ RmqID() int64 }); ok {
 		return &server.RmqID{MessageID: id.RmqID()}
+	} else if id, ok := messageID.(interface{ KafkaID() int64 }); ok {
+		return mqkafka.NewKafkaID(id.KafkaID())
 	}
 	panic("unsupported now")
 }
@@ -31,6 +35,8 @@ func MustGetMessageIDFromMQWrapperID(commonMessageID common.MessageID) message.M
 		return msgpulsar.NewPulsarID(id.PulsarID())
 	} else if id, ok := commonMessageID.(*server.RmqID); ok {
 		return rmq.NewRmqID(id.MessageID)
+	} else if id, ok := commonMessageID.(interface{ KafkaID() int64 }); ok {
+		return msgkafka.NewKafkaID(id.KafkaID())
 	}
 	return nil
 }
@@ -48,6 +54,9 @@ func DeserializeToMQWrapperID(msgID []byte, walName string) (common.MessageID, e
 	case "rocksmq":
 		rID := server.DeserializeRmqID(msgID)
 		return &server.RmqID{MessageID: rID}, nil
+	case "kafka":
+		kID := mqkafka.DeserializeKafkaID(msgID)
+		return mqkafka.NewKafkaID(kID), nil
 	default:
 		return nil, fmt.Errorf("unsupported mq type %s", walName)
 	}
@@ -65,6 +74,9 @@ func MustGetMessageIDFromMQWrapperIDBytes(walName string, msgIDBytes []byte) mes
 			panic(err)
 		}
 		commonMsgID = mqpulsar.NewPulsarID(msgID)
+	case "kafka":
+		id := mqkafka.DeserializeKafkaID(msgIDBytes)
+		commonMsgID = mqkafka.NewKafkaID(id)
 	default:
 		panic("unsupported now")
 	}
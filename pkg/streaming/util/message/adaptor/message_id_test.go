@@ -6,6 +6,7 @@ import (
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/stretchr/testify/assert"
 
+	msgkafka "github.com/milvus-io/milvus/pkg/streaming/walimpls/impls/kafka"
 	msgpulsar "github.com/milvus-io/milvus/pkg/streaming/walimpls/impls/pulsar"
 	"github.com/milvus-io/milvus/pkg/streaming/walimpls/impls/rmq"
 )
@@ -17,4 +18,24 @@ func TestIDCoversion(t *testing.T) {
 	msgID := pulsar.EarliestMessageID()
 	id = MustGetMessageIDFromMQWrapperID(MustGetMQWrapperIDFromMessage(msgpulsar.NewPulsarID(msgID)))
 	assert.True(t, id.EQ(msgpulsar.NewPulsarID(msgID)))
+
+	id = MustGetMessageIDFromMQWrapperID(MustGetMQWrapperIDFromMessage(msgkafka.NewKafkaID(1)))
+	assert.True(t, id.EQ(msgkafka.NewKafkaID(1)))
+}
+
+func TestDeserializeToMQWrapperID(t *testing.T) {
+	rmqID := MustGetMQWrapperIDFromMessage(rmq.NewRmqID(1))
+	id, err := DeserializeToMQWrapperID(rmqID.Serialize(), "rocksmq")
+	assert.NoError(t, err)
+	assert.True(t, MustGetMessageIDFromMQWrapperID(id).EQ(rmq.NewRmqID(1)))
+	assert.True(t, MustGetMessageIDFromMQWrapperIDBytes("rocksmq", rmqID.Serialize()).EQ(rmq.NewRmqID(1)))
+
+	kafkaID := MustGetMQWrapperIDFromMessage(msgkafka.NewKafkaID(1))
+	id, err = DeserializeToMQWrapperID(kafkaID.Serialize(), "kafka")
+	assert.NoError(t, err)
+	assert.True(t, MustGetMessageIDFromMQWrapperID(id).EQ(msgkafka.NewKafkaID(1)))
+	assert.True(t, MustGetMessageIDFromMQWrapperIDBytes("kafka", kafkaID.Serialize()).EQ(msgkafka.NewKafkaID(1)))
+
+	_, err = DeserializeToMQWrapperID(kafkaID.Serialize(), "unknown")
+	assert.Error(t, err)
 }
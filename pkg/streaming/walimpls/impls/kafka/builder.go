@@ -0,0 +1,115 @@
+package kafka
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	walName = "kafka"
+)
+
+func init() {
+	// register the builder to the wal registry.
+	registry.RegisterBuilder(&builderImpl{})
+	// register the unmarshaler to the message registry.
+	message.RegisterMessageIDUnmsarshaler(walName, UnmarshalMessageID)
+}
+
+// builderImpl is the builder for kafka wal.
+type builderImpl struct{}
+
+// Name returns the name of the wal.
+func (b *builderImpl) Name() string {
+	return walName
+}
+
+// Build build a wal instance.
+func (b *builderImpl) Build() (walimpls.OpenerImpls, error) {
+	producerConfig, consumerConfig, err := b.getProducerAndConsumerConfig()
+	if err != nil {
+		return nil, errors.Wrapf(err, "build kafka client config failed")
+	}
+	p, err := kafka.NewProducer(producerConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create kafka producer failed")
+	}
+	return newOpenerImpl(p, consumerConfig), nil
+}
+
+// getProducerAndConsumerConfig gets the kafka producer and consumer config from the config.
+func (b *builderImpl) getProducerAndConsumerConfig() (producerConfig *kafka.ConfigMap, consumerConfig kafka.ConfigMap, err error) {
+	cfg := &paramtable.Get().KafkaCfg
+	basicConfig, err := getBasicConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	producerConfig = cloneKafkaConfig(basicConfig)
+	// the wal message may be large, default max message size 10M
+	producerConfig.SetKey("message.max.bytes", 10485760)
+	producerConfig.SetKey("compression.codec", "zstd")
+	// we want to ensure the message send out as soon as possible
+	producerConfig.SetKey("linger.ms", 2)
+	// wal requires the message to be persisted by all in-sync replicas and written exactly once.
+	producerConfig.SetKey("acks", "all")
+	producerConfig.SetKey("enable.idempotence", true)
+	for k, v := range cfg.ProducerExtraConfig.GetValue() {
+		producerConfig.SetKey(k, v)
+	}
+
+	consumerConfig = *cloneKafkaConfig(basicConfig)
+	consumerConfig.SetKey("enable.auto.commit", false)
+	// wal is read by assigning the partition offset directly,
+	// the consumer group is never committed.
+	consumerConfig.SetKey("enable.partition.eof", false)
+	// the scanner may be created before the first message is appended.
+	consumerConfig.SetKey("allow.auto.create.topics", true)
+	for k, v := range cfg.ConsumerExtraConfig.GetValue() {
+		consumerConfig.SetKey(k, v)
+	}
+	return producerConfig, consumerConfig, nil
+}
+
+// getBasicConfig gets the common config of kafka producer and consumer.
+func getBasicConfig(cfg *paramtable.KafkaConfig) (kafka.ConfigMap, error) {
+	config := kafka.ConfigMap{
+		"bootstrap.servers":        cfg.Address.GetValue(),
+		"api.version.request":      true,
+		"reconnect.backoff.ms":     20,
+		"reconnect.backoff.max.ms": 5000,
+	}
+	if (cfg.SaslUsername.GetValue() == "") != (cfg.SaslPassword.GetValue() == "") {
+		return nil, errors.New("enable security mode need config username and password at the same time")
+	}
+	if cfg.SecurityProtocol.GetValue() != "" {
+		config.SetKey("security.protocol", cfg.SecurityProtocol.GetValue())
+	}
+	if cfg.SaslUsername.GetValue() != "" {
+		config.SetKey("sasl.mechanisms", cfg.SaslMechanisms.GetValue())
+		config.SetKey("sasl.username", cfg.SaslUsername.GetValue())
+		config.SetKey("sasl.password", cfg.SaslPassword.GetValue())
+	}
+	if cfg.KafkaUseSSL.GetAsBool() {
+		config.SetKey("ssl.certificate.location", cfg.KafkaTLSCert.GetValue())
+		config.SetKey("ssl.key.location", cfg.KafkaTLSKey.GetValue())
+		config.SetKey("ssl.ca.location", cfg.KafkaTLSCACert.GetValue())
+		if cfg.KafkaTLSKeyPassword.GetValue() != "" {
+			config.SetKey("ssl.key.password", cfg.KafkaTLSKeyPassword.GetValue())
+		}
+	}
+	return config, nil
+}
+
+func cloneKafkaConfig(config kafka.ConfigMap) *kafka.ConfigMap {
+	newConfig := make(kafka.ConfigMap, len(config))
+	for k, v := range config {
+		newConfig[k] = v
+	}
+	return &newConfig
+}
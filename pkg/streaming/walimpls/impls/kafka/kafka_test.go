@@ -0,0 +1,38 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	mockCluster, err := kafka.NewMockCluster(1)
+	if err != nil {
+		panic(err)
+	}
+	defer mockCluster.Close()
+	paramtable.Get().Save(paramtable.Get().KafkaCfg.Address.Key, mockCluster.BootstrapServers())
+	m.Run()
+}
+
+func TestRegistry(t *testing.T) {
+	registeredB := registry.MustGetBuilder(walName)
+	assert.NotNil(t, registeredB)
+	assert.Equal(t, walName, registeredB.Name())
+
+	id, err := message.UnmarshalMessageID(walName, kafkaID(1).Marshal())
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(kafkaID(1)))
+}
+
+func TestKafka(t *testing.T) {
+	walimpls.NewWALImplsTestFramework(t, 100, &builderImpl{}).Run()
+}
@@ -0,0 +1,68 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/streaming/walimpls/helper"
+)
+
+var _ walimpls.OpenerImpls = (*openerImpl)(nil)
+
+// newOpenerImpl creates a new kafka opener.
+func newOpenerImpl(p *kafka.Producer, consumerConfig kafka.ConfigMap) *openerImpl {
+	o := &openerImpl{
+		p:              p,
+		consumerConfig: consumerConfig,
+		eventLoopDone:  make(chan struct{}),
+	}
+	go o.execute()
+	return o
+}
+
+// openerImpl is the opener for kafka wal.
+// All wal instances share the same kafka producer.
+type openerImpl struct {
+	p              *kafka.Producer
+	consumerConfig kafka.ConfigMap
+	eventLoopDone  chan struct{}
+}
+
+// Open opens a wal instance.
+func (o *openerImpl) Open(ctx context.Context, opt *walimpls.OpenOption) (walimpls.WALImpls, error) {
+	return &walImpl{
+		WALHelper:      helper.NewWALHelper(opt),
+		p:              o.p,
+		consumerConfig: o.consumerConfig,
+	}, nil
+}
+
+// execute consumes the events of the producer which are not delivered to the delivery channel.
+// The events channel must be drained, otherwise the producer will be blocked.
+func (o *openerImpl) execute() {
+	defer close(o.eventLoopDone)
+	for ev := range o.p.Events() {
+		switch ev := ev.(type) {
+		case kafka.Error:
+			// Generic client instance-level errors, such as broker connection failures,
+			// authentication issues, etc.
+			log.Warn("kafka producer error", zap.Bool("fatal", ev.IsFatal()), zap.Error(ev))
+		default:
+			log.Debug("kafka producer event", zap.Any("event", ev))
+		}
+	}
+}
+
+// Close closes the opener resources.
+func (o *openerImpl) Close() {
+	// flush in-flight messages within queue before closing.
+	if remain := o.p.Flush(10000); remain > 0 {
+		log.Warn("there are still un-flushed outstanding events when closing kafka producer", zap.Int("eventNum", remain))
+	}
+	o.p.Close()
+	<-o.eventLoopDone
+}
@@ -0,0 +1,90 @@
+package kafka
+
+import (
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/streaming/walimpls/helper"
+)
+
+// pollTimeoutMs is the timeout of each poll, the scanner checks whether it's closed between two polls.
+const pollTimeoutMs = 100
+
+var _ walimpls.ScannerImpls = (*scannerImpl)(nil)
+
+// newScanner creates a new scanner.
+func newScanner(scannerName string, consumer *kafka.Consumer) *scannerImpl {
+	s := &scannerImpl{
+		ScannerHelper: helper.NewScannerHelper(scannerName),
+		consumer:      consumer,
+		msgChannel:    make(chan message.ImmutableMessage, 1),
+	}
+	go s.executeConsume()
+	return s
+}
+
+// scannerImpl is the implementation of ScannerImpls for kafka.
+type scannerImpl struct {
+	*helper.ScannerHelper
+	consumer   *kafka.Consumer
+	msgChannel chan message.ImmutableMessage
+}
+
+// Chan returns the channel of message.
+func (s *scannerImpl) Chan() <-chan message.ImmutableMessage {
+	return s.msgChannel
+}
+
+// Close the scanner, release the underlying resources.
+// Return the error same with `Error`
+func (s *scannerImpl) Close() error {
+	err := s.ScannerHelper.Close()
+	s.consumer.Close()
+	return err
+}
+
+// executeConsume consumes the message from the consumer.
+func (s *scannerImpl) executeConsume() {
+	defer close(s.msgChannel)
+	for {
+		select {
+		case <-s.Context().Done():
+			s.Finish(nil)
+			return
+		default:
+		}
+
+		switch ev := s.consumer.Poll(pollTimeoutMs).(type) {
+		case *kafka.Message:
+			if ev.TopicPartition.Error != nil {
+				s.Finish(ev.TopicPartition.Error)
+				return
+			}
+			properties := make(map[string]string, len(ev.Headers))
+			for _, header := range ev.Headers {
+				properties[header.Key] = string(header.Value)
+			}
+			newImmutableMessage := message.NewImmutableMesasge(
+				kafkaID(ev.TopicPartition.Offset),
+				ev.Value,
+				properties,
+			)
+			select {
+			case <-s.Context().Done():
+				s.Finish(nil)
+				return
+			case s.msgChannel <- newImmutableMessage:
+			}
+		case kafka.Error:
+			if ev.IsFatal() {
+				s.Finish(ev)
+				return
+			}
+			// non-fatal errors, such as the topic is not created yet, are retried by the client.
+			log.Debug("kafka consumer error", zap.String("scanner", s.Name()), zap.Error(ev))
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/streaming/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/streaming/walimpls/helper"
+)
+
+const (
+	// defaultPartition is the only partition of the topic used by wal,
+	// the order of messages is only guaranteed in one partition.
+	defaultPartition = int32(0)
+)
+
+var _ walimpls.WALImpls = (*walImpl)(nil)
+
+type walImpl struct {
+	*helper.WALHelper
+	p              *kafka.Producer
+	consumerConfig kafka.ConfigMap
+}
+
+func (w *walImpl) WALName() string {
+	return walName
+}
+
+func (w *walImpl) Append(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	properties := msg.Properties().ToRawMap()
+	headers := make([]kafka.Header, 0, len(properties))
+	for key, value := range properties {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+	topic := w.Channel().Name
+	deliveryChan := make(chan kafka.Event, 1)
+	if err := w.p.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: defaultPartition},
+		Value:          msg.Payload(),
+		Headers:        headers,
+	}, deliveryChan); err != nil {
+		w.Log().RatedWarn(1, "send message to kafka failed", zap.Error(err))
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case ev := <-deliveryChan:
+		m, ok := ev.(*kafka.Message)
+		if !ok {
+			return nil, errors.Errorf("unexpected kafka delivery event, %s", ev.String())
+		}
+		if m.TopicPartition.Error != nil {
+			w.Log().RatedWarn(1, "send message to kafka failed", zap.Error(m.TopicPartition.Error))
+			return nil, m.TopicPartition.Error
+		}
+		return kafkaID(m.TopicPartition.Offset), nil
+	}
+}
+
+func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (s walimpls.ScannerImpls, err error) {
+	// offset of kafka is continuous in one partition, so the start after policy can be implemented by offset + 1.
+	var offset kafka.Offset
+	switch t := opt.DeliverPolicy.GetPolicy().(type) {
+	case *streamingpb.DeliverPolicy_All:
+		offset = kafka.OffsetBeginning
+	case *streamingpb.DeliverPolicy_Latest:
+		offset = kafka.OffsetEnd
+	case *streamingpb.DeliverPolicy_StartFrom:
+		id, err := unmarshalMessageID(t.StartFrom.GetId())
+		if err != nil {
+			return nil, err
+		}
+		offset = kafka.Offset(id)
+	case *streamingpb.DeliverPolicy_StartAfter:
+		id, err := unmarshalMessageID(t.StartAfter.GetId())
+		if err != nil {
+			return nil, err
+		}
+		offset = kafka.Offset(id + 1)
+	default:
+		return nil, errors.Errorf("unsupported deliver policy %T", t)
+	}
+
+	consumerConfig := cloneKafkaConfig(w.consumerConfig)
+	consumerConfig.SetKey("group.id", opt.Name)
+	if opt.ReadAheadBufferSize > 0 {
+		consumerConfig.SetKey("queued.min.messages", opt.ReadAheadBufferSize)
+	}
+	c, err := kafka.NewConsumer(consumerConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "create kafka consumer failed")
+	}
+	topic := w.Channel().Name
+	if err := c.Assign([]kafka.TopicPartition{{Topic: &topic, Partition: defaultPartition, Offset: offset}}); err != nil {
+		c.Close()
+		return nil, errors.Wrap(err, "assign kafka consumer failed")
+	}
+	return newScanner(opt.Name, c), nil
+}
+
+func (w *walImpl) Close() {
+	// the producer is shared by all wal instances, it's closed by the opener.
+}
@@ -1,6 +1,8 @@
 package compressor
 
 import (
+	"compress/gzip"
+	"fmt"
 	"io"
 
 	"github.com/klauspost/compress/zstd"
@@ -10,6 +12,7 @@ type CompressType string
 
 const (
 	CompressTypeZstd CompressType = "zstd"
+	CompressTypeGzip CompressType = "gzip"
 
 	DefaultCompressAlgorithm CompressType = CompressTypeZstd
 )
@@ -193,3 +196,20 @@ func ZstdCompressBytes(src, dst []byte) []byte {
 func ZstdDecompressBytes(src, dst []byte) ([]byte, error) {
 	return globalZstdDecompressor.DecodeAll(src, dst)
 }
+
+// Use case: decompress stream, read the decompressed data from the returned reader
+// Close the returned reader to release the decompressor, the `in` reader is not closed
+func NewDecompressReader(in io.Reader, compressType CompressType) (io.ReadCloser, error) {
+	switch compressType {
+	case CompressTypeZstd:
+		dec, err := zstd.NewReader(in)
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	case CompressTypeGzip:
+		return gzip.NewReader(in)
+	default:
+		return nil, fmt.Errorf("unsupported compress type: %s", compressType)
+	}
+}
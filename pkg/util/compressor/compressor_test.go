@@ -2,6 +2,7 @@ package compressor
 
 import (
 	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"strings"
@@ -160,6 +161,38 @@ func TestCurrencyGlobalMethods(t *testing.T) {
 	wg.Wait()
 }
 
+func TestNewDecompressReader(t *testing.T) {
+	data := "hello decompress reader!"
+
+	compressed := new(bytes.Buffer)
+	err := ZstdCompress(strings.NewReader(data), compressed)
+	assert.NoError(t, err)
+	r, err := NewDecompressReader(compressed, CompressTypeZstd)
+	assert.NoError(t, err)
+	origin, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, data, string(origin))
+	assert.NoError(t, r.Close())
+
+	compressed.Reset()
+	w := gzip.NewWriter(compressed)
+	_, err = w.Write([]byte(data))
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	r, err = NewDecompressReader(compressed, CompressTypeGzip)
+	assert.NoError(t, err)
+	origin, err = io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, data, string(origin))
+	assert.NoError(t, r.Close())
+
+	// Invalid data and type
+	_, err = NewDecompressReader(strings.NewReader(data), CompressTypeGzip)
+	assert.Error(t, err)
+	_, err = NewDecompressReader(strings.NewReader(data), "lz4")
+	assert.Error(t, err)
+}
+
 type ErrReader struct {
 	Err error
 }
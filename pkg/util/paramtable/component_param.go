@@ -1212,6 +1212,8 @@ type proxyConfig struct {
 
 	SlowQuerySpanInSeconds ParamItem `refreshable:"true"`
 	QueryNodePoolingSize   ParamItem `refreshable:"false"`
+
+	ExprCacheCapacity ParamItem `refreshable:"false"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -1477,7 +1479,9 @@ please adjust in embedded Milvus: false`,
 		KeyPrefix: "proxy.accessLog.formatters.",
 		Version:   "2.3.4",
 		Export:    true,
-		Doc:       "Access log formatters for specified methods, if not set, use the base formatter.",
+		Doc: `Access log formatters for specified methods, if not set, use the base formatter.
+A formatter could set "type: json" to log one json object per request instead of the "format" template,
+and "sampleRatio" in [0, 1] to log only a part of the successful requests, failed requests are always logged.`,
 	}
 	p.AccessLog.Formatter.Init(base.mgr)
 
@@ -1623,6 +1627,15 @@ please adjust in embedded Milvus: false`,
 		Export:       true,
 	}
 	p.QueryNodePoolingSize.Init(base.mgr)
+
+	p.ExprCacheCapacity = ParamItem{
+		Key:          "proxy.exprCache.capacity",
+		Version:      "2.5.0",
+		Doc:          "the max number of parsed filter expression templates cached by proxy, 0 means the cache is disabled",
+		DefaultValue: "1024",
+		Export:       true,
+	}
+	p.ExprCacheCapacity.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -2310,6 +2323,12 @@ type queryNodeConfig struct {
 	ReadAheadPolicy     ParamItem `refreshable:"false"`
 	ChunkCacheWarmingUp ParamItem `refreshable:"true"`
 
+	// remote object cache on local disk
+	RemoteCacheEnabled  ParamItem `refreshable:"false"`
+	RemoteCacheDirPath  ParamItem `refreshable:"false"`
+	RemoteCacheCapacity ParamItem `refreshable:"false"`
+	RemoteCacheTTL      ParamItem `refreshable:"false"`
+
 	GroupEnabled          ParamItem `refreshable:"true"`
 	MaxReceiveChanSize    ParamItem `refreshable:"false"`
 	MaxUnsolvedQueueSize  ParamItem `refreshable:"true"`
@@ -2669,6 +2688,48 @@ for a specific duration post-load, albeit accompanied by a concurrent increase i
 	}
 	p.ChunkCacheWarmingUp.Init(base.mgr)
 
+	p.RemoteCacheEnabled = ParamItem{
+		Key:          "queryNode.remoteCache.enabled",
+		Version:      "2.5.0",
+		DefaultValue: "false",
+		Doc:          "Enable caching the objects read from the remote storage on local disk",
+		Export:       true,
+	}
+	p.RemoteCacheEnabled.Init(base.mgr)
+
+	p.RemoteCacheDirPath = ParamItem{
+		Key:          "queryNode.remoteCache.dirPath",
+		Version:      "2.5.0",
+		DefaultValue: "",
+		Formatter: func(v string) string {
+			if len(v) == 0 {
+				return path.Join(base.Get("localStorage.path"), "remote_cache")
+			}
+			return v
+		},
+		Doc:    "The local directory of the remote object cache, it's cleaned up when the query node starts",
+		Export: true,
+	}
+	p.RemoteCacheDirPath.Init(base.mgr)
+
+	p.RemoteCacheCapacity = ParamItem{
+		Key:          "queryNode.remoteCache.capacity",
+		Version:      "2.5.0",
+		DefaultValue: "10240",
+		Doc:          "The max disk size in MB used by the remote object cache, the least recently used objects are evicted when it's exceeded",
+		Export:       true,
+	}
+	p.RemoteCacheCapacity.Init(base.mgr)
+
+	p.RemoteCacheTTL = ParamItem{
+		Key:          "queryNode.remoteCache.ttl",
+		Version:      "2.5.0",
+		DefaultValue: "0",
+		Doc:          "The time in seconds a cached object stays valid, 0 means the cached objects never expire",
+		Export:       true,
+	}
+	p.RemoteCacheTTL.Init(base.mgr)
+
 	p.GroupEnabled = ParamItem{
 		Key:          "queryNode.grouping.enabled",
 		Version:      "2.0.0",
@@ -3121,6 +3182,12 @@ type dataCoordConfig struct {
 	MaxFilesPerImportReq     ParamItem `refreshable:"true"`
 	WaitForIndex             ParamItem `refreshable:"true"`
 
+	// export
+	ExportTaskRetention      ParamItem `refreshable:"true"`
+	ExportScheduleInterval   ParamItem `refreshable:"true"`
+	MaxConcurrentExportTasks ParamItem `refreshable:"false"`
+	MaxExportFileSize        ParamItem `refreshable:"true"`
+
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 
 	ClusteringCompactionSlotUsage ParamItem `refreshable:"true"`
@@ -3891,6 +3958,46 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.WaitForIndex.Init(base.mgr)
 
+	p.ExportTaskRetention = ParamItem{
+		Key:          "dataCoord.export.taskRetention",
+		Version:      "2.4.0",
+		Doc:          "The retention period in seconds for export jobs in the Completed or Failed state, the exported files are kept.",
+		DefaultValue: "10800",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ExportTaskRetention.Init(base.mgr)
+
+	p.ExportScheduleInterval = ParamItem{
+		Key:          "dataCoord.export.scheduleInterval",
+		Version:      "2.4.0",
+		Doc:          "The interval for scheduling export, measured in seconds.",
+		DefaultValue: "2",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ExportScheduleInterval.Init(base.mgr)
+
+	p.MaxConcurrentExportTasks = ParamItem{
+		Key:          "dataCoord.export.maxConcurrentTaskNum",
+		Version:      "2.4.0",
+		Doc:          "The maximum number of export tasks allowed to run concurrently on the datacoord, each task exports one segment.",
+		DefaultValue: "4",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxConcurrentExportTasks.Init(base.mgr)
+
+	p.MaxExportFileSize = ParamItem{
+		Key:          "dataCoord.export.maxFileSize",
+		Version:      "2.4.0",
+		Doc:          "The maximum size in MB of an exported parquet file, the rows of a segment are split into multiple files beyond it.",
+		DefaultValue: "64",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxExportFileSize.Init(base.mgr)
+
 	p.GracefulStopTimeout = ParamItem{
 		Key:          "dataCoord.gracefulStopTimeout",
 		Version:      "2.3.7",
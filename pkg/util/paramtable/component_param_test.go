@@ -17,6 +17,7 @@
 package paramtable
 
 import (
+	"path"
 	"testing"
 	"time"
 
@@ -204,6 +205,8 @@ func TestComponentParam(t *testing.T) {
 		assert.False(t, Params.SkipPartitionKeyCheck.GetAsBool())
 		params.Save("proxy.skipPartitionKeyCheck", "true")
 		assert.True(t, Params.SkipPartitionKeyCheck.GetAsBool())
+
+		assert.Equal(t, 1024, Params.ExprCacheCapacity.GetAsInt())
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -381,6 +384,10 @@ func TestComponentParam(t *testing.T) {
 		// chunk cache
 		assert.Equal(t, "willneed", Params.ReadAheadPolicy.GetValue())
 		assert.Equal(t, "disable", Params.ChunkCacheWarmingUp.GetValue())
+		assert.False(t, Params.RemoteCacheEnabled.GetAsBool())
+		assert.Equal(t, int64(10240), Params.RemoteCacheCapacity.GetAsInt64())
+		assert.Equal(t, 0, Params.RemoteCacheTTL.GetAsInt())
+		assert.Equal(t, path.Join(params.LocalStorageCfg.Path.GetValue(), "remote_cache"), Params.RemoteCacheDirPath.GetValue())
 
 		// test small indexNlist/NProbe default
 		params.Remove("queryNode.segcore.smallIndex.nlist")
@@ -471,6 +478,10 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 120*time.Second, Params.ImportCheckIntervalLow.GetAsDuration(time.Second))
 		assert.Equal(t, 1024, Params.MaxFilesPerImportReq.GetAsInt())
 		assert.Equal(t, true, Params.WaitForIndex.GetAsBool())
+		assert.Equal(t, 10800*time.Second, Params.ExportTaskRetention.GetAsDuration(time.Second))
+		assert.Equal(t, 2*time.Second, Params.ExportScheduleInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 4, Params.MaxConcurrentExportTasks.GetAsInt())
+		assert.Equal(t, 64, Params.MaxExportFileSize.GetAsInt())
 
 		params.Save("datacoord.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
@@ -131,6 +131,7 @@ type quotaConfig struct {
 	NQLimit                        ParamItem `refreshable:"true"`
 	MaxQueryResultWindow           ParamItem `refreshable:"true"`
 	MaxOutputSize                  ParamItem `refreshable:"true"`
+	MaxAggregationGroups           ParamItem `refreshable:"true"`
 	MaxInsertSize                  ParamItem `refreshable:"true"`
 	MaxResourceGroupNumOfQueryNode ParamItem `refreshable:"true"`
 
@@ -1573,6 +1574,14 @@ Check https://milvus.io/docs/limitations.md for more details.`,
 	}
 	p.MaxOutputSize.Init(base.mgr)
 
+	p.MaxAggregationGroups = ParamItem{
+		Key:          "quotaAndLimits.limits.maxAggregationGroups",
+		Version:      "2.5.0",
+		DefaultValue: "100000",
+		Doc:          `Query limit, which applies on: maximum number of groups of an aggregation query, -1 means no limit`,
+	}
+	p.MaxAggregationGroups.Init(base.mgr)
+
 	p.MaxInsertSize = ParamItem{
 		Key:          "quotaAndLimits.limits.maxInsertSize",
 		Version:      "2.4.1",
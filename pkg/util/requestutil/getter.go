@@ -168,6 +168,18 @@ func GetConsistencyLevelFromRequst(req interface{}) (commonpb.ConsistencyLevel,
 	return getter.GetConsistencyLevel(), true
 }
 
+type NqGetter interface {
+	GetNq() int64
+}
+
+func GetNqFromRequest(req interface{}) (any, bool) {
+	getter, ok := req.(NqGetter)
+	if !ok {
+		return nil, false
+	}
+	return getter.GetNq(), true
+}
+
 var TraceLogBaseInfoFuncMap = map[string]func(interface{}) (any, bool){
 	"collection_name": GetCollectionNameFromRequest,
 	"db_name":         GetDbNameFromRequest,
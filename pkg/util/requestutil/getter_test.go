@@ -456,6 +456,47 @@ func TestGetDSLFromRequest(t *testing.T) {
 	}
 }
 
+func TestGetNqFromRequest(t *testing.T) {
+	type args struct {
+		req interface{}
+	}
+	tests := []struct {
+		name  string
+		args  args
+		want  any
+		want1 bool
+	}{
+		{
+			name: "ok",
+			args: args{
+				req: &milvuspb.SearchRequest{
+					Nq: 10,
+				},
+			},
+			want:  int64(10),
+			want1: true,
+		},
+		{
+			name: "fail",
+			args: args{
+				req: &commonpb.Status{},
+			},
+			want1: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, got1 := GetNqFromRequest(tt.args.req)
+			if got1 && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNqFromRequest() got = %v, want %v", got, tt.want)
+			}
+			if got1 != tt.want1 {
+				t.Errorf("GetNqFromRequest() got1 = %v, want %v", got1, tt.want1)
+			}
+		})
+	}
+}
+
 func TestGetStatusFromResponse(t *testing.T) {
 	type args struct {
 		resp interface{}
@@ -260,3 +260,53 @@ func GenEmptyFieldData(field *schemapb.FieldSchema) (*schemapb.FieldData, error)
 		return nil, fmt.Errorf("unsupported data type: %s", dataType.String())
 	}
 }
+
+// GenDefaultFieldData generates the column of numRows rows of a scalar field, every row of which is the default value
+// of the field, or null if the field has no default value. It fills the field for the rows written before the field
+// is added to the collection.
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	if !field.GetNullable() && field.GetDefaultValue() == nil {
+		return nil, fmt.Errorf("field %s is neither nullable nor has default value", field.GetName())
+	}
+	fieldData, err := GenEmptyFieldData(field)
+	if err != nil {
+		return nil, err
+	}
+	defaultValue := field.GetDefaultValue()
+	switch data := fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		data.BoolData.Data = repeat(defaultValue.GetBoolData(), numRows)
+	case *schemapb.ScalarField_IntData:
+		data.IntData.Data = repeat(defaultValue.GetIntData(), numRows)
+	case *schemapb.ScalarField_LongData:
+		data.LongData.Data = repeat(defaultValue.GetLongData(), numRows)
+	case *schemapb.ScalarField_FloatData:
+		data.FloatData.Data = repeat(defaultValue.GetFloatData(), numRows)
+	case *schemapb.ScalarField_DoubleData:
+		data.DoubleData.Data = repeat(defaultValue.GetDoubleData(), numRows)
+	case *schemapb.ScalarField_StringData:
+		data.StringData.Data = repeat(defaultValue.GetStringData(), numRows)
+	case *schemapb.ScalarField_JsonData:
+		data.JsonData.Data = repeat(defaultValue.GetBytesData(), numRows)
+	case *schemapb.ScalarField_ArrayData:
+		// arrays have no default value, the rows are null
+		data.ArrayData.Data = make([]*schemapb.ScalarField, numRows)
+		for i := range data.ArrayData.Data {
+			data.ArrayData.Data[i] = &schemapb.ScalarField{}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported data type to generate default value: %s", field.GetDataType().String())
+	}
+	if field.GetNullable() {
+		fieldData.ValidData = repeat(defaultValue != nil, numRows)
+	}
+	return fieldData, nil
+}
+
+func repeat[T any](value T, n int) []T {
+	values := make([]T, n)
+	for i := range values {
+		values[i] = value
+	}
+	return values
+}
@@ -2438,3 +2438,33 @@ func TestParseJsonSparseFloatRowBytes(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestGenDefaultFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:      101,
+		Name:         "age",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 10}},
+	}
+	fieldData, err := GenDefaultFieldData(field, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), fieldData.GetFieldId())
+	assert.Equal(t, []int64{10, 10, 10}, fieldData.GetScalars().GetLongData().GetData())
+	assert.Nil(t, fieldData.GetValidData())
+
+	field = &schemapb.FieldSchema{FieldID: 102, Name: "name", DataType: schemapb.DataType_VarChar, Nullable: true}
+	fieldData, err = GenDefaultFieldData(field, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", ""}, fieldData.GetScalars().GetStringData().GetData())
+	assert.Equal(t, []bool{false, false}, fieldData.GetValidData())
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}}
+	fieldData, err = GenDefaultFieldData(field, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "a"}, fieldData.GetScalars().GetStringData().GetData())
+	assert.Equal(t, []bool{true, true}, fieldData.GetValidData())
+
+	// the field must be nullable or have a default value
+	_, err = GenDefaultFieldData(&schemapb.FieldSchema{FieldID: 103, Name: "score", DataType: schemapb.DataType_Float}, 1)
+	assert.Error(t, err)
+}